@@ -20,6 +20,30 @@ ClusterRole
 {{- end -}}
 {{- end -}}
 
+{{/*
+Selects the namespaces whose secrets are validated by the gateway webhook: the namespaces gloo watches, or every
+namespace but the Kubernetes system namespaces. Namespaces are matched by the kubernetes.io/metadata.name label,
+which Kubernetes sets on every namespace since 1.21.
+*/}}
+{{- define "gloo.secretValidationNamespaceSelector" -}}
+matchExpressions:
+- key: kubernetes.io/metadata.name
+{{- if .Values.settings.singleNamespace }}
+  operator: In
+  values: [{{ .Release.Namespace | quote }}]
+{{- else if .Values.settings.watchNamespaces }}
+  operator: In
+  values:
+  - {{ .Release.Namespace | quote }}
+  {{- range .Values.settings.watchNamespaces }}
+  - {{ . | quote }}
+  {{- end }}
+{{- else }}
+  operator: NotIn
+  values: ["kube-system", "kube-public", "kube-node-lease"]
+{{- end }}
+{{- end -}}
+
 {{/*
 Expand the name of a container image
 */}}
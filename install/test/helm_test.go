@@ -1736,7 +1736,36 @@ webhooks:
        apiGroups: ["gateway.solo.io"]
        apiVersions: ["v1"]
        resources: ["*"]
+     - operations: [ "CREATE", "UPDATE", "DELETE" ]
+       apiGroups: ["gloo.solo.io"]
+       apiVersions: ["v1"]
+       resources: ["upstreams", "upstreamgroups"]
+     - operations: [ "CREATE", "UPDATE" ]
+       apiGroups: ["gloo.solo.io"]
+       apiVersions: ["v1"]
+       resources: ["settings"]
+   sideEffects: None
+   admissionReviewVersions: ["v1", "v1beta1"]
+   failurePolicy: Ignore
+ - name: secrets.gateway.` + namespace + `.svc
+   clientConfig:
+     service:
+       name: gateway
+       namespace: ` + namespace + `
+       path: "/validation"
+     caBundle: ""
+   rules:
+     - operations: [ "CREATE", "UPDATE", "DELETE" ]
+       apiGroups: [""]
+       apiVersions: ["v1"]
+       resources: ["secrets"]
+   namespaceSelector:
+     matchExpressions:
+       - key: kubernetes.io/metadata.name
+         operator: NotIn
+         values: ["kube-system", "kube-public", "kube-node-lease"]
    sideEffects: None
+   admissionReviewVersions: ["v1", "v1beta1"]
    failurePolicy: Ignore
 
 `)
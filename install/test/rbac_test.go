@@ -456,6 +456,92 @@ var _ = Describe("RBAC Test", func() {
 					})
 				})
 			})
+			Context("leader-elector", func() {
+				BeforeEach(func() {
+					resourceBuilder = ResourceBuilder{
+						Name: "leader-elector",
+						Labels: map[string]string{
+							"app":  "gloo",
+							"gloo": "rbac",
+						},
+						Rules: []rbacv1.PolicyRule{
+							{
+								APIGroups: []string{"coordination.k8s.io"},
+								Resources: []string{"leases"},
+								Verbs:     []string{"get", "create", "update"},
+							},
+						},
+						RoleRef: rbacv1.RoleRef{
+							APIGroup: "rbac.authorization.k8s.io",
+							Kind:     "ClusterRole",
+							Name:     "leader-elector",
+						},
+						Subjects: []rbacv1.Subject{{
+							Kind:      "ServiceAccount",
+							Name:      "gloo",
+							Namespace: namespace,
+						}, {
+							Kind:      "ServiceAccount",
+							Name:      "gateway",
+							Namespace: namespace,
+						}, {
+							Kind:      "ServiceAccount",
+							Name:      "discovery",
+							Namespace: namespace,
+						}},
+					}
+				})
+				Context("cluster scope", func() {
+					It("role", func() {
+						resourceBuilder.Name += "-" + namespace
+						prepareMakefile("global.glooRbac.namespaced=false")
+						testManifest.ExpectClusterRole(resourceBuilder.GetClusterRole())
+					})
+
+					It("role binding", func() {
+						resourceBuilder.Name += "-binding-" + namespace
+						resourceBuilder.RoleRef.Name += "-" + namespace
+						prepareMakefile("global.glooRbac.namespaced=false")
+						testManifest.ExpectClusterRoleBinding(resourceBuilder.GetClusterRoleBinding())
+					})
+				})
+				Context("namespace scope", func() {
+					BeforeEach(func() {
+						resourceBuilder.RoleRef.Kind = "Role"
+						resourceBuilder.Namespace = namespace
+					})
+
+					It("role", func() {
+						prepareMakefile("global.glooRbac.namespaced=true")
+						testManifest.ExpectRole(resourceBuilder.GetRole())
+					})
+
+					It("role binding", func() {
+						resourceBuilder.Name += "-binding"
+						prepareMakefile("global.glooRbac.namespaced=true")
+						testManifest.ExpectRoleBinding(resourceBuilder.GetRoleBinding())
+					})
+				})
+				Context("without the gateway", func() {
+					BeforeEach(func() {
+						resourceBuilder.RoleRef.Kind = "Role"
+						resourceBuilder.Namespace = namespace
+						// gloo and discovery still elect a leader, there is no gateway service account to bind
+						resourceBuilder.Subjects = []rbacv1.Subject{resourceBuilder.Subjects[0], resourceBuilder.Subjects[2]}
+					})
+
+					It("role", func() {
+						prepareMakefile("global.glooRbac.namespaced=true", "gateway.enabled=false")
+						testManifest.ExpectRole(resourceBuilder.GetRole())
+					})
+
+					It("role binding", func() {
+						resourceBuilder.Name += "-binding"
+						prepareMakefile("global.glooRbac.namespaced=true", "gateway.enabled=false")
+						testManifest.ExpectRoleBinding(resourceBuilder.GetRoleBinding())
+					})
+				})
+			})
 		})
 	}
 
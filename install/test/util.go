@@ -30,6 +30,12 @@ func GetServiceAccountPermissions(namespace string) *manifesttestutils.ServiceAc
 		[]string{"gateway.solo.io"},
 		[]string{"virtualservices", "routetables"},
 		[]string{"get", "list", "watch", "update"})
+	permissions.AddExpectedPermission(
+		"gloo-system.gateway",
+		namespace,
+		[]string{"coordination.k8s.io"},
+		[]string{"leases"},
+		[]string{"get", "create", "update"})
 
 	// Gloo
 	permissions.AddExpectedPermission(
@@ -63,6 +69,12 @@ func GetServiceAccountPermissions(namespace string) *manifesttestutils.ServiceAc
 		[]string{"ratelimit.solo.io"},
 		[]string{"ratelimitconfigs", "ratelimitconfigs/status"},
 		[]string{"get", "list", "watch", "update"})
+	permissions.AddExpectedPermission(
+		"gloo-system.gloo",
+		namespace,
+		[]string{"coordination.k8s.io"},
+		[]string{"leases"},
+		[]string{"get", "create", "update"})
 
 	// Discovery
 	permissions.AddExpectedPermission(
@@ -83,6 +95,12 @@ func GetServiceAccountPermissions(namespace string) *manifesttestutils.ServiceAc
 		[]string{"gloo.solo.io"},
 		[]string{"upstreams"},
 		[]string{"get", "list", "watch", "create", "update", "delete"})
+	permissions.AddExpectedPermission(
+		"gloo-system.discovery",
+		namespace,
+		[]string{"coordination.k8s.io"},
+		[]string{"leases"},
+		[]string{"get", "create", "update"})
 
 	return permissions
 }
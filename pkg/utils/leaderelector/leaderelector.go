@@ -0,0 +1,285 @@
+package leaderelector
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/solo-io/gloo/pkg/utils"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/go-utils/kubeutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	"go.opencensus.io/tag"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+var (
+	leaseKey, _ = tag.NewKey("lease")
+
+	mLeader = utils.MakeLastValueCounter("gloo.solo.io/leader", "Whether this replica is the leader (1) or not (0)", leaseKey)
+)
+
+const (
+	defaultLeaseDuration = 15 * time.Second
+	defaultRenewDeadline = 10 * time.Second
+	defaultRetryPeriod   = 2 * time.Second
+)
+
+// Identity tells a replica whether it is the leader. Only the leader writes statuses and discovered resources,
+// while every replica serves xDS and validation.
+type Identity interface {
+	IsLeader() bool
+	// Elected returns a channel which is closed when this replica becomes the leader. It is already closed while
+	// the replica leads.
+	Elected() <-chan struct{}
+	// Demoted returns a channel which is closed when this replica stops leading. It is already closed while the
+	// replica doesn't lead.
+	Demoted() <-chan struct{}
+}
+
+// AlwaysLeader returns the Identity of a replica which doesn't take part in leader election, so always leads.
+func AlwaysLeader() Identity {
+	return alwaysLeader{}
+}
+
+type alwaysLeader struct{}
+
+var elected = func() chan struct{} {
+	c := make(chan struct{})
+	close(c)
+	return c
+}()
+
+func (alwaysLeader) IsLeader() bool           { return true }
+func (alwaysLeader) Elected() <-chan struct{} { return elected }
+func (alwaysLeader) Demoted() <-chan struct{} { return nil }
+
+// ForSettings starts electing the leader of the replicas of the named component if leader election is enabled in
+// settings, until ctx is done. Otherwise it returns AlwaysLeader.
+func ForSettings(ctx context.Context, settings *v1.Settings, name string) (Identity, error) {
+	election := settings.GetKubernetes().GetLeaderElection()
+	if !election.GetEnabled() {
+		return AlwaysLeader(), nil
+	}
+	cfg, err := kubeutils.GetConfig("", "")
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting kube config for leader election")
+	}
+	kubeClient, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return nil, errors.Wrapf(err, "creating kube client for leader election")
+	}
+	namespace := settings.GetDiscoveryNamespace()
+	if namespace == "" {
+		namespace = defaults.GlooSystem
+	}
+	return StartKubeElection(ctx, kubeClient, election, namespace, name)
+}
+
+// StartKubeElection starts electing the leader of the replicas of the named component with the `<name>-leader`
+// Lease in namespace, until ctx is done.
+func StartKubeElection(ctx context.Context, kubeClient kubernetes.Interface, election *v1.Settings_KubernetesConfiguration_LeaderElection, namespace, name string) (Identity, error) {
+	id := os.Getenv("POD_NAME")
+	if id == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return nil, errors.Wrapf(err, "getting hostname for leader election")
+		}
+		id = hostname
+	}
+
+	ctx = contextutils.WithLoggerValues(ctx, "lease", name+"-leader")
+	ctx, err := tag.New(ctx, tag.Insert(leaseKey, name+"-leader"))
+	if err != nil {
+		return nil, err
+	}
+	identity := newKubeIdentity(ctx)
+
+	leaseDuration, err := durationOrDefault(election.GetLeaseDuration(), defaultLeaseDuration)
+	if err != nil {
+		return nil, err
+	}
+	renewDeadline, err := durationOrDefault(election.GetRenewDeadline(), defaultRenewDeadline)
+	if err != nil {
+		return nil, err
+	}
+	retryPeriod, err := durationOrDefault(election.GetRetryPeriod(), defaultRetryPeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	config := leaderelection.LeaderElectionConfig{
+		Lock: &resourcelock.LeaseLock{
+			LeaseMeta:  metav1.ObjectMeta{Namespace: namespace, Name: name + "-leader"},
+			Client:     kubeClient.CoordinationV1(),
+			LockConfig: resourcelock.ResourceLockConfig{Identity: id},
+		},
+		LeaseDuration:   leaseDuration,
+		RenewDeadline:   renewDeadline,
+		RetryPeriod:     retryPeriod,
+		ReleaseOnCancel: true,
+		Name:            name,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(context.Context) { identity.setLeader(true) },
+			OnStoppedLeading: func() { identity.setLeader(false) },
+		},
+	}
+	elector, err := leaderelection.NewLeaderElector(config)
+	if err != nil {
+		return nil, errors.Wrapf(err, "configuring leader election")
+	}
+
+	go func() {
+		// Run returns when this replica stops leading, keep running for elections until ctx is done
+		for ctx.Err() == nil {
+			elector.Run(ctx)
+		}
+	}()
+	return identity, nil
+}
+
+func durationOrDefault(duration *types.Duration, defaultDuration time.Duration) (time.Duration, error) {
+	if duration == nil {
+		return defaultDuration, nil
+	}
+	d, err := types.DurationFromProto(duration)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid leader election duration")
+	}
+	return d, nil
+}
+
+type kubeIdentity struct {
+	ctx     context.Context
+	lock    sync.RWMutex
+	leader  bool
+	elected chan struct{}
+	demoted chan struct{}
+}
+
+func newKubeIdentity(ctx context.Context) *kubeIdentity {
+	demoted := make(chan struct{})
+	close(demoted)
+	utils.MeasureZero(ctx, mLeader)
+	return &kubeIdentity{
+		ctx:     ctx,
+		elected: make(chan struct{}),
+		demoted: demoted,
+	}
+}
+
+func (i *kubeIdentity) IsLeader() bool {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+	return i.leader
+}
+
+func (i *kubeIdentity) Elected() <-chan struct{} {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+	return i.elected
+}
+
+func (i *kubeIdentity) Demoted() <-chan struct{} {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+	return i.demoted
+}
+
+func (i *kubeIdentity) setLeader(leader bool) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	if i.leader == leader {
+		return
+	}
+	i.leader = leader
+	logger := contextutils.LoggerFrom(i.ctx)
+	if leader {
+		logger.Infow("started leading")
+		utils.MeasureOne(i.ctx, mLeader)
+		close(i.elected)
+		i.demoted = make(chan struct{})
+	} else {
+		logger.Infow("stopped leading")
+		utils.MeasureZero(i.ctx, mLeader)
+		close(i.demoted)
+		i.elected = make(chan struct{})
+	}
+}
+
+// OnElected calls f every time identity becomes the leader, until ctx is done. It is never called without leader
+// election.
+func OnElected(ctx context.Context, identity Identity, f func()) {
+	if _, ok := identity.(alwaysLeader); ok {
+		return
+	}
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-identity.Elected():
+			}
+			f()
+			select {
+			case <-ctx.Done():
+				return
+			case <-identity.Demoted():
+			}
+		}
+	}()
+}
+
+// RunWhileLeader calls run every time identity becomes the leader, with a context which is done when it stops
+// leading, until ctx is done. Without leader election, run is called right away and its error returned.
+func RunWhileLeader(ctx context.Context, identity Identity, run func(ctx context.Context) error) error {
+	if _, ok := identity.(alwaysLeader); ok {
+		return run(ctx)
+	}
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-identity.Elected():
+			}
+			leaderCtx, cancel := context.WithCancel(ctx)
+			if err := run(leaderCtx); err != nil {
+				contextutils.LoggerFrom(ctx).Errorw("failed to start as leader", zap.Error(err))
+			}
+			select {
+			case <-ctx.Done():
+			case <-identity.Demoted():
+			}
+			cancel()
+		}
+	}()
+	return nil
+}
+
+// NewStatusReporter returns a StatusReporter which only writes reports while identity leads.
+func NewStatusReporter(rpt reporter.StatusReporter, identity Identity) reporter.StatusReporter {
+	return &leaderStatusReporter{StatusReporter: rpt, identity: identity}
+}
+
+type leaderStatusReporter struct {
+	reporter.StatusReporter
+	identity Identity
+}
+
+func (r *leaderStatusReporter) WriteReports(ctx context.Context, reports reporter.ResourceReports, subresourceStatuses map[string]*core.Status) error {
+	if !r.identity.IsLeader() {
+		return nil
+	}
+	return r.StatusReporter.WriteReports(ctx, reports, subresourceStatuses)
+}
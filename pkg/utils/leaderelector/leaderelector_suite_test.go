@@ -0,0 +1,13 @@
+package leaderelector_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestLeaderelector(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Leaderelector Suite")
+}
@@ -0,0 +1,166 @@
+package leaderelector_test
+
+import (
+	"context"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/solo-io/gloo/pkg/utils/leaderelector"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+var _ = Describe("Leader election", func() {
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+	})
+
+	AfterEach(func() {
+		cancel()
+		os.Unsetenv("POD_NAME")
+	})
+
+	Context("without leader election", func() {
+
+		It("always leads", func() {
+			identity, err := ForSettings(ctx, &v1.Settings{}, "gloo")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(identity.IsLeader()).To(BeTrue())
+			Expect(identity.Elected()).To(BeClosed())
+		})
+
+		It("runs right away", func() {
+			var ran bool
+			err := RunWhileLeader(ctx, AlwaysLeader(), func(context.Context) error {
+				ran = true
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ran).To(BeTrue())
+		})
+
+		It("writes reports", func() {
+			rpt := &countingReporter{}
+			err := NewStatusReporter(rpt, AlwaysLeader()).WriteReports(ctx, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rpt.writes).To(BeEquivalentTo(1))
+		})
+	})
+
+	Context("with kubernetes leases", func() {
+
+		var (
+			kubeClient kubernetes.Interface
+			election   *v1.Settings_KubernetesConfiguration_LeaderElection
+		)
+
+		BeforeEach(func() {
+			kubeClient = fake.NewSimpleClientset()
+			election = &v1.Settings_KubernetesConfiguration_LeaderElection{
+				Enabled:       true,
+				LeaseDuration: types.DurationProto(time.Second),
+				RenewDeadline: types.DurationProto(500 * time.Millisecond),
+				RetryPeriod:   types.DurationProto(100 * time.Millisecond),
+			}
+		})
+
+		start := func(ctx context.Context, podName string) Identity {
+			os.Setenv("POD_NAME", podName)
+			identity, err := StartKubeElection(ctx, kubeClient, election, "gloo-system", "gloo")
+			Expect(err).NotTo(HaveOccurred())
+			return identity
+		}
+
+		It("elects a single leader and hands over when it stops", func() {
+			firstCtx, stopFirst := context.WithCancel(ctx)
+			defer stopFirst()
+			first := start(firstCtx, "first")
+			Eventually(first.Elected(), 5*time.Second).Should(BeClosed())
+			Expect(first.IsLeader()).To(BeTrue())
+
+			second := start(ctx, "second")
+			Consistently(second.IsLeader, 2*time.Second).Should(BeFalse())
+			Expect(second.Demoted()).To(BeClosed())
+
+			stopFirst()
+			Eventually(second.IsLeader, 5*time.Second).Should(BeTrue())
+			Expect(first.IsLeader()).To(BeFalse())
+			Expect(first.Demoted()).To(BeClosed())
+		})
+
+		It("runs while leading", func() {
+			firstCtx, stopFirst := context.WithCancel(ctx)
+			defer stopFirst()
+			first := start(firstCtx, "first")
+			Eventually(first.Elected(), 5*time.Second).Should(BeClosed())
+
+			second := start(ctx, "second")
+			runCtxs := make(chan context.Context, 1)
+			err := RunWhileLeader(ctx, second, func(ctx context.Context) error {
+				runCtxs <- ctx
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Consistently(runCtxs, time.Second).ShouldNot(Receive())
+
+			stopFirst()
+			var runCtx context.Context
+			Eventually(runCtxs, 5*time.Second).Should(Receive(&runCtx))
+			Expect(runCtx.Err()).NotTo(HaveOccurred())
+
+			cancel()
+			Eventually(runCtx.Done(), 5*time.Second).Should(BeClosed())
+		})
+
+		It("calls back on election", func() {
+			identity := start(ctx, "first")
+			var calls int32
+			OnElected(ctx, identity, func() { atomic.AddInt32(&calls, 1) })
+			Eventually(func() int32 { return atomic.LoadInt32(&calls) }, 5*time.Second).Should(BeEquivalentTo(1))
+			Consistently(func() int32 { return atomic.LoadInt32(&calls) }, time.Second).Should(BeEquivalentTo(1))
+		})
+
+		It("only writes reports while leading", func() {
+			firstCtx, stopFirst := context.WithCancel(ctx)
+			defer stopFirst()
+			first := start(firstCtx, "first")
+			Eventually(first.Elected(), 5*time.Second).Should(BeClosed())
+			second := start(ctx, "second")
+
+			rpt := &countingReporter{}
+			Expect(NewStatusReporter(rpt, second).WriteReports(ctx, nil, nil)).NotTo(HaveOccurred())
+			Expect(rpt.writes).To(BeEquivalentTo(0))
+
+			stopFirst()
+			Eventually(second.IsLeader, 5*time.Second).Should(BeTrue())
+			Expect(NewStatusReporter(rpt, second).WriteReports(ctx, nil, nil)).NotTo(HaveOccurred())
+			Expect(rpt.writes).To(BeEquivalentTo(1))
+		})
+	})
+})
+
+type countingReporter struct {
+	writes int32
+}
+
+func (r *countingReporter) WriteReports(context.Context, reporter.ResourceReports, map[string]*core.Status) error {
+	atomic.AddInt32(&r.writes, 1)
+	return nil
+}
+
+func (r *countingReporter) StatusFromReport(reporter.Report, map[string]*core.Status) core.Status {
+	return core.Status{}
+}
@@ -30,6 +30,10 @@ type SetupOpts struct {
 	ExitOnError bool
 	CustomCtx   context.Context
 
+	// optional - if present, settings changes are first applied with this func, and the SetupFunc is only called
+	// again when it can't apply them
+	ReloadFunc ReloadFunc
+
 	// optional - if present, add these values in each JSON log line in the gloo pod.
 	// By default, we already log the gloo version.
 	LoggingPrefixVals []interface{}
@@ -76,7 +80,11 @@ func Main(opts SetupOpts) error {
 
 	emitter := v1.NewSetupEmitter(settingsClient)
 	settingsRef := core.ResourceRef{Namespace: setupNamespace, Name: setupName}
-	eventLoop := v1.NewSetupEventLoop(emitter, NewSetupSyncer(settingsRef, opts.SetupFunc))
+	setupSyncer := NewSetupSyncer(settingsRef, opts.SetupFunc)
+	if opts.ReloadFunc != nil {
+		setupSyncer = NewReloadingSetupSyncer(ctx, settingsRef, opts.SetupFunc, opts.ReloadFunc)
+	}
+	eventLoop := v1.NewSetupEventLoop(emitter, setupSyncer)
 	errs, err := eventLoop.Run([]string{setupNamespace}, clients.WatchOpts{
 		Ctx:         ctx,
 		RefreshRate: time.Second,
@@ -24,10 +24,21 @@ type SetupFunc func(ctx context.Context,
 	inMemoryCache memory.InMemoryResourceCache,
 	settings *v1.Settings) error
 
+// ReloadFunc applies new settings to the components started by the last successful SetupFunc call, without
+// restarting them. It returns false if settings changed in a way that requires calling the SetupFunc again.
+type ReloadFunc func(ctx context.Context, previous, settings *v1.Settings) (bool, error)
+
 type SetupSyncer struct {
 	settingsRef   core.ResourceRef
 	setupFunc     SetupFunc
 	inMemoryCache memory.InMemoryResourceCache
+
+	// only used when settings can be reloaded
+	reloadFunc ReloadFunc
+	rootCtx    context.Context
+	// the settings the running setup uses, and how to stop it
+	running       *v1.Settings
+	cancelRunning context.CancelFunc
 }
 
 func NewSetupSyncer(settingsRef core.ResourceRef, setupFunc SetupFunc) *SetupSyncer {
@@ -38,6 +49,16 @@ func NewSetupSyncer(settingsRef core.ResourceRef, setupFunc SetupFunc) *SetupSyn
 	}
 }
 
+// NewReloadingSetupSyncer returns a SetupSyncer which tries to apply settings changes with reloadFunc before
+// calling setupFunc again. As the components started by setupFunc must then outlive a single sync, they run
+// until ctx is done or they are restarted.
+func NewReloadingSetupSyncer(ctx context.Context, settingsRef core.ResourceRef, setupFunc SetupFunc, reloadFunc ReloadFunc) *SetupSyncer {
+	s := NewSetupSyncer(settingsRef, setupFunc)
+	s.rootCtx = ctx
+	s.reloadFunc = reloadFunc
+	return s
+}
+
 func (s *SetupSyncer) Sync(ctx context.Context, snap *v1.SetupSnapshot) error {
 	settings, err := snap.Settings.Find(s.settingsRef.Strings())
 	if err != nil {
@@ -47,6 +68,10 @@ func (s *SetupSyncer) Sync(ctx context.Context, snap *v1.SetupSnapshot) error {
 
 	contextutils.LoggerFrom(ctx).Debugw("received settings snapshot", zap.Any("settings", settings))
 
+	if s.reloadFunc != nil {
+		return s.reloadOrSetup(ctx, settings)
+	}
+
 	utils.MeasureOne(
 		ctx,
 		mSetupsRun,
@@ -54,3 +79,36 @@ func (s *SetupSyncer) Sync(ctx context.Context, snap *v1.SetupSnapshot) error {
 
 	return s.setupFunc(ctx, kube.NewKubeCache(ctx), s.inMemoryCache, settings)
 }
+
+func (s *SetupSyncer) reloadOrSetup(ctx context.Context, settings *v1.Settings) error {
+	logger := contextutils.LoggerFrom(ctx)
+	if s.running != nil {
+		reloaded, err := s.reloadFunc(ctx, s.running, settings)
+		if err != nil {
+			return errors.Wrapf(err, "reloading settings")
+		}
+		if reloaded {
+			logger.Infof("applied settings %v without restarting", s.settingsRef.Key())
+			s.running = settings
+			return nil
+		}
+		logger.Infof("settings %v changed, restarting", s.settingsRef.Key())
+		s.cancelRunning()
+		s.running, s.cancelRunning = nil, nil
+	}
+
+	runCtx, cancel := context.WithCancel(s.rootCtx)
+	runCtx = settingsutil.WithSettings(runCtx, settings)
+
+	utils.MeasureOne(
+		runCtx,
+		mSetupsRun,
+	)
+
+	if err := s.setupFunc(runCtx, kube.NewKubeCache(runCtx), s.inMemoryCache, settings); err != nil {
+		cancel()
+		return err
+	}
+	s.running, s.cancelRunning = settings, cancel
+	return nil
+}
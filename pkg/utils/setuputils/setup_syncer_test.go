@@ -2,7 +2,9 @@ package setuputils_test
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/gogo/protobuf/proto"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
@@ -35,4 +37,95 @@ var _ = Describe("SetupSyncer", func() {
 		Expect(err).NotTo(HaveOccurred())
 		Expect(actualSettings).To(Equal(expectedSettings))
 	})
+
+	Context("reloading settings", func() {
+		var (
+			rootCtx    context.Context
+			cancelRoot context.CancelFunc
+			settings   *v1.Settings
+
+			setupCtxs []context.Context
+			reloaded  []*v1.Settings
+			// returned by the reload func
+			canReload bool
+			reloadErr error
+
+			setupSyncer *SetupSyncer
+		)
+
+		BeforeEach(func() {
+			rootCtx, cancelRoot = context.WithCancel(context.Background())
+			settings = &v1.Settings{
+				Metadata: core.Metadata{Name: "hello", Namespace: "goodbye"},
+			}
+			setupCtxs, reloaded = nil, nil
+			canReload, reloadErr = true, nil
+			setupSyncer = NewReloadingSetupSyncer(
+				rootCtx,
+				settings.Metadata.Ref(),
+				func(ctx context.Context, kubeCache kube.SharedCache, inMemoryCache memory.InMemoryResourceCache, settings *v1.Settings) error {
+					setupCtxs = append(setupCtxs, ctx)
+					return nil
+				},
+				func(ctx context.Context, previous, settings *v1.Settings) (bool, error) {
+					if !canReload || reloadErr != nil {
+						return false, reloadErr
+					}
+					reloaded = append(reloaded, settings)
+					return true, nil
+				})
+		})
+
+		AfterEach(func() {
+			cancelRoot()
+		})
+
+		// syncs settings like the setup event loop, which cancels the context of each sync before the next one
+		sync := func(settings *v1.Settings) error {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			return setupSyncer.Sync(ctx, &v1.SetupSnapshot{Settings: v1.SettingsList{settings}})
+		}
+
+		update := func(settings *v1.Settings, version int) *v1.Settings {
+			settings = proto.Clone(settings).(*v1.Settings)
+			settings.Metadata.ResourceVersion = fmt.Sprintf("%d", version)
+			return settings
+		}
+
+		It("reloads settings without calling the setup func again", func() {
+			Expect(sync(settings)).NotTo(HaveOccurred())
+			Expect(setupCtxs).To(HaveLen(1))
+			Expect(setupCtxs[0].Err()).NotTo(HaveOccurred())
+
+			updated := update(settings, 2)
+			Expect(sync(updated)).NotTo(HaveOccurred())
+			Expect(setupCtxs).To(HaveLen(1))
+			Expect(reloaded).To(Equal([]*v1.Settings{updated}))
+			Expect(setupCtxs[0].Err()).NotTo(HaveOccurred())
+
+			cancelRoot()
+			Expect(setupCtxs[0].Err()).To(HaveOccurred())
+		})
+
+		It("calls the setup func again when settings can't be reloaded", func() {
+			Expect(sync(settings)).NotTo(HaveOccurred())
+
+			canReload = false
+			updated := update(settings, 2)
+			Expect(sync(updated)).NotTo(HaveOccurred())
+			Expect(setupCtxs).To(HaveLen(2))
+			Expect(setupCtxs[0].Err()).To(HaveOccurred())
+			Expect(setupCtxs[1].Err()).NotTo(HaveOccurred())
+		})
+
+		It("keeps the running setup when reloading fails", func() {
+			Expect(sync(settings)).NotTo(HaveOccurred())
+
+			reloadErr = fmt.Errorf("invalid settings")
+			Expect(sync(update(settings, 2))).To(MatchError(ContainSubstring("invalid settings")))
+			Expect(setupCtxs).To(HaveLen(1))
+			Expect(setupCtxs[0].Err()).NotTo(HaveOccurred())
+		})
+	})
 })
@@ -2,7 +2,6 @@ package aws
 
 import (
 	"context"
-	"fmt"
 	"net/url"
 	"os"
 	"sort"
@@ -11,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
 	errors "github.com/rotisserie/eris"
 	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
@@ -28,18 +28,25 @@ const (
 
 type AWSLambdaFunctionDiscoveryFactory struct {
 	PollingTime time.Duration
+	// overrides the AWS Lambda API endpoint; used to discover functions from a local stand-in for the Lambda API
+	Endpoint string
 }
 
 func (f *AWSLambdaFunctionDiscoveryFactory) NewFunctionDiscovery(u *v1.Upstream) fds.UpstreamFunctionDiscovery {
 	return &AWSLambdaFunctionDiscovery{
-		timetowait: f.PollingTime,
-		upstream:   u,
+		timetowait:      f.PollingTime,
+		endpoint:        f.Endpoint,
+		upstream:        u,
+		functionDetails: newFunctionDetailsCache(),
 	}
 }
 
 type AWSLambdaFunctionDiscovery struct {
 	timetowait time.Duration
+	endpoint   string
 	upstream   *v1.Upstream
+	// the tags and aliases of the functions, so that they are not listed on every poll
+	functionDetails *functionDetailsCache
 }
 
 func (f *AWSLambdaFunctionDiscovery) IsFunctional() bool {
@@ -121,12 +128,16 @@ func (f *AWSLambdaFunctionDiscovery) DetectFunctionsOnce(ctx context.Context, se
 	if awsRegion == "" {
 		awsRegion = os.Getenv(AWS_REGION)
 	}
-	sess, err := awsutils.GetAwsSession(lambdaSpec.SecretRef, secrets, &aws.Config{Region: aws.String(lambdaSpec.Region)})
+	awsConfig := &aws.Config{Region: aws.String(awsRegion)}
+	if f.endpoint != "" {
+		awsConfig.Endpoint = aws.String(f.endpoint)
+	}
+	sess, err := awsutils.GetAwsSession(lambdaSpec.SecretRef, secrets, awsConfig)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to create AWS session")
 	}
 
-	var svc *lambda.Lambda
+	var svc lambdaiface.LambdaAPI
 
 	tokenPath := os.Getenv(AWS_WEB_IDENTITY_TOKEN_FILE)
 	roleArn := os.Getenv(AWS_ROLE_ARN)
@@ -143,32 +154,5 @@ func (f *AWSLambdaFunctionDiscovery) DetectFunctionsOnce(ctx context.Context, se
 		svc = lambda.New(sess)
 	}
 
-	var newfunctions []*glooaws.LambdaFunctionSpec
-
-	options := &lambda.ListFunctionsInput{FunctionVersion: aws.String("ALL")}
-	err = svc.ListFunctionsPagesWithContext(ctx, options, func(results *lambda.ListFunctionsOutput, _ bool) bool {
-
-		for _, f := range results.Functions {
-			version := aws.StringValue(f.Version)
-			name := aws.StringValue(f.FunctionName)
-
-			logicalname := fmt.Sprintf("%s:%s", name, version)
-			if version == "$LATEST" {
-				logicalname = name
-			}
-
-			newfunctions = append(newfunctions, &glooaws.LambdaFunctionSpec{
-				LambdaFunctionName: name,
-				Qualifier:          version,
-				LogicalName:        logicalname,
-			})
-		}
-
-		return true
-	})
-	if err != nil {
-		return nil, errors.Wrap(err, "unable to get list of functions from AWS")
-	}
-
-	return newfunctions, nil
+	return listLambdaFunctions(ctx, svc, tagFilterForUpstream(in), f.functionDetails)
 }
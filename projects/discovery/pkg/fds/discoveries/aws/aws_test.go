@@ -0,0 +1,223 @@
+package aws_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/solo-io/gloo/projects/discovery/pkg/fds/discoveries/aws"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	glooaws "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+type fakeFunction struct {
+	name     string
+	versions []string
+	aliases  []string
+	tags     map[string]string
+	revision string
+}
+
+// fakeLambda is a local stand-in for the parts of the AWS Lambda API used by discovery
+type fakeLambda struct {
+	lock      sync.Mutex
+	functions []fakeFunction
+	pageSize  int
+	// the number of calls listing the tags or aliases of a function
+	detailCalls int
+}
+
+func (f *fakeLambda) setFunctions(functions ...fakeFunction) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.functions = functions
+}
+
+func arn(name string) string {
+	return "arn:aws:lambda:us-east-1:123456789012:function:" + name
+}
+
+func (f *fakeLambda) getDetailCalls() int {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.detailCalls
+}
+
+func (f *fakeLambda) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	var response interface{}
+	switch {
+	case r.URL.Path == "/2015-03-31/functions/":
+		var all []map[string]string
+		for _, fn := range f.functions {
+			for _, version := range append([]string{"$LATEST"}, fn.versions...) {
+				all = append(all, map[string]string{"FunctionName": fn.name, "FunctionArn": arn(fn.name), "Version": version, "RevisionId": fn.revision})
+			}
+		}
+		start, _ := strconv.Atoi(r.URL.Query().Get("Marker"))
+		end := start + f.pageSize
+		page := map[string]interface{}{}
+		if end < len(all) {
+			page["NextMarker"] = strconv.Itoa(end)
+		} else {
+			end = len(all)
+		}
+		page["Functions"] = all[start:end]
+		response = page
+	case strings.HasSuffix(r.URL.Path, "/aliases"):
+		f.detailCalls++
+		name := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/2015-03-31/functions/"), "/aliases")
+		var aliases []map[string]string
+		for _, fn := range f.functions {
+			if fn.name == name {
+				for _, alias := range fn.aliases {
+					aliases = append(aliases, map[string]string{"Name": alias})
+				}
+			}
+		}
+		response = map[string]interface{}{"Aliases": aliases}
+	case strings.HasPrefix(r.URL.Path, "/2017-03-31/tags/"):
+		f.detailCalls++
+		resource := strings.TrimPrefix(r.URL.Path, "/2017-03-31/tags/")
+		tags := map[string]string{}
+		for _, fn := range f.functions {
+			if arn(fn.name) == resource {
+				tags = fn.tags
+			}
+		}
+		response = map[string]interface{}{"Tags": tags}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+var _ = Describe("AWS Lambda function discovery", func() {
+
+	var (
+		ctx     context.Context
+		cancel  context.CancelFunc
+		lambda  *fakeLambda
+		srv     *httptest.Server
+		secrets v1.SecretList
+		us      *v1.Upstream
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		lambda = &fakeLambda{pageSize: 2}
+		srv = httptest.NewServer(lambda)
+
+		secrets = v1.SecretList{{
+			Metadata: core.Metadata{Name: "aws-creds", Namespace: "gloo-system"},
+			Kind: &v1.Secret_Aws{
+				Aws: &v1.AwsSecret{AccessKey: "access", SecretKey: "secret"},
+			},
+		}}
+		us = &v1.Upstream{
+			Metadata: core.Metadata{Name: "lambda", Namespace: "gloo-system"},
+			UpstreamType: &v1.Upstream_Aws{
+				Aws: &glooaws.UpstreamSpec{
+					Region:    "us-east-1",
+					SecretRef: &core.ResourceRef{Name: "aws-creds", Namespace: "gloo-system"},
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		cancel()
+		srv.Close()
+	})
+
+	detectFunctionsWith := func(discovery *AWSLambdaFunctionDiscovery) []*glooaws.LambdaFunctionSpec {
+		functions, err := discovery.DetectFunctionsOnce(ctx, secrets)
+		Expect(err).NotTo(HaveOccurred())
+		return functions
+	}
+
+	newDiscovery := func() *AWSLambdaFunctionDiscovery {
+		factory := &AWSLambdaFunctionDiscoveryFactory{Endpoint: srv.URL}
+		return factory.NewFunctionDiscovery(us).(*AWSLambdaFunctionDiscovery)
+	}
+
+	detectFunctions := func() []*glooaws.LambdaFunctionSpec {
+		return detectFunctionsWith(newDiscovery())
+	}
+
+	logicalNames := func(functions []*glooaws.LambdaFunctionSpec) []string {
+		var names []string
+		for _, fn := range functions {
+			names = append(names, fn.LogicalName)
+		}
+		return names
+	}
+
+	It("discovers versions and aliases across pages", func() {
+		lambda.setFunctions(
+			fakeFunction{name: "hello", versions: []string{"1", "2"}, aliases: []string{"prod", "canary"}},
+			fakeFunction{name: "goodbye", versions: []string{"1"}},
+		)
+
+		functions := detectFunctions()
+		Expect(logicalNames(functions)).To(ConsistOf("hello", "hello:1", "hello:2", "hello:prod", "hello:canary", "goodbye", "goodbye:1"))
+		Expect(functions).To(ContainElement(&glooaws.LambdaFunctionSpec{
+			LogicalName:        "hello:prod",
+			LambdaFunctionName: "hello",
+			Qualifier:          "prod",
+		}))
+	})
+
+	It("only discovers functions with matching tags", func() {
+		lambda.setFunctions(
+			fakeFunction{name: "hello", versions: []string{"1"}, aliases: []string{"prod"}, tags: map[string]string{"team": "a", "gloo": ""}},
+			fakeFunction{name: "goodbye", tags: map[string]string{"team": "b", "gloo": ""}},
+			fakeFunction{name: "internal", tags: map[string]string{"team": "a"}},
+		)
+		us.Metadata.Annotations = map[string]string{TagFilterAnnotation: "team=a, gloo"}
+
+		Expect(logicalNames(detectFunctions())).To(ConsistOf("hello", "hello:1", "hello:prod"))
+	})
+
+	It("removes functions that no longer exist", func() {
+		lambda.setFunctions(
+			fakeFunction{name: "hello", aliases: []string{"prod"}},
+			fakeFunction{name: "goodbye"},
+		)
+		Expect(logicalNames(detectFunctions())).To(ConsistOf("hello", "hello:prod", "goodbye"))
+
+		lambda.setFunctions(
+			fakeFunction{name: "hello"},
+		)
+		Expect(logicalNames(detectFunctions())).To(ConsistOf("hello"))
+	})
+
+	It("only lists the tags and aliases of a function again when it changes", func() {
+		lambda.setFunctions(
+			fakeFunction{name: "hello", aliases: []string{"prod"}, tags: map[string]string{"team": "a"}, revision: "1"},
+		)
+		us.Metadata.Annotations = map[string]string{TagFilterAnnotation: "team=a"}
+		discovery := newDiscovery()
+
+		Expect(logicalNames(detectFunctionsWith(discovery))).To(ConsistOf("hello", "hello:prod"))
+		Expect(lambda.getDetailCalls()).To(Equal(2))
+		Expect(logicalNames(detectFunctionsWith(discovery))).To(ConsistOf("hello", "hello:prod"))
+		Expect(lambda.getDetailCalls()).To(Equal(2))
+
+		lambda.setFunctions(
+			fakeFunction{name: "hello", aliases: []string{"prod", "canary"}, tags: map[string]string{"team": "a"}, revision: "2"},
+		)
+		Expect(logicalNames(detectFunctionsWith(discovery))).To(ConsistOf("hello", "hello:prod", "hello:canary"))
+		Expect(lambda.getDetailCalls()).To(Equal(4))
+	})
+})
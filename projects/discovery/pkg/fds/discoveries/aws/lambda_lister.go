@@ -0,0 +1,186 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
+	errors "github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	glooaws "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws"
+)
+
+// TagFilterAnnotation restricts the functions discovered for an AWS upstream to the ones with matching tags.
+// The value is a comma-separated list of `key=value` (tag must have the value) or `key` (tag must be present).
+const TagFilterAnnotation = "discovery.solo.io/aws_lambda_tags"
+
+const latestVersion = "$LATEST"
+
+// tags and aliases take one call per function to list. they are refetched when the function changes, and at most
+// every functionDetailsMaxAge otherwise, as changing them doesn't change the revision of the function.
+const functionDetailsMaxAge = 5 * time.Minute
+
+type tagFilter map[string]*string
+
+func tagFilterForUpstream(us *v1.Upstream) tagFilter {
+	value := us.GetMetadata().Annotations[TagFilterAnnotation]
+	if value == "" {
+		return nil
+	}
+	filter := tagFilter{}
+	for _, kv := range strings.Split(value, ",") {
+		kv = strings.TrimSpace(kv)
+		if kv == "" {
+			continue
+		}
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) == 1 {
+			filter[parts[0]] = nil
+		} else {
+			filter[parts[0]] = aws.String(parts[1])
+		}
+	}
+	return filter
+}
+
+func (filter tagFilter) matches(tags map[string]*string) bool {
+	for key, value := range filter {
+		tag, ok := tags[key]
+		if !ok {
+			return false
+		}
+		if value != nil && aws.StringValue(tag) != *value {
+			return false
+		}
+	}
+	return true
+}
+
+// functionDetails are the tags and aliases of a function, as of a revision of the function
+type functionDetails struct {
+	revisionId string
+	fetchedAt  time.Time
+	// nil until tags are needed to filter functions
+	tags    map[string]*string
+	aliases []string
+}
+
+// functionDetailsCache keeps the details of the functions of an upstream between polls, by function ARN
+type functionDetailsCache struct {
+	now     func() time.Time
+	entries map[string]*functionDetails
+}
+
+func newFunctionDetailsCache() *functionDetailsCache {
+	return &functionDetailsCache{now: time.Now, entries: map[string]*functionDetails{}}
+}
+
+// listLambdaFunctions returns every published version and alias of every function in the account and region
+// of the client. $LATEST is exposed under the function name, other qualifiers as `<function name>:<qualifier>`.
+func listLambdaFunctions(ctx context.Context, svc lambdaiface.LambdaAPI, filter tagFilter, cache *functionDetailsCache) ([]*glooaws.LambdaFunctionSpec, error) {
+	var newfunctions []*glooaws.LambdaFunctionSpec
+	// tags and aliases belong to the unqualified function, which is listed once per version
+	var unqualifiedFunctions []*lambda.FunctionConfiguration
+
+	options := &lambda.ListFunctionsInput{FunctionVersion: aws.String("ALL")}
+	err := svc.ListFunctionsPagesWithContext(ctx, options, func(results *lambda.ListFunctionsOutput, _ bool) bool {
+		for _, f := range results.Functions {
+			if aws.StringValue(f.Version) == latestVersion {
+				unqualifiedFunctions = append(unqualifiedFunctions, f)
+			}
+			newfunctions = append(newfunctions, lambdaFunctionSpec(aws.StringValue(f.FunctionName), aws.StringValue(f.Version)))
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get list of functions from AWS")
+	}
+
+	excluded := make(map[string]bool)
+	listed := make(map[string]bool)
+	for _, f := range unqualifiedFunctions {
+		name := aws.StringValue(f.FunctionName)
+		listed[aws.StringValue(f.FunctionArn)] = true
+		details, err := cache.details(ctx, svc, f, filter != nil)
+		if err != nil {
+			return nil, err
+		}
+		if filter != nil && !filter.matches(details.tags) {
+			excluded[name] = true
+			continue
+		}
+		for _, alias := range details.aliases {
+			newfunctions = append(newfunctions, lambdaFunctionSpec(name, alias))
+		}
+	}
+	cache.forgetUnlisted(listed)
+
+	if len(excluded) == 0 {
+		return newfunctions, nil
+	}
+	var filtered []*glooaws.LambdaFunctionSpec
+	for _, fn := range newfunctions {
+		if !excluded[fn.LambdaFunctionName] {
+			filtered = append(filtered, fn)
+		}
+	}
+	return filtered, nil
+}
+
+// details returns the cached details of a function, fetching them if the function changed or they are too old
+func (c *functionDetailsCache) details(ctx context.Context, svc lambdaiface.LambdaAPI, f *lambda.FunctionConfiguration, withTags bool) (*functionDetails, error) {
+	functionArn := aws.StringValue(f.FunctionArn)
+	cached := c.entries[functionArn]
+	if cached != nil && cached.revisionId == aws.StringValue(f.RevisionId) &&
+		c.now().Sub(cached.fetchedAt) < functionDetailsMaxAge && (!withTags || cached.tags != nil) {
+		return cached, nil
+	}
+
+	name := aws.StringValue(f.FunctionName)
+	details := &functionDetails{revisionId: aws.StringValue(f.RevisionId), fetchedAt: c.now()}
+	if withTags {
+		tags, err := svc.ListTagsWithContext(ctx, &lambda.ListTagsInput{Resource: f.FunctionArn})
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to get tags for function %v", name)
+		}
+		details.tags = tags.Tags
+		if details.tags == nil {
+			details.tags = map[string]*string{}
+		}
+	}
+	err := svc.ListAliasesPagesWithContext(ctx, &lambda.ListAliasesInput{FunctionName: f.FunctionName}, func(results *lambda.ListAliasesOutput, _ bool) bool {
+		for _, alias := range results.Aliases {
+			details.aliases = append(details.aliases, aws.StringValue(alias.Name))
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to get aliases for function %v", name)
+	}
+	c.entries[functionArn] = details
+	return details, nil
+}
+
+func (c *functionDetailsCache) forgetUnlisted(listed map[string]bool) {
+	for functionArn := range c.entries {
+		if !listed[functionArn] {
+			delete(c.entries, functionArn)
+		}
+	}
+}
+
+func lambdaFunctionSpec(name, qualifier string) *glooaws.LambdaFunctionSpec {
+	logicalname := fmt.Sprintf("%s:%s", name, qualifier)
+	if qualifier == latestVersion {
+		logicalname = name
+	}
+	return &glooaws.LambdaFunctionSpec{
+		LambdaFunctionName: name,
+		Qualifier:          qualifier,
+		LogicalName:        logicalname,
+	}
+}
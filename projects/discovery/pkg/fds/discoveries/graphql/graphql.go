@@ -0,0 +1,328 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/hashicorp/go-multierror"
+	errors "github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	transformation_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/transformation"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
+	rest_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/rest"
+	"github.com/solo-io/go-utils/contextutils"
+)
+
+// GraphQLPathAnnotation can be set on an Upstream (or on the Kubernetes Service it was discovered from)
+// to the path of its GraphQL endpoint. Annotated upstreams skip detection at the well-known paths.
+// Discovery sets it on the upstreams it detected, so that they are not detected again.
+const GraphQLPathAnnotation = "discovery.solo.io/graphql_path"
+
+var commonGraphQLURIs = []string{
+	"/graphql",
+	"/query",
+	"/api/graphql",
+}
+
+// GraphQL operations are discovered as REST functions named `query.<field>` and `mutation.<field>`.
+// Each function transforms the request into a POST to the GraphQL endpoint with the operation as the query,
+// and the operation arguments as variables, so routes can target them with a REST destination spec.
+type FunctionDiscoveryFactory struct {
+	DetectionTimeout time.Duration
+	FunctionPollTime time.Duration
+	GraphQLUrisToTry []string
+}
+
+func (f *FunctionDiscoveryFactory) NewFunctionDiscovery(u *v1.Upstream) fds.UpstreamFunctionDiscovery {
+	var urisToTry []string
+	if path := annotatedPath(u); path != "" {
+		urisToTry = append(urisToTry, path)
+	} else {
+		urisToTry = append(urisToTry, f.GraphQLUrisToTry...)
+		urisToTry = append(urisToTry, commonGraphQLURIs...)
+	}
+	return &UpstreamFunctionDiscovery{
+		detectionTimeout: f.DetectionTimeout,
+		functionPollTime: f.FunctionPollTime,
+		graphQLUrisToTry: urisToTry,
+		upstream:         u,
+	}
+}
+
+type UpstreamFunctionDiscovery struct {
+	detectionTimeout time.Duration
+	functionPollTime time.Duration
+	graphQLUrisToTry []string
+	upstream         *v1.Upstream
+
+	// the path at which the endpoint was detected
+	detectedPath string
+}
+
+func annotatedPath(u *v1.Upstream) string {
+	path := strings.TrimSpace(u.GetMetadata().Annotations[GraphQLPathAnnotation])
+	if path != "" && !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	return path
+}
+
+func getrestspec(u *v1.Upstream) *rest_plugins.ServiceSpec {
+	spec, ok := u.UpstreamType.(v1.ServiceSpecGetter)
+	if !ok {
+		return nil
+	}
+	restwrapper, ok := spec.GetServiceSpec().GetPluginType().(*plugins.ServiceSpec_Rest)
+	if !ok {
+		return nil
+	}
+	return restwrapper.Rest
+}
+
+// the rest service spec does not record that it was generated from graphql, so the detected endpoint
+// is persisted as the path annotation when functions are discovered.
+func (f *UpstreamFunctionDiscovery) IsFunctional() bool {
+	rest := getrestspec(f.upstream)
+	return rest != nil && rest.SwaggerInfo == nil && annotatedPath(f.upstream) != ""
+}
+
+func (f *UpstreamFunctionDiscovery) DetectType(ctx context.Context, baseurl *url.URL) (*plugins.ServiceSpec, error) {
+	var spec *plugins.ServiceSpec
+
+	err := contextutils.NewExponentioalBackoff(contextutils.ExponentioalBackoff{MaxDuration: &f.detectionTimeout}).Backoff(ctx, func(ctx context.Context) error {
+		var err error
+		spec, err = f.detectUpstreamTypeOnce(ctx, baseurl)
+		return err
+	})
+
+	return spec, err
+}
+
+func normalizeBaseUrl(baseUrl *url.URL) (*url.URL, error) {
+	switch baseUrl.Scheme {
+	case "http", "https":
+		return baseUrl, nil
+	case "tcp":
+		// if it is a tcp address, assume it is plain http
+		u := *baseUrl
+		u.Scheme = "http"
+		return &u, nil
+	}
+	return nil, fmt.Errorf("unsupported baseurl for graphql discovery %v", baseUrl)
+}
+
+func (f *UpstreamFunctionDiscovery) detectUpstreamTypeOnce(ctx context.Context, baseUrl *url.URL) (*plugins.ServiceSpec, error) {
+	var errs error
+	logger := contextutils.LoggerFrom(ctx)
+
+	logger.Debugf("attempting to detect graphql base url %v", baseUrl)
+
+	baseUrl, err := normalizeBaseUrl(baseUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, uri := range f.graphQLUrisToTry {
+		endpoint := baseUrl.ResolveReference(&url.URL{Path: uri}).String()
+		if _, err := introspect(ctx, endpoint); err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			errs = multierror.Append(errs, err)
+			continue
+		}
+		logger.Infof("graphql upstream detected: %v", endpoint)
+		f.detectedPath = uri
+		// keep the functions of an existing spec, so that they aren't removed until they are discovered again
+		rest := &rest_plugins.ServiceSpec{}
+		if existing := getrestspec(f.upstream); existing != nil {
+			rest = proto.Clone(existing).(*rest_plugins.ServiceSpec)
+		}
+		return &plugins.ServiceSpec{
+			PluginType: &plugins.ServiceSpec_Rest{
+				Rest: rest,
+			},
+		}, nil
+	}
+	logger.Debugf("failed to detect graphql for %s: %v", baseUrl.String(), errs)
+	return nil, errors.Wrapf(errs, "service at %s does not implement graphql at a known endpoint, "+
+		"or was unreachable", baseUrl.String())
+}
+
+func (f *UpstreamFunctionDiscovery) graphQLPath() string {
+	if f.detectedPath != "" {
+		return f.detectedPath
+	}
+	return annotatedPath(f.upstream)
+}
+
+func (f *UpstreamFunctionDiscovery) DetectFunctions(ctx context.Context, url *url.URL, _ func() fds.Dependencies, updatecb func(fds.UpstreamMutator) error) error {
+	if url == nil {
+		return errors.New("graphql function discovery requires a resolvable upstream address")
+	}
+	path := f.graphQLPath()
+	if path == "" {
+		return errors.New("upstream doesn't have a graphql endpoint")
+	}
+	for {
+		err := contextutils.NewExponentioalBackoff(contextutils.ExponentioalBackoff{}).Backoff(ctx, func(ctx context.Context) error {
+			return f.detectFunctionsOnce(ctx, url, path, updatecb)
+		})
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			// ignore other errors as we would like to continue forever.
+		}
+
+		if err := contextutils.Sleep(ctx, f.functionPollTime); err != nil {
+			return err
+		}
+	}
+}
+
+func (f *UpstreamFunctionDiscovery) detectFunctionsOnce(ctx context.Context, baseUrl *url.URL, path string, updatecb func(fds.UpstreamMutator) error) error {
+	baseUrl, err := normalizeBaseUrl(baseUrl)
+	if err != nil {
+		return err
+	}
+	s, err := introspect(ctx, baseUrl.ResolveReference(&url.URL{Path: path}).String())
+	if err != nil {
+		return err
+	}
+	funcs := createFunctions(s, path)
+
+	return updatecb(func(u *v1.Upstream) error {
+		upstreamSpec, ok := u.UpstreamType.(v1.ServiceSpecMutator)
+		if !ok {
+			return errors.New("not a valid upstream")
+		}
+		spec := upstreamSpec.GetServiceSpec()
+		if spec == nil {
+			spec = &plugins.ServiceSpec{}
+		}
+		restspec, ok := spec.PluginType.(*plugins.ServiceSpec_Rest)
+		if !ok {
+			restspec = &plugins.ServiceSpec_Rest{
+				Rest: &rest_plugins.ServiceSpec{},
+			}
+		}
+
+		restspec.Rest.Transformations = funcs
+		spec.PluginType = restspec
+
+		// so that the upstream is recognised as functional, and not detected again
+		if annotatedPath(u) == "" {
+			if u.Metadata.Annotations == nil {
+				u.Metadata.Annotations = map[string]string{}
+			}
+			u.Metadata.Annotations[GraphQLPathAnnotation] = path
+		}
+
+		upstreamSpec.SetServiceSpec(spec)
+		return nil
+	})
+}
+
+func createFunctions(s *schema, path string) map[string]*transformation_plugins.TransformationTemplate {
+	funcs := make(map[string]*transformation_plugins.TransformationTemplate)
+	addOperations := func(operationType string, root *namedType) {
+		if root == nil {
+			return
+		}
+		rootType := s.typeByName(root.Name)
+		if rootType == nil {
+			return
+		}
+		for _, fld := range rootType.Fields {
+			funcs[operationType+"."+fld.Name] = createFunctionForField(s, operationType, fld, path)
+		}
+	}
+	addOperations("query", s.QueryType)
+	addOperations("mutation", s.MutationType)
+	return funcs
+}
+
+func createFunctionForField(s *schema, operationType string, fld field, path string) *transformation_plugins.TransformationTemplate {
+	var variableDefinitions, arguments, variables []string
+	for _, arg := range fld.Args {
+		variableDefinitions = append(variableDefinitions, fmt.Sprintf("$%v: %v", arg.Name, arg.Type.String()))
+		arguments = append(arguments, fmt.Sprintf("%v: $%v", arg.Name, arg.Name))
+		if isStringLike(arg.Type) {
+			// string needs quoting
+			variables = append(variables, fmt.Sprintf(`"%v": "{{ default(%v, "") }}"`, arg.Name, arg.Name))
+		} else {
+			variables = append(variables, fmt.Sprintf(`"%v": {{ default(%v, "null") }}`, arg.Name, arg.Name))
+		}
+	}
+
+	query := operationType + " " + fld.Name
+	if len(variableDefinitions) > 0 {
+		query += "(" + strings.Join(variableDefinitions, ", ") + ")"
+	}
+	query += " { " + fld.Name
+	if len(arguments) > 0 {
+		query += "(" + strings.Join(arguments, ", ") + ")"
+	}
+	if selection := selectionSet(s, fld.Type); selection != "" {
+		query += " " + selection
+	}
+	query += " }"
+
+	// the query only contains names and graphql punctuation, but escape it to be safe
+	quotedQuery, _ := json.Marshal(query)
+
+	// keep braces separated so they are not mistaken for template delimiters
+	body := fmt.Sprintf(`{ "query": %s, "variables": { %s } }`, quotedQuery, strings.Join(variables, ", "))
+
+	return &transformation_plugins.TransformationTemplate{
+		Headers: map[string]*transformation_plugins.InjaTemplate{
+			":method":      {Text: "POST"},
+			":path":        {Text: path},
+			"content-type": {Text: "application/json"},
+		},
+		BodyTransformation: &transformation_plugins.TransformationTemplate_Body{
+			Body: &transformation_plugins.InjaTemplate{Text: body},
+		},
+	}
+}
+
+func isStringLike(t typeRef) bool {
+	named := t.named()
+	if named.Kind == kindEnum {
+		return true
+	}
+	return named.Kind == kindScalar && (named.Name == "String" || named.Name == "ID")
+}
+
+// selectionSet selects the scalar fields of object results. fields returning other types are not selected,
+// as that would require choosing how deep to traverse the graph.
+func selectionSet(s *schema, t typeRef) string {
+	named := t.named()
+	if named.Kind == kindScalar || named.Kind == kindEnum {
+		return ""
+	}
+	var fields []string
+	if named.Kind == kindObject {
+		if fullType := s.typeByName(named.Name); fullType != nil {
+			for _, fld := range fullType.Fields {
+				fieldType := fld.Type.named()
+				if len(fld.Args) == 0 && (fieldType.Kind == kindScalar || fieldType.Kind == kindEnum) {
+					fields = append(fields, fld.Name)
+				}
+			}
+		}
+	}
+	if len(fields) == 0 {
+		fields = []string{"__typename"}
+	}
+	sort.Strings(fields)
+	return "{ " + strings.Join(fields, " ") + " }"
+}
@@ -0,0 +1,13 @@
+package graphql_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestGraphql(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Graphql Suite")
+}
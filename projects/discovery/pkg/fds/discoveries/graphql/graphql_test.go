@@ -0,0 +1,199 @@
+package graphql_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	. "github.com/solo-io/gloo/projects/discovery/pkg/fds/discoveries/graphql"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	static_plugin_gloo "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+const bookstoreSchema = `{
+  "data": {
+    "__schema": {
+      "queryType": {"name": "Query"},
+      "mutationType": {"name": "Mutation"},
+      "types": [
+        {"kind": "OBJECT", "name": "Query", "fields": [
+          {"name": "book", "args": [{"name": "id", "type": {"kind": "NON_NULL", "name": null, "ofType": {"kind": "SCALAR", "name": "ID"}}}],
+           "type": {"kind": "OBJECT", "name": "Book"}},
+          {"name": "count", "args": [], "type": {"kind": "SCALAR", "name": "Int"}}
+        ]},
+        {"kind": "OBJECT", "name": "Mutation", "fields": [
+          {"name": "addBook", "args": [
+            {"name": "title", "type": {"kind": "SCALAR", "name": "String"}},
+            {"name": "pages", "type": {"kind": "SCALAR", "name": "Int"}}],
+           "type": {"kind": "LIST", "name": null, "ofType": {"kind": "OBJECT", "name": "Book"}}}
+        ]},
+        {"kind": "OBJECT", "name": "Book", "fields": [
+          {"name": "title", "args": [], "type": {"kind": "SCALAR", "name": "String"}},
+          {"name": "id", "args": [], "type": {"kind": "NON_NULL", "name": null, "ofType": {"kind": "SCALAR", "name": "ID"}}},
+          {"name": "author", "args": [], "type": {"kind": "OBJECT", "name": "Author"}}
+        ]}
+      ]
+    }
+  }
+}`
+
+var _ = Describe("GraphQL function discovery", func() {
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+		srv    *httptest.Server
+		srvUrl *url.URL
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+			var req map[string]interface{}
+			if r.Method != "POST" || r.URL.Path != "/query" || json.Unmarshal(body, &req) != nil ||
+				!strings.Contains(req["query"].(string), "__schema") {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write([]byte(bookstoreSchema))
+		}))
+		var err error
+		srvUrl, err = url.Parse(srv.URL)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		cancel()
+		srv.Close()
+	})
+
+	It("detects a graphql endpoint and creates functions for its operations", func() {
+		us := &v1.Upstream{
+			Metadata: core.Metadata{Name: "bookstore", Namespace: "default"},
+			UpstreamType: &v1.Upstream_Static{
+				Static: &static_plugin_gloo.UpstreamSpec{},
+			},
+		}
+		factory := &FunctionDiscoveryFactory{DetectionTimeout: time.Second, FunctionPollTime: time.Second}
+		discovery := factory.NewFunctionDiscovery(us)
+		Expect(discovery.IsFunctional()).To(BeFalse())
+
+		spec, err := discovery.DetectType(ctx, srvUrl)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(spec.GetRest()).NotTo(BeNil())
+		us.GetStatic().ServiceSpec = spec
+
+		ctx, cancel := context.WithCancel(ctx)
+		err = discovery.DetectFunctions(ctx, srvUrl, nil, func(mutator fds.UpstreamMutator) error {
+			defer cancel()
+			return mutator(us)
+		})
+		Expect(err).To(Equal(context.Canceled))
+
+		transformations := us.GetStatic().GetServiceSpec().GetRest().GetTransformations()
+		Expect(transformations).To(HaveKey("query.count"))
+
+		book := transformations["query.book"]
+		Expect(book).NotTo(BeNil())
+		Expect(book.Headers[":method"].Text).To(Equal("POST"))
+		Expect(book.Headers[":path"].Text).To(Equal("/query"))
+		Expect(book.GetBody().GetText()).To(Equal(`{ "query": "query book($id: ID!) { book(id: $id) { id title } }", "variables": { "id": "{{ default(id, "") }}" } }`))
+
+		addBook := transformations["mutation.addBook"]
+		Expect(addBook).NotTo(BeNil())
+		Expect(addBook.GetBody().GetText()).To(Equal(`{ "query": "mutation addBook($title: String, $pages: Int) { addBook(title: $title, pages: $pages) { id title } }", "variables": { "title": "{{ default(title, "") }}", "pages": {{ default(pages, "null") }} } }`))
+	})
+
+	It("does not rewrite an upstream it already discovered", func() {
+		upstreamClient, err := v1.NewUpstreamClient(&factory.MemoryResourceClientFactory{Cache: memory.NewInMemoryResourceCache()})
+		Expect(err).NotTo(HaveOccurred())
+		us, err := upstreamClient.Write(&v1.Upstream{
+			Metadata: core.Metadata{Name: "bookstore", Namespace: "default"},
+			UpstreamType: &v1.Upstream_Static{
+				Static: &static_plugin_gloo.UpstreamSpec{},
+			},
+		}, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+
+		writer := &countingWriter{UpstreamClient: upstreamClient}
+		discoveryFactory := &FunctionDiscoveryFactory{DetectionTimeout: time.Second, FunctionPollTime: 100 * time.Millisecond}
+		updater := fds.NewUpdater(ctx, staticResolver{url: srvUrl}, writer, 0, []fds.FunctionDiscoveryFactory{discoveryFactory})
+
+		readUpstream := func() *v1.Upstream {
+			us, err := upstreamClient.Read("default", "bookstore", clients.ReadOpts{})
+			Expect(err).NotTo(HaveOccurred())
+			return us
+		}
+
+		updater.UpstreamAdded(us)
+		Eventually(func() map[string]string {
+			return readUpstream().GetMetadata().Annotations
+		}, 5*time.Second).Should(HaveKeyWithValue(GraphQLPathAnnotation, "/query"))
+		us = readUpstream()
+		Expect(us.GetStatic().GetServiceSpec().GetRest().GetTransformations()).To(HaveKey("query.count"))
+		writes := writer.Writes()
+
+		// the written upstream is received in the next snapshot
+		updater.UpstreamUpdated(us)
+		Consistently(writer.Writes, time.Second).Should(Equal(writes))
+		Expect(readUpstream()).To(Equal(us))
+	})
+
+	It("uses the path from the upstream annotation", func() {
+		us := &v1.Upstream{
+			Metadata: core.Metadata{
+				Name:        "bookstore",
+				Namespace:   "default",
+				Annotations: map[string]string{GraphQLPathAnnotation: "/not-graphql"},
+			},
+			UpstreamType: &v1.Upstream_Static{
+				Static: &static_plugin_gloo.UpstreamSpec{},
+			},
+		}
+		factory := &FunctionDiscoveryFactory{DetectionTimeout: 100 * time.Millisecond}
+		// the well-known paths are not tried, so the type is never detected
+		spec, _ := factory.NewFunctionDiscovery(us).DetectType(ctx, srvUrl)
+		Expect(spec).To(BeNil())
+	})
+})
+
+type staticResolver struct {
+	url *url.URL
+}
+
+func (r staticResolver) Resolve(*v1.Upstream) (*url.URL, error) {
+	return r.url, nil
+}
+
+type countingWriter struct {
+	v1.UpstreamClient
+	lock   sync.Mutex
+	writes int
+}
+
+func (w *countingWriter) Write(us *v1.Upstream, opts clients.WriteOpts) (*v1.Upstream, error) {
+	w.lock.Lock()
+	w.writes++
+	w.lock.Unlock()
+	return w.UpstreamClient.Write(us, opts)
+}
+
+func (w *countingWriter) Writes() int {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return w.writes
+}
@@ -0,0 +1,156 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+
+	errors "github.com/rotisserie/eris"
+)
+
+const introspectionQuery = `query IntrospectionQuery {
+  __schema {
+    queryType { name }
+    mutationType { name }
+    types {
+      kind
+      name
+      fields {
+        name
+        args { name type { ...TypeRef } }
+        type { ...TypeRef }
+      }
+    }
+  }
+}
+fragment TypeRef on __Type {
+  kind
+  name
+  ofType { kind name ofType { kind name ofType { kind name ofType { kind name } } } }
+}`
+
+const (
+	kindNonNull = "NON_NULL"
+	kindList    = "LIST"
+	kindScalar  = "SCALAR"
+	kindEnum    = "ENUM"
+	kindObject  = "OBJECT"
+)
+
+type introspectionResponse struct {
+	Data struct {
+		Schema *schema `json:"__schema"`
+	} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+type schema struct {
+	QueryType    *namedType `json:"queryType"`
+	MutationType *namedType `json:"mutationType"`
+	Types        []fullType `json:"types"`
+}
+
+type namedType struct {
+	Name string `json:"name"`
+}
+
+type fullType struct {
+	Kind   string  `json:"kind"`
+	Name   string  `json:"name"`
+	Fields []field `json:"fields"`
+}
+
+type field struct {
+	Name string     `json:"name"`
+	Args []argument `json:"args"`
+	Type typeRef    `json:"type"`
+}
+
+type argument struct {
+	Name string  `json:"name"`
+	Type typeRef `json:"type"`
+}
+
+type typeRef struct {
+	Kind   string   `json:"kind"`
+	Name   string   `json:"name"`
+	OfType *typeRef `json:"ofType"`
+}
+
+// String renders the type reference in graphql syntax, e.g. `[String!]!`
+func (t typeRef) String() string {
+	switch t.Kind {
+	case kindNonNull:
+		if t.OfType == nil {
+			return ""
+		}
+		return t.OfType.String() + "!"
+	case kindList:
+		if t.OfType == nil {
+			return "[]"
+		}
+		return "[" + t.OfType.String() + "]"
+	}
+	return t.Name
+}
+
+// named returns the innermost named type, stripping list and non-null wrappers
+func (t typeRef) named() typeRef {
+	if t.OfType != nil && (t.Kind == kindNonNull || t.Kind == kindList) {
+		return t.OfType.named()
+	}
+	return t
+}
+
+func (s *schema) typeByName(name string) *fullType {
+	for i := range s.Types {
+		if s.Types[i].Name == name {
+			return &s.Types[i]
+		}
+	}
+	return nil
+}
+
+// introspect runs the introspection query against the given graphql endpoint
+func introspect(ctx context.Context, endpoint string) (*schema, error) {
+	reqBody, err := json.Marshal(map[string]string{"query": introspectionQuery})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", endpoint, bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid url for request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Gloo-Discovery", "GraphQL-Discovery")
+	req = req.WithContext(ctx)
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not perform HTTP POST on resolved addr: %v", endpoint)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("path: %v response code: %v", endpoint, res.Status)
+	}
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading introspection response")
+	}
+	var resp introspectionResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, errors.Wrap(err, "invalid introspection response")
+	}
+	if len(resp.Errors) > 0 {
+		return nil, errors.Errorf("introspection query failed: %v", resp.Errors[0].Message)
+	}
+	if resp.Data.Schema == nil || resp.Data.Schema.QueryType == nil {
+		return nil, errors.Errorf("introspection response from %v does not contain a schema", endpoint)
+	}
+	return resp.Data.Schema, nil
+}
@@ -0,0 +1,170 @@
+package grpc
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	errors "github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
+	grpc_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// Upstreams that do not expose the gRPC reflection API can instead be discovered from a
+// serialized google.protobuf.FileDescriptorSet. The descriptor set must be base64-encoded,
+// the same way function discovery writes it to the `descriptors` field of the grpc ServiceSpec.
+const (
+	// <namespace>/<name> of an Artifact containing the descriptor set under DescriptorsKey
+	DescriptorsArtifactAnnotation = "discovery.solo.io/grpc_descriptors_artifact"
+	// <namespace>/<name> of a header Secret containing the descriptor set under DescriptorsKey
+	DescriptorsSecretAnnotation = "discovery.solo.io/grpc_descriptors_secret"
+	// set to "true" to discover services from the descriptors already set inline on the upstream's grpc ServiceSpec
+	DescriptorsInlineAnnotation = "discovery.solo.io/grpc_descriptors_inline"
+
+	DescriptorsKey = "descriptors"
+)
+
+const reflectionServiceName = "grpc.reflection.v1alpha.ServerReflection"
+
+func hasDescriptorSource(u *v1.Upstream) bool {
+	annotations := u.GetMetadata().Annotations
+	return annotations[DescriptorsArtifactAnnotation] != "" ||
+		annotations[DescriptorsSecretAnnotation] != "" ||
+		annotations[DescriptorsInlineAnnotation] == "true"
+}
+
+func parseDescriptorSourceRef(u *v1.Upstream, annotation string) (core.ResourceRef, error) {
+	value := u.GetMetadata().Annotations[annotation]
+	parts := strings.Split(value, "/")
+	switch len(parts) {
+	case 1:
+		return core.ResourceRef{Namespace: u.GetMetadata().Namespace, Name: parts[0]}, nil
+	case 2:
+		return core.ResourceRef{Namespace: parts[0], Name: parts[1]}, nil
+	}
+	return core.ResourceRef{}, errors.Errorf("invalid value %q for annotation %v, expected <namespace>/<name>", value, annotation)
+}
+
+// loadDescriptors returns the base64-encoded descriptor set for the upstream from the configured source
+func (f *UpstreamFunctionDiscovery) loadDescriptors(ctx context.Context, dependencies func() fds.Dependencies) ([]byte, error) {
+	annotations := f.upstream.GetMetadata().Annotations
+	switch {
+	case annotations[DescriptorsArtifactAnnotation] != "":
+		if f.artifacts == nil {
+			return nil, errors.New("no artifact client configured for grpc discovery")
+		}
+		ref, err := parseDescriptorSourceRef(f.upstream, DescriptorsArtifactAnnotation)
+		if err != nil {
+			return nil, err
+		}
+		artifact, err := f.artifacts.Read(ref.Namespace, ref.Name, clients.ReadOpts{Ctx: ctx})
+		if err != nil {
+			return nil, errors.Wrapf(err, "reading descriptors artifact %v", ref)
+		}
+		data, ok := artifact.GetData()[DescriptorsKey]
+		if !ok {
+			return nil, errors.Errorf("artifact %v does not contain key %v", ref, DescriptorsKey)
+		}
+		return []byte(data), nil
+	case annotations[DescriptorsSecretAnnotation] != "":
+		ref, err := parseDescriptorSourceRef(f.upstream, DescriptorsSecretAnnotation)
+		if err != nil {
+			return nil, err
+		}
+		var secrets v1.SecretList
+		if dependencies != nil {
+			secrets = dependencies().Secrets
+		}
+		secret, err := secrets.Find(ref.Strings())
+		if err != nil {
+			return nil, errors.Wrapf(err, "finding descriptors secret")
+		}
+		data, ok := secret.GetHeader().GetHeaders()[DescriptorsKey]
+		if !ok {
+			return nil, errors.Errorf("secret %v does not contain key %v", ref, DescriptorsKey)
+		}
+		return []byte(data), nil
+	default:
+		spec := getgrpcspec(f.upstream)
+		if len(spec.GetDescriptors()) == 0 {
+			return nil, errors.New("upstream does not have inline grpc descriptors")
+		}
+		return spec.GetDescriptors(), nil
+	}
+}
+
+// ServicesFromDescriptors lists every service defined in a base64-encoded FileDescriptorSet
+func ServicesFromDescriptors(encodedDescriptors []byte) ([]*grpc_plugins.ServiceSpec_GrpcService, error) {
+	rawDescriptors, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(encodedDescriptors)))
+	if err != nil {
+		return nil, errors.Wrap(err, "descriptors are not base64-encoded")
+	}
+	var descriptors descriptor.FileDescriptorSet
+	if err := proto.Unmarshal(rawDescriptors, &descriptors); err != nil {
+		return nil, errors.Wrap(err, "unmarshalling proto descriptors")
+	}
+
+	var grpcservices []*grpc_plugins.ServiceSpec_GrpcService
+	for _, file := range descriptors.GetFile() {
+		for _, svc := range file.GetService() {
+			if genFullServiceName(file.GetPackage(), svc.GetName()) == reflectionServiceName {
+				continue
+			}
+			grpcservice := &grpc_plugins.ServiceSpec_GrpcService{
+				PackageName: file.GetPackage(),
+				ServiceName: svc.GetName(),
+			}
+			for _, method := range svc.GetMethod() {
+				grpcservice.FunctionNames = append(grpcservice.FunctionNames, method.GetName())
+			}
+			grpcservices = append(grpcservices, grpcservice)
+		}
+	}
+	if len(grpcservices) == 0 {
+		return nil, errors.New("descriptors do not contain any services")
+	}
+	return grpcservices, nil
+}
+
+func genFullServiceName(packageName, serviceName string) string {
+	if packageName == "" {
+		return serviceName
+	}
+	return packageName + "." + serviceName
+}
+
+func (f *UpstreamFunctionDiscovery) detectFunctionsFromDescriptorsOnce(ctx context.Context, dependencies func() fds.Dependencies, updatecb func(fds.UpstreamMutator) error) error {
+	encodedDescriptors, err := f.loadDescriptors(ctx, dependencies)
+	if err != nil {
+		return err
+	}
+	grpcservices, err := ServicesFromDescriptors(encodedDescriptors)
+	if err != nil {
+		return err
+	}
+
+	return updatecb(func(out *v1.Upstream) error {
+		upstreamSpec, ok := out.UpstreamType.(v1.ServiceSpecMutator)
+		if !ok {
+			return errors.New("not a valid upstream")
+		}
+		svcspec := getgrpcspec(out)
+		if svcspec == nil {
+			svcspec = &grpc_plugins.ServiceSpec{}
+			upstreamSpec.SetServiceSpec(&plugins.ServiceSpec{
+				PluginType: &plugins.ServiceSpec_Grpc{
+					Grpc: svcspec,
+				},
+			})
+		}
+		svcspec.GrpcServices = grpcservices
+		svcspec.Descriptors = encodedDescriptors
+		return nil
+	})
+}
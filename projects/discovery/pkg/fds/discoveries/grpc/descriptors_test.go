@@ -0,0 +1,138 @@
+package grpc_test
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	. "github.com/solo-io/gloo/projects/discovery/pkg/fds/discoveries/grpc"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
+	grpc_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc"
+	static_plugin_gloo "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Descriptor based discovery", func() {
+
+	var (
+		ctx                context.Context
+		cancel             context.CancelFunc
+		encodedDescriptors string
+		expectedServices   []*grpc_plugins.ServiceSpec_GrpcService
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		descriptors := &descriptor.FileDescriptorSet{
+			File: []*descriptor.FileDescriptorProto{
+				{
+					Name:    proto.String("bookstore.proto"),
+					Package: proto.String("main"),
+					Service: []*descriptor.ServiceDescriptorProto{{
+						Name: proto.String("Bookstore"),
+						Method: []*descriptor.MethodDescriptorProto{
+							{Name: proto.String("ListShelves")},
+							{Name: proto.String("GetShelf")},
+						},
+					}},
+				},
+				{
+					Name:    proto.String("reflection.proto"),
+					Package: proto.String("grpc.reflection.v1alpha"),
+					Service: []*descriptor.ServiceDescriptorProto{{
+						Name: proto.String("ServerReflection"),
+					}},
+				},
+			},
+		}
+		raw, err := proto.Marshal(descriptors)
+		Expect(err).NotTo(HaveOccurred())
+		encodedDescriptors = base64.StdEncoding.EncodeToString(raw)
+		expectedServices = []*grpc_plugins.ServiceSpec_GrpcService{{
+			PackageName:   "main",
+			ServiceName:   "Bookstore",
+			FunctionNames: []string{"ListShelves", "GetShelf"},
+		}}
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	newUpstream := func(annotations map[string]string) *v1.Upstream {
+		return &v1.Upstream{
+			Metadata: core.Metadata{Name: "bookstore", Namespace: "default", Annotations: annotations},
+			UpstreamType: &v1.Upstream_Static{
+				Static: &static_plugin_gloo.UpstreamSpec{},
+			},
+		}
+	}
+
+	detectOnce := func(factory *FunctionDiscoveryFactory, us *v1.Upstream, deps fds.Dependencies) *grpc_plugins.ServiceSpec {
+		discovery := factory.NewFunctionDiscovery(us)
+		Expect(discovery.IsFunctional()).To(BeTrue())
+
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		err := discovery.DetectFunctions(ctx, nil, func() fds.Dependencies { return deps }, func(mutator fds.UpstreamMutator) error {
+			defer cancel()
+			return mutator(us)
+		})
+		Expect(err).To(Equal(context.Canceled))
+		return us.GetStatic().GetServiceSpec().GetGrpc()
+	}
+
+	It("lists the services in a descriptor set", func() {
+		services, err := ServicesFromDescriptors([]byte(encodedDescriptors))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(services).To(Equal(expectedServices))
+	})
+
+	It("discovers functions from an artifact", func() {
+		artifactClient, err := v1.NewArtifactClient(&factory.MemoryResourceClientFactory{Cache: memory.NewInMemoryResourceCache()})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = artifactClient.Write(&v1.Artifact{
+			Metadata: core.Metadata{Name: "bookstore-descriptors", Namespace: "gloo-system"},
+			Data:     map[string]string{DescriptorsKey: encodedDescriptors},
+		}, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+
+		us := newUpstream(map[string]string{DescriptorsArtifactAnnotation: "gloo-system/bookstore-descriptors"})
+		spec := detectOnce(&FunctionDiscoveryFactory{Artifacts: artifactClient}, us, fds.Dependencies{})
+		Expect(spec.GetGrpcServices()).To(Equal(expectedServices))
+		Expect(string(spec.GetDescriptors())).To(Equal(encodedDescriptors))
+	})
+
+	It("discovers functions from a secret in the upstream namespace", func() {
+		secret := &v1.Secret{
+			Metadata: core.Metadata{Name: "bookstore-descriptors", Namespace: "default"},
+			Kind: &v1.Secret_Header{
+				Header: &v1.HeaderSecret{Headers: map[string]string{DescriptorsKey: encodedDescriptors}},
+			},
+		}
+
+		us := newUpstream(map[string]string{DescriptorsSecretAnnotation: "bookstore-descriptors"})
+		spec := detectOnce(&FunctionDiscoveryFactory{}, us, fds.Dependencies{Secrets: v1.SecretList{secret}})
+		Expect(spec.GetGrpcServices()).To(Equal(expectedServices))
+	})
+
+	It("discovers functions from inline descriptors", func() {
+		us := newUpstream(map[string]string{DescriptorsInlineAnnotation: "true"})
+		us.GetStatic().ServiceSpec = &options.ServiceSpec{
+			PluginType: &options.ServiceSpec_Grpc{
+				Grpc: &grpc_plugins.ServiceSpec{Descriptors: []byte(encodedDescriptors)},
+			},
+		}
+		spec := detectOnce(&FunctionDiscoveryFactory{}, us, fds.Dependencies{})
+		Expect(spec.GetGrpcServices()).To(Equal(expectedServices))
+	})
+})
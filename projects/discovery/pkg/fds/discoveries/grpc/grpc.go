@@ -45,21 +45,30 @@ type FunctionDiscoveryFactory struct {
 	DetectionTimeout   time.Duration
 	DetectionRetryBase time.Duration
 	FunctionPollTime   time.Duration
-	// TODO: move over to ArtifactClient
+	// used to read descriptors for upstreams annotated with DescriptorsArtifactAnnotation
 	Artifacts v1.ArtifactClient
 }
 
 func (f *FunctionDiscoveryFactory) NewFunctionDiscovery(u *v1.Upstream) fds.UpstreamFunctionDiscovery {
 	return &UpstreamFunctionDiscovery{
-		upstream: u,
+		upstream:         u,
+		artifacts:        f.Artifacts,
+		functionPollTime: f.FunctionPollTime,
 	}
 }
 
 type UpstreamFunctionDiscovery struct {
-	upstream *v1.Upstream
+	upstream         *v1.Upstream
+	artifacts        v1.ArtifactClient
+	functionPollTime time.Duration
 }
 
 func (f *UpstreamFunctionDiscovery) IsFunctional() bool {
+	if hasDescriptorSource(f.upstream) {
+		// the grpc spec is populated from the descriptors, no need to detect the type
+		_, ok := f.upstream.UpstreamType.(v1.ServiceSpecMutator)
+		return ok
+	}
 	return getgrpcspec(f.upstream) != nil
 }
 
@@ -67,6 +76,14 @@ func (f *UpstreamFunctionDiscovery) DetectType(ctx context.Context, url *url.URL
 	log := contextutils.LoggerFrom(ctx)
 	log.Debugf("attempting to detect GRPC for %s", f.upstream.Metadata.Name)
 
+	if hasDescriptorSource(f.upstream) {
+		return &plugins.ServiceSpec{
+			PluginType: &plugins.ServiceSpec_Grpc{
+				Grpc: &grpc_plugins.ServiceSpec{},
+			},
+		}, nil
+	}
+
 	refClient, closeConn, err := getclient(ctx, url)
 	if err != nil {
 		return nil, err
@@ -87,10 +104,13 @@ func (f *UpstreamFunctionDiscovery) DetectType(ctx context.Context, url *url.URL
 	return svcInfo, nil
 }
 
-func (f *UpstreamFunctionDiscovery) DetectFunctions(ctx context.Context, url *url.URL, _ func() fds.Dependencies, updatecb func(fds.UpstreamMutator) error) error {
+func (f *UpstreamFunctionDiscovery) DetectFunctions(ctx context.Context, url *url.URL, dependencies func() fds.Dependencies, updatecb func(fds.UpstreamMutator) error) error {
 	for {
 		// TODO: get backoff values from config?
 		err := contextutils.NewExponentioalBackoff(contextutils.ExponentioalBackoff{}).Backoff(ctx, func(ctx context.Context) error {
+			if hasDescriptorSource(f.upstream) {
+				return f.detectFunctionsFromDescriptorsOnce(ctx, dependencies, updatecb)
+			}
 			return f.DetectFunctionsOnce(ctx, url, updatecb)
 		})
 
@@ -102,8 +122,11 @@ func (f *UpstreamFunctionDiscovery) DetectFunctions(ctx context.Context, url *ur
 		}
 
 		// sleep so we are not hogging
-		// TODO(yuval-k): customize time to sleep in config
-		if err := contextutils.Sleep(ctx, time.Minute); err != nil {
+		pollTime := f.functionPollTime
+		if pollTime == 0 {
+			pollTime = time.Minute
+		}
+		if err := contextutils.Sleep(ctx, pollTime); err != nil {
 			return err
 		}
 	}
@@ -131,7 +154,7 @@ func (f *UpstreamFunctionDiscovery) DetectFunctionsOnce(ctx context.Context, url
 
 	for _, s := range services {
 		// ignore the reflection descriptor
-		if s == "grpc.reflection.v1alpha.ServerReflection" {
+		if s == reflectionServiceName {
 			continue
 		}
 		// TODO(yuval-k): do not add the same file twice
@@ -0,0 +1,245 @@
+package swagger
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/go-openapi/spec"
+	errors "github.com/rotisserie/eris"
+	transformation_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/transformation"
+	"github.com/solo-io/go-utils/log"
+)
+
+const (
+	openAPI3SchemaRefPrefix      = "#/components/schemas/"
+	openAPI3ParameterRefPrefix   = "#/components/parameters/"
+	openAPI3RequestBodyRefPrefix = "#/components/requestBodies/"
+)
+
+// openAPI3Doc is the subset of an OpenAPI 3.x document needed to generate REST functions.
+// schemas are parsed as go-openapi json schemas so that they can share the body template logic
+// with swagger 2.0 definitions.
+type openAPI3Doc struct {
+	OpenAPI    string                      `json:"openapi"`
+	Servers    []openAPI3Server            `json:"servers,omitempty"`
+	Paths      map[string]openAPI3PathItem `json:"paths,omitempty"`
+	Components openAPI3Components          `json:"components,omitempty"`
+}
+
+type openAPI3Server struct {
+	URL       string                            `json:"url"`
+	Variables map[string]openAPI3ServerVariable `json:"variables,omitempty"`
+}
+
+type openAPI3ServerVariable struct {
+	Default string `json:"default"`
+}
+
+type openAPI3PathItem struct {
+	Parameters []openAPI3Parameter `json:"parameters,omitempty"`
+	Get        *openAPI3Operation  `json:"get,omitempty"`
+	Put        *openAPI3Operation  `json:"put,omitempty"`
+	Post       *openAPI3Operation  `json:"post,omitempty"`
+	Delete     *openAPI3Operation  `json:"delete,omitempty"`
+	Options    *openAPI3Operation  `json:"options,omitempty"`
+	Head       *openAPI3Operation  `json:"head,omitempty"`
+	Patch      *openAPI3Operation  `json:"patch,omitempty"`
+}
+
+type openAPI3Operation struct {
+	OperationID string               `json:"operationId,omitempty"`
+	Parameters  []openAPI3Parameter  `json:"parameters,omitempty"`
+	RequestBody *openAPI3RequestBody `json:"requestBody,omitempty"`
+}
+
+type openAPI3Parameter struct {
+	Ref  string `json:"$ref,omitempty"`
+	Name string `json:"name,omitempty"`
+	In   string `json:"in,omitempty"`
+}
+
+type openAPI3RequestBody struct {
+	Ref     string                       `json:"$ref,omitempty"`
+	Content map[string]openAPI3MediaType `json:"content,omitempty"`
+}
+
+type openAPI3MediaType struct {
+	Schema *spec.Schema `json:"schema,omitempty"`
+}
+
+type openAPI3Components struct {
+	Schemas       map[string]spec.Schema         `json:"schemas,omitempty"`
+	Parameters    map[string]openAPI3Parameter   `json:"parameters,omitempty"`
+	RequestBodies map[string]openAPI3RequestBody `json:"requestBodies,omitempty"`
+}
+
+// returns the document as json, converting from yaml if needed
+func docToJson(docBytes []byte) ([]byte, error) {
+	if json.Valid(docBytes) {
+		return docBytes, nil
+	}
+	jsn, err := yaml.YAMLToJSON(docBytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert yaml to json")
+	}
+	return jsn, nil
+}
+
+// isOpenAPI3Doc returns true if the document declares an `openapi: 3.x` version
+func isOpenAPI3Doc(docBytes []byte) bool {
+	jsn, err := docToJson(docBytes)
+	if err != nil {
+		return false
+	}
+	var version struct {
+		OpenAPI string `json:"openapi"`
+	}
+	if err := json.Unmarshal(jsn, &version); err != nil {
+		return false
+	}
+	return strings.HasPrefix(version.OpenAPI, "3.")
+}
+
+func parseOpenAPI3Doc(docBytes []byte) (*openAPI3Doc, error) {
+	jsn, err := docToJson(docBytes)
+	if err != nil {
+		return nil, err
+	}
+	var doc openAPI3Doc
+	if err := json.Unmarshal(jsn, &doc); err != nil {
+		return nil, errors.Wrap(err, "invalid openapi doc")
+	}
+	if !strings.HasPrefix(doc.OpenAPI, "3.") {
+		return nil, errors.Errorf("unsupported openapi version %q", doc.OpenAPI)
+	}
+	if doc.Paths == nil {
+		return nil, errors.Errorf("openapi spec paths was nil")
+	}
+	return &doc, nil
+}
+
+// the base path for an openapi 3 doc is the path of its first server. server urls may be relative
+// and may contain variables, in which case the default values are substituted.
+func (doc *openAPI3Doc) basePath() string {
+	if len(doc.Servers) == 0 {
+		return ""
+	}
+	server := doc.Servers[0]
+	serverUrl := server.URL
+	for name, variable := range server.Variables {
+		serverUrl = strings.Replace(serverUrl, "{"+name+"}", variable.Default, -1)
+	}
+	parsed, err := url.Parse(serverUrl)
+	if err != nil {
+		log.Warnf("ignoring invalid openapi server url %v: %v", server.URL, err)
+		return ""
+	}
+	return strings.TrimSuffix(parsed.Path, "/")
+}
+
+// definitions returns the component schemas, keyed by the same name used by schema references
+func (doc *openAPI3Doc) definitions() spec.Definitions {
+	return doc.Components.Schemas
+}
+
+func (doc *openAPI3Doc) resolveParameter(param openAPI3Parameter) (openAPI3Parameter, bool) {
+	if param.Ref == "" {
+		return param, true
+	}
+	resolved, ok := doc.Components.Parameters[strings.TrimPrefix(param.Ref, openAPI3ParameterRefPrefix)]
+	return resolved, ok
+}
+
+func (doc *openAPI3Doc) resolveRequestBody(body *openAPI3RequestBody) *openAPI3RequestBody {
+	if body == nil || body.Ref == "" {
+		return body
+	}
+	resolved, ok := doc.Components.RequestBodies[strings.TrimPrefix(body.Ref, openAPI3RequestBodyRefPrefix)]
+	if !ok {
+		return nil
+	}
+	return &resolved
+}
+
+func createFunctionsForOpenAPI3Doc(pathFunctions map[string]*transformation_plugins.TransformationTemplate, doc *openAPI3Doc) {
+	basePath := doc.basePath()
+	for functionPath, pathItem := range doc.Paths {
+		createFunctionsForOpenAPI3Path(pathFunctions, doc, basePath, functionPath, pathItem)
+	}
+}
+
+func createFunctionsForOpenAPI3Path(pathFunctions map[string]*transformation_plugins.TransformationTemplate, doc *openAPI3Doc, basePath, functionPath string, path openAPI3PathItem) {
+	appendFunction := func(method string, operation *openAPI3Operation) {
+		name, trans := createFunctionForOpenAPI3Operation(doc, method, basePath, functionPath, path.Parameters, operation)
+		pathFunctions[name] = trans
+	}
+	if path.Get != nil {
+		appendFunction("GET", path.Get)
+	}
+	if path.Put != nil {
+		appendFunction("PUT", path.Put)
+	}
+	if path.Post != nil {
+		appendFunction("POST", path.Post)
+	}
+	if path.Delete != nil {
+		appendFunction("DELETE", path.Delete)
+	}
+	if path.Options != nil {
+		appendFunction("OPTIONS", path.Options)
+	}
+	if path.Head != nil {
+		appendFunction("HEAD", path.Head)
+	}
+	if path.Patch != nil {
+		appendFunction("PATCH", path.Patch)
+	}
+}
+
+func createFunctionForOpenAPI3Operation(doc *openAPI3Doc, method, basePath, functionPath string, pathParams []openAPI3Parameter, operation *openAPI3Operation) (string, *transformation_plugins.TransformationTemplate) {
+	var queryParams, headerParams []string
+	// operation level parameters override path level parameters with the same name and location
+	params := make(map[string]openAPI3Parameter)
+	var order []string
+	for _, param := range append(append([]openAPI3Parameter{}, pathParams...), operation.Parameters...) {
+		resolved, ok := doc.resolveParameter(param)
+		if !ok {
+			log.Warnf("could not resolve openapi parameter %v; ignoring", param.Ref)
+			continue
+		}
+		key := resolved.In + "/" + resolved.Name
+		if _, exists := params[key]; !exists {
+			order = append(order, key)
+		}
+		params[key] = resolved
+	}
+	for _, key := range order {
+		param := params[key]
+		switch param.In {
+		case "query":
+			queryParams = append(queryParams, param.Name)
+		case "header":
+			headerParams = append(headerParams, param.Name)
+		case "path":
+			// nothing to do here, we already get the template
+		case "cookie":
+			log.Warnf("cookie params not currently supported; ignoring")
+		}
+	}
+
+	var body *string
+	if requestBody := doc.resolveRequestBody(operation.RequestBody); requestBody != nil {
+		if mediaType, ok := requestBody.Content["application/json"]; ok && mediaType.Schema != nil {
+			schema := mediaType.Schema
+			if def := getDefinitionFor(schema.Ref, doc.definitions()); def != nil {
+				schema = def
+			}
+			tmp := getBodyTemplate("", schema.SchemaProps, doc.definitions())
+			body = &tmp
+		}
+	}
+
+	return createFunction(method, basePath, functionPath, operation.OperationID, queryParams, headerParams, body)
+}
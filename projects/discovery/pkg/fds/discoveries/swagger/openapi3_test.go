@@ -0,0 +1,166 @@
+package swagger_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	. "github.com/solo-io/gloo/projects/discovery/pkg/fds/discoveries/swagger"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
+	rest_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/rest"
+	static_plugin_gloo "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+const petstoreOpenAPI3 = `
+openapi: 3.0.0
+info:
+  title: petstore
+  version: 1.0.0
+servers:
+- url: http://{host}/{basePath}/
+  variables:
+    host:
+      default: petstore.example.com
+    basePath:
+      default: api
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      parameters:
+      - $ref: '#/components/parameters/limit'
+      - name: X-Trace
+        in: header
+    post:
+      operationId: addPet
+      requestBody:
+        $ref: '#/components/requestBodies/Pet'
+  /pets/{id}:
+    parameters:
+    - name: id
+      in: path
+      required: true
+    delete:
+      parameters:
+      - name: force
+        in: query
+components:
+  parameters:
+    limit:
+      name: limit
+      in: query
+  requestBodies:
+    Pet:
+      content:
+        application/json:
+          schema:
+            $ref: '#/components/schemas/Pet'
+  schemas:
+    Pet:
+      type: object
+      properties:
+        name:
+          type: string
+        age:
+          type: integer
+`
+
+var _ = Describe("OpenAPI 3", func() {
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	newUpstream := func(spec *plugins.ServiceSpec) *v1.Upstream {
+		return &v1.Upstream{
+			Metadata: core.Metadata{Name: "petstore", Namespace: "default"},
+			UpstreamType: &v1.Upstream_Static{
+				Static: &static_plugin_gloo.UpstreamSpec{ServiceSpec: spec},
+			},
+		}
+	}
+
+	restSpec := func(us *v1.Upstream) *rest_plugins.ServiceSpec {
+		return us.UpstreamType.(*v1.Upstream_Static).Static.GetServiceSpec().GetRest()
+	}
+
+	It("creates functions from an inline openapi 3 document", func() {
+		us := newUpstream(&plugins.ServiceSpec{
+			PluginType: &plugins.ServiceSpec_Rest{
+				Rest: &rest_plugins.ServiceSpec{
+					SwaggerInfo: &rest_plugins.ServiceSpec_SwaggerInfo{
+						SwaggerSpec: &rest_plugins.ServiceSpec_SwaggerInfo_Inline{Inline: petstoreOpenAPI3},
+					},
+				},
+			},
+		})
+		factory := &SwaggerFunctionDiscoveryFactory{}
+		discovery := factory.NewFunctionDiscovery(us)
+		Expect(discovery.IsFunctional()).To(BeTrue())
+
+		err := discovery.DetectFunctions(ctx, nil, nil, func(mutator fds.UpstreamMutator) error {
+			return mutator(us)
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		transformations := restSpec(us).GetTransformations()
+		Expect(transformations).To(HaveLen(3))
+
+		listPets := transformations["listPets"]
+		Expect(listPets).NotTo(BeNil())
+		Expect(listPets.Headers[":method"].Text).To(Equal("GET"))
+		Expect(listPets.Headers[":path"].Text).To(Equal(`/api/pets?limit={{default(limit, "")}}`))
+		Expect(listPets.Headers["X-Trace"].Text).To(Equal(`{{default(X-Trace, "")}}`))
+
+		addPet := transformations["addPet"]
+		Expect(addPet).NotTo(BeNil())
+		Expect(addPet.Headers[":method"].Text).To(Equal("POST"))
+		Expect(addPet.Headers["content-type"].Text).To(Equal("application/json"))
+		Expect(addPet.GetBody().GetText()).To(Equal(`{"age": {{ default(age, "") }},"name": "{{ default(name, "")}}"}`))
+
+		deletePet := transformations["delete.pets.{id}"]
+		Expect(deletePet).NotTo(BeNil())
+		Expect(deletePet.Headers[":path"].Text).To(Equal(`/api/pets/{{ default(id, "") }}?force={{default(force, "")}}`))
+	})
+
+	It("detects an openapi 3 document at a path configured on the upstream", func() {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/docs/openapi.yaml" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write([]byte(petstoreOpenAPI3))
+		}))
+		defer srv.Close()
+
+		us := newUpstream(nil)
+		us.Metadata.Annotations = map[string]string{SwaggerPathsAnnotation: "docs/openapi.yaml"}
+
+		factory := &SwaggerFunctionDiscoveryFactory{DetectionTimeout: time.Second}
+		discovery := factory.NewFunctionDiscovery(us)
+		Expect(discovery.IsFunctional()).To(BeFalse())
+
+		baseUrl, err := url.Parse(srv.URL)
+		Expect(err).NotTo(HaveOccurred())
+		spec, err := discovery.DetectType(ctx, baseUrl)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(spec.GetRest().GetSwaggerInfo().GetUrl()).To(Equal(srv.URL + "/docs/openapi.yaml"))
+	})
+})
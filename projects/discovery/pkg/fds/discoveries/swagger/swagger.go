@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/go-openapi/loads"
@@ -30,8 +31,16 @@ var commonSwaggerURIs = []string{
 	"/swagger/docs/v2",
 	"/v1/swagger",
 	"/v2/swagger",
+	"/openapi.json",
+	"/openapi.yaml",
+	"/v3/api-docs",
 }
 
+// SwaggerPathsAnnotation can be set on an Upstream (or on the Kubernetes Service it was discovered from)
+// to a comma-separated list of paths at which to look for a Swagger 2.0 or OpenAPI 3.x document.
+// These paths are tried before the ones configured on the factory and the well-known defaults.
+const SwaggerPathsAnnotation = "discovery.solo.io/swagger_paths"
+
 // TODO(yuval-k): run this in a back off for a limited amount of time, with high initial retry.
 // maybe backoff with initial 1 minute a total of 10 minutes till giving up. this should probably be configurable
 
@@ -45,11 +54,27 @@ func (f *SwaggerFunctionDiscoveryFactory) NewFunctionDiscovery(u *v1.Upstream) f
 	return &SwaggerFunctionDiscovery{
 		detectionTimeout: f.DetectionTimeout,
 		functionPollTime: f.FunctionPollTime,
-		swaggerUrisToTry: append(f.SwaggerUrisToTry, commonSwaggerURIs...),
+		swaggerUrisToTry: swaggerUrisForUpstream(u, f.SwaggerUrisToTry),
 		upstream:         u,
 	}
 }
 
+func swaggerUrisForUpstream(u *v1.Upstream, configuredUris []string) []string {
+	var uris []string
+	for _, uri := range strings.Split(u.GetMetadata().Annotations[SwaggerPathsAnnotation], ",") {
+		uri = strings.TrimSpace(uri)
+		if uri == "" {
+			continue
+		}
+		if !strings.HasPrefix(uri, "/") {
+			uri = "/" + uri
+		}
+		uris = append(uris, uri)
+	}
+	uris = append(uris, configuredUris...)
+	return append(uris, commonSwaggerURIs...)
+}
+
 type SwaggerFunctionDiscovery struct {
 	detectionTimeout time.Duration
 	functionPollTime time.Duration
@@ -128,7 +153,7 @@ func (f *SwaggerFunctionDiscovery) detectUpstreamTypeOnce(ctx context.Context, b
 		}
 		// might have found a swagger service
 		if res.StatusCode == http.StatusOK {
-			if _, err := RetrieveSwaggerDocFromUrl(ctx, url); err != nil {
+			if _, err := retrieveDocFromUrl(ctx, url); err != nil {
 				// first check if this is a context error
 				if ctx.Err() != nil {
 					return nil, ctx.Err()
@@ -182,11 +207,11 @@ func (f *SwaggerFunctionDiscovery) detectFunctionsFromUrl(ctx context.Context, u
 	for {
 		err := contextutils.NewExponentioalBackoff(contextutils.ExponentioalBackoff{}).Backoff(ctx, func(ctx context.Context) error {
 
-			spec, err := RetrieveSwaggerDocFromUrl(ctx, url)
+			doc, err := retrieveDocFromUrl(ctx, url)
 			if err != nil {
 				return err
 			}
-			err = f.detectFunctionsFromSpec(ctx, spec, in, updatecb)
+			err = f.detectFunctionsFromDoc(ctx, doc, in, updatecb)
 			if err != nil {
 				return err
 			}
@@ -207,11 +232,24 @@ func (f *SwaggerFunctionDiscovery) detectFunctionsFromUrl(ctx context.Context, u
 }
 
 func (f *SwaggerFunctionDiscovery) detectFunctionsFromInline(ctx context.Context, document string, in *v1.Upstream, updatecb func(fds.UpstreamMutator) error) error {
-	spec, err := parseSwaggerDoc([]byte(document))
+	doc, err := parseDoc([]byte(document))
 	if err != nil {
 		return err
 	}
-	return f.detectFunctionsFromSpec(ctx, spec, in, updatecb)
+	return f.detectFunctionsFromDoc(ctx, doc, in, updatecb)
+}
+
+func (f *SwaggerFunctionDiscovery) detectFunctionsFromDoc(ctx context.Context, doc *apiDoc, in *v1.Upstream, updatecb func(fds.UpstreamMutator) error) error {
+	if doc.openAPI3 != nil {
+		return f.detectFunctionsFromOpenAPI3Spec(ctx, doc.openAPI3, in, updatecb)
+	}
+	return f.detectFunctionsFromSpec(ctx, doc.swagger, in, updatecb)
+}
+
+func (f *SwaggerFunctionDiscovery) detectFunctionsFromOpenAPI3Spec(ctx context.Context, openAPI3Spec *openAPI3Doc, in *v1.Upstream, updatecb func(fds.UpstreamMutator) error) error {
+	funcs := make(map[string]*transformation_plugins.TransformationTemplate)
+	createFunctionsForOpenAPI3Doc(funcs, openAPI3Spec)
+	return updateTransformations(funcs, updatecb)
 }
 
 func (f *SwaggerFunctionDiscovery) detectFunctionsFromSpec(ctx context.Context, swaggerSpec *openapi.Swagger, in *v1.Upstream, updatecb func(fds.UpstreamMutator) error) error {
@@ -241,6 +279,10 @@ func (f *SwaggerFunctionDiscovery) detectFunctionsFromSpec(ctx context.Context,
 		createFunctionsForPath(funcs, swaggerSpec.BasePath, functionPath, pathItem.PathItemProps, swaggerSpec.Definitions)
 	}
 
+	return updateTransformations(funcs, updatecb)
+}
+
+func updateTransformations(funcs map[string]*transformation_plugins.TransformationTemplate, updatecb func(fds.UpstreamMutator) error) error {
 	return updatecb(func(u *v1.Upstream) error {
 		upstreamSpec, ok := u.UpstreamType.(v1.ServiceSpecMutator)
 		if !ok {
@@ -265,6 +307,35 @@ func (f *SwaggerFunctionDiscovery) detectFunctionsFromSpec(ctx context.Context,
 	})
 }
 
+// apiDoc is either a Swagger 2.0 or an OpenAPI 3.x document; exactly one field is set
+type apiDoc struct {
+	swagger  *openapi.Swagger
+	openAPI3 *openAPI3Doc
+}
+
+func retrieveDocFromUrl(ctx context.Context, url string) (*apiDoc, error) {
+	docBytes, err := LoadFromFileOrHTTP(ctx, url)
+	if err != nil {
+		return nil, errors.Wrap(err, "loading swagger doc from url")
+	}
+	return parseDoc(docBytes)
+}
+
+func parseDoc(docBytes []byte) (*apiDoc, error) {
+	if isOpenAPI3Doc(docBytes) {
+		spec, err := parseOpenAPI3Doc(docBytes)
+		if err != nil {
+			return nil, err
+		}
+		return &apiDoc{openAPI3: spec}, nil
+	}
+	spec, err := parseSwaggerDoc(docBytes)
+	if err != nil {
+		return nil, err
+	}
+	return &apiDoc{swagger: spec}, nil
+}
+
 func RetrieveSwaggerDocFromUrl(ctx context.Context, url string) (*openapi.Swagger, error) {
 	docBytes, err := LoadFromFileOrHTTP(ctx, url)
 	if err != nil {
@@ -308,7 +379,7 @@ func parseSwaggerDoc(docBytes []byte) (*openapi.Swagger, error) {
 	doc, err := loads.Analyzed(docBytes, "")
 	if err != nil {
 		log.Debugf("parsing doc as json failed, falling back to yaml")
-		jsn, err := swag.YAMLToJSON(docBytes)
+		jsn, err := docToJson(docBytes)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to convert yaml to json (after falling back to yaml parsing)")
 		}
@@ -46,7 +46,7 @@ func createFunctionForOpertaion(method string, basePath, functionPath string, op
 		// sort parameters by the template they will go into
 		switch param.In {
 		case "query":
-			queryParams = append(queryParams, fmt.Sprintf("%v={{default(%v, \"\")}}", param.Name, param.Name))
+			queryParams = append(queryParams, param.Name)
 		case "header":
 			headerParams = append(headerParams, param.Name)
 		case "path":
@@ -60,6 +60,17 @@ func createFunctionForOpertaion(method string, basePath, functionPath string, op
 		}
 	}
 
+	return createFunction(method, basePath, functionPath, operation.ID, queryParams, headerParams, body)
+}
+
+// createFunction builds the request transformation for a single operation. it is shared by
+// swagger 2.0 and openapi 3.x documents.
+func createFunction(method, basePath, functionPath, operationId string, queryParamNames, headerParams []string, body *string) (string, *transformation_plugins.TransformationTemplate) {
+	var queryParams []string
+	for _, name := range queryParamNames {
+		queryParams = append(queryParams, fmt.Sprintf("%v={{default(%v, \"\")}}", name, name))
+	}
+
 	path := swaggerPathToJinjaTemplate(basePath + functionPath)
 	if len(queryParams) > 0 {
 		path += "?" + strings.Join(queryParams, "&")
@@ -71,7 +82,7 @@ func createFunctionForOpertaion(method string, basePath, functionPath string, op
 		headersTemplate[name] = fmt.Sprintf("{{default(%v, \"\")}}", name)
 	}
 
-	fnName := operation.ID
+	fnName := operationId
 	if fnName == "" {
 		fnName = strings.ToLower(method) + strings.Replace(functionPath, "/", ".", -1)
 	}
@@ -165,7 +176,7 @@ func getBodyTemplate(parent string, schema spec.SchemaProps, definitions spec.De
 }
 
 func getDefinitionFor(ref spec.Ref, definitions spec.Definitions) *spec.Schema {
-	refName := strings.TrimPrefix(ref.String(), "#/definitions/")
+	refName := strings.TrimPrefix(strings.TrimPrefix(ref.String(), "#/definitions/"), openAPI3SchemaRefPrefix)
 	schema, ok := definitions[refName]
 	if !ok {
 		return nil
@@ -1,12 +1,15 @@
 package syncer
 
 import (
+	"context"
 	"time"
 
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 
+	"github.com/solo-io/gloo/pkg/utils/leaderelector"
 	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
 	"github.com/solo-io/gloo/projects/discovery/pkg/fds/discoveries/aws"
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds/discoveries/graphql"
 	"github.com/solo-io/gloo/projects/discovery/pkg/fds/discoveries/grpc"
 	"github.com/solo-io/gloo/projects/discovery/pkg/fds/discoveries/swagger"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
@@ -19,6 +22,19 @@ import (
 )
 
 func RunFDS(opts bootstrap.Opts) error {
+	opts.WatchOpts = opts.WatchOpts.WithDefaults()
+	identity, err := leaderelector.ForSettings(opts.WatchOpts.Ctx, opts.Settings, "fds")
+	if err != nil {
+		return err
+	}
+	// only the leader discovers and writes upstreams
+	return leaderelector.RunWhileLeader(opts.WatchOpts.Ctx, identity, func(ctx context.Context) error {
+		opts.WatchOpts.Ctx = ctx
+		return runFDS(opts)
+	})
+}
+
+func runFDS(opts bootstrap.Opts) error {
 	fdsMode := getFdsMode(opts.Settings)
 	if fdsMode == v1.Settings_DiscoveryOptions_DISABLED {
 		contextutils.LoggerFrom(opts.WatchOpts.Ctx).Info("function discovery disabled. to enable, modify "+
@@ -43,6 +59,13 @@ func RunFDS(opts bootstrap.Opts) error {
 	if err := secretClient.Register(); err != nil {
 		return err
 	}
+	artifactClient, err := v1.NewArtifactClient(opts.Artifacts)
+	if err != nil {
+		return err
+	}
+	if err := artifactClient.Register(); err != nil {
+		return err
+	}
 
 	var nsClient skkube.KubeNamespaceClient
 	if opts.KubeClient != nil && opts.KubeCoreCache.NamespaceLister() != nil {
@@ -73,6 +96,11 @@ func RunFDS(opts bootstrap.Opts) error {
 		&grpc.FunctionDiscoveryFactory{
 			DetectionTimeout: time.Minute,
 			FunctionPollTime: time.Second * 15,
+			Artifacts:        artifactClient,
+		},
+		&graphql.FunctionDiscoveryFactory{
+			DetectionTimeout: time.Minute,
+			FunctionPollTime: time.Second * 15,
 		},
 	}
 
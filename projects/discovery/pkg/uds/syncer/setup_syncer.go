@@ -1,7 +1,10 @@
 package syncer
 
 import (
+	"context"
+
 	"github.com/solo-io/gloo/pkg/utils"
+	"github.com/solo-io/gloo/pkg/utils/leaderelector"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap"
 	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
@@ -15,6 +18,19 @@ import (
 )
 
 func RunUDS(opts bootstrap.Opts) error {
+	opts.WatchOpts = opts.WatchOpts.WithDefaults()
+	identity, err := leaderelector.ForSettings(opts.WatchOpts.Ctx, opts.Settings, "uds")
+	if err != nil {
+		return err
+	}
+	// only the leader discovers and writes upstreams
+	return leaderelector.RunWhileLeader(opts.WatchOpts.Ctx, identity, func(ctx context.Context) error {
+		opts.WatchOpts.Ctx = ctx
+		return runUDS(opts)
+	})
+}
+
+func runUDS(opts bootstrap.Opts) error {
 	watchOpts := opts.WatchOpts.WithDefaults()
 	watchOpts.Ctx = contextutils.WithLogger(watchOpts.Ctx, "uds")
 
@@ -0,0 +1,131 @@
+package reporting
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gateway/pkg/translator"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	validationutils "github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+)
+
+// the key of a route's status in the subresource statuses of the virtual service or route table it is defined on.
+// named routes are keyed by their name, unnamed routes by their index.
+func RouteStatusKey(route *v1.Route, routeIndex int) string {
+	name := route.GetName()
+	if name == "" {
+		name = fmt.Sprintf("routes[%d]", routeIndex)
+	}
+	return fmt.Sprintf("%T.%v", route, name)
+}
+
+// Returns the statuses gloo reported for the routes of the proxy, keyed by the virtual services and route tables
+// they were generated from, and the routes of those resources. A route which was delegated to is reported on the
+// route table it is defined on as well as on the route of each resource delegating to it.
+// Routes without errors or warnings have no status.
+func RouteStatusesBySource(reports reporter.ResourceReports, proxy *gloov1.Proxy, proxyStatus core.Status) (map[resources.InputResource]map[string]*core.Status, error) {
+	statuses := map[resources.InputResource]map[string]*core.Status{}
+	if len(proxyStatus.SubresourceStatuses) == 0 {
+		return statuses, nil
+	}
+
+	for _, listener := range proxy.GetListeners() {
+		for _, virtualHost := range listener.GetHttpListener().GetVirtualHosts() {
+			for i, route := range virtualHost.GetRoutes() {
+				routeStatus, ok := proxyStatus.SubresourceStatuses[validationutils.RouteStatusKey(listener, virtualHost, i)]
+				if !ok || routeStatus == nil {
+					continue
+				}
+				reasons := strings.Split(routeStatus.Reason, "\n")
+				if names := envoyRouteNames(route); len(names) > 0 {
+					for j := range reasons {
+						reasons[j] = fmt.Sprintf("[envoy route %v] %v", strings.Join(names, ", "), reasons[j])
+					}
+				}
+
+				if err := translator.ForEachSource(route, func(src translator.SourceRef) error {
+					if src.RouteIndex == nil {
+						return nil
+					}
+					resource, _ := reports.Find(src.ResourceKind, src.ResourceRef)
+					sourceRoute := routeAtIndex(resource, *src.RouteIndex)
+					if sourceRoute == nil {
+						// the resource changed since the proxy was generated
+						return nil
+					}
+					if statuses[resource] == nil {
+						statuses[resource] = map[string]*core.Status{}
+					}
+					key := RouteStatusKey(sourceRoute, *src.RouteIndex)
+					statuses[resource][key] = mergeRouteStatus(statuses[resource][key], routeStatus.State, reasons)
+					return nil
+				}); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	return statuses, nil
+}
+
+// gloo names the envoy route generated for each matcher of a named route <name>-<matcher index>
+func envoyRouteNames(route *gloov1.Route) []string {
+	if route.GetName() == "" {
+		return nil
+	}
+	var names []string
+	for i := range route.GetMatchers() {
+		names = append(names, fmt.Sprintf("%v-%d", route.GetName(), i))
+	}
+	return names
+}
+
+func routeAtIndex(resource resources.InputResource, index int) *v1.Route {
+	var routes []*v1.Route
+	switch resource := resource.(type) {
+	case *v1.VirtualService:
+		routes = resource.GetVirtualHost().GetRoutes()
+	case *v1.RouteTable:
+		routes = resource.GetRoutes()
+	}
+	if index < 0 || index >= len(routes) {
+		return nil
+	}
+	return routes[index]
+}
+
+// a route generated into several proxy routes (e.g. by delegating) is rejected if any of them is rejected,
+// and lists the reasons for each of them
+func mergeRouteStatus(status *core.Status, state core.Status_State, reasons []string) *core.Status {
+	if status == nil {
+		status = &core.Status{State: state}
+	} else if state == core.Status_Rejected {
+		status.State = state
+	}
+	var merged []string
+	if status.Reason != "" {
+		merged = strings.Split(status.Reason, "\n")
+	}
+	for _, reason := range reasons {
+		if !containsString(merged, reason) {
+			merged = append(merged, reason)
+		}
+	}
+	sort.Strings(merged)
+	status.Reason = strings.Join(merged, "\n")
+	return status
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,102 @@
+package reporting_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gateway/pkg/translator"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
+	"github.com/solo-io/gloo/test/samples"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+
+	. "github.com/solo-io/gloo/projects/gateway/pkg/reporting"
+)
+
+var _ = Describe("RouteStatusesBySource", func() {
+	var (
+		snap    *v1.ApiSnapshot
+		proxy   *gloov1.Proxy
+		reports reporter.ResourceReports
+		ns      = "ns"
+	)
+	BeforeEach(func() {
+		snap = samples.GatewaySnapshotWithDelegates(core.ResourceRef{Name: "us", Namespace: ns}, ns)
+		tx := translator.NewTranslator([]translator.ListenerFactory{&translator.HttpTranslator{}, &translator.TcpTranslator{}}, translator.Opts{})
+		proxy, reports = tx.Translate(context.TODO(), "proxy", ns, snap, snap.Gateways)
+	})
+
+	// returns the status keys of the proxy routes generated from the route table
+	delegatedRouteKeys := func() []string {
+		var keys []string
+		for _, listener := range proxy.Listeners {
+			for _, vh := range listener.GetHttpListener().GetVirtualHosts() {
+				for i, route := range vh.Routes {
+					Expect(translator.ForEachSource(route, func(src translator.SourceRef) error {
+						if src.ResourceKind == "*v1.RouteTable" {
+							keys = append(keys, validation.RouteStatusKey(listener, vh, i))
+						}
+						return nil
+					})).NotTo(HaveOccurred())
+				}
+			}
+		}
+		Expect(keys).NotTo(BeEmpty())
+		return keys
+	}
+
+	It("reports route statuses on the route table and the delegating virtual services", func() {
+		proxyStatus := core.Status{State: core.Status_Rejected, SubresourceStatuses: map[string]*core.Status{}}
+		for _, key := range delegatedRouteKeys() {
+			proxyStatus.SubresourceStatuses[key] = &core.Status{
+				State:  core.Status_Rejected,
+				Reason: "Route Error: ProcessingError. Reason: bad route",
+			}
+		}
+
+		statuses, err := RouteStatusesBySource(reports, proxy, proxyStatus)
+		Expect(err).NotTo(HaveOccurred())
+
+		rt := snap.RouteTables[0]
+		Expect(statuses[rt]).To(Equal(map[string]*core.Status{
+			"*v1.Route.routes[0]": {
+				State:  core.Status_Rejected,
+				Reason: "Route Error: ProcessingError. Reason: bad route",
+			},
+		}))
+		for _, vs := range snap.VirtualServices {
+			delegateIndex := len(vs.VirtualHost.Routes) - 1
+			Expect(statuses[vs]).To(HaveKeyWithValue(RouteStatusKey(vs.VirtualHost.Routes[delegateIndex], delegateIndex),
+				&core.Status{
+					State:  core.Status_Rejected,
+					Reason: "Route Error: ProcessingError. Reason: bad route",
+				}))
+			Expect(statuses[vs]).To(HaveLen(1))
+		}
+	})
+
+	It("includes the names of the generated envoy routes", func() {
+		snap.RouteTables[0].Routes[0].Name = "named"
+		proxy, reports = translator.NewTranslator([]translator.ListenerFactory{&translator.HttpTranslator{}}, translator.Opts{}).
+			Translate(context.TODO(), "proxy", ns, snap, snap.Gateways)
+
+		key := delegatedRouteKeys()[0]
+		statuses, err := RouteStatusesBySource(reports, proxy, core.Status{SubresourceStatuses: map[string]*core.Status{
+			key: {State: core.Status_Warning, Reason: "Route Warning: InvalidDestinationWarning. Reason: no upstream"},
+		}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(statuses[snap.RouteTables[0]]).To(HaveKey("*v1.Route.named"))
+		Expect(statuses[snap.RouteTables[0]]["*v1.Route.named"].State).To(Equal(core.Status_Warning))
+		Expect(statuses[snap.RouteTables[0]]["*v1.Route.named"].Reason).To(MatchRegexp(
+			`^\[envoy route vs:.*_route:<unnamed>_rt:delegated-routes_route:named-\d+\] Route Warning: InvalidDestinationWarning. Reason: no upstream$`))
+	})
+
+	It("returns no statuses when no routes have errors or warnings", func() {
+		statuses, err := RouteStatusesBySource(reports, proxy, core.Status{State: core.Status_Accepted})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(statuses).To(BeEmpty())
+	})
+})
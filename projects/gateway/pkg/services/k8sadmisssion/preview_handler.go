@@ -0,0 +1,104 @@
+package k8sadmisssion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/ghodss/yaml"
+	errors "github.com/rotisserie/eris"
+	gwv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gateway/pkg/validation"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/utils/protoutils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	PreviewPath = "/preview"
+)
+
+// serves the impact of applying a Virtual Service or Route Table to the latest snapshot, without
+// writing it. the resource is posted in kubernetes format, as JSON or YAML.
+type previewHandler struct {
+	ctx       context.Context
+	validator validation.Validator
+}
+
+func NewPreviewHandler(ctx context.Context, validator validation.Validator) *previewHandler {
+	return &previewHandler{ctx: ctx, validator: validator}
+}
+
+func (h *previewHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	logger := contextutils.LoggerFrom(h.ctx)
+
+	if r.Method != http.MethodPost {
+		http.Error(w, fmt.Sprintf("method %v not allowed, expecting %v", r.Method, http.MethodPost), http.StatusMethodNotAllowed)
+		return
+	}
+
+	var body []byte
+	if r.Body != nil {
+		if data, err := ioutil.ReadAll(r.Body); err == nil {
+			body = data
+		}
+		defer r.Body.Close()
+	}
+	if len(body) == 0 {
+		http.Error(w, "empty body", http.StatusBadRequest)
+		return
+	}
+
+	preview, err := h.preview(body)
+	if err != nil {
+		logger.Errorf("failed to preview change: %v", err)
+		status := http.StatusBadRequest
+		if err == validation.NotReadyErr {
+			status = http.StatusServiceUnavailable
+		}
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	resp, err := json.Marshal(preview)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("could not encode response: %v", err), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", ApplicationJson)
+	if _, err := w.Write(resp); err != nil {
+		logger.Errorf("Can't write response: %v", err)
+	}
+}
+
+func (h *previewHandler) preview(body []byte) (*validation.ImpactPreview, error) {
+	// yaml is a superset of json
+	rawJson, err := yaml.YAMLToJSON(body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not parse resource")
+	}
+	var typeMeta metav1.TypeMeta
+	if err := json.Unmarshal(rawJson, &typeMeta); err != nil {
+		return nil, errors.Wrapf(err, "could not parse resource")
+	}
+
+	switch gvk := typeMeta.GroupVersionKind(); gvk {
+	case gwv1.VirtualServiceGVK:
+		var vs gwv1.VirtualService
+		if err := protoutils.UnmarshalResource(rawJson, &vs); err != nil {
+			return nil, validation.WrappedUnmarshalErr(err)
+		}
+		return h.validator.PreviewVirtualService(h.ctx, &vs)
+	case gwv1.RouteTableGVK:
+		var rt gwv1.RouteTable
+		if err := protoutils.UnmarshalResource(rawJson, &rt); err != nil {
+			return nil, validation.WrappedUnmarshalErr(err)
+		}
+		return h.validator.PreviewRouteTable(h.ctx, &rt)
+	default:
+		return nil, errors.Errorf("previewing changes to %v is not supported, expected a %v or %v",
+			gvk, gwv1.VirtualServiceGVK.Kind, gwv1.RouteTableGVK.Kind)
+	}
+}
@@ -0,0 +1,95 @@
+package k8sadmisssion
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gateway/pkg/validation"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("PreviewHandler", func() {
+
+	var (
+		srv *httptest.Server
+		mv  *mockValidator
+	)
+	BeforeEach(func() {
+		mv = &mockValidator{}
+		srv = httptest.NewServer(NewPreviewHandler(context.TODO(), mv))
+	})
+	AfterEach(func() {
+		srv.Close()
+	})
+
+	impact := &validation.ImpactPreview{Proxies: []*validation.ProxyImpact{{
+		Proxy:        core.ResourceRef{Name: "gateway-proxy", Namespace: "gloo-system"},
+		ProxyChanges: []validation.ResourceChange{{Type: "Route", Name: "listener-::-8080/gloo-system.vs/routes[0]", Change: validation.Modified}},
+	}}}
+
+	post := func(body string) *http.Response {
+		res, err := srv.Client().Post(srv.URL+PreviewPath, ApplicationYaml, bytes.NewBufferString(body))
+		Expect(err).NotTo(HaveOccurred())
+		return res
+	}
+
+	It("previews virtual services", func() {
+		var previewed *v1.VirtualService
+		mv.fPreviewVirtualService = func(ctx context.Context, vs *v1.VirtualService) (*validation.ImpactPreview, error) {
+			previewed = vs
+			return impact, nil
+		}
+
+		res := post(`
+apiVersion: gateway.solo.io/v1
+kind: VirtualService
+metadata:
+  name: vs
+  namespace: gloo-system
+spec:
+  virtualHost:
+    domains: ["*"]
+`)
+		Expect(res.StatusCode).To(Equal(http.StatusOK))
+		var preview validation.ImpactPreview
+		Expect(json.NewDecoder(res.Body).Decode(&preview)).NotTo(HaveOccurred())
+		Expect(&preview).To(Equal(impact))
+		Expect(previewed.GetMetadata().Ref()).To(Equal(core.ResourceRef{Name: "vs", Namespace: "gloo-system"}))
+		Expect(previewed.GetVirtualHost().GetDomains()).To(Equal([]string{"*"}))
+	})
+
+	It("previews route tables", func() {
+		var previewed *v1.RouteTable
+		mv.fPreviewRouteTable = func(ctx context.Context, rt *v1.RouteTable) (*validation.ImpactPreview, error) {
+			previewed = rt
+			return impact, nil
+		}
+
+		res := post(`{"apiVersion": "gateway.solo.io/v1", "kind": "RouteTable", "metadata": {"name": "rt", "namespace": "gloo-system"}}`)
+		Expect(res.StatusCode).To(Equal(http.StatusOK))
+		Expect(previewed.GetMetadata().Ref()).To(Equal(core.ResourceRef{Name: "rt", Namespace: "gloo-system"}))
+	})
+
+	It("rejects unsupported kinds", func() {
+		res := post(`{"apiVersion": "gateway.solo.io/v1", "kind": "Gateway", "metadata": {"name": "gw"}}`)
+		Expect(res.StatusCode).To(Equal(http.StatusBadRequest))
+		body, err := ioutil.ReadAll(res.Body)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(body)).To(ContainSubstring("previewing changes to gateway.solo.io/v1, Kind=Gateway is not supported"))
+	})
+
+	It("returns service unavailable before the first snapshot", func() {
+		mv.fPreviewVirtualService = func(ctx context.Context, vs *v1.VirtualService) (*validation.ImpactPreview, error) {
+			return nil, validation.NotReadyErr
+		}
+		res := post(`{"apiVersion": "gateway.solo.io/v1", "kind": "VirtualService", "metadata": {"name": "vs"}}`)
+		Expect(res.StatusCode).To(Equal(http.StatusServiceUnavailable))
+	})
+})
@@ -0,0 +1,235 @@
+package k8sadmisssion
+
+import (
+	"fmt"
+	"strings"
+
+	gwv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gateway/pkg/translator"
+	"github.com/solo-io/gloo/projects/gateway/pkg/validation"
+	validationapi "github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// the resource under admission. errors originating from it are reported with the path of the offending field,
+// errors originating from other resources name the resource and field they originate from.
+type admittedResource struct {
+	kind string
+	ref  core.ResourceRef
+}
+
+func newAdmittedResource(gvk schema.GroupVersionKind, ref core.ResourceRef) admittedResource {
+	var kind string
+	switch gvk {
+	case gwv1.GatewayGVK:
+		kind = resources.Kind(&gwv1.Gateway{})
+	case gwv1.VirtualServiceGVK:
+		kind = resources.Kind(&gwv1.VirtualService{})
+	case gwv1.RouteTableGVK:
+		kind = resources.Kind(&gwv1.RouteTable{})
+	}
+	return admittedResource{kind: kind, ref: ref}
+}
+
+// a problem found in a proxy report, along with the config objects on the proxy it applies to
+type reportedProblem struct {
+	message string
+	// the listener, virtual host or route the problem was reported on
+	configObj translator.ObjectWithMetadata
+	// returns the path of the field the problem originates from on the given source of the config object.
+	// innermost is true for the first source, e.g. the route table a route was defined on
+	fieldPath func(src translator.SourceRef, innermost bool) string
+}
+
+func getFailureCauses(proxyReports validation.ProxyReports, admitted admittedResource) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	forEachProblem(proxyReports, false, func(problem reportedProblem) {
+		field, location := admitted.locate(problem)
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: problem.message + location,
+			Field:   field,
+		})
+	})
+	return causes
+}
+
+func getWarnings(proxyReports validation.ProxyReports, admitted admittedResource) []string {
+	var warnings []string
+	forEachProblem(proxyReports, true, func(problem reportedProblem) {
+		field, location := admitted.locate(problem)
+		if field != "" {
+			location = fmt.Sprintf(" (%v)", field)
+		}
+		warnings = append(warnings, problem.message+location)
+	})
+	return warnings
+}
+
+// returns the field path of the problem on the admitted resource if it originates from it, otherwise a
+// description of the resource and field it originates from
+func (a admittedResource) locate(problem reportedProblem) (string, string) {
+	meta, err := translator.GetSourceMeta(problem.configObj)
+	if err != nil || meta == nil || len(meta.Sources) == 0 {
+		return "", ""
+	}
+	for i, src := range meta.Sources {
+		if a.kind != "" && src.ResourceKind == a.kind && src.ResourceRef == a.ref {
+			return problem.fieldPath(src, i == 0), ""
+		}
+	}
+	src := meta.Sources[0]
+	return "", fmt.Sprintf(" (%v %v %v)", strings.TrimPrefix(src.ResourceKind, "*v1."), src.ResourceRef.Key(), problem.fieldPath(src, true))
+}
+
+// calls fn for every error (or warning, if warnings is true) in the proxy reports
+func forEachProblem(proxyReports validation.ProxyReports, warnings bool, fn func(problem reportedProblem)) {
+	for proxy, proxyReport := range proxyReports {
+		listeners := proxy.GetListeners()
+		for i, listenerReport := range proxyReport.GetListenerReports() {
+			if i >= len(listeners) {
+				break
+			}
+			listener := listeners[i]
+			if warnings {
+				forEachHttpListenerWarning(listener, listenerReport.GetHttpListenerReport(), fn)
+				continue
+			}
+
+			for _, err := range listenerReport.GetErrors() {
+				fn(reportedProblem{
+					message:   fmt.Sprintf("Listener Error %v: %v", err.GetType().String(), err.GetReason()),
+					configObj: listener,
+					fieldPath: func(translator.SourceRef, bool) string { return "spec" },
+				})
+			}
+			switch listenerType := listenerReport.GetListenerTypeReport().(type) {
+			case *validationapi.ListenerReport_HttpListenerReport:
+				forEachHttpListenerError(listener, listenerType.HttpListenerReport, fn)
+			case *validationapi.ListenerReport_TcpListenerReport:
+				forEachTcpListenerError(listener, listenerType.TcpListenerReport, fn)
+			}
+		}
+	}
+}
+
+func forEachHttpListenerError(listener *gloov1.Listener, report *validationapi.HttpListenerReport, fn func(problem reportedProblem)) {
+	for _, err := range report.GetErrors() {
+		fn(reportedProblem{
+			message:   fmt.Sprintf("HTTPListener Error %v: %v", err.GetType().String(), err.GetReason()),
+			configObj: listener,
+			fieldPath: func(translator.SourceRef, bool) string { return "spec.httpGateway" },
+		})
+	}
+
+	virtualHosts := listener.GetHttpListener().GetVirtualHosts()
+	for j, vhReport := range report.GetVirtualHostReports() {
+		if j >= len(virtualHosts) {
+			break
+		}
+		virtualHost := virtualHosts[j]
+		for _, err := range vhReport.GetErrors() {
+			field := "spec.virtualHost"
+			switch err.GetType() {
+			case validationapi.VirtualHostReport_Error_DomainsNotUniqueError, validationapi.VirtualHostReport_Error_EmptyDomainError:
+				field += ".domains"
+			}
+			fn(reportedProblem{
+				message:   fmt.Sprintf("VirtualHost Error %v: %v", err.GetType().String(), err.GetReason()),
+				configObj: virtualHost,
+				fieldPath: func(translator.SourceRef, bool) string { return field },
+			})
+		}
+
+		routes := virtualHost.GetRoutes()
+		for k, routeReport := range vhReport.GetRouteReports() {
+			if k >= len(routes) {
+				break
+			}
+			for _, err := range routeReport.GetErrors() {
+				var fieldSuffix string
+				if err.GetType() == validationapi.RouteReport_Error_InvalidMatcherError {
+					fieldSuffix = ".matchers"
+				}
+				fn(reportedProblem{
+					message:   fmt.Sprintf("Route Error %v: %v", err.GetType().String(), err.GetReason()),
+					configObj: routes[k],
+					fieldPath: routeFieldPath(fieldSuffix),
+				})
+			}
+		}
+	}
+}
+
+func forEachHttpListenerWarning(listener *gloov1.Listener, report *validationapi.HttpListenerReport, fn func(problem reportedProblem)) {
+	virtualHosts := listener.GetHttpListener().GetVirtualHosts()
+	for j, vhReport := range report.GetVirtualHostReports() {
+		if j >= len(virtualHosts) {
+			break
+		}
+		routes := virtualHosts[j].GetRoutes()
+		for k, routeReport := range vhReport.GetRouteReports() {
+			if k >= len(routes) {
+				break
+			}
+			for _, warning := range routeReport.GetWarnings() {
+				var fieldSuffix string
+				if warning.GetType() == validationapi.RouteReport_Warning_InvalidDestinationWarning {
+					fieldSuffix = ".routeAction"
+				}
+				fn(reportedProblem{
+					message:   fmt.Sprintf("Route Warning %v: %v", warning.GetType().String(), warning.GetReason()),
+					configObj: routes[k],
+					fieldPath: routeFieldPath(fieldSuffix),
+				})
+			}
+		}
+	}
+}
+
+func forEachTcpListenerError(listener *gloov1.Listener, report *validationapi.TcpListenerReport, fn func(problem reportedProblem)) {
+	for _, err := range report.GetErrors() {
+		fn(reportedProblem{
+			message:   fmt.Sprintf("TCPListener Error %v: %v", err.GetType().String(), err.GetReason()),
+			configObj: listener,
+			fieldPath: func(translator.SourceRef, bool) string { return "spec.tcpGateway" },
+		})
+	}
+	// tcp hosts are copied from the gateway in order
+	for m, hostReport := range report.GetTcpHostReports() {
+		for _, err := range hostReport.GetErrors() {
+			field := fmt.Sprintf("spec.tcpGateway.tcpHosts[%d]", m)
+			if err.GetType() == validationapi.TcpHostReport_Error_InvalidDestinationError {
+				field += ".destination"
+			}
+			fn(reportedProblem{
+				message:   fmt.Sprintf("TcpHost Error %v: %v", err.GetType().String(), err.GetReason()),
+				configObj: listener,
+				fieldPath: func(translator.SourceRef, bool) string { return field },
+			})
+		}
+	}
+}
+
+// the suffix is only applied to the route the proxy route was generated from, and not to the routes
+// delegating to it
+func routeFieldPath(fieldSuffix string) func(src translator.SourceRef, innermost bool) string {
+	return func(src translator.SourceRef, innermost bool) string {
+		if src.RouteIndex == nil {
+			return ""
+		}
+		routesField := "spec.routes"
+		if src.ResourceKind == resources.Kind(&gwv1.VirtualService{}) {
+			routesField = "spec.virtualHost.routes"
+		}
+		path := fmt.Sprintf("%v[%d]", routesField, *src.RouteIndex)
+		if innermost {
+			path += fieldSuffix
+		}
+		return path
+	}
+}
@@ -0,0 +1,128 @@
+package k8sadmisssion
+
+import (
+	"encoding/json"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	gwv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gateway/pkg/translator"
+	"github.com/solo-io/gloo/projects/gateway/pkg/validation"
+	validationapi "github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	validationutils "github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Status causes", func() {
+
+	var (
+		proxy  *gloov1.Proxy
+		report *validationapi.ProxyReport
+
+		vsRef = core.ResourceRef{Name: "vs", Namespace: "ns"}
+		rtRef = core.ResourceRef{Name: "rt", Namespace: "ns"}
+		gwRef = core.ResourceRef{Name: "gw", Namespace: "ns"}
+	)
+
+	sourceMeta := func(sources ...translator.SourceRef) *types.Struct {
+		data, err := json.Marshal(&translator.SourceMetadata{Sources: sources})
+		Expect(err).NotTo(HaveOccurred())
+		var s types.Struct
+		Expect(jsonpb.UnmarshalString(string(data), &s)).NotTo(HaveOccurred())
+		return &s
+	}
+	source := func(kind string, ref core.ResourceRef, routeIndex *int) translator.SourceRef {
+		return translator.SourceRef{ResourceRef: ref, ResourceKind: kind, RouteIndex: routeIndex}
+	}
+	index := func(i int) *int {
+		return &i
+	}
+
+	BeforeEach(func() {
+		// the first route is defined on the virtual service, the second is delegated to the route table
+		// from the virtual service's third route
+		proxy = &gloov1.Proxy{
+			Metadata: core.Metadata{Name: "proxy", Namespace: "ns"},
+			Listeners: []*gloov1.Listener{{
+				Metadata: sourceMeta(source("*v1.Gateway", gwRef, nil)),
+				ListenerType: &gloov1.Listener_HttpListener{
+					HttpListener: &gloov1.HttpListener{
+						VirtualHosts: []*gloov1.VirtualHost{{
+							Metadata: sourceMeta(source("*v1.VirtualService", vsRef, nil)),
+							Routes: []*gloov1.Route{
+								{Metadata: sourceMeta(source("*v1.VirtualService", vsRef, index(1)))},
+								{Metadata: sourceMeta(
+									source("*v1.RouteTable", rtRef, index(0)),
+									source("*v1.VirtualService", vsRef, index(2)),
+								)},
+							},
+						}},
+					},
+				},
+			}},
+		}
+		report = validationutils.MakeReport(proxy)
+	})
+
+	routeReports := func() []*validationapi.RouteReport {
+		return report.GetListenerReports()[0].GetHttpListenerReport().GetVirtualHostReports()[0].GetRouteReports()
+	}
+
+	It("reports the field path of errors on the admitted resource", func() {
+		validationutils.AppendRouteError(routeReports()[0], validationapi.RouteReport_Error_InvalidMatcherError, "bad matcher")
+		validationutils.AppendRouteError(routeReports()[1], validationapi.RouteReport_Error_ProcessingError, "bad option")
+
+		causes := getFailureCauses(validation.ProxyReports{proxy: report}, newAdmittedResource(gwv1.VirtualServiceGVK, vsRef))
+		Expect(causes).To(ConsistOf(
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "Route Error InvalidMatcherError: bad matcher",
+				Field:   "spec.virtualHost.routes[1].matchers",
+			},
+			// the route table's route is reported on the delegating route
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "Route Error ProcessingError: bad option",
+				Field:   "spec.virtualHost.routes[2]",
+			},
+		))
+	})
+
+	It("reports errors on delegated routes on the route table they are defined on", func() {
+		validationutils.AppendRouteError(routeReports()[1], validationapi.RouteReport_Error_InvalidMatcherError, "bad matcher")
+
+		causes := getFailureCauses(validation.ProxyReports{proxy: report}, newAdmittedResource(gwv1.RouteTableGVK, rtRef))
+		Expect(causes).To(HaveLen(1))
+		Expect(causes[0].Field).To(Equal("spec.routes[0].matchers"))
+	})
+
+	It("names the originating resource for errors on other resources", func() {
+		validationutils.AppendRouteError(routeReports()[1], validationapi.RouteReport_Error_ProcessingError, "missing secret")
+		validationutils.AppendVirtualHostError(report.GetListenerReports()[0].GetHttpListenerReport().GetVirtualHostReports()[0],
+			validationapi.VirtualHostReport_Error_DomainsNotUniqueError, "duplicate domain")
+
+		causes := getFailureCauses(validation.ProxyReports{proxy: report}, newAdmittedResource(gloov1.UpstreamGVK, core.ResourceRef{Name: "us", Namespace: "ns"}))
+		Expect(causes).To(ConsistOf(
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "VirtualHost Error DomainsNotUniqueError: duplicate domain (VirtualService ns.vs spec.virtualHost.domains)",
+			},
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "Route Error ProcessingError: missing secret (RouteTable ns.rt spec.routes[0])",
+			},
+		))
+	})
+
+	It("reports warnings with their field path", func() {
+		validationutils.AppendRouteWarning(routeReports()[0], validationapi.RouteReport_Warning_InvalidDestinationWarning, "upstream not found")
+
+		warnings := getWarnings(validation.ProxyReports{proxy: report}, newAdmittedResource(gwv1.VirtualServiceGVK, vsRef))
+		Expect(warnings).To(ConsistOf("Route Warning InvalidDestinationWarning: upstream not found (spec.virtualHost.routes[1].routeAction)"))
+		Expect(getFailureCauses(validation.ProxyReports{proxy: report}, newAdmittedResource(gwv1.VirtualServiceGVK, vsRef))).To(BeEmpty())
+	})
+})
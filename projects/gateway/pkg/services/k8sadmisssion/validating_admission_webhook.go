@@ -14,7 +14,10 @@ import (
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
+	kubeconverters "github.com/solo-io/gloo/projects/gloo/pkg/api/converters/kube"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kubesecret"
+	kubev1 "k8s.io/api/core/v1"
 
 	"github.com/solo-io/solo-kit/pkg/utils/protoutils"
 
@@ -25,13 +28,12 @@ import (
 
 	validationutil "github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
 
-	validationapi "github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
-
 	errors "github.com/rotisserie/eris"
 	gwv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gateway/pkg/validation"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	admissionv1 "k8s.io/api/admission/v1"
 	"k8s.io/api/admission/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -66,6 +68,11 @@ var (
 		Group:   "",
 		Kind:    "List",
 	}
+	SecretGVK = schema.GroupVersionKind{
+		Version: "v1",
+		Group:   "",
+		Kind:    "Secret",
+	}
 )
 
 const (
@@ -140,6 +147,7 @@ func NewGatewayValidatingWebhook(cfg WebhookConfig) (*http.Server, error) {
 
 	mux := http.NewServeMux()
 	mux.Handle(ValidationPath, handler)
+	mux.Handle(PreviewPath, NewPreviewHandler(contextutils.WithLogger(ctx, "gateway-preview"), validator))
 
 	return &http.Server{
 		Addr:      fmt.Sprintf(":%v", port),
@@ -166,14 +174,25 @@ type gatewayValidationWebhook struct {
 	webhookNamespace              string
 }
 
+// admission.k8s.io/v1 and v1beta1 AdmissionReviews are identical on the wire, so both are decoded
+// into the v1 types. The response is sent with the version of the request.
 type AdmissionReviewWithProxies struct {
-	v1beta1.AdmissionReview
-	Proxies []*gloov1.Proxy `json:"proxies,omitempty"`
+	metav1.TypeMeta `json:",inline"`
+	Request         *admissionv1.AdmissionRequest `json:"request,omitempty"`
+	Response        *AdmissionResponse            `json:"response,omitempty"`
+	Proxies         []*gloov1.Proxy               `json:"proxies,omitempty"`
+}
+
+// AdmissionResponse adds the warnings field introduced in Kubernetes 1.19, which is not part of the
+// admission types we build against. Older API servers ignore it.
+type AdmissionResponse struct {
+	*admissionv1.AdmissionResponse
+	Warnings []string `json:"warnings,omitempty"`
 }
 
 // Validation webhook works properly even if extra fields are provided in the response
 type AdmissionResponseWithProxies struct {
-	*v1beta1.AdmissionResponse
+	*AdmissionResponse
 	Proxies []*gloov1.Proxy `json:"proxies,omitempty"`
 }
 
@@ -217,30 +236,36 @@ func (wh *gatewayValidationWebhook) ServeHTTP(w http.ResponseWriter, r *http.Req
 
 	var (
 		admissionResponse = &AdmissionResponseWithProxies{}
-		review            v1beta1.AdmissionReview
+		review            admissionv1.AdmissionReview
 		err               error
 	)
 
 	if contentType == ApplicationYaml {
-		if err = yaml.Unmarshal(body, &review); err == nil {
-			admissionResponse = wh.makeAdmissionResponse(wh.ctx, &review)
-		}
+		err = yaml.Unmarshal(body, &review)
 	} else {
-		if _, _, err := deserializer.Decode(body, nil, &review); err == nil {
-			admissionResponse = wh.makeAdmissionResponse(wh.ctx, &review)
-		}
+		_, _, err = deserializer.Decode(body, nil, &review)
+	}
+	if err == nil {
+		err = checkAdmissionReviewVersion(review.TypeMeta)
 	}
 
-	if err != nil {
+	if err == nil && review.Request != nil {
+		admissionResponse = wh.makeAdmissionResponse(wh.ctx, &review)
+	} else {
+		if err == nil {
+			err = errors.New("admission review contains no request")
+		}
 		logger.Errorf("Can't decode body: %v", err)
-		admissionResponse.AdmissionResponse = &v1beta1.AdmissionResponse{
-			Result: &metav1.Status{
-				Message: err.Error(),
+		admissionResponse.AdmissionResponse = &AdmissionResponse{
+			AdmissionResponse: &admissionv1.AdmissionResponse{
+				Result: &metav1.Status{
+					Message: err.Error(),
+				},
 			},
 		}
 	}
 
-	admissionReview := AdmissionReviewWithProxies{}
+	admissionReview := AdmissionReviewWithProxies{TypeMeta: responseTypeMeta(review.TypeMeta)}
 	if admissionResponse != nil {
 		admissionReview.Response = admissionResponse.AdmissionResponse
 		admissionReview.Proxies = admissionResponse.Proxies
@@ -264,7 +289,27 @@ func (wh *gatewayValidationWebhook) ServeHTTP(w http.ResponseWriter, r *http.Req
 	logger.Debugf("responded with review: %s", resp)
 }
 
-func (wh *gatewayValidationWebhook) makeAdmissionResponse(ctx context.Context, review *v1beta1.AdmissionReview) *AdmissionResponseWithProxies {
+// only the admission.k8s.io versions we serve are accepted. an empty version is treated as v1beta1,
+// which is what older API servers sent.
+func checkAdmissionReviewVersion(typeMeta metav1.TypeMeta) error {
+	switch typeMeta.APIVersion {
+	case "", admissionv1.SchemeGroupVersion.String(), v1beta1.SchemeGroupVersion.String():
+		return nil
+	}
+	return errors.Errorf("unsupported AdmissionReview version %v, expected one of %v, %v",
+		typeMeta.APIVersion, admissionv1.SchemeGroupVersion, v1beta1.SchemeGroupVersion)
+}
+
+// the API server expects the response in the version it sent the request in
+func responseTypeMeta(requestTypeMeta metav1.TypeMeta) metav1.TypeMeta {
+	apiVersion := requestTypeMeta.APIVersion
+	if apiVersion == "" {
+		apiVersion = v1beta1.SchemeGroupVersion.String()
+	}
+	return metav1.TypeMeta{APIVersion: apiVersion, Kind: "AdmissionReview"}
+}
+
+func (wh *gatewayValidationWebhook) makeAdmissionResponse(ctx context.Context, review *admissionv1.AdmissionReview) *AdmissionResponseWithProxies {
 	logger := contextutils.LoggerFrom(ctx)
 
 	req := review.Request
@@ -303,8 +348,10 @@ func (wh *gatewayValidationWebhook) makeAdmissionResponse(ctx context.Context, r
 	// if it's not our namespace, do not validate
 	if !validatingForNamespace {
 		return &AdmissionResponseWithProxies{
-			AdmissionResponse: &v1beta1.AdmissionResponse{
-				Allowed: true,
+			AdmissionResponse: &AdmissionResponse{
+				AdmissionResponse: &admissionv1.AdmissionResponse{
+					Allowed: true,
+				},
 			},
 		}
 	}
@@ -314,7 +361,7 @@ func (wh *gatewayValidationWebhook) makeAdmissionResponse(ctx context.Context, r
 		Name:      req.Name,
 	}
 
-	isDelete := req.Operation == v1beta1.Delete
+	isDelete := req.Operation == admissionv1.Delete
 
 	var dryRun bool
 	if req.DryRun != nil {
@@ -329,13 +376,23 @@ func (wh *gatewayValidationWebhook) makeAdmissionResponse(ctx context.Context, r
 
 	isUnmarshalErr := validationErr != nil && errors.Is(validationErr, UnmarshalErr)
 
+	admitted := newAdmittedResource(gvk, ref)
+	warnings := getWarnings(proxyReports, admitted)
+
 	// even if validation is set to always accept, we want to fail on unmarshal errors
 	if validationErr == nil || (wh.alwaysAccept && !isUnmarshalErr) {
 		logger.Debug("Succeeded, alwaysAccept: %v validationErr: %v", wh.alwaysAccept, validationErr)
 		incrementMetric(ctx, gvk.String(), ref, mGatewayResourcesAccepted)
+		if validationErr != nil {
+			// let the user know the resource was only accepted because validation is set to always accept
+			warnings = append(warnings, fmt.Sprintf("resource accepted with validation errors: %v", validationErr))
+		}
 		return &AdmissionResponseWithProxies{
-			AdmissionResponse: &v1beta1.AdmissionResponse{
-				Allowed: true,
+			AdmissionResponse: &AdmissionResponse{
+				AdmissionResponse: &admissionv1.AdmissionResponse{
+					Allowed: true,
+				},
+				Warnings: warnings,
 			},
 			Proxies: proxies,
 		}
@@ -359,70 +416,23 @@ func (wh *gatewayValidationWebhook) makeAdmissionResponse(ctx context.Context, r
 		Name:   req.Name,
 		Group:  gvk.Group,
 		Kind:   gvk.Kind,
-		Causes: wh.getFailureCauses(proxyReports),
+		Causes: getFailureCauses(proxyReports, admitted),
 	}
 
 	return &AdmissionResponseWithProxies{
-		AdmissionResponse: &v1beta1.AdmissionResponse{
-			Result: &metav1.Status{
-				Message: validationErr.Error(),
-				Details: details,
+		AdmissionResponse: &AdmissionResponse{
+			AdmissionResponse: &admissionv1.AdmissionResponse{
+				Result: &metav1.Status{
+					Message: validationErr.Error(),
+					Details: details,
+				},
 			},
+			Warnings: warnings,
 		},
 		Proxies: proxies,
 	}
 }
 
-func (wh *gatewayValidationWebhook) getFailureCauses(proxyReports validation.ProxyReports) []metav1.StatusCause {
-	var causes []metav1.StatusCause
-	for _, proxyReport := range proxyReports {
-		for _, listenerReport := range proxyReport.ListenerReports {
-			for _, err := range listenerReport.Errors {
-				causes = append(causes, metav1.StatusCause{
-					Message: fmt.Sprintf("Listener Error %v: %v", err.Type.String(), err.Reason),
-				})
-			}
-			switch listener := listenerReport.ListenerTypeReport.(type) {
-			case *validationapi.ListenerReport_HttpListenerReport:
-				for _, err := range listener.HttpListenerReport.Errors {
-					causes = append(causes, metav1.StatusCause{
-						Message: fmt.Sprintf("HTTPListener Error %v: %v", err.Type.String(), err.Reason),
-					})
-				}
-				for _, vh := range listener.HttpListenerReport.VirtualHostReports {
-					for _, err := range vh.Errors {
-						causes = append(causes, metav1.StatusCause{
-							Message: fmt.Sprintf("VirtualHost Error %v: %v", err.Type.String(), err.Reason),
-						})
-					}
-					for _, r := range vh.RouteReports {
-						for _, err := range r.Errors {
-							causes = append(causes, metav1.StatusCause{
-								Message: fmt.Sprintf("Route Error %v: %v", err.Type.String(), err.Reason),
-							})
-						}
-					}
-				}
-			case *validationapi.ListenerReport_TcpListenerReport:
-				for _, err := range listener.TcpListenerReport.Errors {
-					causes = append(causes, metav1.StatusCause{
-						Message: fmt.Sprintf("TCPListener Error %v: %v", err.Type.String(), err.Reason),
-					})
-				}
-				for _, host := range listener.TcpListenerReport.TcpHostReports {
-					for _, err := range host.Errors {
-						causes = append(causes, metav1.StatusCause{
-							Message: fmt.Sprintf("TcpHost Error %v: %v", err.Type.String(), err.Reason),
-						})
-					}
-				}
-			}
-		}
-	}
-
-	return causes
-}
-
 func (wh *gatewayValidationWebhook) validate(ctx context.Context, gvk schema.GroupVersionKind, ref core.ResourceRef, rawJson []byte, isDelete, dryRun bool) (validation.ProxyReports, error) {
 
 	switch gvk {
@@ -446,6 +456,30 @@ func (wh *gatewayValidationWebhook) validate(ctx context.Context, gvk schema.Gro
 		} else {
 			return wh.validateRouteTable(ctx, rawJson, dryRun)
 		}
+	case gloov1.UpstreamGVK:
+		if isDelete {
+			return validation.ProxyReports{}, wh.validator.ValidateDeleteUpstream(ctx, ref)
+		} else {
+			return wh.validateUpstream(ctx, rawJson)
+		}
+	case gloov1.UpstreamGroupGVK:
+		if isDelete {
+			return validation.ProxyReports{}, wh.validator.ValidateDeleteUpstreamGroup(ctx, ref)
+		} else {
+			return wh.validateUpstreamGroup(ctx, rawJson)
+		}
+	case SecretGVK:
+		if isDelete {
+			return validation.ProxyReports{}, wh.validator.ValidateDeleteSecret(ctx, ref)
+		} else {
+			return wh.validateSecret(ctx, rawJson)
+		}
+	case gloov1.SettingsGVK:
+		if isDelete {
+			// we don't validate settings deletion
+			break
+		}
+		return validation.ProxyReports{}, wh.validateSettings(ctx, rawJson)
 	}
 	return validation.ProxyReports{}, nil
 
@@ -520,3 +554,106 @@ func (wh *gatewayValidationWebhook) validateRouteTable(ctx context.Context, rawJ
 	}
 	return proxyReports, nil
 }
+
+func (wh *gatewayValidationWebhook) validateUpstream(ctx context.Context, rawJson []byte) (validation.ProxyReports, error) {
+	var (
+		us           gloov1.Upstream
+		proxyReports validation.ProxyReports
+		err          error
+	)
+	if err := protoutils.UnmarshalResource(rawJson, &us); err != nil {
+		return nil, WrappedUnmarshalErr(err)
+	}
+	if skipValidationCheck(us.Metadata.Annotations) {
+		return nil, nil
+	}
+	if proxyReports, err = wh.validator.ValidateUpstream(ctx, &us); err != nil {
+		return proxyReports, errors.Wrapf(err, "Validating %T failed", us)
+	}
+	return proxyReports, nil
+}
+
+func (wh *gatewayValidationWebhook) validateUpstreamGroup(ctx context.Context, rawJson []byte) (validation.ProxyReports, error) {
+	var (
+		ug           gloov1.UpstreamGroup
+		proxyReports validation.ProxyReports
+		err          error
+	)
+	if err := protoutils.UnmarshalResource(rawJson, &ug); err != nil {
+		return nil, WrappedUnmarshalErr(err)
+	}
+	if skipValidationCheck(ug.Metadata.Annotations) {
+		return nil, nil
+	}
+	if proxyReports, err = wh.validator.ValidateUpstreamGroup(ctx, &ug); err != nil {
+		return proxyReports, errors.Wrapf(err, "Validating %T failed", ug)
+	}
+	return proxyReports, nil
+}
+
+func (wh *gatewayValidationWebhook) validateSecret(ctx context.Context, rawJson []byte) (validation.ProxyReports, error) {
+	var (
+		kubeSecret   kubev1.Secret
+		proxyReports validation.ProxyReports
+		err          error
+	)
+	if err := json.Unmarshal(rawJson, &kubeSecret); err != nil {
+		return nil, WrappedUnmarshalErr(err)
+	}
+	if skipValidationCheck(kubeSecret.Annotations) {
+		return nil, nil
+	}
+	secret, err := convertKubeSecret(ctx, &kubeSecret)
+	if err != nil {
+		return nil, WrappedUnmarshalErr(err)
+	}
+	// secrets which gloo does not read can't affect any proxy
+	if secret == nil {
+		return nil, nil
+	}
+	if proxyReports, err = wh.validator.ValidateSecret(ctx, secret); err != nil {
+		return proxyReports, errors.Wrapf(err, "Validating %T failed", *secret)
+	}
+	return proxyReports, nil
+}
+
+// convert the kube secret the same way the gloo secret client does, returning nil for secrets
+// which are not gloo secrets
+func convertKubeSecret(ctx context.Context, kubeSecret *kubev1.Secret) (*gloov1.Secret, error) {
+	rc, err := kubesecret.NewResourceClientWithSecretConverter(nil, &gloov1.Secret{}, nil, kubeconverters.GlooSecretConverterChain)
+	if err != nil {
+		return nil, err
+	}
+	resource, err := kubeconverters.GlooSecretConverterChain.FromKubeSecret(ctx, rc, kubeSecret)
+	if err != nil {
+		return nil, err
+	}
+	if resource == nil {
+		resource, err = rc.FromKubeSecret(kubeSecret)
+		if err == kubesecret.NotOurResource {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	secret, ok := resource.(*gloov1.Secret)
+	if !ok {
+		return nil, errors.Errorf("unexpected resource type %T converted from kube secret", resource)
+	}
+	return secret, nil
+}
+
+func (wh *gatewayValidationWebhook) validateSettings(ctx context.Context, rawJson []byte) error {
+	var settings gloov1.Settings
+	if err := protoutils.UnmarshalResource(rawJson, &settings); err != nil {
+		return WrappedUnmarshalErr(err)
+	}
+	if skipValidationCheck(settings.Metadata.Annotations) {
+		return nil
+	}
+	if err := wh.validator.ValidateSettings(ctx, &settings); err != nil {
+		return errors.Wrapf(err, "Validating %T failed", settings)
+	}
+	return nil
+}
@@ -21,10 +21,13 @@ import (
 	. "github.com/onsi/gomega"
 	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gateway/pkg/defaults"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/crd"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	admissionv1 "k8s.io/api/admission/v1"
 	"k8s.io/api/admission/v1beta1"
+	kubev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -56,6 +59,8 @@ var _ = Describe("ValidatingAdmissionWebhook", func() {
 	}
 
 	routeTable := &v1.RouteTable{Metadata: core.Metadata{Namespace: "namespace", Name: "rt"}}
+	upstream := &gloov1.Upstream{Metadata: core.Metadata{Namespace: "namespace", Name: "us"}}
+	upstreamGroup := &gloov1.UpstreamGroup{Metadata: core.Metadata{Namespace: "namespace", Name: "ug"}}
 
 	errMsg := "didn't say the magic word"
 
@@ -76,6 +81,12 @@ var _ = Describe("ValidatingAdmissionWebhook", func() {
 			mv.fValidateRouteTable = func(ctx context.Context, rt *v1.RouteTable, dryRun bool) (validation.ProxyReports, error) {
 				return proxyReports(), fmt.Errorf(errMsg)
 			}
+			mv.fValidateUpstream = func(ctx context.Context, us *gloov1.Upstream) (validation.ProxyReports, error) {
+				return proxyReports(), fmt.Errorf(errMsg)
+			}
+			mv.fValidateUpstreamGroup = func(ctx context.Context, ug *gloov1.UpstreamGroup) (validation.ProxyReports, error) {
+				return proxyReports(), fmt.Errorf(errMsg)
+			}
 		}
 		req, err := makeReviewRequest(srv.URL, crd, gvk, v1beta1.Create, resource)
 
@@ -104,10 +115,105 @@ var _ = Describe("ValidatingAdmissionWebhook", func() {
 		Entry("invalid virtual service", false, v1.VirtualServiceCrd, v1.VirtualServiceCrd.GroupVersionKind(), vs),
 		Entry("valid route table", true, v1.RouteTableCrd, v1.RouteTableCrd.GroupVersionKind(), routeTable),
 		Entry("invalid route table", false, v1.RouteTableCrd, v1.RouteTableCrd.GroupVersionKind(), routeTable),
+		Entry("valid upstream", true, gloov1.UpstreamCrd, gloov1.UpstreamCrd.GroupVersionKind(), upstream),
+		Entry("invalid upstream", false, gloov1.UpstreamCrd, gloov1.UpstreamCrd.GroupVersionKind(), upstream),
+		Entry("valid upstream group", true, gloov1.UpstreamGroupCrd, gloov1.UpstreamGroupCrd.GroupVersionKind(), upstreamGroup),
+		Entry("invalid upstream group", false, gloov1.UpstreamGroupCrd, gloov1.UpstreamGroupCrd.GroupVersionKind(), upstreamGroup),
 		Entry("valid unstructured list", true, nil, ListGVK, unstructuredList),
 		Entry("invalid unstructured list", false, nil, ListGVK, unstructuredList),
 	)
 
+	Context("gloo resources", func() {
+
+		review := func(gvk schema.GroupVersionKind, operation v1beta1.Operation, name string, raw []byte) *AdmissionReviewWithProxies {
+			req, err := makeReviewRequestRawJsonEncoded(srv.URL, gvk, operation, name, "namespace", raw)
+			Expect(err).NotTo(HaveOccurred())
+
+			res, err := srv.Client().Do(req)
+			Expect(err).NotTo(HaveOccurred())
+
+			review, err := parseReviewResponse(res)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(review.Response).NotTo(BeNil())
+			return review
+		}
+
+		kubeSecret := func(annotations map[string]string, secretType kubev1.SecretType, data map[string][]byte) []byte {
+			raw, err := json.Marshal(&kubev1.Secret{
+				TypeMeta:   metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: "secret", Namespace: "namespace", Annotations: annotations},
+				Type:       secretType,
+				Data:       data,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			return raw
+		}
+
+		It("validates kube tls secrets as gloo secrets", func() {
+			var validated *gloov1.Secret
+			mv.fValidateSecret = func(ctx context.Context, secret *gloov1.Secret) (validation.ProxyReports, error) {
+				validated = secret
+				return proxyReports(), fmt.Errorf(errMsg)
+			}
+
+			raw := kubeSecret(nil, kubev1.SecretTypeTLS, map[string][]byte{
+				kubev1.TLSCertKey:       []byte("cert"),
+				kubev1.TLSPrivateKeyKey: []byte("key"),
+			})
+			rev := review(SecretGVK, v1beta1.Update, "secret", raw)
+
+			Expect(rev.Response.Allowed).To(BeFalse())
+			Expect(rev.Response.Result.Message).To(ContainSubstring(errMsg))
+			Expect(validated.GetTls().GetCertChain()).To(Equal("cert"))
+			Expect(validated.GetMetadata().Ref()).To(Equal(core.ResourceRef{Name: "secret", Namespace: "namespace"}))
+		})
+
+		It("accepts secrets which are not gloo secrets without validating them", func() {
+			mv.fValidateSecret = func(ctx context.Context, secret *gloov1.Secret) (validation.ProxyReports, error) {
+				Fail("secret should not be validated")
+				return nil, nil
+			}
+
+			raw := kubeSecret(nil, kubev1.SecretTypeOpaque, map[string][]byte{"password": []byte("hunter2")})
+			rev := review(SecretGVK, v1beta1.Update, "secret", raw)
+
+			Expect(rev.Response.Allowed).To(BeTrue())
+		})
+
+		It("rejects deletions of resources which proxies depend on", func() {
+			mv.fValidateDeleteUpstream = func(ctx context.Context, us core.ResourceRef) error {
+				Expect(us).To(Equal(core.ResourceRef{Name: "us", Namespace: "namespace"}))
+				return fmt.Errorf(errMsg)
+			}
+			mv.fValidateDeleteSecret = func(ctx context.Context, secret core.ResourceRef) error {
+				return fmt.Errorf(errMsg)
+			}
+
+			rev := review(gloov1.UpstreamCrd.GroupVersionKind(), v1beta1.Delete, "us", nil)
+			Expect(rev.Response.Allowed).To(BeFalse())
+			Expect(rev.Response.Result.Message).To(ContainSubstring(errMsg))
+
+			rev = review(SecretGVK, v1beta1.Delete, "secret", nil)
+			Expect(rev.Response.Allowed).To(BeFalse())
+			Expect(rev.Response.Result.Message).To(ContainSubstring(errMsg))
+		})
+
+		It("validates settings", func() {
+			mv.fValidateSettings = func(ctx context.Context, settings *gloov1.Settings) error {
+				return fmt.Errorf(errMsg)
+			}
+			settings := &gloov1.Settings{Metadata: core.Metadata{Namespace: "namespace", Name: "default"}}
+			resourceCrd, err := gloov1.SettingsCrd.KubeResource(settings)
+			Expect(err).NotTo(HaveOccurred())
+			raw, err := json.Marshal(resourceCrd)
+			Expect(err).NotTo(HaveOccurred())
+
+			rev := review(gloov1.SettingsCrd.GroupVersionKind(), v1beta1.Update, "default", raw)
+			Expect(rev.Response.Allowed).To(BeFalse())
+			Expect(rev.Response.Result.Message).To(ContainSubstring(errMsg))
+		})
+	})
+
 	Context("invalid yaml", func() {
 
 		invalidYamlTests := func(useYamlEncoding bool) {
@@ -140,6 +246,58 @@ var _ = Describe("ValidatingAdmissionWebhook", func() {
 		})
 	})
 
+	Context("admission review versions", func() {
+
+		postReview := func(apiVersion string) *http.Response {
+			review := admissionv1.AdmissionReview{
+				TypeMeta: metav1.TypeMeta{APIVersion: apiVersion, Kind: "AdmissionReview"},
+				Request: &admissionv1.AdmissionRequest{
+					UID:       "1234",
+					Kind:      metav1.GroupVersionKind{Group: v1.RouteTableGVK.Group, Version: v1.RouteTableGVK.Version, Kind: v1.RouteTableGVK.Kind},
+					Name:      routeTable.Metadata.Name,
+					Namespace: routeTable.Metadata.Namespace,
+					Operation: admissionv1.Delete,
+				},
+			}
+			body, err := json.Marshal(review)
+			Expect(err).NotTo(HaveOccurred())
+			res, err := srv.Client().Post(srv.URL+"/validation", ApplicationJson, bytes.NewBuffer(body))
+			Expect(err).NotTo(HaveOccurred())
+			return res
+		}
+
+		DescribeTable("responds with the version of the request", func(requestVersion, responseVersion string) {
+			review, err := parseReviewResponse(postReview(requestVersion))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(review.APIVersion).To(Equal(responseVersion))
+			Expect(review.Kind).To(Equal("AdmissionReview"))
+			Expect(review.Response.UID).To(BeEquivalentTo("1234"))
+			Expect(review.Response.Allowed).To(BeTrue())
+		},
+			Entry("v1", "admission.k8s.io/v1", "admission.k8s.io/v1"),
+			Entry("v1beta1", "admission.k8s.io/v1beta1", "admission.k8s.io/v1beta1"),
+			Entry("unset", "", "admission.k8s.io/v1beta1"),
+		)
+
+		It("rejects unsupported versions", func() {
+			review, err := parseReviewResponse(postReview("admission.k8s.io/v2"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(review.Response.Allowed).To(BeFalse())
+			Expect(review.Response.Result.Message).To(ContainSubstring("unsupported AdmissionReview version admission.k8s.io/v2"))
+		})
+
+		It("returns warnings for accepted resources", func() {
+			wh.alwaysAccept = true
+			mv.fValidateDeleteRouteTable = func(ctx context.Context, rt core.ResourceRef, dryRun bool) error {
+				return fmt.Errorf(errMsg)
+			}
+			review, err := parseReviewResponse(postReview("admission.k8s.io/v1"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(review.Response.Allowed).To(BeTrue())
+			Expect(review.Response.Warnings).To(ConsistOf(ContainSubstring("resource accepted with validation errors: " + errMsg)))
+		})
+	})
+
 	Context("namespace scoping", func() {
 		It("does not process the resource if it's not whitelisted by watchNamespaces", func() {
 			wh.alwaysAccept = false
@@ -273,6 +431,15 @@ type mockValidator struct {
 	fValidateDeleteVirtualService func(ctx context.Context, vs core.ResourceRef, dryRun bool) error
 	fValidateRouteTable           func(ctx context.Context, rt *v1.RouteTable, dryRun bool) (validation.ProxyReports, error)
 	fValidateDeleteRouteTable     func(ctx context.Context, rt core.ResourceRef, dryRun bool) error
+	fValidateUpstream             func(ctx context.Context, us *gloov1.Upstream) (validation.ProxyReports, error)
+	fValidateDeleteUpstream       func(ctx context.Context, us core.ResourceRef) error
+	fValidateUpstreamGroup        func(ctx context.Context, ug *gloov1.UpstreamGroup) (validation.ProxyReports, error)
+	fValidateDeleteUpstreamGroup  func(ctx context.Context, ug core.ResourceRef) error
+	fValidateSecret               func(ctx context.Context, secret *gloov1.Secret) (validation.ProxyReports, error)
+	fValidateDeleteSecret         func(ctx context.Context, secret core.ResourceRef) error
+	fValidateSettings             func(ctx context.Context, settings *gloov1.Settings) error
+	fPreviewVirtualService        func(ctx context.Context, vs *v1.VirtualService) (*validation.ImpactPreview, error)
+	fPreviewRouteTable            func(ctx context.Context, rt *v1.RouteTable) (*validation.ImpactPreview, error)
 }
 
 func (v *mockValidator) Sync(ctx context.Context, snap *v1.ApiSnapshot) error {
@@ -324,6 +491,69 @@ func (v *mockValidator) ValidateDeleteRouteTable(ctx context.Context, rt core.Re
 	return v.fValidateDeleteRouteTable(ctx, rt, dryRun)
 }
 
+func (v *mockValidator) ValidateUpstream(ctx context.Context, us *gloov1.Upstream) (validation.ProxyReports, error) {
+	if v.fValidateUpstream == nil {
+		return proxyReports(), nil
+	}
+	return v.fValidateUpstream(ctx, us)
+}
+
+func (v *mockValidator) ValidateDeleteUpstream(ctx context.Context, us core.ResourceRef) error {
+	if v.fValidateDeleteUpstream == nil {
+		return nil
+	}
+	return v.fValidateDeleteUpstream(ctx, us)
+}
+
+func (v *mockValidator) ValidateUpstreamGroup(ctx context.Context, ug *gloov1.UpstreamGroup) (validation.ProxyReports, error) {
+	if v.fValidateUpstreamGroup == nil {
+		return proxyReports(), nil
+	}
+	return v.fValidateUpstreamGroup(ctx, ug)
+}
+
+func (v *mockValidator) ValidateDeleteUpstreamGroup(ctx context.Context, ug core.ResourceRef) error {
+	if v.fValidateDeleteUpstreamGroup == nil {
+		return nil
+	}
+	return v.fValidateDeleteUpstreamGroup(ctx, ug)
+}
+
+func (v *mockValidator) ValidateSecret(ctx context.Context, secret *gloov1.Secret) (validation.ProxyReports, error) {
+	if v.fValidateSecret == nil {
+		return proxyReports(), nil
+	}
+	return v.fValidateSecret(ctx, secret)
+}
+
+func (v *mockValidator) ValidateDeleteSecret(ctx context.Context, secret core.ResourceRef) error {
+	if v.fValidateDeleteSecret == nil {
+		return nil
+	}
+	return v.fValidateDeleteSecret(ctx, secret)
+}
+
+func (v *mockValidator) ValidateSettings(ctx context.Context, settings *gloov1.Settings) error {
+	if v.fValidateSettings == nil {
+		return nil
+	}
+	return v.fValidateSettings(ctx, settings)
+}
+
+func (v *mockValidator) PreviewVirtualService(ctx context.Context, vs *v1.VirtualService) (*validation.ImpactPreview, error) {
+	if v.fPreviewVirtualService == nil {
+		return &validation.ImpactPreview{}, nil
+	}
+	return v.fPreviewVirtualService(ctx, vs)
+}
+
+func (v *mockValidator) PreviewRouteTable(ctx context.Context, rt *v1.RouteTable) (*validation.ImpactPreview, error) {
+	if v.fPreviewRouteTable == nil {
+		return &validation.ImpactPreview{}, nil
+	}
+	return v.fPreviewRouteTable(ctx, rt)
+}
+
 func proxyReports() validation.ProxyReports {
 	return validation.ProxyReports{
 		{
@@ -18,6 +18,7 @@ import (
 
 	"github.com/gogo/protobuf/types"
 	"github.com/solo-io/gloo/pkg/utils"
+	"github.com/solo-io/gloo/pkg/utils/leaderelector"
 	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gateway/pkg/defaults"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
@@ -49,12 +50,18 @@ func Setup(ctx context.Context, kubeCache kube.SharedCache, inMemoryCache memory
 		return err
 	}
 
+	etcdClient, err := bootstrap.EtcdClientForSettings(ctx, settings)
+	if err != nil {
+		return err
+	}
+
 	params := bootstrap.NewConfigFactoryParams(
 		settings,
 		inMemoryCache,
 		kubeCache,
 		&cfg,
 		consulClient,
+		etcdClient,
 	)
 
 	proxyFactory, err := bootstrap.ConfigFactoryForSettings(params, gloov1.ProxyCrd)
@@ -131,6 +138,11 @@ func Setup(ctx context.Context, kubeCache kube.SharedCache, inMemoryCache memory
 		}
 	}
 
+	identity, err := leaderelector.ForSettings(ctx, settings, "gateway")
+	if err != nil {
+		return err
+	}
+
 	opts := translator.Opts{
 		GlooNamespace:   settings.Metadata.Namespace,
 		WriteNamespace:  writeNamespace,
@@ -146,6 +158,7 @@ func Setup(ctx context.Context, kubeCache kube.SharedCache, inMemoryCache memory
 		DevMode:                       true,
 		ReadGatewaysFromAllNamespaces: settings.GetGateway().GetReadGatewaysFromAllNamespaces(),
 		Validation:                    validation,
+		Identity:                      identity,
 	}
 
 	return RunGateway(opts)
@@ -189,7 +202,13 @@ func RunGateway(opts translator.Opts) error {
 		return err
 	}
 
-	rpt := reporter.NewReporter("gateway", gatewayClient.BaseClient(), virtualServiceClient.BaseClient(), routeTableClient.BaseClient())
+	identity := opts.Identity
+	if identity == nil {
+		identity = leaderelector.AlwaysLeader()
+	}
+
+	// every replica serves validation, but only the leader writes proxies and statuses
+	rpt := leaderelector.NewStatusReporter(reporter.NewReporter("gateway", gatewayClient.BaseClient(), virtualServiceClient.BaseClient(), routeTableClient.BaseClient()), identity)
 	writeErrs := make(chan error)
 
 	txlator := translator.NewDefaultTranslator(opts)
@@ -223,7 +242,34 @@ func RunGateway(opts translator.Opts) error {
 		allowWarnings = opts.Validation.AllowWarnings
 	}
 
-	emitter := v1.NewApiEmitterWithEmit(virtualServiceClient, routeTableClient, gatewayClient, notifications)
+	// resync when validation notifies us, and when this replica is elected so that it writes proxies and statuses
+	resync := make(chan struct{}, 1)
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case _, ok := <-notifications:
+				if !ok {
+					return
+				}
+				select {
+				case resync <- struct{}{}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	leaderelector.OnElected(ctx, identity, func() {
+		select {
+		case resync <- struct{}{}:
+		default:
+			// a sync is already pending
+		}
+	})
+
+	emitter := v1.NewApiEmitterWithEmit(virtualServiceClient, routeTableClient, gatewayClient, resync)
 
 	validationSyncer := gatewayvalidation.NewValidator(gatewayvalidation.NewValidatorConfig(
 		txlator,
@@ -233,7 +279,10 @@ func RunGateway(opts translator.Opts) error {
 		allowWarnings,
 	))
 
-	proxyReconciler := reconciler.NewProxyReconciler(validationClient, proxyClient)
+	proxyReconciler := leaderProxyReconciler{
+		ProxyReconciler: reconciler.NewProxyReconciler(validationClient, proxyClient),
+		identity:        identity,
+	}
 
 	translatorSyncer := NewTranslatorSyncer(
 		ctx,
@@ -335,3 +384,16 @@ func RunGateway(opts translator.Opts) error {
 
 	return nil
 }
+
+// leaderProxyReconciler only writes proxies while the replica leads.
+type leaderProxyReconciler struct {
+	reconciler.ProxyReconciler
+	identity leaderelector.Identity
+}
+
+func (r leaderProxyReconciler) ReconcileProxies(ctx context.Context, proxiesToWrite reconciler.GeneratedProxies, writeNamespace string, labels map[string]string) error {
+	if !r.identity.IsLeader() {
+		return nil
+	}
+	return r.ProxyReconciler.ReconcileProxies(ctx, proxiesToWrite, writeNamespace, labels)
+}
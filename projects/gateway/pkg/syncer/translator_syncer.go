@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/solo-io/gloo/pkg/utils/syncutil"
 	"github.com/solo-io/gloo/projects/gateway/pkg/reconciler"
+	"github.com/solo-io/gloo/projects/gateway/pkg/reporting"
 	"github.com/solo-io/go-utils/hashutils"
 	"go.uber.org/zap"
 
@@ -19,6 +20,7 @@ import (
 	"github.com/solo-io/gloo/projects/gateway/pkg/translator"
 	"github.com/solo-io/gloo/projects/gateway/pkg/utils"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	validationutils "github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
@@ -102,6 +104,8 @@ func (s *translatorSyncer) reconcile(ctx context.Context, desiredProxies reconci
 type reportsAndStatus struct {
 	Status  *core.Status
 	Reports reporter.ResourceReports
+	// the proxy the status was reported on
+	Proxy *gloov1.Proxy
 }
 type statusSyncer struct {
 	proxyToLastStatus       map[core.ResourceRef]reportsAndStatus
@@ -220,10 +224,12 @@ func (s *statusSyncer) setStatuses(list gloov1.ProxyList) {
 		status := proxy.Status
 		if current, ok := s.proxyToLastStatus[ref]; ok {
 			current.Status = &status
+			current.Proxy = proxy
 			s.proxyToLastStatus[ref] = current
 		} else {
 			s.proxyToLastStatus[ref] = reportsAndStatus{
 				Status: &status,
+				Proxy:  proxy,
 			}
 		}
 	}
@@ -280,15 +286,26 @@ func (s *statusSyncer) syncStatus(ctx context.Context) error {
 			if !ok {
 				continue
 			}
+			var routeStatuses map[resources.InputResource]map[string]*core.Status
+			if reportsAndStatus.Status != nil && reportsAndStatus.Proxy != nil {
+				var err error
+				routeStatuses, err = reporting.RouteStatusesBySource(reportsAndStatus.Reports, reportsAndStatus.Proxy, *reportsAndStatus.Status)
+				if err != nil {
+					contextutils.LoggerFrom(ctx).Warnw("failed to report route statuses", zap.Any("proxy", ref), zap.Error(err))
+				}
+			}
 			// merge all the reports for the gateway resources from all the proxies.
 			for inputResource, subresourceStatuses := range reportsAndStatus.Reports {
 				if reportsAndStatus.Status != nil {
 					// add the proxy status as well if we have it
-					status := *reportsAndStatus.Status
+					status := withoutRouteStatuses(*reportsAndStatus.Status)
 					if _, ok := inputResourceBySubresourceStatuses[inputResource]; !ok {
 						inputResourceBySubresourceStatuses[inputResource] = map[string]*core.Status{}
 					}
 					inputResourceBySubresourceStatuses[inputResource][fmt.Sprintf("%T.%s", nilProxy, ref.Key())] = &status
+					for key, routeStatus := range routeStatuses[inputResource] {
+						inputResourceBySubresourceStatuses[inputResource][key] = routeStatus
+					}
 				}
 				if report, ok := allReports[inputResource]; ok {
 					if subresourceStatuses.Errors != nil {
@@ -326,3 +343,20 @@ func (s *statusSyncer) syncStatus(ctx context.Context) error {
 	}
 	return errs
 }
+
+// the statuses of the routes of a proxy are reported on the routes of the resources they were generated from,
+// rather than nested in the proxy status of every resource
+func withoutRouteStatuses(status core.Status) core.Status {
+	var subresourceStatuses map[string]*core.Status
+	for key, subresourceStatus := range status.SubresourceStatuses {
+		if validationutils.IsRouteStatusKey(key) {
+			continue
+		}
+		if subresourceStatuses == nil {
+			subresourceStatuses = map[string]*core.Status{}
+		}
+		subresourceStatuses[key] = subresourceStatus
+	}
+	status.SubresourceStatuses = subresourceStatuses
+	return status
+}
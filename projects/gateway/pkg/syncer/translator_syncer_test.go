@@ -8,8 +8,11 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gateway/pkg/defaults"
 	"github.com/solo-io/gloo/projects/gateway/pkg/reconciler"
+	"github.com/solo-io/gloo/projects/gateway/pkg/translator"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	validationutils "github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
@@ -249,6 +252,49 @@ var _ = Describe("TranslatorSyncer", func() {
 		Expect(mockReporter.Statuses()[reportedKey]).To(BeEquivalentTo(m))
 	})
 
+	It("should report route statuses on the routes of the resources they were generated from", func() {
+		route := func() *gatewayv1.Route {
+			return &gatewayv1.Route{Action: &gatewayv1.Route_DirectResponseAction{
+				DirectResponseAction: &gloov1.DirectResponseAction{Status: 200},
+			}}
+		}
+		firstRoute := route()
+		firstRoute.Name = "first"
+		vs := &gatewayv1.VirtualService{
+			Metadata:    core.Metadata{Name: "vs", Namespace: "gloo-system"},
+			VirtualHost: &gatewayv1.VirtualHost{Routes: []*gatewayv1.Route{firstRoute, route()}},
+		}
+		snap := &gatewayv1.ApiSnapshot{
+			Gateways:        gatewayv1.GatewayList{defaults.DefaultGateway("gloo-system")},
+			VirtualServices: gatewayv1.VirtualServiceList{vs},
+		}
+		tx := translator.NewTranslator([]translator.ListenerFactory{&translator.HttpTranslator{}}, translator.Opts{})
+		proxy, errs := tx.Translate(context.TODO(), "test", "gloo-system", snap, snap.Gateways)
+		Expect(errs.ValidateStrict()).NotTo(HaveOccurred())
+
+		listener := proxy.Listeners[0]
+		virtualHost := listener.GetHttpListener().VirtualHosts[0]
+		routeStatus := &core.Status{State: core.Status_Warning, Reason: "Route Warning: InvalidDestinationWarning. Reason: no upstream"}
+		proxy.Status = core.Status{
+			State: core.Status_Accepted,
+			SubresourceStatuses: map[string]*core.Status{
+				validationutils.RouteStatusKey(listener, virtualHost, 1): routeStatus,
+			},
+		}
+
+		syncer.setCurrentProxies(reconciler.GeneratedProxies{proxy: errs})
+		syncer.setStatuses(gloov1.ProxyList{proxy})
+
+		err := syncer.syncStatus(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+
+		m := map[string]*core.Status{
+			"*v1.Proxy.gloo-system.test": {State: core.Status_Accepted},
+			"*v1.Route.routes[1]":        routeStatus,
+		}
+		Expect(mockReporter.Statuses()[vs.GetMetadata().Ref()]).To(BeEquivalentTo(m))
+	})
+
 })
 
 type fakeWatcher struct {
@@ -20,6 +20,9 @@ type SourceRef struct {
 	core.ResourceRef
 	ResourceKind       string `json:"kind"`
 	ObservedGeneration int64  `json:"observedGeneration"`
+	// set on the sources of routes to the index of the route on the source resource
+	// the route was generated from (or delegated through)
+	RouteIndex *int `json:"routeIndex,omitempty"`
 }
 
 type ObjectWithMetadata interface {
@@ -78,6 +81,17 @@ func appendSource(obj ObjectWithMetadata, source resources.InputResource) error
 	return setObjMeta(obj, meta)
 }
 
+func appendRouteSource(route *v1.Route, source resources.InputResource, routeIndex int) error {
+	meta, err := GetSourceMeta(route)
+	if err != nil {
+		return errors.Wrapf(err, "getting obj metadata")
+	}
+	sourceRef := makeSourceRef(source)
+	sourceRef.RouteIndex = &routeIndex
+	meta.Sources = append(meta.Sources, sourceRef)
+	return setObjMeta(route, meta)
+}
+
 func ForEachSource(obj ObjectWithMetadata, fn func(source SourceRef) error) error {
 	meta, err := GetSourceMeta(obj)
 	if err != nil {
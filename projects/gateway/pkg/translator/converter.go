@@ -159,9 +159,13 @@ func (rv *routeVisitor) visit(
 	visitedRouteTables gatewayv1.RouteTableList,
 	reporterHelper *reporterHelper,
 ) ([]*gloov1.Route, error) {
-	var routes []*gloov1.Route
+	var (
+		routes []*gloov1.Route
+		// the index of the route on this resource each of the routes was generated from
+		sourceRouteIndices []int
+	)
 
-	for _, gatewayRoute := range resource.GetRoutes() {
+	for routeIndex, gatewayRoute := range resource.GetRoutes() {
 
 		// Clone route to be safe, since we might mutate it
 		routeClone := proto.Clone(gatewayRoute).(*gatewayv1.Route)
@@ -254,6 +258,9 @@ func (rv *routeVisitor) visit(
 				}
 
 				routes = append(routes, rtRoutesForWeight...)
+				for range rtRoutesForWeight {
+					sourceRouteIndices = append(sourceRouteIndices, routeIndex)
+				}
 			}
 
 		default:
@@ -283,12 +290,13 @@ func (rv *routeVisitor) visit(
 				continue
 			}
 			routes = append(routes, glooRoute)
+			sourceRouteIndices = append(sourceRouteIndices, routeIndex)
 		}
 	}
 
 	// Append source metadata to all the routes
-	for _, r := range routes {
-		if err := appendSource(r, resource.InputResource()); err != nil {
+	for i, r := range routes {
+		if err := appendRouteSource(r, resource.InputResource(), sourceRouteIndices[i]); err != nil {
 			// should never happen
 			return nil, err
 		}
@@ -1,6 +1,7 @@
 package translator
 
 import (
+	"github.com/solo-io/gloo/pkg/utils/leaderelector"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
 )
@@ -18,6 +19,8 @@ type Opts struct {
 	DevMode                       bool
 	ReadGatewaysFromAllNamespaces bool
 	Validation                    *ValidationOpts
+	// only the leader writes proxies and statuses; defaults to always leading
+	Identity leaderelector.Identity
 }
 
 type ValidationOpts struct {
@@ -1131,6 +1131,7 @@ var expectedRouteMetadatas = [][]*SourceMetadata{
 					},
 					ResourceKind:       "*v1.RouteTable",
 					ObservedGeneration: 0,
+					RouteIndex:         intPtr(0),
 				},
 				{
 					ResourceRef: core.ResourceRef{
@@ -1139,6 +1140,7 @@ var expectedRouteMetadatas = [][]*SourceMetadata{
 					},
 					ResourceKind:       "*v1.VirtualService",
 					ObservedGeneration: 0,
+					RouteIndex:         intPtr(0),
 				},
 			},
 		},
@@ -1151,6 +1153,7 @@ var expectedRouteMetadatas = [][]*SourceMetadata{
 					},
 					ResourceKind:       "*v1.RouteTable",
 					ObservedGeneration: 0,
+					RouteIndex:         intPtr(0),
 				},
 				{
 					ResourceRef: core.ResourceRef{
@@ -1159,6 +1162,7 @@ var expectedRouteMetadatas = [][]*SourceMetadata{
 					},
 					ResourceKind:       "*v1.RouteTable",
 					ObservedGeneration: 0,
+					RouteIndex:         intPtr(1),
 				},
 				{
 					ResourceRef: core.ResourceRef{
@@ -1167,6 +1171,7 @@ var expectedRouteMetadatas = [][]*SourceMetadata{
 					},
 					ResourceKind:       "*v1.VirtualService",
 					ObservedGeneration: 0,
+					RouteIndex:         intPtr(0),
 				},
 			},
 		},
@@ -1179,6 +1184,7 @@ var expectedRouteMetadatas = [][]*SourceMetadata{
 					},
 					ResourceKind:       "*v1.RouteTable",
 					ObservedGeneration: 0,
+					RouteIndex:         intPtr(1),
 				},
 				{
 					ResourceRef: core.ResourceRef{
@@ -1187,6 +1193,7 @@ var expectedRouteMetadatas = [][]*SourceMetadata{
 					},
 					ResourceKind:       "*v1.RouteTable",
 					ObservedGeneration: 0,
+					RouteIndex:         intPtr(1),
 				},
 				{
 					ResourceRef: core.ResourceRef{
@@ -1195,6 +1202,7 @@ var expectedRouteMetadatas = [][]*SourceMetadata{
 					},
 					ResourceKind:       "*v1.VirtualService",
 					ObservedGeneration: 0,
+					RouteIndex:         intPtr(0),
 				},
 			},
 		},
@@ -1209,6 +1217,7 @@ var expectedRouteMetadatas = [][]*SourceMetadata{
 					},
 					ResourceKind:       "*v1.RouteTable",
 					ObservedGeneration: 0,
+					RouteIndex:         intPtr(0),
 				},
 				{
 					ResourceRef: core.ResourceRef{
@@ -1217,6 +1226,7 @@ var expectedRouteMetadatas = [][]*SourceMetadata{
 					},
 					ResourceKind:       "*v1.VirtualService",
 					ObservedGeneration: 0,
+					RouteIndex:         intPtr(0),
 				},
 			},
 		},
@@ -1229,6 +1239,7 @@ var expectedRouteMetadatas = [][]*SourceMetadata{
 					},
 					ResourceKind:       "*v1.RouteTable",
 					ObservedGeneration: 0,
+					RouteIndex:         intPtr(1),
 				},
 				{
 					ResourceRef: core.ResourceRef{
@@ -1237,6 +1248,7 @@ var expectedRouteMetadatas = [][]*SourceMetadata{
 					},
 					ResourceKind:       "*v1.VirtualService",
 					ObservedGeneration: 0,
+					RouteIndex:         intPtr(0),
 				},
 			},
 		},
@@ -1246,3 +1258,7 @@ var expectedRouteMetadatas = [][]*SourceMetadata{
 func expectedRouteMetadata(virtualHostIndex, routeIndex int) *SourceMetadata {
 	return expectedRouteMetadatas[virtualHostIndex][routeIndex]
 }
+
+func intPtr(i int) *int {
+	return &i
+}
@@ -0,0 +1,134 @@
+package validation
+
+import (
+	"context"
+	"time"
+
+	"github.com/avast/retry-go"
+	errors "github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	validationutils "github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"go.uber.org/multierr"
+)
+
+// Gloo resources are not part of the gateway snapshot. Changes to them are sent to the Gloo validation
+// server, which translates every Proxy with the change applied and reports the Proxies it breaks.
+
+func (v *validator) ValidateUpstream(ctx context.Context, us *gloov1.Upstream) (ProxyReports, error) {
+	return v.validateGlooResource(ctx, us, &validation.ResourceValidationServiceRequest{
+		Resource: &validation.ResourceValidationServiceRequest_Upstream{Upstream: us},
+	})
+}
+
+func (v *validator) ValidateDeleteUpstream(ctx context.Context, usRef core.ResourceRef) error {
+	us := &gloov1.Upstream{Metadata: metadataForRef(usRef)}
+	return v.validateDeleteGlooResource(ctx, us, &validation.ResourceValidationServiceRequest{
+		Resource: &validation.ResourceValidationServiceRequest_Upstream{Upstream: us},
+		Delete:   true,
+	})
+}
+
+func (v *validator) ValidateUpstreamGroup(ctx context.Context, ug *gloov1.UpstreamGroup) (ProxyReports, error) {
+	return v.validateGlooResource(ctx, ug, &validation.ResourceValidationServiceRequest{
+		Resource: &validation.ResourceValidationServiceRequest_UpstreamGroup{UpstreamGroup: ug},
+	})
+}
+
+func (v *validator) ValidateDeleteUpstreamGroup(ctx context.Context, ugRef core.ResourceRef) error {
+	ug := &gloov1.UpstreamGroup{Metadata: metadataForRef(ugRef)}
+	return v.validateDeleteGlooResource(ctx, ug, &validation.ResourceValidationServiceRequest{
+		Resource: &validation.ResourceValidationServiceRequest_UpstreamGroup{UpstreamGroup: ug},
+		Delete:   true,
+	})
+}
+
+func (v *validator) ValidateSecret(ctx context.Context, secret *gloov1.Secret) (ProxyReports, error) {
+	return v.validateGlooResource(ctx, secret, &validation.ResourceValidationServiceRequest{
+		Resource: &validation.ResourceValidationServiceRequest_Secret{Secret: secret},
+	})
+}
+
+func (v *validator) ValidateDeleteSecret(ctx context.Context, secretRef core.ResourceRef) error {
+	secret := &gloov1.Secret{Metadata: metadataForRef(secretRef)}
+	return v.validateDeleteGlooResource(ctx, secret, &validation.ResourceValidationServiceRequest{
+		Resource: &validation.ResourceValidationServiceRequest_Secret{Secret: secret},
+		Delete:   true,
+	})
+}
+
+func (v *validator) validateDeleteGlooResource(ctx context.Context, resource resources.Resource, req *validation.ResourceValidationServiceRequest) error {
+	if _, err := v.validateGlooResource(ctx, resource, req); err != nil {
+		return GlooResourceDeleteErr(resource, err)
+	}
+	return nil
+}
+
+func (v *validator) validateGlooResource(ctx context.Context, resource resources.Resource, req *validation.ResourceValidationServiceRequest) (ProxyReports, error) {
+	ctx = contextutils.WithLogger(ctx, "gateway-validator")
+	logger := contextutils.LoggerFrom(ctx)
+	ref := resource.GetMetadata().Ref()
+
+	if v.validationClient == nil {
+		logger.Warnf("skipping validation of %T %v as the Proxy validation client has not been initialized. "+
+			"check to ensure that the gateway and gloo processes are configured to communicate.", resource, ref)
+		return nil, nil
+	}
+
+	var response *validation.ResourceValidationServiceResponse
+	err := retry.Do(func() error {
+		rpt, err := v.validationClient.ValidateResource(ctx, req)
+		response = rpt
+		return err
+	},
+		retry.Attempts(4),
+		retry.Delay(250*time.Millisecond),
+	)
+	if err != nil {
+		err = errors.Wrapf(err, "failed to communicate with Gloo Proxy validation server")
+		if v.ignoreProxyValidationFailure {
+			logger.Error(err)
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var (
+		errs         error
+		proxyReports ProxyReports = map[*gloov1.Proxy]*validation.ProxyReport{}
+	)
+	for _, result := range response.GetProxyResults() {
+		proxy, report := result.GetProxy(), result.GetProxyReport()
+		proxyReports[proxy] = report
+		if err := validationutils.GetProxyError(report); err != nil {
+			errs = multierr.Append(errs, errors.Wrapf(err, "Proxy %v", proxy.GetMetadata().Ref()))
+			continue
+		}
+		// the server only reports the proxies the change makes worse, so the warnings it returns on
+		// deletion are caused by removing a resource that is still referenced
+		if v.allowWarnings && !req.GetDelete() {
+			continue
+		}
+		for _, warning := range validationutils.GetProxyWarning(report) {
+			errs = multierr.Append(errs, errors.Errorf("Proxy %v: %v", proxy.GetMetadata().Ref(), warning))
+		}
+	}
+	for _, resourceErr := range response.GetResourceErrors() {
+		errs = multierr.Append(errs, errors.New(resourceErr))
+	}
+
+	if errs != nil {
+		logger.Debugf("Rejected %T %v: %v", resource, ref, errs)
+		return proxyReports, errors.Wrapf(errs, "validating %T %v", resource, ref)
+	}
+
+	logger.Debugf("Accepted %T %v", resource, ref)
+	return proxyReports, nil
+}
+
+func metadataForRef(ref core.ResourceRef) core.Metadata {
+	return core.Metadata{Name: ref.Name, Namespace: ref.Namespace}
+}
@@ -0,0 +1,137 @@
+package validation
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gateway/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	validationutils "github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
+	"github.com/solo-io/gloo/test/samples"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"google.golang.org/grpc"
+)
+
+var _ = Describe("Gloo resource validation", func() {
+	var (
+		vc    *mockValidationClient
+		proxy *gloov1.Proxy
+		us    *gloov1.Upstream
+	)
+
+	newValidator := func(ignoreProxyValidationFailure, allowWarnings bool) *validator {
+		return NewValidator(NewValidatorConfig(translator.NewDefaultTranslator(translator.Opts{}), vc, "my-namespace", ignoreProxyValidationFailure, allowWarnings))
+	}
+
+	BeforeEach(func() {
+		vc = &mockValidationClient{}
+		us = samples.SimpleUpstream()
+		proxy = samples.SimpleGlooSnapshot().Proxies[0]
+	})
+
+	respondWith := func(report func(rpt *validation.ProxyReport), resourceErrors ...string) func(context.Context, *validation.ResourceValidationServiceRequest, ...grpc.CallOption) (*validation.ResourceValidationServiceResponse, error) {
+		return func(ctx context.Context, in *validation.ResourceValidationServiceRequest, opts ...grpc.CallOption) (*validation.ResourceValidationServiceResponse, error) {
+			response := &validation.ResourceValidationServiceResponse{ResourceErrors: resourceErrors}
+			if report != nil {
+				rpt := validationutils.MakeReport(proxy)
+				report(rpt)
+				response.ProxyResults = []*validation.ResourceValidationServiceResponse_ProxyResult{{Proxy: proxy, ProxyReport: rpt}}
+			}
+			return response, nil
+		}
+	}
+
+	routeWarning := func(rpt *validation.ProxyReport) {
+		routeReport := rpt.GetListenerReports()[0].GetHttpListenerReport().GetVirtualHostReports()[0].GetRouteReports()[0]
+		validationutils.AppendRouteWarning(routeReport, validation.RouteReport_Warning_InvalidDestinationWarning, "upstream not found")
+	}
+	listenerError := func(rpt *validation.ProxyReport) {
+		validationutils.AppendListenerError(rpt.GetListenerReports()[0], validation.ListenerReport_Error_SSLConfigError, "secret not found")
+	}
+
+	It("accepts resources which don't break any proxy", func() {
+		var request *validation.ResourceValidationServiceRequest
+		vc.validateResource = func(ctx context.Context, in *validation.ResourceValidationServiceRequest, opts ...grpc.CallOption) (*validation.ResourceValidationServiceResponse, error) {
+			request = in
+			return &validation.ResourceValidationServiceResponse{}, nil
+		}
+		reports, err := newValidator(false, false).ValidateUpstream(context.TODO(), us)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(reports).To(BeEmpty())
+		Expect(request.GetUpstream()).To(Equal(us))
+		Expect(request.GetDelete()).To(BeFalse())
+	})
+
+	It("rejects resources which cause proxy errors", func() {
+		vc.validateResource = respondWith(listenerError)
+		reports, err := newValidator(false, true).ValidateSecret(context.TODO(), &gloov1.Secret{Metadata: core.Metadata{Name: "tls", Namespace: "ns"}})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("secret not found"))
+		Expect(reports).To(HaveKey(proxy))
+	})
+
+	It("accepts resources which only cause warnings if allowWarnings=true", func() {
+		vc.validateResource = respondWith(routeWarning)
+		_, err := newValidator(false, true).ValidateUpstream(context.TODO(), us)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("rejects resources with errors of their own", func() {
+		vc.validateResource = respondWith(nil, "bad upstream spec")
+		_, err := newValidator(false, false).ValidateUpstream(context.TODO(), us)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("bad upstream spec"))
+	})
+
+	Context("deletion", func() {
+		It("rejects deleting an upstream referenced by routes", func() {
+			var request *validation.ResourceValidationServiceRequest
+			vc.validateResource = func(ctx context.Context, in *validation.ResourceValidationServiceRequest, opts ...grpc.CallOption) (*validation.ResourceValidationServiceResponse, error) {
+				request = in
+				return respondWith(routeWarning)(ctx, in, opts...)
+			}
+			err := newValidator(false, false).ValidateDeleteUpstream(context.TODO(), us.Metadata.Ref())
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Deletion blocked because active Proxies depend on *v1.Upstream"))
+			Expect(err.Error()).To(ContainSubstring("upstream not found"))
+			Expect(request.GetDelete()).To(BeTrue())
+			Expect(request.GetUpstream().GetMetadata().Ref()).To(Equal(us.Metadata.Ref()))
+		})
+
+		It("rejects deletions which cause warnings even if allowWarnings=true", func() {
+			vc.validateResource = respondWith(routeWarning)
+			err := newValidator(false, true).ValidateDeleteUpstream(context.TODO(), us.Metadata.Ref())
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("upstream not found"))
+
+			err = newValidator(false, true).ValidateDeleteSecret(context.TODO(), core.ResourceRef{Name: "tls", Namespace: "ns"})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("allows deletions which don't affect any proxy if allowWarnings=true", func() {
+			vc.validateResource = respondWith(nil)
+			err := newValidator(false, true).ValidateDeleteUpstreamGroup(context.TODO(), core.ResourceRef{Name: "ug", Namespace: "ns"})
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Context("gloo validation server unavailable", func() {
+		BeforeEach(func() {
+			vc.validateResource = func(ctx context.Context, in *validation.ResourceValidationServiceRequest, opts ...grpc.CallOption) (*validation.ResourceValidationServiceResponse, error) {
+				return nil, eris.Errorf("communication no good")
+			}
+		})
+		It("rejects the resource if ignoreProxyValidation=false", func() {
+			_, err := newValidator(false, false).ValidateUpstream(context.TODO(), us)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("failed to communicate with Gloo Proxy validation server"))
+		})
+		It("accepts the resource if ignoreProxyValidation=true", func() {
+			err := newValidator(true, false).ValidateDeleteSecret(context.TODO(), core.ResourceRef{Name: "tls", Namespace: "ns"})
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})
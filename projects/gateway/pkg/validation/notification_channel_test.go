@@ -100,3 +100,11 @@ func (m *mockValidationClient) RecvMsg(v interface{}) error {
 func (m *mockValidationClient) ValidateProxy(ctx context.Context, in *validation.ProxyValidationServiceRequest, opts ...grpc.CallOption) (*validation.ProxyValidationServiceResponse, error) {
 	panic("implement me")
 }
+
+func (m *mockValidationClient) ValidateResource(ctx context.Context, in *validation.ResourceValidationServiceRequest, opts ...grpc.CallOption) (*validation.ResourceValidationServiceResponse, error) {
+	panic("implement me")
+}
+
+func (m *mockValidationClient) TranslateProxy(ctx context.Context, in *validation.ProxyTranslationServiceRequest, opts ...grpc.CallOption) (*validation.ProxyTranslationServiceResponse, error) {
+	panic("implement me")
+}
@@ -0,0 +1,246 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	errors "github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gateway/pkg/utils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/go-utils/hashutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"go.uber.org/multierr"
+)
+
+type ChangeType string
+
+const (
+	Added    ChangeType = "Added"
+	Removed  ChangeType = "Removed"
+	Modified ChangeType = "Modified"
+)
+
+// a listener, virtual host or route on a Proxy, or an Envoy xDS resource, which would be changed
+type ResourceChange struct {
+	// Listener, VirtualHost or Route for proxy config, the short xDS type (e.g. Cluster) for xDS resources
+	Type   string     `json:"type"`
+	Name   string     `json:"name"`
+	Change ChangeType `json:"change"`
+}
+
+// the changes a candidate resource would make to a single proxy
+type ProxyImpact struct {
+	Proxy core.ResourceRef `json:"proxy"`
+	// changes to the Proxy resource generated by the gateway translator
+	ProxyChanges []ResourceChange `json:"proxyChanges,omitempty"`
+	// changes to the Envoy config generated by gloo for the proxy
+	XdsChanges []ResourceChange `json:"xdsChanges,omitempty"`
+	// errors and warnings reported on the candidate resource when translating the proxy
+	Errors   []string `json:"errors,omitempty"`
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// the result of previewing a change, with an entry for every proxy which would change
+type ImpactPreview struct {
+	Proxies []*ProxyImpact `json:"proxies"`
+}
+
+func (v *validator) PreviewVirtualService(ctx context.Context, vs *v1.VirtualService) (*ImpactPreview, error) {
+	return v.previewChange(ctx, func(snap *v1.ApiSnapshot) (proxyNames []string, resource resources.Resource, ref core.ResourceRef) {
+		ref = vs.GetMetadata().Ref()
+		var virtualServices v1.VirtualServiceList
+		for _, existing := range snap.VirtualServices {
+			if existing.GetMetadata().Ref() != ref {
+				virtualServices = append(virtualServices, existing)
+			}
+		}
+		snap.VirtualServices = append(virtualServices, vs)
+		snap.VirtualServices.Sort()
+		return nil, vs, ref
+	})
+}
+
+func (v *validator) PreviewRouteTable(ctx context.Context, rt *v1.RouteTable) (*ImpactPreview, error) {
+	return v.previewChange(ctx, func(snap *v1.ApiSnapshot) (proxyNames []string, resource resources.Resource, ref core.ResourceRef) {
+		ref = rt.GetMetadata().Ref()
+		var routeTables v1.RouteTableList
+		for _, existing := range snap.RouteTables {
+			if existing.GetMetadata().Ref() != ref {
+				routeTables = append(routeTables, existing)
+			}
+		}
+		snap.RouteTables = append(routeTables, rt)
+		snap.RouteTables.Sort()
+		return nil, rt, ref
+	})
+}
+
+// translates every proxy before and after applying the change to a copy of the latest snapshot.
+// every proxy is considered (rather than the proxies returned by apply), as a change may also remove a
+// resource from the proxies it was previously a part of.
+func (v *validator) previewChange(ctx context.Context, apply applyResource) (*ImpactPreview, error) {
+	if !v.ready() {
+		return nil, NotReadyErr
+	}
+
+	ctx = contextutils.WithLogger(ctx, "gateway-preview")
+
+	v.lock.RLock()
+	before := v.latestSnapshot.Clone()
+	v.lock.RUnlock()
+
+	after := before.Clone()
+	_, resource, ref := apply(&after)
+
+	gatewaysBefore := utils.GatewaysByProxyName(before.Gateways)
+	gatewaysAfter := utils.GatewaysByProxyName(after.Gateways)
+	proxyNames := map[string]struct{}{}
+	for proxyName := range gatewaysBefore {
+		proxyNames[proxyName] = struct{}{}
+	}
+	for proxyName := range gatewaysAfter {
+		proxyNames[proxyName] = struct{}{}
+	}
+	var sortedProxyNames []string
+	for proxyName := range proxyNames {
+		sortedProxyNames = append(sortedProxyNames, proxyName)
+	}
+	sort.Strings(sortedProxyNames)
+
+	preview := &ImpactPreview{}
+	for _, proxyName := range sortedProxyNames {
+		proxyBefore, _ := v.translator.Translate(ctx, proxyName, v.writeNamespace, &before, gatewaysBefore[proxyName])
+		proxyAfter, reports := v.translator.Translate(ctx, proxyName, v.writeNamespace, &after, gatewaysAfter[proxyName])
+
+		impact := &ProxyImpact{
+			Proxy:        core.ResourceRef{Name: proxyName, Namespace: v.writeNamespace},
+			ProxyChanges: diffProxies(proxyBefore, proxyAfter),
+		}
+		if _, report := reports.Find(resources.Kind(resource), ref); report.Errors != nil || len(report.Warnings) > 0 {
+			for _, err := range multierr.Errors(report.Errors) {
+				impact.Errors = append(impact.Errors, err.Error())
+			}
+			impact.Warnings = report.Warnings
+		}
+
+		if len(impact.ProxyChanges) > 0 {
+			xdsChanges, err := v.diffXds(ctx, proxyBefore, proxyAfter)
+			if err != nil {
+				return nil, errors.Wrapf(err, "previewing Envoy config for proxy %v", proxyName)
+			}
+			impact.XdsChanges = xdsChanges
+		}
+
+		if len(impact.ProxyChanges) > 0 || len(impact.Errors) > 0 || len(impact.Warnings) > 0 {
+			preview.Proxies = append(preview.Proxies, impact)
+		}
+	}
+
+	contextutils.LoggerFrom(ctx).Debugf("previewed change to %T %v: %v proxies affected", resource, ref, len(preview.Proxies))
+
+	return preview, nil
+}
+
+// translates both proxies with gloo and compares the resulting xDS resources.
+// a nil proxy has no xDS resources
+func (v *validator) diffXds(ctx context.Context, before, after *gloov1.Proxy) ([]ResourceChange, error) {
+	if v.validationClient == nil {
+		contextutils.LoggerFrom(ctx).Warnf("skipping Envoy config preview as the " +
+			"Proxy validation client has not been initialized. check to ensure that the gateway and gloo processes " +
+			"are configured to communicate.")
+		return nil, nil
+	}
+
+	translate := func(proxy *gloov1.Proxy) (map[string]uint64, error) {
+		xdsResources := map[string]uint64{}
+		if proxy == nil {
+			return xdsResources, nil
+		}
+		resp, err := v.validationClient.TranslateProxy(ctx, &validation.ProxyTranslationServiceRequest{Proxy: proxy})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to communicate with Gloo Proxy validation server")
+		}
+		for _, res := range resp.GetXdsResources() {
+			xdsResources[xdsResourceKey(res)] = res.GetHash()
+		}
+		return xdsResources, nil
+	}
+
+	xdsBefore, err := translate(before)
+	if err != nil {
+		return nil, err
+	}
+	xdsAfter, err := translate(after)
+	if err != nil {
+		return nil, err
+	}
+	return diffHashes(xdsBefore, xdsAfter), nil
+}
+
+// resources are keyed by "<Type> <Name>"
+func xdsResourceKey(res *validation.ProxyTranslationServiceResponse_XdsResource) string {
+	typeUrl := res.GetTypeUrl()
+	shortType := typeUrl[strings.LastIndex(typeUrl, ".")+1:]
+	return shortType + " " + res.GetName()
+}
+
+// compares the listeners, virtual hosts and routes of the proxies.
+// routes without a name are identified by their index on the virtual host
+func diffProxies(before, after *gloov1.Proxy) []ResourceChange {
+	flatten := func(proxy *gloov1.Proxy) map[string]uint64 {
+		hashes := map[string]uint64{}
+		for _, listener := range proxy.GetListeners() {
+			hashes["Listener "+listener.GetName()] = hashutils.MustHash(listener)
+			for _, virtualHost := range listener.GetHttpListener().GetVirtualHosts() {
+				vhName := listener.GetName() + "/" + virtualHost.GetName()
+				hashes["VirtualHost "+vhName] = hashutils.MustHash(virtualHost)
+				for i, route := range virtualHost.GetRoutes() {
+					routeName := route.GetName()
+					if routeName == "" {
+						routeName = fmt.Sprintf("routes[%d]", i)
+					}
+					hashes["Route "+vhName+"/"+routeName] = hashutils.MustHash(route)
+				}
+			}
+		}
+		return hashes
+	}
+
+	return diffHashes(flatten(before), flatten(after))
+}
+
+// compares the hashes of the resources, keyed by "<Type> <Name>"
+func diffHashes(before, after map[string]uint64) []ResourceChange {
+	var changes []ResourceChange
+	addChange := func(key string, change ChangeType) {
+		parts := strings.SplitN(key, " ", 2)
+		changes = append(changes, ResourceChange{Type: parts[0], Name: parts[1], Change: change})
+	}
+
+	for key, hashBefore := range before {
+		if hashAfter, ok := after[key]; !ok {
+			addChange(key, Removed)
+		} else if hashBefore != hashAfter {
+			addChange(key, Modified)
+		}
+	}
+	for key := range after {
+		if _, ok := before[key]; !ok {
+			addChange(key, Added)
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].Type != changes[j].Type {
+			return changes[i].Type < changes[j].Type
+		}
+		return changes[i].Name < changes[j].Name
+	})
+	return changes
+}
@@ -0,0 +1,143 @@
+package validation
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/go-utils/hashutils"
+	"github.com/solo-io/go-utils/testutils"
+
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gateway/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/gloo/test/samples"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"google.golang.org/grpc"
+)
+
+var _ = Describe("Preview", func() {
+	var (
+		vc   *mockValidationClient
+		v    *validator
+		snap *gatewayv1.ApiSnapshot
+		ns   = "my-namespace"
+	)
+
+	// returns a route configuration and a cluster for each proxy. the route configuration changes with the
+	// routes of the proxy, the cluster does not
+	translateProxy := func(ctx context.Context, in *validation.ProxyTranslationServiceRequest, opts ...grpc.CallOption) (*validation.ProxyTranslationServiceResponse, error) {
+		var routes string
+		for _, listener := range in.GetProxy().GetListeners() {
+			for _, vh := range listener.GetHttpListener().GetVirtualHosts() {
+				for _, route := range vh.GetRoutes() {
+					routes += route.String()
+				}
+			}
+		}
+		return &validation.ProxyTranslationServiceResponse{XdsResources: []*validation.ProxyTranslationServiceResponse_XdsResource{
+			{TypeUrl: "type.googleapis.com/envoy.api.v2.Cluster", Name: "us", Hash: 1},
+			{TypeUrl: "type.googleapis.com/envoy.api.v2.RouteConfiguration", Name: "listener-::-8080-routes", Hash: hashutils.HashAll(routes)},
+		}}, nil
+	}
+
+	changes := func(resourceChanges []ResourceChange) []string {
+		var descriptions []string
+		for _, change := range resourceChanges {
+			descriptions = append(descriptions, change.Type+" "+string(change.Change))
+		}
+		return descriptions
+	}
+
+	BeforeEach(func() {
+		vc = &mockValidationClient{translateProxy: translateProxy}
+		v = NewValidator(NewValidatorConfig(translator.NewDefaultTranslator(translator.Opts{}), vc, ns, false, false))
+		us := samples.SimpleUpstream()
+		snap = samples.GatewaySnapshotWithDelegates(us.Metadata.Ref(), ns)
+	})
+
+	It("returns an error before sync called", func() {
+		_, err := v.PreviewRouteTable(context.TODO(), snap.RouteTables[0])
+		Expect(err).To(testutils.HaveInErrorChain(NotReadyErr))
+	})
+
+	It("reports no proxies for a resource which doesn't change them", func() {
+		Expect(v.Sync(context.TODO(), snap)).NotTo(HaveOccurred())
+		vc.translateProxy = nil
+
+		preview, err := v.PreviewRouteTable(context.TODO(), proto.Clone(snap.RouteTables[0]).(*gatewayv1.RouteTable))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(preview.Proxies).To(BeEmpty())
+	})
+
+	It("reports the proxy config and envoy resources changed by a route table", func() {
+		Expect(v.Sync(context.TODO(), snap)).NotTo(HaveOccurred())
+
+		rt := proto.Clone(snap.RouteTables[0]).(*gatewayv1.RouteTable)
+		rt.Routes[0].Matchers = []*matchers.Matcher{{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/changed"}}}
+
+		preview, err := v.PreviewRouteTable(context.TODO(), rt)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(preview.Proxies).To(HaveLen(1))
+
+		impact := preview.Proxies[0]
+		Expect(impact.Proxy).To(Equal(core.ResourceRef{Name: "gateway-proxy", Namespace: ns}))
+		Expect(changes(impact.ProxyChanges)).To(ContainElement("Route Modified"))
+		Expect(changes(impact.ProxyChanges)).NotTo(ContainElement(ContainSubstring("Added")))
+		Expect(impact.XdsChanges).To(Equal([]ResourceChange{
+			{Type: "RouteConfiguration", Name: "listener-::-8080-routes", Change: Modified},
+		}))
+		Expect(impact.Errors).To(BeEmpty())
+	})
+
+	It("reports the virtual hosts added by a new virtual service", func() {
+		Expect(v.Sync(context.TODO(), snap)).NotTo(HaveOccurred())
+
+		vs := proto.Clone(snap.VirtualServices[0]).(*gatewayv1.VirtualService)
+		vs.Metadata.Name = "new-vs"
+		vs.VirtualHost.Domains = []string{"new.example.com"}
+
+		preview, err := v.PreviewVirtualService(context.TODO(), vs)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(preview.Proxies).To(HaveLen(1))
+		Expect(changes(preview.Proxies[0].ProxyChanges)).To(ContainElement("VirtualHost Added"))
+		Expect(changes(preview.Proxies[0].ProxyChanges)).NotTo(ContainElement("VirtualHost Removed"))
+	})
+
+	It("reports errors on the candidate resource", func() {
+		Expect(v.Sync(context.TODO(), snap)).NotTo(HaveOccurred())
+
+		rt := proto.Clone(snap.RouteTables[0]).(*gatewayv1.RouteTable)
+		rt.Routes[0].Matchers = []*matchers.Matcher{{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "missing-parent-prefix"}}}
+
+		preview, err := v.PreviewRouteTable(context.TODO(), rt)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(preview.Proxies).To(HaveLen(1))
+		Expect(preview.Proxies[0].Errors).NotTo(BeEmpty())
+	})
+})
+
+var _ = Describe("diffProxies", func() {
+	It("identifies unnamed routes by their index", func() {
+		listener := func(routes ...*gloov1.Route) *gloov1.Proxy {
+			return &gloov1.Proxy{Listeners: []*gloov1.Listener{{
+				Name: "listener",
+				ListenerType: &gloov1.Listener_HttpListener{HttpListener: &gloov1.HttpListener{
+					VirtualHosts: []*gloov1.VirtualHost{{Name: "vh", Routes: routes}},
+				}},
+			}}}
+		}
+		before := listener(&gloov1.Route{Name: "named"}, &gloov1.Route{})
+		after := listener(&gloov1.Route{Name: "named"}, &gloov1.Route{}, &gloov1.Route{})
+
+		Expect(diffProxies(before, after)).To(Equal([]ResourceChange{
+			{Type: "Listener", Name: "listener", Change: Modified},
+			{Type: "Route", Name: "listener/vh/routes[2]", Change: Added},
+			{Type: "VirtualHost", Name: "listener/vh", Change: Modified},
+		}))
+		Expect(diffProxies(before, nil)).To(HaveLen(4))
+	})
+})
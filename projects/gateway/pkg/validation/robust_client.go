@@ -68,6 +68,30 @@ func (c *connectionRefreshingValidationClient) ValidateProxy(ctx context.Context
 	})
 }
 
+func (c *connectionRefreshingValidationClient) ValidateResource(ctx context.Context, in *validation.ResourceValidationServiceRequest, opts ...grpc.CallOption) (*validation.ResourceValidationServiceResponse, error) {
+	ctx = contextutils.WithLogger(ctx, "retrying-validation-client")
+
+	var resourceReport *validation.ResourceValidationServiceResponse
+
+	return resourceReport, c.retryWithNewClient(ctx, func(validationClient validation.ProxyValidationServiceClient) error {
+		var err error
+		resourceReport, err = validationClient.ValidateResource(ctx, in, opts...)
+		return err
+	})
+}
+
+func (c *connectionRefreshingValidationClient) TranslateProxy(ctx context.Context, in *validation.ProxyTranslationServiceRequest, opts ...grpc.CallOption) (*validation.ProxyTranslationServiceResponse, error) {
+	ctx = contextutils.WithLogger(ctx, "retrying-validation-client")
+
+	var translation *validation.ProxyTranslationServiceResponse
+
+	return translation, c.retryWithNewClient(ctx, func(validationClient validation.ProxyValidationServiceClient) error {
+		var err error
+		translation, err = validationClient.TranslateProxy(ctx, in, opts...)
+		return err
+	})
+}
+
 func (c *connectionRefreshingValidationClient) NotifyOnResync(ctx context.Context, in *validation.NotifyOnResyncRequest, opts ...grpc.CallOption) (validation.ProxyValidationService_NotifyOnResyncClient, error) {
 	var notifier validation.ProxyValidationService_NotifyOnResyncClient
 
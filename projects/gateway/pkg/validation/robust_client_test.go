@@ -24,6 +24,14 @@ func (s *mockValidationService) ValidateProxy(context.Context, *validation.Proxy
 	return res, s.err
 }
 
+func (s *mockValidationService) ValidateResource(context.Context, *validation.ResourceValidationServiceRequest) (*validation.ResourceValidationServiceResponse, error) {
+	panic("implement me")
+}
+
+func (s *mockValidationService) TranslateProxy(context.Context, *validation.ProxyTranslationServiceRequest) (*validation.ProxyTranslationServiceResponse, error) {
+	panic("implement me")
+}
+
 func (s *mockValidationService) NotifyOnResync(*validation.NotifyOnResyncRequest, validation.ProxyValidationService_NotifyOnResyncServer) error {
 	panic("implement me")
 }
@@ -107,6 +115,14 @@ func (c *mockWrappedValidationClient) ValidateProxy(ctx context.Context, in *val
 	return res, c.err
 }
 
+func (c *mockWrappedValidationClient) ValidateResource(ctx context.Context, in *validation.ResourceValidationServiceRequest, opts ...grpc.CallOption) (*validation.ResourceValidationServiceResponse, error) {
+	return nil, c.err
+}
+
+func (c *mockWrappedValidationClient) TranslateProxy(ctx context.Context, in *validation.ProxyTranslationServiceRequest, opts ...grpc.CallOption) (*validation.ProxyTranslationServiceResponse, error) {
+	return nil, c.err
+}
+
 var _ = Describe("RobustClient", func() {
 	It("swaps out the client when it returns a connection error", func() {
 		original := &mockWrappedValidationClient{name: "original"}
@@ -0,0 +1,90 @@
+package validation
+
+import (
+	"context"
+	"net"
+
+	"github.com/gogo/protobuf/types"
+	errors "github.com/rotisserie/eris"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/contextutils"
+	"go.uber.org/multierr"
+)
+
+var (
+	InvalidAddressErr = func(field, addr string, err error) error {
+		return errors.Wrapf(err, "%v %q is not a valid host:port address", field, addr)
+	}
+	InvalidDurationErr = func(field string, err error) error {
+		return errors.Wrapf(err, "%v is not a valid duration", field)
+	}
+	NegativeDurationErr = func(field string) error {
+		return errors.Errorf("%v must not be negative", field)
+	}
+	InvalidResponseCodeErr = func(code uint32) error {
+		return errors.Errorf("invalidConfigPolicy.invalidRouteResponseCode %v is not a valid HTTP status code", code)
+	}
+)
+
+// Settings are not translated into Proxies, so they are checked for values which would prevent
+// gloo and gateway from starting or serving config once the Settings are reloaded.
+func (v *validator) ValidateSettings(ctx context.Context, settings *gloov1.Settings) error {
+	if err := validateSettings(settings); err != nil {
+		contextutils.LoggerFrom(ctx).Debugw("Rejected %T %v: %v", settings, settings.GetMetadata().Ref(), err)
+		return errors.Wrapf(err, "validating %T %v", settings, settings.GetMetadata().Ref())
+	}
+	return nil
+}
+
+func validateSettings(settings *gloov1.Settings) error {
+	var errs error
+
+	addresses := []struct {
+		field, addr string
+	}{
+		{"gloo.xdsBindAddr", settings.GetGloo().GetXdsBindAddr()},
+		{"gloo.validationBindAddr", settings.GetGloo().GetValidationBindAddr()},
+		{"gloo.restXdsBindAddr", settings.GetGloo().GetRestXdsBindAddr()},
+		{"gateway.validationServerAddr", settings.GetGateway().GetValidationServerAddr()},
+		{"gateway.validation.proxyValidationServerAddr", settings.GetGateway().GetValidation().GetProxyValidationServerAddr()},
+	}
+	for _, address := range addresses {
+		if address.addr == "" {
+			continue
+		}
+		if _, _, err := net.SplitHostPort(address.addr); err != nil {
+			errs = multierr.Append(errs, InvalidAddressErr(address.field, address.addr, err))
+		}
+	}
+
+	durations := []struct {
+		field    string
+		duration *types.Duration
+	}{
+		{"refreshRate", settings.GetRefreshRate()},
+		{"gloo.endpointsWarmingTimeout", settings.GetGloo().GetEndpointsWarmingTimeout()},
+		{"gloo.xdsValidation.envoyValidationTimeout", settings.GetGloo().GetXdsValidation().GetEnvoyValidationTimeout()},
+		{"consul.dnsPollingInterval", settings.GetConsul().GetDnsPollingInterval()},
+		{"consul.waitTime", settings.GetConsul().GetWaitTime()},
+	}
+	for _, d := range durations {
+		if d.duration == nil {
+			continue
+		}
+		duration, err := types.DurationFromProto(d.duration)
+		if err != nil {
+			errs = multierr.Append(errs, InvalidDurationErr(d.field, err))
+			continue
+		}
+		if duration < 0 {
+			errs = multierr.Append(errs, NegativeDurationErr(d.field))
+		}
+	}
+
+	// an unset response code falls back to the default
+	if code := settings.GetGloo().GetInvalidConfigPolicy().GetInvalidRouteResponseCode(); code != 0 && (code < 100 || code > 599) {
+		errs = multierr.Append(errs, InvalidResponseCodeErr(code))
+	}
+
+	return errs
+}
@@ -0,0 +1,67 @@
+package validation
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Settings validation", func() {
+	var (
+		v        *validator
+		settings *gloov1.Settings
+	)
+
+	BeforeEach(func() {
+		v = NewValidator(ValidatorConfig{})
+		settings = &gloov1.Settings{
+			Metadata:    core.Metadata{Name: "default", Namespace: "gloo-system"},
+			RefreshRate: &types.Duration{Seconds: 60},
+			Gloo: &gloov1.GlooOptions{
+				XdsBindAddr:        "0.0.0.0:9977",
+				ValidationBindAddr: "0.0.0.0:9988",
+				InvalidConfigPolicy: &gloov1.GlooOptions_InvalidConfigPolicy{
+					InvalidRouteResponseCode: 404,
+				},
+			},
+			Gateway: &gloov1.GatewayOptions{
+				Validation: &gloov1.GatewayOptions_ValidationOptions{
+					ProxyValidationServerAddr: "gloo:9988",
+				},
+			},
+		}
+	})
+
+	It("accepts valid settings", func() {
+		Expect(v.ValidateSettings(context.TODO(), settings)).NotTo(HaveOccurred())
+	})
+
+	It("accepts empty settings", func() {
+		Expect(v.ValidateSettings(context.TODO(), &gloov1.Settings{})).NotTo(HaveOccurred())
+	})
+
+	It("rejects malformed addresses", func() {
+		settings.Gloo.XdsBindAddr = "0.0.0.0"
+		settings.Gateway.Validation.ProxyValidationServerAddr = "gloo::9988:"
+		err := v.ValidateSettings(context.TODO(), settings)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(`gloo.xdsBindAddr "0.0.0.0" is not a valid host:port address`))
+		Expect(err.Error()).To(ContainSubstring("gateway.validation.proxyValidationServerAddr"))
+	})
+
+	It("rejects negative durations", func() {
+		settings.RefreshRate = &types.Duration{Seconds: -1}
+		err := v.ValidateSettings(context.TODO(), settings)
+		Expect(err).To(MatchError(ContainSubstring("refreshRate must not be negative")))
+	})
+
+	It("rejects invalid response codes", func() {
+		settings.Gloo.InvalidConfigPolicy.InvalidRouteResponseCode = 1000
+		err := v.ValidateSettings(context.TODO(), settings)
+		Expect(err).To(MatchError(ContainSubstring("invalidRouteResponseCode 1000 is not a valid HTTP status code")))
+	})
+})
@@ -43,6 +43,9 @@ var (
 	VirtualServiceDeleteErr = func(parentGateways []core.ResourceRef) error {
 		return errors.Errorf("Deletion blocked because active Gateways reference this Virtual Service. Remove refs to this virtual service from the gateways: %v, then try again", parentGateways)
 	}
+	GlooResourceDeleteErr = func(resource resources.Resource, err error) error {
+		return errors.Wrapf(err, "Deletion blocked because active Proxies depend on %v %v. Remove references to it, then try again", resources.Kind(resource), resource.GetMetadata().Ref())
+	}
 	unmarshalErrMsg     = "could not unmarshal raw object"
 	WrappedUnmarshalErr = func(err error) error {
 		return errors.Wrapf(err, unmarshalErrMsg)
@@ -64,6 +67,15 @@ type Validator interface {
 	ValidateDeleteVirtualService(ctx context.Context, vs core.ResourceRef, dryRun bool) error
 	ValidateRouteTable(ctx context.Context, rt *v1.RouteTable, dryRun bool) (ProxyReports, error)
 	ValidateDeleteRouteTable(ctx context.Context, rt core.ResourceRef, dryRun bool) error
+	ValidateUpstream(ctx context.Context, us *gloov1.Upstream) (ProxyReports, error)
+	ValidateDeleteUpstream(ctx context.Context, us core.ResourceRef) error
+	ValidateUpstreamGroup(ctx context.Context, ug *gloov1.UpstreamGroup) (ProxyReports, error)
+	ValidateDeleteUpstreamGroup(ctx context.Context, ug core.ResourceRef) error
+	ValidateSecret(ctx context.Context, secret *gloov1.Secret) (ProxyReports, error)
+	ValidateDeleteSecret(ctx context.Context, secret core.ResourceRef) error
+	ValidateSettings(ctx context.Context, settings *gloov1.Settings) error
+	PreviewVirtualService(ctx context.Context, vs *v1.VirtualService) (*ImpactPreview, error)
+	PreviewRouteTable(ctx context.Context, rt *v1.RouteTable) (*ImpactPreview, error)
 }
 
 type validator struct {
@@ -730,7 +730,9 @@ var _ = Describe("Validator", func() {
 })
 
 type mockValidationClient struct {
-	validateProxy func(ctx context.Context, in *validation.ProxyValidationServiceRequest, opts ...grpc.CallOption) (*validation.ProxyValidationServiceResponse, error)
+	validateProxy    func(ctx context.Context, in *validation.ProxyValidationServiceRequest, opts ...grpc.CallOption) (*validation.ProxyValidationServiceResponse, error)
+	validateResource func(ctx context.Context, in *validation.ResourceValidationServiceRequest, opts ...grpc.CallOption) (*validation.ResourceValidationServiceResponse, error)
+	translateProxy   func(ctx context.Context, in *validation.ProxyTranslationServiceRequest, opts ...grpc.CallOption) (*validation.ProxyTranslationServiceResponse, error)
 }
 
 func (c *mockValidationClient) NotifyOnResync(ctx context.Context, in *validation.NotifyOnResyncRequest, opts ...grpc.CallOption) (validation.ProxyValidationService_NotifyOnResyncClient, error) {
@@ -744,6 +746,20 @@ func (c *mockValidationClient) ValidateProxy(ctx context.Context, in *validation
 	return c.validateProxy(ctx, in, opts...)
 }
 
+func (c *mockValidationClient) ValidateResource(ctx context.Context, in *validation.ResourceValidationServiceRequest, opts ...grpc.CallOption) (*validation.ResourceValidationServiceResponse, error) {
+	if c.validateResource == nil {
+		Fail("validateResource was called unexpectedly")
+	}
+	return c.validateResource(ctx, in, opts...)
+}
+
+func (c *mockValidationClient) TranslateProxy(ctx context.Context, in *validation.ProxyTranslationServiceRequest, opts ...grpc.CallOption) (*validation.ProxyTranslationServiceResponse, error) {
+	if c.translateProxy == nil {
+		Fail("translateProxy was called unexpectedly")
+	}
+	return c.translateProxy(ctx, in, opts...)
+}
+
 func acceptProxy(ctx context.Context, in *validation.ProxyValidationServiceRequest, opts ...grpc.CallOption) (*validation.ProxyValidationServiceResponse, error) {
 	return &validation.ProxyValidationServiceResponse{ProxyReport: validationutils.MakeReport(in.Proxy)}, nil
 }
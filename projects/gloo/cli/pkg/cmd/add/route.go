@@ -78,13 +78,10 @@ func addRoute(opts *options.Options) error {
 		Options:  plugins,
 	}
 
-	if opts.Add.Route.Destination.Delegate.Single.Name != "" {
-		v1Route.Action = &gatewayv1.Route_DelegateAction{
-			DelegateAction: &gatewayv1.DelegateAction{
-				DelegationType: &gatewayv1.DelegateAction_Ref{
-					Ref: &opts.Add.Route.Destination.Delegate.Single,
-				},
-			},
+	if delegate := opts.Add.Route.Destination.Delegate; delegate.Single.Name != "" || isDelegateSelectorSet(delegate.Selector) {
+		v1Route.Action, err = delegateActionFromInput(delegate)
+		if err != nil {
+			return err
 		}
 	} else {
 		v1Route.Action, err = routeActionFromInput(opts.Add.Route)
@@ -250,6 +247,35 @@ func routeActionFromInput(input options.InputRoute) (*gatewayv1.Route_RouteActio
 	return a, nil
 }
 
+func isDelegateSelectorSet(selector options.DelegateSelector) bool {
+	return len(selector.Labels) > 0 || len(selector.Namespaces) > 0
+}
+
+func delegateActionFromInput(input options.Delegate) (*gatewayv1.Route_DelegateAction, error) {
+	if input.Single.Name != "" {
+		if isDelegateSelectorSet(input.Selector) {
+			return nil, errors.Errorf("can only set one of delegate-name or delegate-label-selector")
+		}
+		return &gatewayv1.Route_DelegateAction{
+			DelegateAction: &gatewayv1.DelegateAction{
+				DelegationType: &gatewayv1.DelegateAction_Ref{
+					Ref: &input.Single,
+				},
+			},
+		}, nil
+	}
+	return &gatewayv1.Route_DelegateAction{
+		DelegateAction: &gatewayv1.DelegateAction{
+			DelegationType: &gatewayv1.DelegateAction_Selector{
+				Selector: &gatewayv1.RouteTableSelector{
+					Labels:     input.Selector.Labels,
+					Namespaces: input.Selector.Namespaces,
+				},
+			},
+		},
+	}, nil
+}
+
 func pluginsFromInput(input options.RoutePlugins) (*v1.RouteOptions, error) {
 	if input.PrefixRewrite.Value == nil {
 		return nil, nil
@@ -128,4 +128,37 @@ var _ = Describe("Routes", func() {
 			},
 		}))
 	})
+
+	It("should add delegate route with a selector", func() {
+		err := testutils.Glooctl("add route --path-prefix /a --delegate-label-selector team=a,env=prod --delegate-selector-namespaces team-a,team-b")
+		Expect(err).NotTo(HaveOccurred())
+
+		vs, err := helpers.MustVirtualServiceClient().Read("gloo-system", "default", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(vs.GetVirtualHost().GetRoutes()).To(Equal([]*v1.Route{
+			{
+				Matchers: []*matchers.Matcher{{
+					PathSpecifier: &matchers.Matcher_Prefix{
+						Prefix: "/a",
+					},
+				}},
+				Action: &v1.Route_DelegateAction{
+					DelegateAction: &v1.DelegateAction{
+						DelegationType: &v1.DelegateAction_Selector{
+							Selector: &v1.RouteTableSelector{
+								Labels:     map[string]string{"team": "a", "env": "prod"},
+								Namespaces: []string{"team-a", "team-b"},
+							},
+						},
+					},
+				},
+			},
+		}))
+	})
+
+	It("should not allow both a delegate name and a delegate selector", func() {
+		err := testutils.Glooctl("add route --path-prefix /a --delegate-name my-delegate --delegate-label-selector team=a")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("can only set one of delegate-name or delegate-label-selector"))
+	})
 })
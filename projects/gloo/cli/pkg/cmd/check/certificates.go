@@ -0,0 +1,67 @@
+package check
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"time"
+
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+)
+
+// used to compute certificate expiry, overridden in tests
+var now = time.Now
+
+// checkCertificates fails on expired TLS secrets, and warns about the ones that expire within --cert-expiry-warning.
+func checkCertificates(state *CheckState, result *CheckResult) error {
+	client := helpers.MustSecretClientWithOptions(5*time.Second, state.namespaces)
+	for _, ns := range state.namespaces {
+		secrets, err := client.List(ns, clients.ListOpts{})
+		if err != nil {
+			return err
+		}
+		for _, secret := range secrets {
+			certChain := secret.GetTls().GetCertChain()
+			if certChain == "" {
+				continue
+			}
+			notAfter, err := earliestExpiry([]byte(certChain))
+			if err != nil {
+				result.Fail("Could not parse the certificate chain of TLS secret %s: %v", renderMetadata(secret.GetMetadata()), err)
+				continue
+			}
+			if notAfter.IsZero() {
+				continue
+			}
+			switch remaining := notAfter.Sub(now()); {
+			case remaining <= 0:
+				result.Fail("TLS secret %s has expired on %s", renderMetadata(secret.GetMetadata()), notAfter.UTC().Format(time.RFC3339))
+			case remaining < state.opts.Check.CertExpiryWarning:
+				result.Warn("TLS secret %s expires on %s", renderMetadata(secret.GetMetadata()), notAfter.UTC().Format(time.RFC3339))
+			}
+		}
+	}
+	return nil
+}
+
+// earliestExpiry returns the earliest expiry of the PEM encoded certificates in the chain.
+func earliestExpiry(certChain []byte) (time.Time, error) {
+	var earliest time.Time
+	for {
+		var block *pem.Block
+		block, certChain = pem.Decode(certChain)
+		if block == nil {
+			return earliest, nil
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if earliest.IsZero() || cert.NotAfter.Before(earliest) {
+			earliest = cert.NotAfter
+		}
+	}
+}
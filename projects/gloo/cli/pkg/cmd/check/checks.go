@@ -0,0 +1,181 @@
+package check
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	appsv1 "k8s.io/api/apps/v1"
+)
+
+// CheckStatus is the outcome of a single check.
+type CheckStatus string
+
+const (
+	StatusOK      CheckStatus = "OK"
+	StatusWarning CheckStatus = "Warning"
+	StatusFailed  CheckStatus = "Failed"
+	StatusSkipped CheckStatus = "Skipped"
+)
+
+// CheckResult is the outcome of a single check, as it appears in the report.
+type CheckResult struct {
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Status      CheckStatus `json:"status"`
+	// the problems found by the check, or the reason it was skipped
+	Details []string `json:"details,omitempty"`
+	// set when the check could not be completed
+	Error string `json:"error,omitempty"`
+}
+
+// Fail marks the check as failed and records the problem.
+func (r *CheckResult) Fail(format string, args ...interface{}) {
+	r.Status = StatusFailed
+	r.Details = append(r.Details, fmt.Sprintf(format, args...))
+}
+
+// Warn records a problem that does not fail the check.
+func (r *CheckResult) Warn(format string, args ...interface{}) {
+	if r.Status != StatusFailed {
+		r.Status = StatusWarning
+	}
+	r.Details = append(r.Details, fmt.Sprintf(format, args...))
+}
+
+// Skip marks the check as skipped, e.g. because the component it checks is not installed.
+func (r *CheckResult) Skip(format string, args ...interface{}) {
+	r.Status = StatusSkipped
+	r.Details = append(r.Details, fmt.Sprintf(format, args...))
+}
+
+// Report is the outcome of all the checks run by `glooctl check`.
+type Report struct {
+	// false if any check failed
+	Ok     bool           `json:"ok"`
+	Checks []*CheckResult `json:"checks"`
+}
+
+// Err returns the first error that prevented a check from completing.
+func (r *Report) Err() error {
+	for _, result := range r.Checks {
+		if result.Error != "" {
+			return fmt.Errorf("%s: %s", result.Name, result.Error)
+		}
+	}
+	return nil
+}
+
+// CheckState is shared by the checks of a single run, earlier checks record what later checks need.
+type CheckState struct {
+	ctx  context.Context
+	opts *options.Options
+
+	deployments           *appsv1.DeploymentList
+	settings              *v1.Settings
+	namespaces            []string
+	knownUpstreams        []string
+	knownAuthConfigs      []string
+	knownRateLimitConfigs []string
+	glooStats             string
+}
+
+// Check is a single check run by `glooctl check`.
+type Check struct {
+	// identifies the check in the report and in --exclude
+	Name string
+	// printed as "Checking <description>..."
+	Description string
+	// the checks that must have completed for this check to run. If any of them was excluded, skipped or
+	// returned an error, this check is skipped.
+	Requires []string
+	// reports the problems found on the result. Returning an error fails the check and marks it as not completed.
+	Run func(state *CheckState, result *CheckResult) error
+}
+
+// RunChecks runs every check, in order, and writes the progress of each one to out.
+func RunChecks(opts *options.Options, checks []Check, out io.Writer) *Report {
+	state := &CheckState{ctx: opts.Top.Ctx, opts: opts}
+	report := &Report{Ok: true}
+	completed := map[string]bool{}
+	for _, check := range checks {
+		result := &CheckResult{
+			Name:        check.Name,
+			Description: check.Description,
+			Status:      StatusOK,
+		}
+		report.Checks = append(report.Checks, result)
+
+		if !doesNotContain(opts.Top.CheckName, check.Name) {
+			result.Skip("excluded")
+			continue
+		}
+		fmt.Fprintf(out, "Checking %s... ", check.Description)
+		if missing := missingRequirements(check, completed); len(missing) > 0 {
+			result.Skip("requires the %s check", strings.Join(missing, ", "))
+		} else if err := check.Run(state, result); err != nil {
+			result.Status = StatusFailed
+			result.Error = err.Error()
+		} else if result.Status != StatusSkipped {
+			completed[check.Name] = true
+		}
+		if result.Status == StatusFailed {
+			report.Ok = false
+		}
+		printResult(out, result)
+	}
+	return report
+}
+
+func missingRequirements(check Check, completed map[string]bool) []string {
+	var missing []string
+	for _, required := range check.Requires {
+		if !completed[required] {
+			missing = append(missing, required)
+		}
+	}
+	return missing
+}
+
+func printResult(out io.Writer, result *CheckResult) {
+	if result.Status == StatusOK && len(result.Details) == 0 {
+		fmt.Fprintf(out, "OK\n")
+		return
+	}
+	fmt.Fprintf(out, "%s\n", result.Status)
+	for _, detail := range result.Details {
+		for _, line := range strings.Split(detail, "\n") {
+			fmt.Fprintf(out, "  %s\n", line)
+		}
+	}
+	if result.Error != "" {
+		fmt.Fprintf(out, "  Error: %s\n", result.Error)
+	}
+}
+
+// DefaultChecks are the checks run by `glooctl check`, before any extension checks.
+func DefaultChecks() []Check {
+	return []Check{
+		{Name: "kubernetes", Description: "kubernetes connection", Run: checkConnection},
+		{Name: "deployments", Description: "deployments", Requires: []string{"kubernetes"}, Run: checkDeployments},
+		{Name: "pods", Description: "pods", Requires: []string{"kubernetes"}, Run: checkPods},
+		{Name: "discovery", Description: "discovery pods", Requires: []string{"deployments"}, Run: checkDiscoveryPods},
+		{Name: "settings", Description: "settings", Requires: []string{"kubernetes"}, Run: checkSettings},
+		{Name: "upstreams", Description: "upstreams", Requires: []string{"settings"}, Run: checkUpstreams},
+		{Name: "upstreamgroup", Description: "upstream groups", Requires: []string{"settings"}, Run: checkUpstreamGroups},
+		{Name: "authconfigs", Description: "auth configs", Requires: []string{"settings"}, Run: checkAuthConfigs},
+		{Name: "ratelimitconfigs", Description: "rate limit configs", Requires: []string{"settings"}, Run: checkRateLimitConfigs},
+		{Name: "secrets", Description: "secrets", Requires: []string{"settings"}, Run: checkSecrets},
+		{Name: "certificates", Description: "TLS certificate expiry", Requires: []string{"settings"}, Run: checkCertificates},
+		{Name: "virtualservices", Description: "virtual services", Requires: []string{"upstreams", "authconfigs", "ratelimitconfigs"}, Run: checkVirtualServices},
+		{Name: "gateways", Description: "gateways", Requires: []string{"settings"}, Run: checkGateways},
+		{Name: "proxies", Description: "proxies", Requires: []string{"settings"}, Run: checkProxies},
+		{Name: "webhook", Description: "validation webhook", Requires: []string{"kubernetes"}, Run: checkValidationWebhook},
+		{Name: "xds", Description: "xDS sync state of each Envoy", Requires: []string{"deployments"}, Run: checkEnvoysInSync},
+		{Name: "gloo-stats", Description: "gloo xDS metrics", Requires: []string{"deployments"}, Run: checkGlooePromStats},
+		{Name: "ratelimit", Description: "rate limit server", Requires: []string{"gloo-stats"}, Run: checkRateLimitServer},
+	}
+}
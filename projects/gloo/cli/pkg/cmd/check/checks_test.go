@@ -0,0 +1,346 @@
+package check
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"k8s.io/api/admissionregistration/v1beta1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Checks", func() {
+
+	var (
+		opts  *options.Options
+		state *CheckState
+	)
+
+	BeforeEach(func() {
+		helpers.UseMemoryClients()
+		opts = &options.Options{
+			Metadata: core.Metadata{Namespace: "gloo-system"},
+			Top:      options.Top{Ctx: context.Background()},
+		}
+		state = &CheckState{ctx: opts.Top.Ctx, opts: opts, namespaces: []string{"gloo-system"}}
+	})
+
+	AfterEach(func() {
+		helpers.UseDefaultClients()
+	})
+
+	Context("RunChecks", func() {
+
+		var (
+			ran    []string
+			checks []Check
+		)
+
+		passing := func(name string, requires ...string) Check {
+			return Check{Name: name, Description: name, Requires: requires, Run: func(*CheckState, *CheckResult) error {
+				ran = append(ran, name)
+				return nil
+			}}
+		}
+
+		BeforeEach(func() {
+			ran = nil
+			checks = []Check{
+				passing("a"),
+				{Name: "b", Description: "b", Run: func(_ *CheckState, result *CheckResult) error {
+					ran = append(ran, "b")
+					result.Fail("b is broken")
+					return nil
+				}},
+				{Name: "c", Description: "c", Run: func(*CheckState, *CheckResult) error {
+					ran = append(ran, "c")
+					return errors.New("could not check c")
+				}},
+				passing("d", "a", "b"),
+				passing("e", "c"),
+			}
+		})
+
+		It("runs every check and reports each result", func() {
+			out := &bytes.Buffer{}
+			report := RunChecks(opts, checks, out)
+
+			Expect(ran).To(Equal([]string{"a", "b", "c", "d"}))
+			Expect(report.Ok).To(BeFalse())
+			Expect(report.Err()).To(MatchError("c: could not check c"))
+			Expect(report.Checks).To(Equal([]*CheckResult{
+				{Name: "a", Description: "a", Status: StatusOK},
+				{Name: "b", Description: "b", Status: StatusFailed, Details: []string{"b is broken"}},
+				{Name: "c", Description: "c", Status: StatusFailed, Error: "could not check c"},
+				{Name: "d", Description: "d", Status: StatusOK},
+				{Name: "e", Description: "e", Status: StatusSkipped, Details: []string{"requires the c check"}},
+			}))
+			Expect(out.String()).To(Equal(`Checking a... OK
+Checking b... Failed
+  b is broken
+Checking c... Failed
+  Error: could not check c
+Checking d... OK
+Checking e... Skipped
+  requires the c check
+`))
+		})
+
+		It("skips excluded checks and the checks that require them", func() {
+			opts.Top.CheckName = []string{"a", "c"}
+			report := RunChecks(opts, checks, ioutil.Discard)
+
+			Expect(ran).To(Equal([]string{"b"}))
+			Expect(report.Checks[0]).To(Equal(&CheckResult{Name: "a", Description: "a", Status: StatusSkipped, Details: []string{"excluded"}}))
+			Expect(report.Checks[3].Details).To(Equal([]string{"requires the a check"}))
+			Expect(report.Err()).NotTo(HaveOccurred())
+		})
+
+		It("passes when no check fails", func() {
+			report := RunChecks(opts, []Check{
+				passing("a"),
+				{Name: "b", Description: "b", Run: func(_ *CheckState, result *CheckResult) error {
+					result.Warn("b looks odd")
+					return nil
+				}},
+			}, ioutil.Discard)
+			Expect(report.Ok).To(BeTrue())
+			Expect(report.Checks[1].Status).To(Equal(StatusWarning))
+		})
+	})
+
+	Context("certificates", func() {
+
+		var (
+			secretClient v1.SecretClient
+			realNow      = now
+		)
+
+		BeforeEach(func() {
+			secretClient = helpers.MustSecretClientWithOptions(time.Second, state.namespaces)
+			opts.Check.CertExpiryWarning = 30 * 24 * time.Hour
+			now = func() time.Time { return time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC) }
+		})
+
+		AfterEach(func() {
+			now = realNow
+		})
+
+		writeTlsSecret := func(name string, notAfter ...time.Time) {
+			var chain []byte
+			for _, expiry := range notAfter {
+				chain = append(chain, selfSignedCert(expiry)...)
+			}
+			_, err := secretClient.Write(&v1.Secret{
+				Metadata: core.Metadata{Name: name, Namespace: "gloo-system"},
+				Kind:     &v1.Secret_Tls{Tls: &v1.TlsSecret{CertChain: string(chain)}},
+			}, clients.WriteOpts{})
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		It("passes when certificates are far from expiry", func() {
+			writeTlsSecret("valid", time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+			result := &CheckResult{Status: StatusOK}
+			Expect(checkCertificates(state, result)).NotTo(HaveOccurred())
+			Expect(result.Status).To(Equal(StatusOK))
+		})
+
+		It("warns about certificates that expire soon", func() {
+			writeTlsSecret("expiring", time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2020, 6, 10, 0, 0, 0, 0, time.UTC))
+			result := &CheckResult{Status: StatusOK}
+			Expect(checkCertificates(state, result)).NotTo(HaveOccurred())
+			Expect(result.Status).To(Equal(StatusWarning))
+			Expect(result.Details).To(Equal([]string{"TLS secret gloo-system expiring expires on 2020-06-10T00:00:00Z"}))
+		})
+
+		It("fails on expired certificates", func() {
+			writeTlsSecret("expired", time.Date(2020, 5, 1, 0, 0, 0, 0, time.UTC))
+			result := &CheckResult{Status: StatusOK}
+			Expect(checkCertificates(state, result)).NotTo(HaveOccurred())
+			Expect(result.Status).To(Equal(StatusFailed))
+			Expect(result.Details).To(Equal([]string{"TLS secret gloo-system expired has expired on 2020-05-01T00:00:00Z"}))
+		})
+	})
+
+	Context("validation webhook", func() {
+
+		writeWebhook := func(caBundle []byte) {
+			_, err := helpers.MustKubeClient().AdmissionregistrationV1beta1().ValidatingWebhookConfigurations().Create(&v1beta1.ValidatingWebhookConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "gloo-gateway-validation-webhook-gloo-system"},
+				Webhooks: []v1beta1.ValidatingWebhook{{
+					Name: "gateway.gloo-system.svc",
+					ClientConfig: v1beta1.WebhookClientConfig{
+						Service:  &v1beta1.ServiceReference{Namespace: "gloo-system", Name: "gateway"},
+						CABundle: caBundle,
+					},
+				}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		writeEndpoints := func(ready bool) {
+			subset := corev1.EndpointSubset{}
+			address := corev1.EndpointAddress{IP: "10.0.0.1"}
+			if ready {
+				subset.Addresses = append(subset.Addresses, address)
+			} else {
+				subset.NotReadyAddresses = append(subset.NotReadyAddresses, address)
+			}
+			_, err := helpers.MustKubeClient().CoreV1().Endpoints("gloo-system").Create(&corev1.Endpoints{
+				ObjectMeta: metav1.ObjectMeta{Name: "gateway", Namespace: "gloo-system"},
+				Subsets:    []corev1.EndpointSubset{subset},
+			})
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		It("is skipped when the webhook is not installed", func() {
+			result := &CheckResult{Status: StatusOK}
+			Expect(checkValidationWebhook(state, result)).NotTo(HaveOccurred())
+			Expect(result.Status).To(Equal(StatusSkipped))
+		})
+
+		It("passes when the webhook service has ready endpoints", func() {
+			writeWebhook([]byte("ca"))
+			writeEndpoints(true)
+			result := &CheckResult{Status: StatusOK}
+			Expect(checkValidationWebhook(state, result)).NotTo(HaveOccurred())
+			Expect(result.Status).To(Equal(StatusOK))
+		})
+
+		It("fails when the webhook cannot be reached", func() {
+			writeWebhook(nil)
+			writeEndpoints(false)
+			result := &CheckResult{Status: StatusOK}
+			Expect(checkValidationWebhook(state, result)).NotTo(HaveOccurred())
+			Expect(result.Details).To(Equal([]string{
+				"Webhook gateway.gloo-system.svc has no caBundle, the certgen job may not have completed",
+				"Webhook gateway.gloo-system.svc: service gloo-system gateway has no ready endpoints",
+			}))
+		})
+	})
+
+	Context("discovery pods", func() {
+
+		BeforeEach(func() {
+			state.deployments = &appsv1.DeploymentList{Items: []appsv1.Deployment{{
+				ObjectMeta: metav1.ObjectMeta{Name: "discovery", Namespace: "gloo-system"},
+				Spec: appsv1.DeploymentSpec{
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"gloo": "discovery"}},
+				},
+			}}}
+		})
+
+		writePod := func(name string, status corev1.PodStatus) {
+			_, err := helpers.MustKubeClient().CoreV1().Pods("gloo-system").Create(&corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "gloo-system", Labels: map[string]string{"gloo": "discovery"}},
+				Status:     status,
+			})
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		It("is skipped when discovery is not deployed", func() {
+			state.deployments = &appsv1.DeploymentList{}
+			result := &CheckResult{Status: StatusOK}
+			Expect(checkDiscoveryPods(state, result)).NotTo(HaveOccurred())
+			Expect(result.Status).To(Equal(StatusSkipped))
+		})
+
+		It("passes when the discovery pods are ready", func() {
+			writePod("discovery-1", corev1.PodStatus{
+				Phase:             corev1.PodRunning,
+				ContainerStatuses: []corev1.ContainerStatus{{Name: "discovery", Ready: true}},
+			})
+			result := &CheckResult{Status: StatusOK}
+			Expect(checkDiscoveryPods(state, result)).NotTo(HaveOccurred())
+			Expect(result.Status).To(Equal(StatusOK))
+		})
+
+		It("fails when discovery is crash looping", func() {
+			writePod("discovery-1", corev1.PodStatus{
+				Phase: corev1.PodRunning,
+				ContainerStatuses: []corev1.ContainerStatus{{
+					Name:  "discovery",
+					State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff", Message: "back-off 5m0s"}},
+				}},
+			})
+			result := &CheckResult{Status: StatusOK}
+			Expect(checkDiscoveryPods(state, result)).NotTo(HaveOccurred())
+			Expect(result.Details).To(Equal([]string{"Container discovery of discovery pod discovery-1 is crash looping: back-off 5m0s"}))
+		})
+
+		It("fails when there are no discovery pods", func() {
+			result := &CheckResult{Status: StatusOK}
+			Expect(checkDiscoveryPods(state, result)).NotTo(HaveOccurred())
+			Expect(result.Status).To(Equal(StatusFailed))
+		})
+	})
+
+	Context("extension checks", func() {
+
+		var dir string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "glooctl-check")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(dir)
+		})
+
+		writeExtension := func(name, script string, mode os.FileMode) {
+			err := ioutil.WriteFile(filepath.Join(dir, name), []byte("#!/bin/sh\n"+script), mode)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		It("runs the glooctl-check executables with the glooctl options", func() {
+			writeExtension("glooctl-check-json", `echo '{"status": "Warning", "description": "json check", "details": ["in '$GLOOCTL_NAMESPACE'"]}'`, 0755)
+			writeExtension("glooctl-check-failing", "echo something is wrong; exit 3", 0755)
+			writeExtension("glooctl-check-passing", "echo all good", 0755)
+			writeExtension("glooctl-check-not-executable", "exit 1", 0644)
+			writeExtension("glooctl-other", "exit 1", 0755)
+
+			checks := extensionChecks([]string{dir})
+			report := RunChecks(opts, checks, ioutil.Discard)
+			Expect(report.Checks).To(Equal([]*CheckResult{
+				{Name: "failing", Description: "failing", Status: StatusFailed, Details: []string{"something is wrong"}},
+				{Name: "json", Description: "json check", Status: StatusWarning, Details: []string{"in gloo-system"}},
+				{Name: "passing", Description: "passing", Status: StatusOK},
+			}))
+		})
+	})
+})
+
+func selfSignedCert(notAfter time.Time) []byte {
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	Expect(err).NotTo(HaveOccurred())
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "gloo.example.com"},
+		NotBefore:    notAfter.Add(-365 * 24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	Expect(err).NotTo(HaveOccurred())
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
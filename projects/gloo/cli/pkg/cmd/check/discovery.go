@@ -0,0 +1,48 @@
+package check
+
+import (
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const discoveryDeployment = "discovery"
+
+// checkDiscoveryPods checks that the pods of the discovery deployment are running, ready and not crash looping.
+func checkDiscoveryPods(state *CheckState, result *CheckResult) error {
+	var selector *metav1.LabelSelector
+	for _, deployment := range state.deployments.Items {
+		if deployment.Name == discoveryDeployment {
+			selector = deployment.Spec.Selector
+		}
+	}
+	if selector == nil {
+		result.Skip("the %s deployment was not found", discoveryDeployment)
+		return nil
+	}
+	labelSelector, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return err
+	}
+	pods, err := helpers.MustKubeClient().CoreV1().Pods(state.opts.Metadata.Namespace).List(metav1.ListOptions{LabelSelector: labelSelector.String()})
+	if err != nil {
+		return err
+	}
+	if len(pods.Items) == 0 {
+		result.Fail("No pods found for the %s deployment", discoveryDeployment)
+	}
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != corev1.PodRunning {
+			result.Fail("Discovery pod %s is %s", pod.Name, pod.Status.Phase)
+			continue
+		}
+		for _, container := range pod.Status.ContainerStatuses {
+			if waiting := container.State.Waiting; waiting != nil && waiting.Reason == "CrashLoopBackOff" {
+				result.Fail("Container %s of discovery pod %s is crash looping: %s", container.Name, pod.Name, waiting.Message)
+			} else if !container.Ready {
+				result.Fail("Container %s of discovery pod %s is not ready", container.Name, pod.Name)
+			}
+		}
+	}
+	return nil
+}
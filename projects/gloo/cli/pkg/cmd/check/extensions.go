@@ -0,0 +1,98 @@
+package check
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
+)
+
+// ExtensionChecks returns a check for each glooctl-check-<name> executable on the PATH.
+//
+// Extension checks run with the namespace and kubeconfig of the glooctl invocation in the GLOOCTL_NAMESPACE and
+// GLOOCTL_KUBECONFIG environment variables. An extension check can print its result as a JSON object with the
+// "status" (OK, Warning, Failed or Skipped), "description" and "details" fields of the report. Otherwise it
+// passes if it exits with 0, and fails with its output as details if it does not.
+func ExtensionChecks() []Check {
+	return extensionChecks(filepath.SplitList(os.Getenv("PATH")))
+}
+
+func extensionChecks(dirs []string) []Check {
+	var checks []Check
+	seen := map[string]bool{}
+	for _, dir := range dirs {
+		files, err := ioutil.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, file := range files {
+			if file.IsDir() || file.Mode()&0111 == 0 || !strings.HasPrefix(file.Name(), constants.CheckExtensionPrefix) {
+				continue
+			}
+			name := strings.TrimSuffix(strings.TrimPrefix(file.Name(), constants.CheckExtensionPrefix), ".exe")
+			// like the shell, the first executable on the PATH shadows the others
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+			checks = append(checks, Check{
+				Name:        name,
+				Description: name,
+				Run:         runExtensionCheck(filepath.Join(dir, file.Name())),
+			})
+		}
+	}
+	return checks
+}
+
+func runExtensionCheck(path string) func(state *CheckState, result *CheckResult) error {
+	return func(state *CheckState, result *CheckResult) error {
+		ctx := state.ctx
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		cmd := exec.CommandContext(ctx, path)
+		cmd.Env = append(os.Environ(),
+			constants.NamespaceEnvVar+"="+state.opts.Metadata.Namespace,
+			constants.KubeConfigEnvVar+"="+state.opts.Top.KubeConfig,
+		)
+		var stdout, stderr bytes.Buffer
+		cmd.Stdout = &stdout
+		cmd.Stderr = &stderr
+		runErr := cmd.Run()
+
+		var reported CheckResult
+		if err := json.Unmarshal(stdout.Bytes(), &reported); err == nil && reported.Status != "" {
+			switch reported.Status {
+			case StatusOK, StatusWarning, StatusFailed, StatusSkipped:
+				result.Status = reported.Status
+			default:
+				result.Fail("Reported unknown status %q", reported.Status)
+			}
+			if reported.Description != "" {
+				result.Description = reported.Description
+			}
+			result.Details = append(result.Details, reported.Details...)
+			result.Error = reported.Error
+			return nil
+		}
+
+		if runErr != nil {
+			if _, ok := runErr.(*exec.ExitError); !ok {
+				return runErr
+			}
+			output := strings.TrimSpace(stdout.String() + stderr.String())
+			if output == "" {
+				output = runErr.Error()
+			}
+			result.Fail("%s", output)
+		}
+		return nil
+	}
+}
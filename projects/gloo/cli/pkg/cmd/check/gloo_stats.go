@@ -1,11 +1,12 @@
 package check
 
 import (
-	"context"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 
+	"github.com/rotisserie/eris"
 	"github.com/solo-io/gloo/pkg/cliutil"
 	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
 	v1 "k8s.io/api/apps/v1"
@@ -30,6 +31,11 @@ var (
 )
 
 func ResourcesSyncedOverXds(stats, deploymentName string) bool {
+	return len(outOfSyncResources(stats, deploymentName)) == 0
+}
+
+// outOfSyncResources returns the types of resources that have not been accepted by all the xDS clients of gloo.
+func outOfSyncResources(stats, deploymentName string) []string {
 	var outOfSyncResources []string
 	metrics := parseMetrics(stats, []string{glooeTotalEntites, glooeInSyncEntities}, deploymentName)
 	for metric, val := range metrics {
@@ -42,44 +48,35 @@ func ResourcesSyncedOverXds(stats, deploymentName string) bool {
 			}
 		}
 	}
-	if len(outOfSyncResources) > 0 {
-		fmt.Println(resourcesOutOfSyncMessage(outOfSyncResources))
-		return false
-	}
-	return true
+	sort.Strings(outOfSyncResources)
+	return outOfSyncResources
 }
 
 func RateLimitIsConnected(stats string) bool {
-	connectedStateErrMessage := "The rate limit server is out of sync with the Gloo control plane and is not receiving valid gloo config.\n" +
-		"You may want to try using the `glooctl debug logs --errors-only` command to find any relevant error logs."
-
 	metrics := parseMetrics(stats, []string{GlooeRateLimitConnectedState}, "gloo")
 
 	if val, ok := metrics[GlooeRateLimitConnectedState]; ok && val == 0 {
-		fmt.Println(connectedStateErrMessage)
 		return false
 	}
 
 	return true
 }
 
-func checkGlooePromStats(ctx context.Context, glooNamespace string, deployments *v1.DeploymentList) (bool, error) {
+func checkGlooePromStats(state *CheckState, result *CheckResult) error {
 	errMessage := "Problem while checking for gloo xds errors"
 
 	// port-forward proxy deployment and get prometheus metrics
 	freePort, err := cliutil.GetFreePort()
 	if err != nil {
-		fmt.Println(errMessage)
-		return false, err
+		return eris.Wrap(err, errMessage)
 	}
 	localPort := strconv.Itoa(freePort)
 	adminPort := strconv.Itoa(int(defaults.GlooAdminPort))
 	// stats is the string containing all stats from /stats/prometheus
-	stats, portFwdCmd, err := cliutil.PortForwardGet(ctx, glooNamespace, "deploy/"+glooDeployment,
+	stats, portFwdCmd, err := cliutil.PortForwardGet(state.ctx, state.opts.Metadata.Namespace, "deploy/"+glooDeployment,
 		localPort, adminPort, false, glooStatsPath)
 	if err != nil {
-		fmt.Println(errMessage)
-		return false, err
+		return eris.Wrap(err, errMessage)
 	}
 	if portFwdCmd.Process != nil {
 		defer portFwdCmd.Process.Release()
@@ -87,23 +84,33 @@ func checkGlooePromStats(ctx context.Context, glooNamespace string, deployments
 	}
 
 	if strings.TrimSpace(stats) == "" {
-		fmt.Println(errMessage+": could not find any metrics at", glooStatsPath, "endpoint of the "+glooDeployment+" deployment")
-		return false, nil
+		return eris.Errorf("%s: could not find any metrics at %s endpoint of the %s deployment", errMessage, glooStatsPath, glooDeployment)
 	}
+	state.glooStats = stats
 
-	if !ResourcesSyncedOverXds(stats, glooDeployment) {
-		return false, nil
+	if resources := outOfSyncResources(stats, glooDeployment); len(resources) > 0 {
+		result.Fail("%s", resourcesOutOfSyncMessage(resources))
 	}
+	return nil
+}
 
+func checkRateLimitServer(state *CheckState, result *CheckResult) error {
+	if !hasDeployment(state.deployments, rateLimitDeployment) {
+		result.Skip("the %s deployment was not found", rateLimitDeployment)
+		return nil
+	}
+	if !RateLimitIsConnected(state.glooStats) {
+		result.Fail("The rate limit server is out of sync with the Gloo control plane and is not receiving valid gloo config.\n" +
+			"You may want to try using the `glooctl debug logs --errors-only` command to find any relevant error logs.")
+	}
+	return nil
+}
+
+func hasDeployment(deployments *v1.DeploymentList, name string) bool {
 	for _, deployment := range deployments.Items {
-		if deployment.Name == rateLimitDeployment {
-			fmt.Printf("Checking rate limit server... ")
-			if !RateLimitIsConnected(stats) {
-				return false, nil
-			}
-			fmt.Printf("OK\n")
+		if deployment.Name == name {
+			return true
 		}
 	}
-
-	return true, nil
+	return false
 }
@@ -1,89 +1,109 @@
 package check
 
 import (
-	"context"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"os"
 	"reflect"
 	"strconv"
 	"strings"
 	"time"
 
-	v1 "k8s.io/api/apps/v1"
-
+	"github.com/rotisserie/eris"
 	"github.com/solo-io/gloo/pkg/cliutil"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
 	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 const promStatsPath = "/stats/prometheus"
 
 const metricsUpdateInterval = time.Millisecond * 250
 
-func checkProxiesPromStats(ctx context.Context, glooNamespace string, deployments *v1.DeploymentList) (bool, error) {
-	for _, deployment := range deployments.Items {
-		if deployment.Name == "gateway-proxy" || deployment.Name == "ingress-proxy" || deployment.Name == "knative-external-proxy" || deployment.Name == "knative-internal-proxy" {
-			if passed, err := checkProxyPromStats(ctx, glooNamespace, deployment.Name); !passed || err != nil {
-				return passed, err
+var proxyDeployments = []string{"gateway-proxy", "ingress-proxy", "knative-external-proxy", "knative-internal-proxy"}
+
+// checkEnvoysInSync checks that every Envoy of the proxy deployments is connected to gloo and accepts the config it serves.
+func checkEnvoysInSync(state *CheckState, result *CheckResult) error {
+	client := helpers.MustKubeClient()
+	for _, deployment := range state.deployments.Items {
+		if !cliutil.Contains(proxyDeployments, deployment.Name) {
+			continue
+		}
+		selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+		if err != nil {
+			return err
+		}
+		pods, err := client.CoreV1().Pods(deployment.Namespace).List(metav1.ListOptions{LabelSelector: selector.String()})
+		if err != nil {
+			return err
+		}
+		for _, pod := range pods.Items {
+			if pod.Status.Phase != corev1.PodRunning {
+				// not ready pods are reported by the pods check
+				continue
+			}
+			problem, err := checkProxyPromStats(state, deployment.Name, pod.Name)
+			if err != nil {
+				return err
+			}
+			if problem != "" {
+				result.Fail("%s (pod %s): %s", deployment.Name, pod.Name, problem)
 			}
 		}
 	}
-	fmt.Printf("OK\n")
-	return true, nil
+	return nil
 }
 
-func checkProxyPromStats(ctx context.Context, glooNamespace string, deploymentName string) (bool, error) {
+func checkProxyPromStats(state *CheckState, deploymentName, podName string) (string, error) {
 
 	// check if any proxy instances are out of sync with the Gloo control plane
 	errMessage := "Problem while checking for out of sync proxies"
 
-	// port-forward proxy deployment and get prometheus metrics
+	// port-forward proxy pod and get prometheus metrics
 	freePort, err := cliutil.GetFreePort()
 	if err != nil {
-		fmt.Println(errMessage)
-		return false, err
+		return "", eris.Wrap(err, errMessage)
 	}
 	localPort := strconv.Itoa(freePort)
 	adminPort := strconv.Itoa(int(defaults.EnvoyAdminPort))
 	// stats is the string containing all stats from /stats/prometheus
-	stats, portFwdCmd, err := cliutil.PortForwardGet(ctx, glooNamespace, "deploy/"+deploymentName,
+	stats, portFwdCmd, err := cliutil.PortForwardGet(state.ctx, state.opts.Metadata.Namespace, "pod/"+podName,
 		localPort, adminPort, false, promStatsPath)
 	if err != nil {
-		fmt.Println(errMessage)
-		return false, err
+		return "", eris.Wrap(err, errMessage)
 	}
 	if portFwdCmd.Process != nil {
 		defer portFwdCmd.Process.Release()
 		defer portFwdCmd.Process.Kill()
 	}
 
-	if !checkProxyConnectedState(stats, deploymentName, errMessage,
+	if problem := checkProxyConnectedState(stats, deploymentName, errMessage,
 		"Your "+deploymentName+" is out of sync with the Gloo control plane and is not receiving valid gloo config.\n"+
-			"You may want to try using the `glooctl proxy logs` or `glooctl debug logs` commands.") {
-		return false, nil
+			"You may want to try using the `glooctl proxy logs` or `glooctl debug logs` commands."); problem != "" {
+		return problem, nil
 	}
 
 	return checkProxyUpdate(stats, localPort, deploymentName, errMessage)
 }
 
 // checks that envoy_control_plane_connected_state metric has a value of 1
-func checkProxyConnectedState(stats string, deploymentName string, genericErrMessage string, connectedStateErrMessage string) bool {
+func checkProxyConnectedState(stats string, deploymentName string, genericErrMessage string, connectedStateErrMessage string) string {
 
 	if strings.TrimSpace(stats) == "" {
-		fmt.Println(genericErrMessage+": could not find any metrics at", promStatsPath, "endpoint of the "+deploymentName+" deployment")
-		return false
+		return fmt.Sprint(genericErrMessage+": could not find any metrics at ", promStatsPath, " endpoint of the "+deploymentName+" deployment")
 	}
 
 	if !strings.Contains(stats, "envoy_control_plane_connected_state{} 1") {
-		fmt.Println(connectedStateErrMessage)
-		return false
+		return connectedStateErrMessage
 	}
 
-	return true
+	return ""
 }
 
 // checks that update_rejected and update_failure stats have not increased by getting stats from /stats/prometheus again
-func checkProxyUpdate(stats string, localPort string, deploymentName string, errMessage string) (bool, error) {
+func checkProxyUpdate(stats string, localPort string, deploymentName string, errMessage string) (string, error) {
 
 	// wait for metrics to update
 	time.Sleep(metricsUpdateInterval)
@@ -91,24 +111,20 @@ func checkProxyUpdate(stats string, localPort string, deploymentName string, err
 	// gather metrics again
 	res, err := http.Get("http://localhost:" + localPort + promStatsPath)
 	if err != nil {
-		fmt.Println(errMessage)
-		return false, err
+		return "", eris.Wrap(err, errMessage)
 	}
 	if res.StatusCode != 200 {
-		fmt.Println(errMessage+": received unexpected status code", res.StatusCode, "from", promStatsPath, "endpoint of the "+deploymentName+" deployment")
-		return false, nil
+		return fmt.Sprint(errMessage+": received unexpected status code ", res.StatusCode, " from ", promStatsPath, " endpoint of the "+deploymentName+" deployment"), nil
 	}
 	b, err := ioutil.ReadAll(res.Body)
 	if err != nil {
-		fmt.Println(errMessage)
-		return false, err
+		return "", eris.Wrap(err, errMessage)
 	}
 	res.Body.Close()
 	newStats := string(b)
 
 	if strings.TrimSpace(newStats) == "" {
-		fmt.Println(errMessage+": could not find any metrics at", promStatsPath, "endpoint of the "+deploymentName+" deployment")
-		return false, nil
+		return fmt.Sprint(errMessage+": could not find any metrics at ", promStatsPath, " endpoint of the "+deploymentName+" deployment"), nil
 	}
 
 	// for example, look for stats like "envoy_http_rds_update_attempt" and "envoy_http_rds_update_rejected"
@@ -118,7 +134,7 @@ func checkProxyUpdate(stats string, localPort string, deploymentName string, err
 	newStatsMap := parseMetrics(newStats, desiredMetricsSegments, deploymentName)
 
 	if reflect.DeepEqual(newStatsMap, statsMap) {
-		return true, nil
+		return "", nil
 	}
 
 	for metricName, oldVal := range statsMap {
@@ -126,17 +142,15 @@ func checkProxyUpdate(stats string, localPort string, deploymentName string, err
 		if ok && strings.Contains(metricName, "rejected") && newVal > oldVal {
 			// for example, if envoy_http_rds_update_rejected{envoy_http_conn_manager_prefix="http",envoy_rds_route_config="listener-__-8080-routes"}
 			// increases, which occurs if envoy cannot parse the config from gloo
-			fmt.Printf("An update to your "+deploymentName+" deployment was rejected due to schema/validation errors. The %v metric increased.\n"+
-				"You may want to try using the `glooctl proxy logs` or `glooctl debug logs` commands.\n", metricName)
-			return false, nil
+			return fmt.Sprintf("An update to your "+deploymentName+" deployment was rejected due to schema/validation errors. The %v metric increased.\n"+
+				"You may want to try using the `glooctl proxy logs` or `glooctl debug logs` commands.", metricName), nil
 		} else if ok && strings.Contains(metricName, "failure") && newVal > oldVal {
-			fmt.Printf("An update to your "+deploymentName+" deployment was rejected due to network errors. The %v metric increased.\n"+
-				"You may want to try using the `glooctl proxy logs` or `glooctl debug logs` commands.\n", metricName)
-			return false, nil
+			return fmt.Sprintf("An update to your "+deploymentName+" deployment was rejected due to network errors. The %v metric increased.\n"+
+				"You may want to try using the `glooctl proxy logs` or `glooctl debug logs` commands.", metricName), nil
 		}
 	}
 
-	return true, nil
+	return "", nil
 }
 
 // parseMetrics parses prometheus metrics and returns a map from the metric name and labels to its value.
@@ -161,7 +175,8 @@ func parseMetrics(stats string, desiredMetricSegments []string, deploymentName s
 			metric := strings.Join(pieces[0:len(pieces)-1], "")   // get all but last piece (as one string)- this is metric name and labels
 			metricVal, err := strconv.Atoi(pieces[len(pieces)-1]) // get last piece (as int)- this is metric value
 			if err != nil {
-				fmt.Printf("Found an unexpected format in metrics at %v endpoint of the "+deploymentName+" deployment. "+
+				// written to stderr so it does not end up in json or yaml reports
+				fmt.Fprintf(os.Stderr, "Found an unexpected format in metrics at %v endpoint of the "+deploymentName+" deployment. "+
 					"Expected %v metric to have an int value but got value %v.\nContinuing check...", promStatsPath, metric, pieces[len(pieces)-1])
 				continue
 			}
@@ -1,8 +1,10 @@
 package check
 
 import (
-	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"os"
 	"time"
 
@@ -11,6 +13,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/flagutils"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/printers"
 	ratelimit "github.com/solo-io/gloo/projects/gloo/pkg/api/external/solo/ratelimit"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	rlopts "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/ratelimit"
@@ -24,6 +27,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
 )
 
 var (
@@ -46,136 +50,99 @@ func RootCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.
 	cmd := &cobra.Command{
 		Use:   constants.CHECK_COMMAND.Use,
 		Short: constants.CHECK_COMMAND.Short,
+		Long: "Runs every check and reports its result. With -o json or -o yaml, prints a report with the status and details " +
+			"of each check. Executables named glooctl-check-<name> on the PATH are run as additional checks.",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ok, err := CheckResources(opts)
-			if err != nil {
-				// Not returning error here because this shouldn't propagate as a standard CLI error, which prints usage.
-				fmt.Printf("Error!\n")
-				fmt.Printf("%s\n", err.Error())
-				os.Exit(1)
-			} else if !ok {
+			var progress io.Writer
+			switch opts.Top.Output {
+			case printers.TABLE:
+				progress = os.Stdout
+			case printers.JSON, printers.YAML:
+				progress = ioutil.Discard
+			default:
+				return eris.Errorf("output format %v is not supported by check", opts.Top.Output.String())
+			}
+
+			checks := append(DefaultChecks(), ExtensionChecks()...)
+			report := RunChecks(opts, checks, progress)
+
+			if opts.Top.Output != printers.TABLE {
+				if err := printReport(report, opts.Top.Output); err != nil {
+					return err
+				}
+			} else if report.Ok {
+				fmt.Printf("No problems detected.\n")
+				CheckMulticlusterResources(opts)
+			} else {
 				fmt.Printf("Problems detected!\n")
+			}
+			if !report.Ok {
+				// Not returning error here because this shouldn't propagate as a standard CLI error, which prints usage.
 				os.Exit(1)
-			} else {
-				fmt.Printf("No problems detected.\n")
 			}
-			CheckMulticlusterResources(opts)
 			return nil
 		},
 	}
 	pflags := cmd.PersistentFlags()
 	flagutils.AddNamespaceFlag(pflags, &opts.Metadata.Namespace)
 	flagutils.AddExcludecheckFlag(pflags, &opts.Top.CheckName)
+	flagutils.AddCheckOutputFlag(pflags, &opts.Top.Output)
+	flagutils.AddCertExpiryWarningFlag(pflags, &opts.Check.CertExpiryWarning)
 	cliutils.ApplyOptions(cmd, optionsFunc)
 	return cmd
 }
 
+// CheckResources runs the default checks and reports whether all of them passed.
 func CheckResources(opts *options.Options) (bool, error) {
-	err := checkConnection(opts.Metadata.Namespace)
-	if err != nil {
-		return false, err
-	}
-
-	deployments, ok, err := getAndCheckDeployments(opts)
-	if !ok || err != nil {
-		return ok, err
-	}
-
-	includePods := doesNotContain(opts.Top.CheckName, "pods")
-	if includePods {
-		ok, err := checkPods(opts)
-		if !ok || err != nil {
-			return ok, err
-		}
-	}
+	report := RunChecks(opts, DefaultChecks(), os.Stdout)
+	return report.Ok, report.Err()
+}
 
-	settings, err := getSettings(opts)
-	if err != nil {
-		return false, err
+func printReport(report *Report, outputType printers.OutputType) error {
+	var (
+		out []byte
+		err error
+	)
+	if outputType == printers.YAML {
+		out, err = yaml.Marshal(report)
+	} else {
+		out, err = json.MarshalIndent(report, "", "  ")
+		out = append(out, '\n')
 	}
-
-	namespaces, err := getNamespaces(settings)
 	if err != nil {
-		return false, err
-	}
-
-	knownUpstreams, ok, err := checkUpstreams(namespaces)
-	if !ok || err != nil {
-		return ok, err
-	}
-
-	includeUpstreamGroup := doesNotContain(opts.Top.CheckName, "upstreamgroup")
-	if includeUpstreamGroup {
-		ok, err := checkUpstreamGroups(namespaces)
-		if !ok || err != nil {
-			return ok, err
-		}
+		return err
 	}
-
-	knownAuthConfigs, ok, err := checkAuthConfigs(namespaces)
-	if !ok || err != nil {
-		return ok, err
-	}
-
-	knownRateLimitConfigs, ok, err := checkRateLimitConfigs(namespaces)
-	if !ok || err != nil {
-		return ok, err
-	}
-
-	includeSecrets := doesNotContain(opts.Top.CheckName, "secrets")
-	if includeSecrets {
-		ok, err := checkSecrets(namespaces)
-		if !ok || err != nil {
-			return ok, err
-		}
-	}
-
-	ok, err = checkVirtualServices(namespaces, knownUpstreams, knownAuthConfigs, knownRateLimitConfigs)
-	if !ok || err != nil {
-		return ok, err
-	}
-
-	includeGateway := doesNotContain(opts.Top.CheckName, "gateways")
-	if includeGateway {
-		ok, err := checkGateways(namespaces)
-		if !ok || err != nil {
-			return ok, err
-		}
-	}
-
-	includeProxy := doesNotContain(opts.Top.CheckName, "proxies")
-	if includeProxy {
-		ok, err := checkProxies(opts.Top.Ctx, namespaces, opts.Metadata.Namespace, deployments)
-		if !ok || err != nil {
-			return ok, err
-		}
-	}
-
-	ok, err = checkGlooePromStats(opts.Top.Ctx, opts.Metadata.Namespace, deployments)
-	if !ok || err != nil {
-		return ok, err
-	}
-	return true, nil
+	fmt.Printf("%s", out)
+	return nil
 }
 
-func getAndCheckDeployments(opts *options.Options) (*appsv1.DeploymentList, bool, error) {
-	fmt.Printf("Checking deployments... ")
+func checkDeployments(state *CheckState, result *CheckResult) error {
 	client := helpers.MustKubeClient()
-	_, err := client.CoreV1().Namespaces().Get(opts.Metadata.Namespace, metav1.GetOptions{})
+	_, err := client.CoreV1().Namespaces().Get(state.opts.Metadata.Namespace, metav1.GetOptions{})
 	if err != nil {
-		fmt.Printf("Gloo namespace does not exist\n")
-		return nil, false, err
+		return eris.Wrapf(err, "Gloo namespace does not exist")
 	}
-	deployments, err := client.AppsV1().Deployments(opts.Metadata.Namespace).List(metav1.ListOptions{})
+	deployments, err := client.AppsV1().Deployments(state.opts.Metadata.Namespace).List(metav1.ListOptions{})
 	if err != nil {
-		return nil, false, err
+		return err
 	}
 	if len(deployments.Items) == 0 {
-		fmt.Printf("Gloo is not installed\n")
-		return nil, false, nil
+		return eris.Errorf("Gloo is not installed")
+	}
+	state.deployments = deployments
+
+	for _, deployment := range deployments.Items {
+		if problem := deploymentProblem(deployment); problem != "" {
+			result.Fail("%s", problem)
+		}
 	}
+	return nil
+}
 
-	var errorToPrint string
+// possible condition types listed at https://godoc.org/k8s.io/api/apps/v1#DeploymentConditionType
+// check for each condition independently because multiple conditions will be True and DeploymentReplicaFailure
+// tends to provide the most explicit error message.
+func deploymentProblem(deployment appsv1.Deployment) string {
 	var message string
 	setMessage := func(c appsv1.DeploymentCondition) {
 		if c.Message != "" {
@@ -183,115 +150,79 @@ func getAndCheckDeployments(opts *options.Options) (*appsv1.DeploymentList, bool
 		}
 	}
 
-	for _, deployment := range deployments.Items {
-		// possible condition types listed at https://godoc.org/k8s.io/api/apps/v1#DeploymentConditionType
-		// check for each condition independently because multiple conditions will be True and DeploymentReplicaFailure
-		// tends to provide the most explicit error message.
-		for _, condition := range deployment.Status.Conditions {
-			setMessage(condition)
-			if condition.Type == appsv1.DeploymentReplicaFailure && condition.Status == corev1.ConditionTrue {
-				errorToPrint = fmt.Sprintf("Deployment %s in namespace %s failed to create pods!%s\n", deployment.Name, deployment.Namespace, message)
-			}
-			if errorToPrint != "" {
-				fmt.Print(errorToPrint)
-				return nil, false, err
-			}
+	for _, condition := range deployment.Status.Conditions {
+		setMessage(condition)
+		if condition.Type == appsv1.DeploymentReplicaFailure && condition.Status == corev1.ConditionTrue {
+			return fmt.Sprintf("Deployment %s in namespace %s failed to create pods!%s", deployment.Name, deployment.Namespace, message)
 		}
+	}
 
-		for _, condition := range deployment.Status.Conditions {
-			setMessage(condition)
-			if condition.Type == appsv1.DeploymentProgressing && condition.Status != corev1.ConditionTrue {
-				errorToPrint = fmt.Sprintf("Deployment %s in namespace %s is not progressing!%s\n", deployment.Name, deployment.Namespace, message)
-			}
-
-			if errorToPrint != "" {
-				fmt.Print(errorToPrint)
-				return nil, false, err
-			}
-		}
-
-		for _, condition := range deployment.Status.Conditions {
-			setMessage(condition)
-			if condition.Type == appsv1.DeploymentAvailable && condition.Status != corev1.ConditionTrue {
-				errorToPrint = fmt.Sprintf("Deployment %s in namespace %s is not available!%s\n", deployment.Name, deployment.Namespace, message)
-			}
-
-			if errorToPrint != "" {
-				fmt.Print(errorToPrint)
-				return nil, false, err
-			}
+	for _, condition := range deployment.Status.Conditions {
+		setMessage(condition)
+		if condition.Type == appsv1.DeploymentProgressing && condition.Status != corev1.ConditionTrue {
+			return fmt.Sprintf("Deployment %s in namespace %s is not progressing!%s", deployment.Name, deployment.Namespace, message)
 		}
+	}
 
-		for _, condition := range deployment.Status.Conditions {
-			if condition.Type != appsv1.DeploymentAvailable &&
-				condition.Type != appsv1.DeploymentReplicaFailure &&
-				condition.Type != appsv1.DeploymentProgressing {
-				fmt.Printf("Note: Unhandled deployment condition %s", condition.Type)
-			}
+	for _, condition := range deployment.Status.Conditions {
+		setMessage(condition)
+		if condition.Type == appsv1.DeploymentAvailable && condition.Status != corev1.ConditionTrue {
+			return fmt.Sprintf("Deployment %s in namespace %s is not available!%s", deployment.Name, deployment.Namespace, message)
 		}
 	}
-	fmt.Printf("OK\n")
-	return deployments, true, nil
+	return ""
 }
 
-func checkPods(opts *options.Options) (bool, error) {
-	fmt.Printf("Checking pods... ")
+func checkPods(state *CheckState, result *CheckResult) error {
 	client := helpers.MustKubeClient()
-	pods, err := client.CoreV1().Pods(opts.Metadata.Namespace).List(metav1.ListOptions{})
+	pods, err := client.CoreV1().Pods(state.opts.Metadata.Namespace).List(metav1.ListOptions{})
 	if err != nil {
-		return false, err
+		return err
 	}
 	for _, pod := range pods.Items {
 		for _, condition := range pod.Status.Conditions {
-			var errorToPrint string
 			var message string
-
 			if condition.Message != "" {
 				message = fmt.Sprintf(" Message: %s", condition.Message)
 			}
 
 			// if condition is not met and the pod is not completed
-			conditionNotMet := condition.Status != corev1.ConditionTrue && condition.Reason != "PodCompleted"
+			if condition.Status == corev1.ConditionTrue || condition.Reason == "PodCompleted" {
+				continue
+			}
 
 			// possible condition types listed at https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle/#pod-conditions
 			switch condition.Type {
 			case corev1.PodScheduled:
-				if conditionNotMet {
-					errorToPrint = fmt.Sprintf("Pod %s in namespace %s is not yet scheduled!%s\n", pod.Name, pod.Namespace, message)
-				}
+				result.Fail("Pod %s in namespace %s is not yet scheduled!%s", pod.Name, pod.Namespace, message)
 			case corev1.PodReady:
-				if conditionNotMet {
-					errorToPrint = fmt.Sprintf("Pod %s in namespace %s is not ready!%s\n", pod.Name, pod.Namespace, message)
-				}
+				result.Fail("Pod %s in namespace %s is not ready!%s", pod.Name, pod.Namespace, message)
 			case corev1.PodInitialized:
-				if conditionNotMet {
-					errorToPrint = fmt.Sprintf("Pod %s in namespace %s is not yet initialized!%s\n", pod.Name, pod.Namespace, message)
-				}
+				result.Fail("Pod %s in namespace %s is not yet initialized!%s", pod.Name, pod.Namespace, message)
 			case corev1.PodReasonUnschedulable:
-				if conditionNotMet {
-					errorToPrint = fmt.Sprintf("Pod %s in namespace %s is unschedulable!%s\n", pod.Name, pod.Namespace, message)
-				}
+				result.Fail("Pod %s in namespace %s is unschedulable!%s", pod.Name, pod.Namespace, message)
 			case corev1.ContainersReady:
-				if conditionNotMet {
-					errorToPrint = fmt.Sprintf("Not all containers in pod %s in namespace %s are ready!%s\n", pod.Name, pod.Namespace, message)
-				}
-			default:
-				fmt.Printf("Note: Unhandled pod condition %s", condition.Type)
-			}
-
-			if errorToPrint != "" {
-				fmt.Print(errorToPrint)
-				return false, err
+				result.Fail("Not all containers in pod %s in namespace %s are ready!%s", pod.Name, pod.Namespace, message)
 			}
 		}
 	}
-	fmt.Printf("OK\n")
-	return true, nil
+	return nil
 }
 
-func getSettings(opts *options.Options) (*v1.Settings, error) {
-	client := helpers.MustNamespacedSettingsClient(opts.Metadata.GetNamespace())
-	return client.Read(opts.Metadata.Namespace, defaults.SettingsName, clients.ReadOpts{})
+func checkSettings(state *CheckState, result *CheckResult) error {
+	client := helpers.MustNamespacedSettingsClient(state.opts.Metadata.GetNamespace())
+	settings, err := client.Read(state.opts.Metadata.Namespace, defaults.SettingsName, clients.ReadOpts{})
+	if err != nil {
+		return err
+	}
+	namespaces, err := getNamespaces(settings)
+	if err != nil {
+		return err
+	}
+	state.settings = settings
+	state.namespaces = namespaces
+	reportStatus(result, "settings", settings.GetMetadata(), settings.Status)
+	return nil
 }
 
 func getNamespaces(settings *v1.Settings) ([]string, error) {
@@ -302,271 +233,182 @@ func getNamespaces(settings *v1.Settings) ([]string, error) {
 	return helpers.GetNamespaces()
 }
 
-func checkUpstreams(namespaces []string) ([]string, bool, error) {
-	fmt.Printf("Checking upstreams... ")
-	var knownUpstreams []string
-	for _, ns := range namespaces {
+// reportStatus fails the check if the resource was rejected or accepted with warnings.
+func reportStatus(result *CheckResult, kind string, metadata core.Metadata, status core.Status) {
+	switch status.GetState() {
+	case core.Status_Rejected:
+		result.Fail("Found rejected %s: %s\nReason: %s", kind, renderMetadata(metadata), status.GetReason())
+	case core.Status_Warning:
+		result.Fail("Found %s with warnings: %s\nReason: %s", kind, renderMetadata(metadata), status.GetReason())
+	}
+}
+
+func checkUpstreams(state *CheckState, result *CheckResult) error {
+	for _, ns := range state.namespaces {
 		upstreams, err := helpers.MustNamespacedUpstreamClient(ns).List(ns, clients.ListOpts{})
 		if err != nil {
-			return nil, false, err
+			return err
 		}
 		for _, upstream := range upstreams {
-			if upstream.Status.GetState() == core.Status_Rejected {
-				fmt.Printf("Found rejected upstream: %s\n", renderMetadata(upstream.GetMetadata()))
-				fmt.Printf("Reason: %s\n", upstream.Status.Reason)
-				return nil, false, nil
-			}
-			if upstream.Status.GetState() == core.Status_Warning {
-				fmt.Printf("Found upstream with warnings: %s\n", renderMetadata(upstream.GetMetadata()))
-				fmt.Printf("Reason: %s\n", upstream.Status.Reason)
-				return nil, false, nil
-			}
-			knownUpstreams = append(knownUpstreams, renderMetadata(upstream.GetMetadata()))
+			reportStatus(result, "upstream", upstream.GetMetadata(), upstream.Status)
+			state.knownUpstreams = append(state.knownUpstreams, renderMetadata(upstream.GetMetadata()))
 		}
 	}
-	fmt.Printf("OK\n")
-	return knownUpstreams, true, nil
+	return nil
 }
 
-func checkUpstreamGroups(namespaces []string) (bool, error) {
-	fmt.Printf("Checking upstream groups... ")
-	for _, ns := range namespaces {
+func checkUpstreamGroups(state *CheckState, result *CheckResult) error {
+	for _, ns := range state.namespaces {
 		upstreamGroups, err := helpers.MustNamespacedUpstreamGroupClient(ns).List(ns, clients.ListOpts{})
 		if err != nil {
-			return false, err
+			return err
 		}
 		for _, upstreamGroup := range upstreamGroups {
-			if upstreamGroup.Status.GetState() == core.Status_Rejected {
-				fmt.Printf("Found rejected upstream group: %s\n", renderMetadata(upstreamGroup.GetMetadata()))
-				fmt.Printf("Reason: %s\n", upstreamGroup.Status.Reason)
-				return false, nil
-			}
-			if upstreamGroup.Status.GetState() == core.Status_Warning {
-				fmt.Printf("Found upstream group with warnings: %s\n", renderMetadata(upstreamGroup.GetMetadata()))
-				fmt.Printf("Reason: %s\n", upstreamGroup.Status.Reason)
-				return false, nil
-			}
+			reportStatus(result, "upstream group", upstreamGroup.GetMetadata(), upstreamGroup.Status)
 		}
 	}
-	fmt.Printf("OK\n")
-	return true, nil
+	return nil
 }
 
-func checkAuthConfigs(namespaces []string) ([]string, bool, error) {
-	fmt.Printf("Checking auth configs... ")
-	var knownAuthConfigs []string
-	for _, ns := range namespaces {
+func checkAuthConfigs(state *CheckState, result *CheckResult) error {
+	for _, ns := range state.namespaces {
 		authConfigs, err := helpers.MustNamespacedAuthConfigClient(ns).List(ns, clients.ListOpts{})
 		if err != nil {
-			return nil, false, err
+			return err
 		}
 		for _, authConfig := range authConfigs {
-			if authConfig.Status.GetState() == core.Status_Rejected {
-				fmt.Printf("Found rejected auth config: %s\n", renderMetadata(authConfig.GetMetadata()))
-				fmt.Printf("Reason: %s\n", authConfig.Status.Reason)
-				return nil, false, nil
-			}
-			if authConfig.Status.GetState() == core.Status_Warning {
-				fmt.Printf("Found auth config with warnings: %s\n", renderMetadata(authConfig.GetMetadata()))
-				fmt.Printf("Reason: %s\n", authConfig.Status.Reason)
-				return nil, false, nil
-			}
-			knownAuthConfigs = append(knownAuthConfigs, renderMetadata(authConfig.GetMetadata()))
+			reportStatus(result, "auth config", authConfig.GetMetadata(), authConfig.Status)
+			state.knownAuthConfigs = append(state.knownAuthConfigs, renderMetadata(authConfig.GetMetadata()))
 		}
 	}
-	fmt.Printf("OK\n")
-	return knownAuthConfigs, true, nil
+	return nil
 }
 
-func checkRateLimitConfigs(namespaces []string) ([]string, bool, error) {
-	fmt.Printf("Checking rate limit configs... ")
-	var knownConfigs []string
-	for _, ns := range namespaces {
+func checkRateLimitConfigs(state *CheckState, result *CheckResult) error {
+	for _, ns := range state.namespaces {
 
 		rlcClient, err := helpers.RateLimitConfigClient([]string{ns})
 		if err != nil {
 			if isCrdNotFoundErr(err) {
 				// Just warn. If the CRD is required, the check would have failed on the crashing gloo/gloo-ee pod.
-				fmt.Printf("WARN: %s\n", CrdNotFoundErr(ratelimit.RateLimitConfigCrd.KindName).Error())
-				return nil, true, nil
+				result.Warn("%v", CrdNotFoundErr(ratelimit.RateLimitConfigCrd.KindName))
+				return nil
 			}
-			return nil, false, err
+			return err
 		}
 
 		configs, err := rlcClient.List(ns, clients.ListOpts{})
 		if err != nil {
-			return nil, false, err
+			return err
 		}
 		for _, config := range configs {
 			if config.Status.GetState() == v1alpha1.RateLimitConfigStatus_REJECTED {
-				fmt.Printf("Found rejected rate limit config: %s\n", renderMetadata(config.GetMetadata()))
-				fmt.Printf("Reason: %s\n", config.Status.Message)
-				return nil, false, nil
+				result.Fail("Found rejected rate limit config: %s\nReason: %s", renderMetadata(config.GetMetadata()), config.Status.Message)
 			}
-			knownConfigs = append(knownConfigs, renderMetadata(config.GetMetadata()))
+			state.knownRateLimitConfigs = append(state.knownRateLimitConfigs, renderMetadata(config.GetMetadata()))
 		}
 	}
-	fmt.Printf("OK\n")
-	return knownConfigs, true, nil
+	return nil
 }
 
-func checkVirtualServices(namespaces, knownUpstreams, knownAuthConfigs, knownRateLimitConfigs []string) (bool, error) {
-	fmt.Printf("Checking virtual services... ")
-	for _, ns := range namespaces {
+func checkVirtualServices(state *CheckState, result *CheckResult) error {
+	for _, ns := range state.namespaces {
 		virtualServices, err := helpers.MustNamespacedVirtualServiceClient(ns).List(ns, clients.ListOpts{})
 		if err != nil {
-			return false, err
+			return err
 		}
 		for _, virtualService := range virtualServices {
-			if virtualService.Status.GetState() == core.Status_Rejected {
-				fmt.Printf("Found rejected virtual service: %s\n", renderMetadata(virtualService.GetMetadata()))
-				fmt.Printf("Reason: %s\n", virtualService.Status.GetReason())
-				return false, nil
-			}
-			if virtualService.Status.GetState() == core.Status_Warning {
-				fmt.Printf("Found virtual service with warnings: %s\n", renderMetadata(virtualService.GetMetadata()))
-				fmt.Printf("Reason: %s\n", virtualService.Status.GetReason())
-				return false, nil
-			}
+			reportStatus(result, "virtual service", virtualService.GetMetadata(), virtualService.Status)
 			for _, route := range virtualService.GetVirtualHost().GetRoutes() {
-				if route.GetRouteAction() != nil {
-					if route.GetRouteAction().GetSingle() != nil {
-						us := route.GetRouteAction().GetSingle()
-						if us.GetUpstream() != nil {
-							if !cliutils.Contains(knownUpstreams, renderRef(us.GetUpstream())) {
-								fmt.Printf("Virtual service references unknown upstream:\n")
-								fmt.Printf("  Virtual service: %s\n", renderMetadata(virtualService.GetMetadata()))
-								fmt.Printf("  Upstream: %s\n", renderRef(us.GetUpstream()))
-								return false, nil
-							}
-						}
-					}
+				us := route.GetRouteAction().GetSingle().GetUpstream()
+				if us != nil && !cliutils.Contains(state.knownUpstreams, renderRef(us)) {
+					result.Fail("Virtual service references unknown upstream:\n  Virtual service: %s\n  Upstream: %s",
+						renderMetadata(virtualService.GetMetadata()), renderRef(us))
 				}
 			}
 
 			// Check references to auth configs
-			isAuthConfigRefValid := func(knownConfigs []string, ref *core.ResourceRef) bool {
+			checkAuthConfigRef := func(ref *core.ResourceRef) {
 				// If the virtual service points to a specific, non-existent authconfig, it is not valid.
-				if ref != nil && !cliutils.Contains(knownConfigs, renderRef(ref)) {
-					fmt.Printf("Virtual service references unknown auth config:\n")
-					fmt.Printf("  Virtual service: %s\n", renderMetadata(virtualService.GetMetadata()))
-					fmt.Printf("  Auth Config: %s\n", renderRef(ref))
-					return false
+				if ref != nil && !cliutils.Contains(state.knownAuthConfigs, renderRef(ref)) {
+					result.Fail("Virtual service references unknown auth config:\n  Virtual service: %s\n  Auth Config: %s",
+						renderMetadata(virtualService.GetMetadata()), renderRef(ref))
 				}
-				return true
 			}
 			// Check virtual host options
-			if !isAuthConfigRefValid(knownAuthConfigs, virtualService.GetVirtualHost().GetOptions().GetExtauth().GetConfigRef()) {
-				return false, nil
-			}
+			checkAuthConfigRef(virtualService.GetVirtualHost().GetOptions().GetExtauth().GetConfigRef())
 			// Check route options
 			for _, route := range virtualService.GetVirtualHost().GetRoutes() {
-				if !isAuthConfigRefValid(knownAuthConfigs, route.GetOptions().GetExtauth().GetConfigRef()) {
-					return false, nil
-				}
+				checkAuthConfigRef(route.GetOptions().GetExtauth().GetConfigRef())
 				// Check weighted destination options
 				for _, weightedDest := range route.GetRouteAction().GetMulti().GetDestinations() {
-					if !isAuthConfigRefValid(knownAuthConfigs, weightedDest.GetOptions().GetExtauth().GetConfigRef()) {
-						return false, nil
-					}
+					checkAuthConfigRef(weightedDest.GetOptions().GetExtauth().GetConfigRef())
 				}
 			}
 
 			// Check references to rate limit configs
-			isRateLimitConfigRefValid := func(knownConfigs []string, ref *rlopts.RateLimitConfigRef) bool {
+			checkRateLimitConfigRef := func(ref *rlopts.RateLimitConfigRef) {
 				resourceRef := &core.ResourceRef{
 					Name:      ref.Name,
 					Namespace: ref.Namespace,
 				}
-				if !cliutils.Contains(knownConfigs, renderRef(resourceRef)) {
-					fmt.Printf("Virtual service references unknown rate limit config:\n")
-					fmt.Printf("  Virtual service: %s\n", renderMetadata(virtualService.GetMetadata()))
-					fmt.Printf("  Rate Limit Config: %s\n", renderRef(resourceRef))
-					return false
+				if !cliutils.Contains(state.knownRateLimitConfigs, renderRef(resourceRef)) {
+					result.Fail("Virtual service references unknown rate limit config:\n  Virtual service: %s\n  Rate Limit Config: %s",
+						renderMetadata(virtualService.GetMetadata()), renderRef(resourceRef))
 				}
-				return true
 			}
 			// Check virtual host options
 			for _, ref := range virtualService.GetVirtualHost().GetOptions().GetRateLimitConfigs().GetRefs() {
-				if !isRateLimitConfigRefValid(knownRateLimitConfigs, ref) {
-					return false, nil
-				}
+				checkRateLimitConfigRef(ref)
 			}
 			// Check route options
 			for _, route := range virtualService.GetVirtualHost().GetRoutes() {
 				for _, ref := range route.GetOptions().GetRateLimitConfigs().GetRefs() {
-					if !isRateLimitConfigRefValid(knownRateLimitConfigs, ref) {
-						return false, nil
-					}
+					checkRateLimitConfigRef(ref)
 				}
 			}
 		}
 	}
-	fmt.Printf("OK\n")
-	return true, nil
+	return nil
 }
 
-func checkGateways(namespaces []string) (bool, error) {
-	fmt.Printf("Checking gateways... ")
-	for _, ns := range namespaces {
+func checkGateways(state *CheckState, result *CheckResult) error {
+	for _, ns := range state.namespaces {
 		gateways, err := helpers.MustNamespacedGatewayClient(ns).List(ns, clients.ListOpts{})
 		if err != nil {
-			return false, err
+			return err
 		}
 		for _, gateway := range gateways {
-			if gateway.Status.GetState() == core.Status_Rejected {
-				fmt.Printf("Found rejected gateway: %s\n", renderMetadata(gateway.GetMetadata()))
-				fmt.Printf("Reason: %s\n", gateway.Status.Reason)
-				return false, nil
-			}
-			if gateway.Status.GetState() == core.Status_Warning {
-				fmt.Printf("Found gateway with warnings: %s\n", renderMetadata(gateway.GetMetadata()))
-				fmt.Printf("Reason: %s\n", gateway.Status.Reason)
-				return false, nil
-			}
+			reportStatus(result, "gateway", gateway.GetMetadata(), gateway.Status)
 		}
 	}
-	fmt.Printf("OK\n")
-	return true, nil
+	return nil
 }
 
-func checkProxies(ctx context.Context, namespaces []string, glooNamespace string, deployments *appsv1.DeploymentList) (bool, error) {
-	fmt.Printf("Checking proxies... ")
-	for _, ns := range namespaces {
+func checkProxies(state *CheckState, result *CheckResult) error {
+	for _, ns := range state.namespaces {
 		proxies, err := helpers.MustNamespacedProxyClient(ns).List(ns, clients.ListOpts{})
 		if err != nil {
-			return false, err
+			return err
 		}
 		for _, proxy := range proxies {
-			if proxy.Status.GetState() == core.Status_Rejected {
-				fmt.Printf("Found rejected proxy: %s\n", renderMetadata(proxy.GetMetadata()))
-				fmt.Printf("Reason: %s\n", proxy.Status.Reason)
-				return false, nil
-			}
-			if proxy.Status.GetState() == core.Status_Warning {
-				fmt.Printf("Found proxy with warnings: %s\n", renderMetadata(proxy.GetMetadata()))
-				fmt.Printf("Reason: %s\n", proxy.Status.Reason)
-				return false, nil
-			}
+			reportStatus(result, "proxy", proxy.GetMetadata(), proxy.Status)
 		}
 	}
-
-	return checkProxiesPromStats(ctx, glooNamespace, deployments)
+	return nil
 }
 
-func checkSecrets(namespaces []string) (bool, error) {
-	fmt.Printf("Checking secrets... ")
-	client := helpers.MustSecretClientWithOptions(5*time.Second, namespaces)
+func checkSecrets(state *CheckState, result *CheckResult) error {
+	client := helpers.MustSecretClientWithOptions(5*time.Second, state.namespaces)
 
-	for _, ns := range namespaces {
+	for _, ns := range state.namespaces {
 		_, err := client.List(ns, clients.ListOpts{})
 		if err != nil {
-			return false, err
+			return err
 		}
 		// currently this would only find syntax errors
 	}
-	fmt.Printf("OK\n")
-	return true, nil
+	return nil
 }
 
 func renderMetadata(metadata core.Metadata) string {
@@ -583,12 +425,12 @@ func renderNamespaceName(namespace, name string) string {
 
 // Checks whether the cluster that the kubeconfig points at is available
 // The timeout for the kubernetes client is set to a low value to notify the user of the failure
-func checkConnection(ns string) error {
+func checkConnection(state *CheckState, _ *CheckResult) error {
 	client, err := helpers.GetKubernetesClientWithTimeout(5 * time.Second)
 	if err != nil {
 		return eris.Wrapf(err, "Could not get kubernetes client")
 	}
-	_, err = client.CoreV1().Namespaces().Get(ns, metav1.GetOptions{})
+	_, err = client.CoreV1().Namespaces().Get(state.opts.Metadata.Namespace, metav1.GetOptions{})
 	if err != nil {
 		return eris.Wrapf(err, "Could not communicate with kubernetes cluster")
 	}
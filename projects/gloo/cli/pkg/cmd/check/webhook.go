@@ -0,0 +1,54 @@
+package check
+
+import (
+	"fmt"
+
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	kubeerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// the name given to the webhook configuration by the gloo helm chart
+func validationWebhookName(namespace string) string {
+	return fmt.Sprintf("gloo-gateway-validation-webhook-%s", namespace)
+}
+
+// checkValidationWebhook checks that the validating webhook has a CA bundle and that its service has ready endpoints,
+// otherwise the kube apiserver cannot reach the webhook.
+func checkValidationWebhook(state *CheckState, result *CheckResult) error {
+	client := helpers.MustKubeClient()
+	name := validationWebhookName(state.opts.Metadata.Namespace)
+	webhookConfig, err := client.AdmissionregistrationV1beta1().ValidatingWebhookConfigurations().Get(name, metav1.GetOptions{})
+	if err != nil {
+		if kubeerrors.IsNotFound(err) {
+			result.Skip("validating webhook configuration %s was not found", name)
+			return nil
+		}
+		return err
+	}
+	for _, webhook := range webhookConfig.Webhooks {
+		if len(webhook.ClientConfig.CABundle) == 0 {
+			result.Fail("Webhook %s has no caBundle, the certgen job may not have completed", webhook.Name)
+		}
+		service := webhook.ClientConfig.Service
+		if service == nil {
+			continue
+		}
+		endpoints, err := client.CoreV1().Endpoints(service.Namespace).Get(service.Name, metav1.GetOptions{})
+		if err != nil {
+			if kubeerrors.IsNotFound(err) {
+				result.Fail("Webhook %s: service %s %s was not found", webhook.Name, service.Namespace, service.Name)
+				continue
+			}
+			return err
+		}
+		ready := 0
+		for _, subset := range endpoints.Subsets {
+			ready += len(subset.Addresses)
+		}
+		if ready == 0 {
+			result.Fail("Webhook %s: service %s %s has no ready endpoints", webhook.Name, service.Namespace, service.Name)
+		}
+	}
+	return nil
+}
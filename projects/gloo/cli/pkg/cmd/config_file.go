@@ -30,6 +30,7 @@ const (
 
 	// note that the available keys in this config file should be kept up to date in our public docs
 	disableUsageReporting = "disableUsageReporting"
+	pluginIndex           = "pluginIndex"
 )
 
 var DefaultConfigPath = path.Join(homeDir, ConfigDirName, ConfigFileName)
@@ -62,6 +63,9 @@ func ReadConfigFile(opts *options.Options, cmd *cobra.Command) error {
 	}
 
 	opts.Top.DisableUsageStatistics = viper.GetBool(disableUsageReporting)
+	if opts.Plugin.Index == "" {
+		opts.Plugin.Index = viper.GetString(pluginIndex)
+	}
 
 	return err
 }
@@ -0,0 +1,117 @@
+package create
+
+import (
+	"github.com/gogo/protobuf/types"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/argsutils"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/flagutils"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/prerun"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/printers"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/surveyutils"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/cliutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+func GatewayCreate(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     constants.GATEWAY_COMMAND.Use,
+		Aliases: constants.GATEWAY_COMMAND.Aliases,
+		Short:   "Create a Gateway",
+		Long: "A gateway describes a single listener on the proxies it is applied to. " +
+			"HTTP gateways serve the virtual services selected by the gateway; TCP gateways proxy raw tcp traffic. " +
+			"By default, an HTTP gateway is created which serves all virtual services without ssl configured.",
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := prerun.CallParentPrerun(cmd, args); err != nil {
+				return err
+			}
+			if err := prerun.EnableConsulClients(opts); err != nil {
+				return err
+			}
+			return nil
+		},
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Top.Interactive {
+				if err := surveyutils.AddGatewayFlagsInteractive(&opts.Create.InputGateway); err != nil {
+					return err
+				}
+			}
+			if err := argsutils.MetadataArgsParse(opts, args); err != nil {
+				return err
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return createGateway(opts)
+		},
+	}
+
+	pflags := cmd.PersistentFlags()
+	flagutils.AddMetadataFlags(pflags, &opts.Metadata)
+	flagutils.AddGatewayFlags(pflags, &opts.Create.InputGateway)
+	cliutils.ApplyOptions(cmd, optionsFunc)
+
+	return cmd
+}
+
+func createGateway(opts *options.Options) error {
+	gw, err := gatewayFromOpts(opts.Metadata, opts.Create.InputGateway)
+	if err != nil {
+		return err
+	}
+
+	if !opts.Create.DryRun {
+		gw, err = helpers.MustNamespacedGatewayClient(opts.Metadata.GetNamespace()).Write(gw, clients.WriteOpts{})
+		if err != nil {
+			return err
+		}
+	}
+
+	_ = printers.PrintGateways(v1.GatewayList{gw}, opts.Top.Output)
+
+	return nil
+}
+
+func gatewayFromOpts(meta core.Metadata, input options.InputGateway) (*v1.Gateway, error) {
+	gw := &v1.Gateway{
+		Metadata:      meta,
+		Ssl:           input.Ssl,
+		BindAddress:   input.BindAddress,
+		BindPort:      input.BindPort,
+		ProxyNames:    input.ProxyNames,
+		UseProxyProto: &types.BoolValue{Value: input.UseProxyProto},
+	}
+	if input.PerConnectionBufferLimitBytes != 0 {
+		gw.Options = &gloov1.ListenerOptions{
+			PerConnectionBufferLimitBytes: &types.UInt32Value{Value: input.PerConnectionBufferLimitBytes},
+		}
+	}
+
+	if input.Tcp {
+		if len(input.VirtualServiceSelector.Entries) > 0 || len(input.VirtualServiceNamespaces) > 0 {
+			return nil, errors.Errorf("virtual services can only be selected for http gateways")
+		}
+		gw.GatewayType = &v1.Gateway_TcpGateway{
+			TcpGateway: &v1.TcpGateway{},
+		}
+		return gw, nil
+	}
+
+	httpGateway := &v1.HttpGateway{}
+	if len(input.VirtualServiceSelector.Entries) > 0 {
+		httpGateway.VirtualServiceSelector = input.VirtualServiceSelector.MustMap()
+	}
+	if len(input.VirtualServiceNamespaces) > 0 {
+		httpGateway.VirtualServiceNamespaces = input.VirtualServiceNamespaces
+	}
+	gw.GatewayType = &v1.Gateway_HttpGateway{
+		HttpGateway: httpGateway,
+	}
+	return gw, nil
+}
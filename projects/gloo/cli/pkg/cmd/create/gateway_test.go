@@ -0,0 +1,105 @@
+package create_test
+
+import (
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/argsutils"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/testutils"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+)
+
+var _ = Describe("Gateway", func() {
+
+	BeforeEach(func() {
+		helpers.UseMemoryClients()
+	})
+
+	getGateway := func(name string) *gatewayv1.Gateway {
+		gw, err := helpers.MustGatewayClient().Read("gloo-system", name, clients.ReadOpts{})
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		return gw
+	}
+
+	Context("Empty args and flags", func() {
+		It("should give clear error message", func() {
+			err := testutils.Glooctl("create gateway")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal(argsutils.NameError))
+		})
+	})
+
+	It("should create an http gateway with the default settings", func() {
+		err := testutils.Glooctl("create gateway gw")
+		Expect(err).NotTo(HaveOccurred())
+
+		gw := getGateway("gw")
+		Expect(gw.BindAddress).To(Equal("::"))
+		Expect(gw.BindPort).To(Equal(uint32(8080)))
+		Expect(gw.Ssl).To(BeFalse())
+		Expect(gw.ProxyNames).To(Equal([]string{"gateway-proxy"}))
+		Expect(gw.UseProxyProto).To(Equal(&types.BoolValue{Value: false}))
+		Expect(gw.Options).To(BeNil())
+		Expect(gw.GetHttpGateway()).To(Equal(&gatewayv1.HttpGateway{}))
+	})
+
+	It("should create an ssl http gateway which selects virtual services", func() {
+		err := testutils.Glooctl("create gateway gw --bind-port 8443 --ssl --use-proxy-proto --proxy-names p1,p2 " +
+			"--per-connection-buffer-limit-bytes 1024 --vs-selector team=a --vs-namespaces team-a,team-b")
+		Expect(err).NotTo(HaveOccurred())
+
+		gw := getGateway("gw")
+		Expect(gw.BindPort).To(Equal(uint32(8443)))
+		Expect(gw.Ssl).To(BeTrue())
+		Expect(gw.ProxyNames).To(Equal([]string{"p1", "p2"}))
+		Expect(gw.UseProxyProto).To(Equal(&types.BoolValue{Value: true}))
+		Expect(gw.Options).To(Equal(&gloov1.ListenerOptions{
+			PerConnectionBufferLimitBytes: &types.UInt32Value{Value: 1024},
+		}))
+		Expect(gw.GetHttpGateway()).To(Equal(&gatewayv1.HttpGateway{
+			VirtualServiceSelector:   map[string]string{"team": "a"},
+			VirtualServiceNamespaces: []string{"team-a", "team-b"},
+		}))
+	})
+
+	It("should create a tcp gateway", func() {
+		err := testutils.Glooctl("create gateway gw --tcp --bind-port 8000")
+		Expect(err).NotTo(HaveOccurred())
+
+		gw := getGateway("gw")
+		Expect(gw.BindPort).To(Equal(uint32(8000)))
+		Expect(gw.GetTcpGateway()).To(Equal(&gatewayv1.TcpGateway{}))
+	})
+
+	It("should not select virtual services for a tcp gateway", func() {
+		err := testutils.Glooctl("create gateway gw --tcp --vs-selector team=a")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("virtual services can only be selected for http gateways"))
+	})
+
+	It("can print as kube yaml in dry run", func() {
+		out, err := testutils.GlooctlOut("create gateway --dry-run --name gw")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(Equal(`apiVersion: gateway.solo.io/v1
+kind: Gateway
+metadata:
+  creationTimestamp: null
+  name: gw
+  namespace: gloo-system
+spec:
+  bindAddress: '::'
+  bindPort: 8080
+  httpGateway: {}
+  proxyNames:
+  - gateway-proxy
+  useProxyProto: false
+status: {}
+`))
+
+		_, err = helpers.MustGatewayClient().Read("gloo-system", "gw", clients.ReadOpts{})
+		Expect(err).To(HaveOccurred())
+	})
+})
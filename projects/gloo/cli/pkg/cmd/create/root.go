@@ -64,6 +64,8 @@ func RootCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.
 	flagutils.AddDryRunFlag(cmd.PersistentFlags(), &opts.Create.DryRun)
 
 	cmd.AddCommand(VSCreate(opts))
+	cmd.AddCommand(RouteTableCreate(opts))
+	cmd.AddCommand(GatewayCreate(opts))
 	cmd.AddCommand(Upstream(opts))
 	cmd.AddCommand(UpstreamGroup(opts))
 	cmd.AddCommand(secret.CreateCmd(opts))
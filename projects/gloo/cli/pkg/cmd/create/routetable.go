@@ -0,0 +1,85 @@
+package create
+
+import (
+	"github.com/gogo/protobuf/types"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/argsutils"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/flagutils"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/prerun"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/printers"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/surveyutils"
+	"github.com/solo-io/go-utils/cliutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/spf13/cobra"
+)
+
+func RouteTableCreate(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     constants.ROUTE_TABLE_COMMAND.Use,
+		Aliases: constants.ROUTE_TABLE_COMMAND.Aliases,
+		Short:   "Create a Route Table",
+		Long: "Route tables are collections of routes which virtual services and other route tables delegate to, " +
+			"either by reference or by label selector. The route table is created without routes; use " +
+			"`glooctl add route --to-route-table` to add routes to it.",
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := prerun.CallParentPrerun(cmd, args); err != nil {
+				return err
+			}
+			if err := prerun.EnableConsulClients(opts); err != nil {
+				return err
+			}
+			return nil
+		},
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Top.Interactive {
+				if err := surveyutils.AddRouteTableFlagsInteractive(&opts.Create.InputRouteTable); err != nil {
+					return err
+				}
+			}
+			if err := argsutils.MetadataArgsParse(opts, args); err != nil {
+				return err
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return createRouteTable(opts)
+		},
+	}
+
+	pflags := cmd.PersistentFlags()
+	flagutils.AddMetadataFlags(pflags, &opts.Metadata)
+	flagutils.AddRouteTableFlags(pflags, &opts.Create.InputRouteTable)
+	cliutils.ApplyOptions(cmd, optionsFunc)
+
+	return cmd
+}
+
+func createRouteTable(opts *options.Options) error {
+	rt := routeTableFromOpts(opts.Metadata, opts.Create.InputRouteTable)
+
+	if !opts.Create.DryRun {
+		var err error
+		rt, err = helpers.MustNamespacedRouteTableClient(opts.Metadata.GetNamespace()).Write(rt, clients.WriteOpts{})
+		if err != nil {
+			return err
+		}
+	}
+
+	_ = printers.PrintRouteTables(v1.RouteTableList{rt}, opts.Top.Output)
+
+	return nil
+}
+
+func routeTableFromOpts(meta core.Metadata, input options.InputRouteTable) *v1.RouteTable {
+	rt := &v1.RouteTable{
+		Metadata: meta,
+	}
+	if input.Weight != 0 {
+		rt.Weight = &types.Int32Value{Value: input.Weight}
+	}
+	return rt
+}
@@ -0,0 +1,63 @@
+package create_test
+
+import (
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/argsutils"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/testutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+)
+
+var _ = Describe("RouteTable", func() {
+
+	BeforeEach(func() {
+		helpers.UseMemoryClients()
+	})
+
+	Context("Empty args and flags", func() {
+		It("should give clear error message", func() {
+			err := testutils.Glooctl("create routetable")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal(argsutils.NameError))
+		})
+	})
+
+	It("should create a route table", func() {
+		err := testutils.Glooctl("create routetable rt --weight 5")
+		Expect(err).NotTo(HaveOccurred())
+
+		rt, err := helpers.MustRouteTableClient().Read("gloo-system", "rt", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rt.Weight).To(Equal(&types.Int32Value{Value: 5}))
+		Expect(rt.Routes).To(BeEmpty())
+	})
+
+	It("should not set a weight by default", func() {
+		err := testutils.Glooctl("create routetable rt")
+		Expect(err).NotTo(HaveOccurred())
+
+		rt, err := helpers.MustRouteTableClient().Read("gloo-system", "rt", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rt.Weight).To(BeNil())
+	})
+
+	It("can print as kube yaml in dry run", func() {
+		out, err := testutils.GlooctlOut("create routetable --dry-run --name rt --weight 5")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(Equal(`apiVersion: gateway.solo.io/v1
+kind: RouteTable
+metadata:
+  creationTimestamp: null
+  name: rt
+  namespace: gloo-system
+spec:
+  weight: 5
+status: {}
+`))
+
+		_, err = helpers.MustRouteTableClient().Read("gloo-system", "rt", clients.ReadOpts{})
+		Expect(err).To(HaveOccurred())
+	})
+})
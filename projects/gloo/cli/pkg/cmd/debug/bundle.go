@@ -0,0 +1,500 @@
+package debug
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	gogoproto "github.com/gogo/protobuf/proto"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/cliutil"
+	"github.com/solo-io/gloo/pkg/cliutil/install"
+	installcmd "github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/install"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/xdsinspection"
+	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	BundleFilename  = "/tmp/gloo-debug-bundle.tgz"
+	BundleIndexFile = "index.yaml"
+
+	redactedValue = "<redacted>"
+	// the envoys of every kind of gloo proxy
+	envoyPodSelector = "gloo in (gateway-proxy,ingress-proxy,knative-external-proxy,knative-internal-proxy)"
+)
+
+var (
+	// the values of these fields are replaced wherever they appear in the bundle
+	sensitiveFields = map[string]bool{
+		"token":         true,
+		"password":      true,
+		"privateKey":    true,
+		"private_key":   true,
+		"clientSecret":  true,
+		"client_secret": true,
+		"hmacSecret":    true,
+		"hmac_secret":   true,
+		// the credentials of aws upstreams, in the clusters served to envoy
+		"accessKey":     true,
+		"access_key":    true,
+		"secretKey":     true,
+		"secret_key":    true,
+		"sessionToken":  true,
+		"session_token": true,
+	}
+
+	// the kubernetes kinds dumped from the gloo namespace, in addition to the gloo resources
+	bundleKubeKinds = append(append([]string{}, installcmd.GlooNamespacedKinds...), "Pod", "Secret")
+)
+
+// BundleIndex describes the content of a debug bundle. It is written to index.yaml at the root of the bundle.
+type BundleIndex struct {
+	CreatedAt time.Time    `json:"createdAt"`
+	Namespace string       `json:"namespace"`
+	Files     []BundleFile `json:"files"`
+	// the parts of the bundle that could not be collected
+	Errors []BundleError `json:"errors,omitempty"`
+}
+
+type BundleFile struct {
+	Path        string `json:"path"`
+	Description string `json:"description"`
+}
+
+type BundleError struct {
+	Collector string `json:"collector"`
+	Error     string `json:"error"`
+}
+
+// bundler collects a debug bundle. The ways it reaches the cluster are fields so tests can replace them.
+type bundler struct {
+	ctx         context.Context
+	namespace   string
+	eventsSince time.Duration
+	now         func() time.Time
+
+	kubeCli install.KubeCli
+	kube    kubernetes.Interface
+	// GETs a path on a port of a kubernetes resource, e.g. "deploy/gloo"
+	portForwardGet func(ctx context.Context, namespace, resource string, port uint32, path string) (string, error)
+	// the xDS config gloo serves to the proxy
+	xdsDump func(ctx context.Context, proxyName, namespace string) (*xdsinspection.XdsDump, error)
+	// the logs of the gloo pods, by file name
+	logs func() (map[string]string, error)
+
+	fs    afero.Fs
+	dir   string
+	index BundleIndex
+}
+
+func DebugBundle(opts *options.Options, w io.Writer) error {
+	file := opts.Top.File
+	if file == "" {
+		file = BundleFilename
+	}
+	b := &bundler{
+		ctx:            opts.Top.Ctx,
+		namespace:      opts.Metadata.Namespace,
+		eventsSince:    opts.Debug.EventsSince,
+		now:            time.Now,
+		kubeCli:        &install.CmdKubectl{},
+		kube:           helpers.MustKubeClient(),
+		portForwardGet: portForwardGet,
+		xdsDump: func(ctx context.Context, proxyName, namespace string) (*xdsinspection.XdsDump, error) {
+			return xdsinspection.GetGlooXdsDump(ctx, proxyName, namespace, false)
+		},
+		logs: func() (map[string]string, error) {
+			return collectLogs(opts)
+		},
+		fs: afero.NewOsFs(),
+	}
+	if err := b.writeBundle(file); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(w, "Wrote debug bundle to %s\n", file)
+	return err
+}
+
+// writeBundle collects every part of the bundle and writes it as a tarball to file. Parts that cannot be
+// collected are recorded in the index rather than failing the bundle.
+func (b *bundler) writeBundle(file string) error {
+	dir, err := afero.TempDir(b.fs, "", "gloo-bundle")
+	if err != nil {
+		return err
+	}
+	defer b.fs.RemoveAll(dir)
+	b.dir = dir
+	b.index = BundleIndex{
+		CreatedAt: b.now().UTC(),
+		Namespace: b.namespace,
+	}
+
+	collectors := []struct {
+		name    string
+		collect func() error
+	}{
+		{"resources", b.collectResources},
+		{"kube", b.collectKubeResources},
+		{"events", b.collectEvents},
+		{"xds", b.collectServedXds},
+		{"envoy", b.collectEnvoys},
+		{"metrics", b.collectMetrics},
+		{"logs", b.collectLogs},
+	}
+	for _, collector := range collectors {
+		if err := collector.collect(); err != nil {
+			b.recordError(collector.name, err)
+		}
+	}
+
+	index, err := yaml.Marshal(b.index)
+	if err != nil {
+		return err
+	}
+	if err := afero.WriteFile(b.fs, filepath.Join(dir, BundleIndexFile), index, filePermissions); err != nil {
+		return err
+	}
+	return zip(b.fs, dir, file)
+}
+
+func (b *bundler) recordError(collector string, err error) {
+	b.index.Errors = append(b.index.Errors, BundleError{Collector: collector, Error: err.Error()})
+}
+
+func (b *bundler) writeFile(name, description string, content []byte) error {
+	file := filepath.Join(b.dir, filepath.FromSlash(name))
+	if err := b.fs.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		return err
+	}
+	if err := afero.WriteFile(b.fs, file, content, filePermissions); err != nil {
+		return err
+	}
+	b.index.Files = append(b.index.Files, BundleFile{Path: name, Description: description})
+	return nil
+}
+
+// collectResources dumps the gloo custom resources of every namespace, including Settings and the rendered Proxies.
+func (b *bundler) collectResources() error {
+	for _, crd := range installcmd.GlooCrdNames {
+		if err := b.dumpKubectl(path.Join("resources", crd+".yaml"), fmt.Sprintf("%s in all namespaces", crd),
+			"get", crd, "-oyaml", "--all-namespaces"); err != nil {
+			b.recordError("resources", err)
+		}
+	}
+	return nil
+}
+
+// collectKubeResources dumps the kubernetes resources of the gloo namespace, with the values of secrets and environment variables redacted.
+func (b *bundler) collectKubeResources() error {
+	for _, kind := range bundleKubeKinds {
+		if err := b.dumpKubectl(path.Join("kube", strings.ToLower(kind)+".yaml"), fmt.Sprintf("%s resources in %s", kind, b.namespace),
+			"get", kind, "-oyaml", "-n", b.namespace); err != nil {
+			b.recordError("kube", err)
+		}
+	}
+	return nil
+}
+
+func (b *bundler) dumpKubectl(name, description string, args ...string) error {
+	out, err := b.kubeCli.KubectlOut(nil, args...)
+	if err != nil {
+		return eris.Wrapf(err, "kubectl %s", strings.Join(args, " "))
+	}
+	redacted, err := redactYaml(out)
+	if err != nil {
+		return eris.Wrapf(err, "redacting the output of kubectl %s", strings.Join(args, " "))
+	}
+	return b.writeFile(name, description, redacted)
+}
+
+// collectEvents dumps the recent kubernetes events of the gloo namespace, oldest first.
+func (b *bundler) collectEvents() error {
+	events, err := b.kube.CoreV1().Events(b.namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	since := b.now().Add(-b.eventsSince)
+	recent := []corev1.Event{}
+	for _, event := range events.Items {
+		if eventTime(event).After(since) {
+			recent = append(recent, event)
+		}
+	}
+	sort.SliceStable(recent, func(i, j int) bool {
+		return eventTime(recent[i]).Before(eventTime(recent[j]))
+	})
+	out, err := yaml.Marshal(recent)
+	if err != nil {
+		return err
+	}
+	return b.writeFile("events.yaml", fmt.Sprintf("kubernetes events in %s of the last %s", b.namespace, b.eventsSince), out)
+}
+
+func eventTime(event corev1.Event) time.Time {
+	switch {
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	}
+	return event.CreationTimestamp.Time
+}
+
+// collectServedXds dumps the xDS config gloo serves for each proxy.
+func (b *bundler) collectServedXds() error {
+	proxies, err := helpers.MustNamespacedProxyClient(b.namespace).List(b.namespace, clients.ListOpts{Ctx: b.ctx})
+	if err != nil {
+		return err
+	}
+	for _, proxy := range proxies {
+		name := proxy.GetMetadata().Name
+		if err := b.dumpServedXds(name); err != nil {
+			b.recordError("xds", eris.Wrapf(err, "proxy %s", name))
+		}
+	}
+	return nil
+}
+
+func (b *bundler) dumpServedXds(proxyName string) error {
+	dump, err := b.xdsDump(b.ctx, proxyName, b.namespace)
+	if err != nil {
+		return err
+	}
+	served := map[string][]interface{}{}
+	add := func(key string, resource proto.Message) error {
+		jsn, err := (&jsonpb.Marshaler{AnyResolver: anyResolver{}}).MarshalToString(resource)
+		if err != nil {
+			return err
+		}
+		var obj interface{}
+		if err := json.Unmarshal([]byte(jsn), &obj); err != nil {
+			return err
+		}
+		served[key] = append(served[key], redact(obj))
+		return nil
+	}
+	for i := range dump.Clusters {
+		if err := add("clusters", &dump.Clusters[i]); err != nil {
+			return err
+		}
+	}
+	for i := range dump.Endpoints {
+		if err := add("endpoints", &dump.Endpoints[i]); err != nil {
+			return err
+		}
+	}
+	for i := range dump.Listeners {
+		if err := add("listeners", &dump.Listeners[i]); err != nil {
+			return err
+		}
+	}
+	for i := range dump.Routes {
+		if err := add("routes", &dump.Routes[i]); err != nil {
+			return err
+		}
+	}
+	out, err := yaml.Marshal(map[string]interface{}{"role": dump.Role, "resources": served})
+	if err != nil {
+		return err
+	}
+	return b.writeFile(path.Join("xds", proxyName+".yaml"), fmt.Sprintf("xDS config served by gloo for proxy %s", proxyName), out)
+}
+
+// collectEnvoys dumps the config and stats of each envoy.
+func (b *bundler) collectEnvoys() error {
+	pods, err := b.kube.CoreV1().Pods(b.namespace).List(metav1.ListOptions{LabelSelector: envoyPodSelector})
+	if err != nil {
+		return err
+	}
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != corev1.PodRunning {
+			continue
+		}
+		if err := b.dumpEnvoy(pod.Name); err != nil {
+			b.recordError("envoy", eris.Wrapf(err, "pod %s", pod.Name))
+		}
+	}
+	return nil
+}
+
+func (b *bundler) dumpEnvoy(podName string) error {
+	configDump, err := b.portForwardGet(b.ctx, b.namespace, "pod/"+podName, defaults.EnvoyAdminPort, "/config_dump")
+	if err != nil {
+		return err
+	}
+	redacted, err := redactJson([]byte(configDump))
+	if err != nil {
+		return err
+	}
+	if err := b.writeFile(path.Join("envoy", podName, "config_dump.json"), fmt.Sprintf("config dump of envoy %s", podName), redacted); err != nil {
+		return err
+	}
+	stats, err := b.portForwardGet(b.ctx, b.namespace, "pod/"+podName, defaults.EnvoyAdminPort, "/stats")
+	if err != nil {
+		return err
+	}
+	return b.writeFile(path.Join("envoy", podName, "stats.txt"), fmt.Sprintf("stats of envoy %s", podName), []byte(stats))
+}
+
+// collectMetrics dumps the prometheus metrics of the gloo control plane.
+func (b *bundler) collectMetrics() error {
+	metrics, err := b.portForwardGet(b.ctx, b.namespace, "deploy/gloo", defaults.GlooAdminPort, "/metrics")
+	if err != nil {
+		return err
+	}
+	return b.writeFile("metrics/gloo.txt", "prometheus metrics of gloo", []byte(metrics))
+}
+
+func (b *bundler) collectLogs() error {
+	logs, err := b.logs()
+	if err != nil {
+		return err
+	}
+	var names []string
+	for name := range logs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := b.writeFile(path.Join("logs", name+".log"), fmt.Sprintf("logs of %s", name), []byte(logs[name])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func collectLogs(opts *options.Options) (map[string]string, error) {
+	responses, err := setup(opts)
+	if err != nil {
+		return nil, err
+	}
+	logs := map[string]string{}
+	for _, response := range responses {
+		filtered := utils.FilterLogLevel(response.Response, utils.LogLevelAll)
+		response.Response.Close()
+		logs[response.ResourceId()] = filtered.String()
+	}
+	return logs, nil
+}
+
+// anyResolver resolves the types packed in the served resources. envoy's types are registered with
+// golang/protobuf, while gloo's envoy extensions, e.g. the aws lambda protocol options, are registered with gogo.
+type anyResolver struct{}
+
+func (anyResolver) Resolve(typeUrl string) (proto.Message, error) {
+	name := typeUrl[strings.LastIndex(typeUrl, "/")+1:]
+	typ := proto.MessageType(name)
+	if typ == nil {
+		typ = gogoproto.MessageType(name)
+	}
+	if typ == nil {
+		return nil, eris.Errorf("unknown message type %q", name)
+	}
+	return reflect.New(typ.Elem()).Interface().(proto.Message), nil
+}
+
+func portForwardGet(ctx context.Context, namespace, resource string, port uint32, path string) (string, error) {
+	localPort, err := cliutil.GetFreePort()
+	if err != nil {
+		return "", err
+	}
+	out, portFwd, err := cliutil.PortForwardGet(ctx, namespace, resource, strconv.Itoa(localPort), strconv.Itoa(int(port)), false, path)
+	if err != nil {
+		return "", err
+	}
+	if portFwd.Process != nil {
+		portFwd.Process.Kill()
+		portFwd.Process.Release()
+	}
+	return out, nil
+}
+
+func redactYaml(in []byte) ([]byte, error) {
+	var obj interface{}
+	if err := yaml.Unmarshal(in, &obj); err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(redact(obj))
+}
+
+func redactJson(in []byte) ([]byte, error) {
+	var obj interface{}
+	if err := json.Unmarshal(in, &obj); err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(redact(obj), "", "  ")
+}
+
+// redact replaces the values of kubernetes secrets, of container environment variables and of sensitive fields
+// in the given json object.
+func redact(obj interface{}) interface{} {
+	switch typed := obj.(type) {
+	case map[string]interface{}:
+		if typed["kind"] == "Secret" {
+			for _, field := range []string{"data", "stringData"} {
+				if data, ok := typed[field].(map[string]interface{}); ok {
+					for key := range data {
+						data[key] = redactedValue
+					}
+				}
+			}
+		}
+		// contains the object as it was applied, including the values redacted below
+		if metadata, ok := typed["metadata"].(map[string]interface{}); ok {
+			if annotations, ok := metadata["annotations"].(map[string]interface{}); ok {
+				delete(annotations, corev1.LastAppliedConfigAnnotation)
+			}
+		}
+		for key, value := range typed {
+			switch {
+			case sensitiveFields[key]:
+				typed[key] = redactedValue
+			case key == "env":
+				typed[key] = redactEnv(value)
+			default:
+				typed[key] = redact(value)
+			}
+		}
+	case []interface{}:
+		for i, value := range typed {
+			typed[i] = redact(value)
+		}
+	}
+	return obj
+}
+
+// redactEnv replaces the values of the environment variables of a container, keeping the references to
+// secrets and config maps.
+func redactEnv(env interface{}) interface{} {
+	vars, ok := env.([]interface{})
+	if !ok {
+		return redact(env)
+	}
+	for _, envVar := range vars {
+		if typed, ok := envVar.(map[string]interface{}); ok {
+			if _, ok := typed["value"]; ok {
+				typed["value"] = redactedValue
+			}
+		}
+	}
+	return vars
+}
@@ -0,0 +1,316 @@
+package debug
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"time"
+
+	v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/cliutil/install"
+	installcmd "github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/install"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/xdsinspection"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	awsapi "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/aws"
+	"github.com/solo-io/go-utils/tarutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+var _ = Describe("Bundle", func() {
+
+	const (
+		namespace = "gloo-system"
+		emptyList = "apiVersion: v1\nkind: List\nitems: []\n"
+		settings  = `apiVersion: v1
+kind: List
+items:
+- apiVersion: gloo.solo.io/v1
+  kind: Settings
+  metadata:
+    name: default
+    namespace: gloo-system
+  spec:
+    vaultSecretSource:
+      address: http://vault:8200
+      token: root
+`
+		secrets = `apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: Secret
+  metadata:
+    name: my-tls
+    namespace: gloo-system
+    annotations:
+      kubectl.kubernetes.io/last-applied-configuration: '{"data":{"tls.key":"a2V5"}}'
+  data:
+    tls.crt: Y2VydA==
+    tls.key: a2V5
+  type: kubernetes.io/tls
+`
+		pods = `apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: Pod
+  metadata:
+    name: gloo-1
+    namespace: gloo-system
+  spec:
+    containers:
+    - name: gloo
+      env:
+      - name: AWS_SECRET_ACCESS_KEY
+        value: hunter2
+      - name: POD_NAMESPACE
+        valueFrom:
+          fieldRef:
+            fieldPath: metadata.namespace
+`
+		deployments = `apiVersion: v1
+kind: List
+items:
+- apiVersion: apps/v1
+  kind: Deployment
+  metadata:
+    name: gloo
+    namespace: gloo-system
+    annotations:
+      kubectl.kubernetes.io/last-applied-configuration: '{"spec":{"template":{"spec":{"containers":[{"env":[{"value":"hunter2"}]}]}}}}'
+  spec:
+    template:
+      spec:
+        initContainers:
+        - name: init
+          env:
+          - name: TOKEN
+            value: hunter2
+`
+		configDump = `{"configs": [{"dynamic_listeners": [{"name": "listener", "tls_certificates": [{"certificate_chain": {"inline_string": "cert"}, "private_key": {"inline_string": "key"}}]}]}]}`
+	)
+
+	var (
+		fs  = afero.NewOsFs()
+		dir string
+		now = time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+		b   *bundler
+	)
+
+	BeforeEach(func() {
+		helpers.UseMemoryClients()
+		var err error
+		dir, err = afero.TempDir(fs, "", "bundle-test")
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = helpers.MustNamespacedProxyClient(namespace).Write(&v1.Proxy{
+			Metadata: core.Metadata{Name: "gateway-proxy", Namespace: namespace},
+		}, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+
+		kube := helpers.MustKubeClient()
+		_, err = kube.CoreV1().Pods(namespace).Create(&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "gateway-proxy-1", Namespace: namespace, Labels: map[string]string{"gloo": "gateway-proxy"}},
+			Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		for name, age := range map[string]time.Duration{"old": 2 * time.Hour, "recent": time.Minute} {
+			_, err = kube.CoreV1().Events(namespace).Create(&corev1.Event{
+				ObjectMeta:    metav1.ObjectMeta{Name: name, Namespace: namespace},
+				Message:       name,
+				LastTimestamp: metav1.NewTime(now.Add(-age)),
+			})
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		var (
+			cmds    []string
+			outputs []string
+		)
+		for _, crd := range installcmd.GlooCrdNames {
+			cmds = append(cmds, "get "+crd+" -oyaml --all-namespaces")
+			if crd == "settings.gloo.solo.io" {
+				outputs = append(outputs, settings)
+			} else {
+				outputs = append(outputs, emptyList)
+			}
+		}
+		for _, kind := range bundleKubeKinds {
+			cmds = append(cmds, "get "+kind+" -oyaml -n "+namespace)
+			switch kind {
+			case "Secret":
+				outputs = append(outputs, secrets)
+			case "Pod":
+				outputs = append(outputs, pods)
+			case "Deployment":
+				outputs = append(outputs, deployments)
+			default:
+				outputs = append(outputs, emptyList)
+			}
+		}
+
+		b = &bundler{
+			ctx:         context.Background(),
+			namespace:   namespace,
+			eventsSince: time.Hour,
+			now:         func() time.Time { return now },
+			kubeCli:     install.NewMockKubectl(cmds, outputs),
+			kube:        kube,
+			portForwardGet: func(_ context.Context, _, resource string, _ uint32, path string) (string, error) {
+				switch resource + path {
+				case "pod/gateway-proxy-1/config_dump":
+					return configDump, nil
+				case "pod/gateway-proxy-1/stats":
+					return "cluster.cluster.upstream_cx_total: 1\n", nil
+				}
+				return "", eris.Errorf("could not reach %s", resource)
+			},
+			xdsDump: func(_ context.Context, proxyName, _ string) (*xdsinspection.XdsDump, error) {
+				return &xdsinspection.XdsDump{
+					Role:     "gloo-system~" + proxyName,
+					Clusters: []v2.Cluster{{Name: "cluster"}},
+				}, nil
+			},
+			logs: func() (map[string]string, error) {
+				return map[string]string{"gloo-1": "started gloo\n"}, nil
+			},
+			fs: fs,
+		}
+	})
+
+	AfterEach(func() {
+		helpers.UseDefaultClients()
+		fs.RemoveAll(dir)
+	})
+
+	writeAndExtractBundle := func() {
+		bundle := filepath.Join(dir, "bundle.tgz")
+		err := b.writeBundle(bundle)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tarutils.Untar(dir, bundle, fs)).To(Succeed())
+	}
+
+	readBundleFile := func(name string) string {
+		content, err := afero.ReadFile(fs, filepath.Join(dir, name))
+		Expect(err).NotTo(HaveOccurred())
+		return string(content)
+	}
+
+	It("collects everything into a tarball with an index", func() {
+		writeAndExtractBundle()
+
+		var index BundleIndex
+		Expect(yaml.Unmarshal([]byte(readBundleFile(BundleIndexFile)), &index)).To(Succeed())
+		Expect(index.CreatedAt).To(Equal(now))
+		Expect(index.Namespace).To(Equal(namespace))
+		Expect(index.Errors).To(Equal([]BundleError{{Collector: "metrics", Error: "could not reach deploy/gloo"}}))
+
+		var paths []string
+		for _, file := range index.Files {
+			paths = append(paths, file.Path)
+			readBundleFile(file.Path)
+		}
+		Expect(paths).To(ContainElements(
+			"resources/proxies.gloo.solo.io.yaml",
+			"resources/settings.gloo.solo.io.yaml",
+			"kube/deployment.yaml",
+			"kube/secret.yaml",
+			"events.yaml",
+			"xds/gateway-proxy.yaml",
+			"envoy/gateway-proxy-1/config_dump.json",
+			"envoy/gateway-proxy-1/stats.txt",
+			"logs/gloo-1.log",
+		))
+		Expect(paths).NotTo(ContainElement("metrics/gloo.txt"))
+
+		Expect(readBundleFile("events.yaml")).To(ContainSubstring("message: recent"))
+		Expect(readBundleFile("events.yaml")).NotTo(ContainSubstring("message: old"))
+		Expect(readBundleFile("xds/gateway-proxy.yaml")).To(ContainSubstring("name: cluster"))
+		Expect(readBundleFile("envoy/gateway-proxy-1/stats.txt")).To(Equal("cluster.cluster.upstream_cx_total: 1\n"))
+		Expect(readBundleFile("logs/gloo-1.log")).To(Equal("started gloo\n"))
+	})
+
+	It("redacts secrets", func() {
+		writeAndExtractBundle()
+
+		secretYaml := readBundleFile("kube/secret.yaml")
+		Expect(secretYaml).To(ContainSubstring("tls.crt: <redacted>"))
+		Expect(secretYaml).To(ContainSubstring("tls.key: <redacted>"))
+		Expect(secretYaml).NotTo(ContainSubstring("a2V5"))
+		Expect(secretYaml).NotTo(ContainSubstring("last-applied-configuration"))
+
+		for _, workload := range []string{"kube/pod.yaml", "kube/deployment.yaml"} {
+			workloadYaml := readBundleFile(workload)
+			Expect(workloadYaml).To(ContainSubstring("value: <redacted>"))
+			Expect(workloadYaml).NotTo(ContainSubstring("hunter2"))
+		}
+		Expect(readBundleFile("kube/pod.yaml")).To(ContainSubstring("fieldPath: metadata.namespace"))
+
+		settingsYaml := readBundleFile("resources/settings.gloo.solo.io.yaml")
+		Expect(settingsYaml).To(ContainSubstring("token: <redacted>"))
+		Expect(settingsYaml).To(ContainSubstring("address: http://vault:8200"))
+
+		var dump map[string]interface{}
+		Expect(json.Unmarshal([]byte(readBundleFile("envoy/gateway-proxy-1/config_dump.json")), &dump)).To(Succeed())
+		tlsCertificate := dump["configs"].([]interface{})[0].(map[string]interface{})["dynamic_listeners"].([]interface{})[0].(map[string]interface{})["tls_certificates"].([]interface{})[0]
+		Expect(tlsCertificate).To(Equal(map[string]interface{}{
+			"certificate_chain": map[string]interface{}{"inline_string": "cert"},
+			"private_key":       "<redacted>",
+		}))
+	})
+
+	It("redacts the credentials of aws upstreams", func() {
+		awsPlugin := aws.NewPlugin(new(bool))
+		Expect(awsPlugin.Init(plugins.InitParams{})).To(Succeed())
+		cluster := v2.Cluster{Name: "lambda"}
+		err := awsPlugin.(plugins.UpstreamPlugin).ProcessUpstream(plugins.Params{
+			Snapshot: &v1.ApiSnapshot{Secrets: v1.SecretList{{
+				Metadata: core.Metadata{Name: "aws-creds", Namespace: namespace},
+				Kind: &v1.Secret_Aws{Aws: &v1.AwsSecret{
+					AccessKey:    "AKIAEXAMPLE",
+					SecretKey:    "wJalrXUtnFEMI",
+					SessionToken: "FwoGZXIvYXdzEJr",
+				}},
+			}}},
+		}, &v1.Upstream{
+			Metadata: core.Metadata{Name: "lambda", Namespace: namespace},
+			UpstreamType: &v1.Upstream_Aws{Aws: &awsapi.UpstreamSpec{
+				Region:    "us-east-1",
+				SecretRef: &core.ResourceRef{Name: "aws-creds", Namespace: namespace},
+			}},
+		}, &cluster)
+		Expect(err).NotTo(HaveOccurred())
+
+		b.xdsDump = func(_ context.Context, proxyName, _ string) (*xdsinspection.XdsDump, error) {
+			return &xdsinspection.XdsDump{Role: "gloo-system~" + proxyName, Clusters: []v2.Cluster{cluster}}, nil
+		}
+		b.portForwardGet = func(_ context.Context, _, resource string, _ uint32, path string) (string, error) {
+			if resource+path == "pod/gateway-proxy-1/config_dump" {
+				// envoy dumps the protocol options of the cluster with the original field names
+				return `{"configs": [{"dynamic_active_clusters": [{"cluster": {"name": "lambda", "typed_extension_protocol_options": {"io.solo.aws_lambda": ` +
+					`{"host": "lambda.us-east-1.amazonaws.com", "access_key": "AKIAEXAMPLE", "secret_key": "wJalrXUtnFEMI", "session_token": "FwoGZXIvYXdzEJr"}}}}]}]}`, nil
+			}
+			return "", eris.Errorf("could not reach %s", resource)
+		}
+		writeAndExtractBundle()
+
+		for _, file := range []string{"xds/gateway-proxy.yaml", "envoy/gateway-proxy-1/config_dump.json"} {
+			dump := readBundleFile(file)
+			Expect(dump).To(ContainSubstring("lambda.us-east-1.amazonaws.com"))
+			Expect(dump).NotTo(ContainSubstring("AKIAEXAMPLE"))
+			Expect(dump).NotTo(ContainSubstring("wJalrXUtnFEMI"))
+			Expect(dump).NotTo(ContainSubstring("FwoGZXIvYXdzEJr"))
+		}
+	})
+})
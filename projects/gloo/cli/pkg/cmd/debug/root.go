@@ -21,6 +21,7 @@ func RootCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.
 
 	cmd.AddCommand(DebugLogCmd(opts))
 	cmd.AddCommand(DebugYamlCmd(opts))
+	cmd.AddCommand(DebugBundleCmd(opts))
 	cliutils.ApplyOptions(cmd, optionsFunc)
 	return cmd
 }
@@ -59,3 +60,25 @@ func DebugYamlCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *c
 
 	return cmd
 }
+
+func DebugBundleCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   constants.DEBUG_BUNDLE_COMMAND.Use,
+		Short: constants.DEBUG_BUNDLE_COMMAND.Short,
+		Long: "Collects the Gloo resources of all namespaces, the kubernetes resources and recent events of the Gloo namespace, " +
+			"the xDS config served by Gloo, the config dump and stats of each Envoy, the metrics of Gloo and the logs of the Gloo pods " +
+			"into a single tarball, with the values of secrets and environment variables redacted. index.yaml at the root of the tarball lists its content " +
+			"and the parts that could not be collected.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return DebugBundle(opts, os.Stdout)
+		},
+	}
+
+	pflags := cmd.PersistentFlags()
+	flagutils.AddFileFlag(pflags, &opts.Top.File)
+	flagutils.AddNamespaceFlag(pflags, &opts.Metadata.Namespace)
+	flagutils.AddDebugBundleFlags(pflags, &opts.Debug)
+	cliutils.ApplyOptions(cmd, optionsFunc)
+
+	return cmd
+}
@@ -35,6 +35,8 @@ func RootCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.
 	cmd.AddCommand(Upstream(opts))
 	cmd.AddCommand(UpstreamGroup(opts))
 	cmd.AddCommand(VirtualService(opts))
+	cmd.AddCommand(RouteTable(opts))
+	cmd.AddCommand(Gateway(opts))
 	cmd.AddCommand(Proxy(opts))
 	cliutils.ApplyOptions(cmd, optionsFunc)
 	return cmd
@@ -0,0 +1,202 @@
+package gateway
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/solo-io/gloo/pkg/cliutil"
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/edit/options"
+	cliopts "github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/cliutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	bindAddressFlag                   = "bind-address"
+	bindPortFlag                      = "bind-port"
+	sslFlag                           = "ssl"
+	useProxyProtoFlag                 = "use-proxy-proto"
+	proxyNamesFlag                    = "proxy-names"
+	perConnectionBufferLimitBytesFlag = "per-connection-buffer-limit-bytes"
+	vsSelectorFlag                    = "vs-selector"
+	vsNamespacesFlag                  = "vs-namespaces"
+)
+
+type EditGateway struct {
+	BindAddress                   string
+	BindPort                      uint32
+	Ssl                           bool
+	UseProxyProto                 bool
+	ProxyNames                    []string
+	PerConnectionBufferLimitBytes uint32
+	VirtualServiceSelector        cliopts.InputMapStringString
+	VirtualServiceNamespaces      []string
+}
+
+func RootCmd(opts *options.EditOptions, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	optsExt := &EditGateway{}
+
+	cmd := &cobra.Command{
+		Use:     constants.GATEWAY_COMMAND.Use,
+		Aliases: constants.GATEWAY_COMMAND.Aliases,
+		Short:   "edit a gateway in a namespace",
+		Long: "usage: glooctl edit gateway [NAME] [--namespace=namespace]\n\n" +
+			"Only the fields of the gateway for which a flag is provided are changed.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return editGateway(opts, optsExt, cmd.Flags())
+		},
+	}
+
+	addEditGatewayOptions(cmd.Flags(), optsExt)
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
+
+func addEditGatewayOptions(set *pflag.FlagSet, edit *EditGateway) {
+	set.StringVar(&edit.BindAddress, bindAddressFlag, "", "the bind address the gateway should serve traffic on")
+	set.Uint32Var(&edit.BindPort, bindPortFlag, 0, "the port the gateway should serve traffic on")
+	set.BoolVar(&edit.Ssl, sslFlag, false, "if set, only serve virtual services with ssl configured. otherwise, only serve virtual services without ssl configured")
+	set.BoolVar(&edit.UseProxyProto, useProxyProtoFlag, false, "enable the proxy protocol on the gateway's listener")
+	set.StringSliceVar(&edit.ProxyNames, proxyNamesFlag, nil, "names of the proxies to apply the gateway to")
+	set.Uint32Var(&edit.PerConnectionBufferLimitBytes, perConnectionBufferLimitBytesFlag, 0,
+		"soft limit on the size of the listener's new connection read and write buffers. if 0, the envoy default is used")
+	set.StringSliceVar(&edit.VirtualServiceSelector.Entries, vsSelectorFlag, nil,
+		"labels of the virtual services to serve on this http gateway. format: 'key=value'. if empty, all virtual services are served")
+	set.StringSliceVar(&edit.VirtualServiceNamespaces, vsNamespacesFlag, nil,
+		"restrict the virtual services served on this http gateway to these namespaces. if empty or '*', all namespaces are searched")
+}
+
+// prompts for every editable field, using the gateway's current configuration as the defaults
+func addEditGatewayInteractiveFlags(gw *gatewayv1.Gateway, opts *EditGateway) error {
+	if err := cliutil.GetStringInputDefault("the bind address the gateway should serve traffic on: ", &opts.BindAddress, gw.GetBindAddress()); err != nil {
+		return err
+	}
+	if err := cliutil.GetUint32InputDefault("the port the gateway should serve traffic on: ", &opts.BindPort, gw.GetBindPort()); err != nil {
+		return err
+	}
+	if err := cliutil.GetBoolInputDefault("only serve virtual services with ssl configured?", &opts.Ssl, gw.GetSsl()); err != nil {
+		return err
+	}
+	if err := cliutil.GetBoolInputDefault("enable the proxy protocol on the gateway's listener?", &opts.UseProxyProto, gw.GetUseProxyProto().GetValue()); err != nil {
+		return err
+	}
+	var proxyNames string
+	if err := cliutil.GetStringInputDefault("comma-separated names of the proxies to apply the gateway to: ", &proxyNames,
+		strings.Join(gw.GetProxyNames(), ",")); err != nil {
+		return err
+	}
+	opts.ProxyNames = splitCsv(proxyNames)
+	if err := cliutil.GetUint32InputDefault("per connection buffer limit in bytes (0 for the envoy default): ", &opts.PerConnectionBufferLimitBytes,
+		gw.GetOptions().GetPerConnectionBufferLimitBytes().GetValue()); err != nil {
+		return err
+	}
+
+	if gw.GetHttpGateway() == nil {
+		return nil
+	}
+	var labels []string
+	for k, v := range gw.GetHttpGateway().GetVirtualServiceSelector() {
+		labels = append(labels, k+"="+v)
+	}
+	sort.Strings(labels)
+	var selector string
+	if err := cliutil.GetStringInputDefault("comma-separated labels (key=value) of the virtual services to serve (empty selects all): ", &selector,
+		strings.Join(labels, ",")); err != nil {
+		return err
+	}
+	opts.VirtualServiceSelector.Entries = splitCsv(selector)
+	var namespaces string
+	if err := cliutil.GetStringInputDefault("comma-separated namespaces to search for virtual services (empty searches all): ", &namespaces,
+		strings.Join(gw.GetHttpGateway().GetVirtualServiceNamespaces(), ",")); err != nil {
+		return err
+	}
+	opts.VirtualServiceNamespaces = splitCsv(namespaces)
+
+	return nil
+}
+
+func splitCsv(csv string) []string {
+	var values []string
+	for _, value := range strings.Split(csv, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+func editGateway(opts *options.EditOptions, optsExt *EditGateway, flags *pflag.FlagSet) error {
+	gwClient := helpers.MustNamespacedGatewayClient(opts.Metadata.GetNamespace())
+	gw, err := gwClient.Read(opts.Metadata.Namespace, opts.Metadata.Name, clients.ReadOpts{})
+	if err != nil {
+		return errors.Wrapf(err, "Error reading gateway")
+	}
+
+	if opts.ResourceVersion != "" {
+		if gw.Metadata.ResourceVersion != opts.ResourceVersion {
+			return fmt.Errorf("conflict - resource version does not match")
+		}
+	}
+
+	changed := flags.Changed
+	if opts.Top.Interactive {
+		if err := addEditGatewayInteractiveFlags(gw, optsExt); err != nil {
+			return err
+		}
+		changed = func(string) bool { return true }
+	}
+
+	if changed(bindAddressFlag) {
+		gw.BindAddress = optsExt.BindAddress
+	}
+	if changed(bindPortFlag) {
+		gw.BindPort = optsExt.BindPort
+	}
+	if changed(sslFlag) {
+		gw.Ssl = optsExt.Ssl
+	}
+	if changed(useProxyProtoFlag) {
+		gw.UseProxyProto = &types.BoolValue{Value: optsExt.UseProxyProto}
+	}
+	if changed(proxyNamesFlag) {
+		gw.ProxyNames = optsExt.ProxyNames
+	}
+	if changed(perConnectionBufferLimitBytesFlag) {
+		if optsExt.PerConnectionBufferLimitBytes == 0 {
+			if gw.Options != nil {
+				gw.Options.PerConnectionBufferLimitBytes = nil
+			}
+		} else {
+			if gw.Options == nil {
+				gw.Options = &gloov1.ListenerOptions{}
+			}
+			gw.Options.PerConnectionBufferLimitBytes = &types.UInt32Value{Value: optsExt.PerConnectionBufferLimitBytes}
+		}
+	}
+
+	if httpGateway := gw.GetHttpGateway(); httpGateway != nil {
+		if changed(vsSelectorFlag) {
+			httpGateway.VirtualServiceSelector = nil
+			if len(optsExt.VirtualServiceSelector.Entries) > 0 {
+				httpGateway.VirtualServiceSelector = optsExt.VirtualServiceSelector.MustMap()
+			}
+		}
+		if changed(vsNamespacesFlag) {
+			httpGateway.VirtualServiceNamespaces = optsExt.VirtualServiceNamespaces
+		}
+	} else if !opts.Top.Interactive && (changed(vsSelectorFlag) || changed(vsNamespacesFlag)) {
+		return fmt.Errorf("virtual services can only be selected for http gateways")
+	}
+
+	_, err = gwClient.Write(gw, clients.WriteOpts{OverwriteExisting: true})
+	return err
+}
@@ -0,0 +1,116 @@
+package gateway_test
+
+import (
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/testutils"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Root", func() {
+	var (
+		gateway  *gatewayv1.Gateway
+		gwClient gatewayv1.GatewayClient
+	)
+	BeforeEach(func() {
+		helpers.UseMemoryClients()
+		gwClient = helpers.MustGatewayClient()
+		gateway = &gatewayv1.Gateway{
+			Metadata: core.Metadata{
+				Name:      "gw",
+				Namespace: "gloo-system",
+			},
+			BindAddress: "::",
+			BindPort:    8080,
+			ProxyNames:  []string{"gateway-proxy"},
+			GatewayType: &gatewayv1.Gateway_HttpGateway{
+				HttpGateway: &gatewayv1.HttpGateway{
+					VirtualServiceSelector: map[string]string{"team": "a"},
+				},
+			},
+		}
+	})
+
+	RefreshGateway := func() {
+		var err error
+		gateway, err = gwClient.Write(gateway, clients.WriteOpts{OverwriteExisting: true})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	JustBeforeEach(func() {
+		RefreshGateway()
+	})
+
+	Glooctl := func(cmd string) {
+		err := testutils.Glooctl(cmd)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		gateway, err = gwClient.Read(gateway.Metadata.Namespace, gateway.Metadata.Name, clients.ReadOpts{})
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	}
+
+	It("should only update the provided fields", func() {
+		Glooctl("edit gateway --name gw --namespace gloo-system --bind-port 8443 --ssl")
+		Expect(gateway.BindPort).To(Equal(uint32(8443)))
+		Expect(gateway.Ssl).To(BeTrue())
+		Expect(gateway.BindAddress).To(Equal("::"))
+		Expect(gateway.ProxyNames).To(Equal([]string{"gateway-proxy"}))
+		Expect(gateway.GetHttpGateway().GetVirtualServiceSelector()).To(Equal(map[string]string{"team": "a"}))
+	})
+
+	It("should update listener options", func() {
+		Glooctl("edit gateway --name gw --namespace gloo-system --use-proxy-proto --per-connection-buffer-limit-bytes 1024")
+		Expect(gateway.UseProxyProto).To(Equal(&types.BoolValue{Value: true}))
+		Expect(gateway.Options).To(Equal(&gloov1.ListenerOptions{
+			PerConnectionBufferLimitBytes: &types.UInt32Value{Value: 1024},
+		}))
+
+		Glooctl("edit gateway --name gw --namespace gloo-system --per-connection-buffer-limit-bytes 0")
+		Expect(gateway.Options.GetPerConnectionBufferLimitBytes()).To(BeNil())
+	})
+
+	It("should update the virtual service selection", func() {
+		Glooctl("edit gateway --name gw --namespace gloo-system --vs-namespaces team-a --vs-selector team=b,env=prod")
+		Expect(gateway.GetHttpGateway()).To(Equal(&gatewayv1.HttpGateway{
+			VirtualServiceSelector:   map[string]string{"team": "b", "env": "prod"},
+			VirtualServiceNamespaces: []string{"team-a"},
+		}))
+
+		Glooctl("edit gateway --name gw --namespace gloo-system --vs-selector=")
+		Expect(gateway.GetHttpGateway().GetVirtualServiceSelector()).To(BeEmpty())
+	})
+
+	Context("Errors", func() {
+
+		It("should not update with out of date resource version", func() {
+			oldResourceVersion := gateway.Metadata.ResourceVersion
+			// mutate the gateway
+			gateway.Metadata.Annotations = map[string]string{"test": "test"}
+			RefreshGateway()
+
+			err := testutils.Glooctl("edit gateway --resource-version " + oldResourceVersion + " --name gw --namespace gloo-system --bind-port 8443")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal("conflict - resource version does not match"))
+		})
+
+		Context("tcp gateway", func() {
+
+			BeforeEach(func() {
+				gateway.GatewayType = &gatewayv1.Gateway_TcpGateway{
+					TcpGateway: &gatewayv1.TcpGateway{},
+				}
+			})
+
+			It("should not select virtual services", func() {
+				err := testutils.Glooctl("edit gateway --name gw --namespace gloo-system --vs-selector team=a")
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("virtual services can only be selected for http gateways"))
+			})
+		})
+	})
+})
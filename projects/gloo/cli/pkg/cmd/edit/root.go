@@ -1,7 +1,9 @@
 package edit
 
 import (
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/edit/gateway"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/edit/route"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/edit/routetable"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/edit/settings"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/prerun"
@@ -54,6 +56,8 @@ func RootCmdWithEditOpts(opts *options.Options, editOpts *editOptions.EditOption
 	cmd.AddCommand(route.RootCmd(editOpts, optionsFunc...))
 	cmd.AddCommand(virtualservice.RootCmd(editOpts, optionsFunc...))
 	cmd.AddCommand(upstream.RootCmd(editOpts, optionsFunc...))
+	cmd.AddCommand(routetable.RootCmd(editOpts, optionsFunc...))
+	cmd.AddCommand(gateway.RootCmd(editOpts, optionsFunc...))
 	return cmd
 }
 
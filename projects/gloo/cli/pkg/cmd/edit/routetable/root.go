@@ -0,0 +1,95 @@
+package routetable
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/solo-io/gloo/pkg/cliutil"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/edit/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/solo-io/go-utils/cliutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+type EditRouteTable struct {
+	Weight       int32
+	RemoveWeight bool
+}
+
+func RootCmd(opts *options.EditOptions, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	optsExt := &EditRouteTable{}
+
+	cmd := &cobra.Command{
+		Use:     constants.ROUTE_TABLE_COMMAND.Use,
+		Aliases: constants.ROUTE_TABLE_COMMAND.Aliases,
+		Short:   "edit a route table in a namespace",
+		Long: "usage: glooctl edit routetable [NAME] [--namespace=namespace]\n\n" +
+			"Use `glooctl add route --to-route-table` and `glooctl remove route --from-route-table` to edit the routes of a route table.",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Top.Interactive {
+				if err := addEditRouteTableInteractiveFlags(optsExt); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return editRouteTable(opts, optsExt, cmd.Flags())
+		},
+	}
+
+	addEditRouteTableOptions(cmd.Flags(), optsExt)
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
+
+func addEditRouteTableOptions(set *pflag.FlagSet, edit *EditRouteTable) {
+	set.Int32Var(&edit.Weight, "weight", 0, "the weight of the route table, used to order route tables selected "+
+		"by the same delegate action. route tables with lower weights are processed first")
+	set.BoolVar(&edit.RemoveWeight, "remove-weight", false, "Remove the weight from this route table")
+}
+
+func addEditRouteTableInteractiveFlags(opts *EditRouteTable) error {
+	var weight string
+	if err := cliutil.GetStringInput("the weight of the route table (empty to remove the weight): ", &weight); err != nil {
+		return err
+	}
+	if weight == "" {
+		opts.RemoveWeight = true
+		return nil
+	}
+	parsed, err := strconv.ParseInt(weight, 10, 32)
+	if err != nil {
+		return err
+	}
+	opts.Weight = int32(parsed)
+	return nil
+}
+
+func editRouteTable(opts *options.EditOptions, optsExt *EditRouteTable, flags *pflag.FlagSet) error {
+	rtClient := helpers.MustNamespacedRouteTableClient(opts.Metadata.GetNamespace())
+	rt, err := rtClient.Read(opts.Metadata.Namespace, opts.Metadata.Name, clients.ReadOpts{})
+	if err != nil {
+		return errors.Wrapf(err, "Error reading route table")
+	}
+
+	if opts.ResourceVersion != "" {
+		if rt.Metadata.ResourceVersion != opts.ResourceVersion {
+			return fmt.Errorf("conflict - resource version does not match")
+		}
+	}
+
+	if optsExt.RemoveWeight {
+		rt.Weight = nil
+	} else if opts.Top.Interactive || flags.Changed("weight") {
+		rt.Weight = &types.Int32Value{Value: optsExt.Weight}
+	}
+
+	_, err = rtClient.Write(rt, clients.WriteOpts{OverwriteExisting: true})
+	return err
+}
@@ -0,0 +1,89 @@
+package routetable_test
+
+import (
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/testutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Root", func() {
+	var (
+		routeTable *gatewayv1.RouteTable
+		rtClient   gatewayv1.RouteTableClient
+	)
+	BeforeEach(func() {
+		helpers.UseMemoryClients()
+		rtClient = helpers.MustRouteTableClient()
+		routeTable = &gatewayv1.RouteTable{
+			Metadata: core.Metadata{
+				Name:      "rt",
+				Namespace: "gloo-system",
+			},
+		}
+	})
+
+	RefreshRouteTable := func() {
+		var err error
+		routeTable, err = rtClient.Write(routeTable, clients.WriteOpts{OverwriteExisting: true})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	JustBeforeEach(func() {
+		RefreshRouteTable()
+	})
+
+	Glooctl := func(cmd string) {
+		err := testutils.Glooctl(cmd)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		routeTable, err = rtClient.Read(routeTable.Metadata.Namespace, routeTable.Metadata.Name, clients.ReadOpts{})
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	}
+
+	It("should update the weight", func() {
+		Glooctl("edit routetable --name rt --namespace gloo-system --weight 10")
+		Expect(routeTable.Weight).To(Equal(&types.Int32Value{Value: 10}))
+	})
+
+	Context("with existing weight", func() {
+
+		BeforeEach(func() {
+			routeTable.Weight = &types.Int32Value{Value: 5}
+		})
+
+		It("should not change the weight when no flag is provided", func() {
+			Glooctl("edit routetable --name rt --namespace gloo-system")
+			Expect(routeTable.Weight).To(Equal(&types.Int32Value{Value: 5}))
+		})
+
+		It("should remove the weight", func() {
+			Glooctl("edit routetable --name rt --namespace gloo-system --remove-weight")
+			Expect(routeTable.Weight).To(BeNil())
+		})
+	})
+
+	Context("Errors", func() {
+
+		It("should not update with out of date resource version", func() {
+			oldResourceVersion := routeTable.Metadata.ResourceVersion
+			// mutate the route table
+			routeTable.Metadata.Annotations = map[string]string{"test": "test"}
+			RefreshRouteTable()
+
+			err := testutils.Glooctl("edit routetable --resource-version " + oldResourceVersion + " --name rt --namespace gloo-system --weight 1")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal("conflict - resource version does not match"))
+		})
+
+		It("should fail on bad route table", func() {
+			err := testutils.Glooctl("edit routetable --name notrt --namespace gloo-system --weight 1")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal("Error reading route table: gloo-system.notrt does not exist"))
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package routetable_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestRouteTable(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "RouteTable Suite")
+}
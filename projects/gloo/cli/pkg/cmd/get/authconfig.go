@@ -4,7 +4,10 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/common"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/printers"
+	extauthv1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extauth/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
 	"github.com/spf13/cobra"
 )
 
@@ -15,6 +18,16 @@ func AuthConfig(opts *options.Options) *cobra.Command {
 		Short:   "read an authconfig or list authconfigs in a namespace",
 		Long:    "usage: glooctl get authconfig [NAME] [--namespace=namespace] [-o FORMAT]",
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Get.Watch {
+				client := helpers.MustNamespacedAuthConfigClient(opts.Metadata.GetNamespace())
+				return common.WatchResources(common.GetName(args, opts), opts, client.BaseClient(), func(list resources.ResourceList) error {
+					var authConfigs extauthv1.AuthConfigList
+					for _, res := range list {
+						authConfigs = append(authConfigs, res.(*extauthv1.AuthConfig))
+					}
+					return printers.PrintAuthConfigs(authConfigs, opts.Top.Output)
+				})
+			}
 			virtualServices, err := common.GetAuthConfigs(common.GetName(args, opts), opts)
 			if err != nil {
 				return err
@@ -0,0 +1,40 @@
+package get
+
+import (
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/common"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/printers"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/spf13/cobra"
+)
+
+func Gateway(opts *options.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     constants.GATEWAY_COMMAND.Use,
+		Aliases: constants.GATEWAY_COMMAND.Aliases,
+		Short:   "read a gateway or list gateways in a namespace",
+		Long:    "usage: glooctl get gateway [NAME] [--namespace=namespace] [-o FORMAT]",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Get.Watch {
+				client := helpers.MustNamespacedGatewayClient(opts.Metadata.GetNamespace())
+				return common.WatchResources(common.GetName(args, opts), opts, client.BaseClient(), func(list resources.ResourceList) error {
+					var gateways v1.GatewayList
+					for _, res := range list {
+						gateways = append(gateways, res.(*v1.Gateway))
+					}
+					return printers.PrintGateways(gateways, opts.Top.Output)
+				})
+			}
+			gateways, err := common.GetGateways(common.GetName(args, opts), opts)
+			if err != nil {
+				return err
+			}
+			_ = printers.PrintGateways(gateways, opts.Top.Output)
+			return nil
+		},
+	}
+	return cmd
+}
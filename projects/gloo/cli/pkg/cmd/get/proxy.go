@@ -4,7 +4,10 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/common"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/printers"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
 	"github.com/spf13/cobra"
 )
 
@@ -15,6 +18,16 @@ func Proxy(opts *options.Options) *cobra.Command {
 		Short:   "read a proxy or list proxies in a namespace",
 		Long:    "usage: glooctl get proxy",
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Get.Watch {
+				client := helpers.MustNamespacedProxyClient(opts.Metadata.GetNamespace())
+				return common.WatchResources(common.GetName(args, opts), opts, client.BaseClient(), func(list resources.ResourceList) error {
+					var proxies v1.ProxyList
+					for _, res := range list {
+						proxies = append(proxies, res.(*v1.Proxy))
+					}
+					return printers.PrintProxies(proxies, opts.Top.Output)
+				})
+			}
 			proxyList, err := common.GetProxies(common.GetName(args, opts), opts)
 			if err != nil {
 				return err
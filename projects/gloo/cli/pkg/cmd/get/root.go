@@ -52,9 +52,11 @@ func RootCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.
 	pflags := cmd.PersistentFlags()
 	flagutils.AddMetadataFlags(pflags, &opts.Metadata)
 	flagutils.AddOutputFlag(pflags, &opts.Top.Output)
+	flagutils.AddWatchFlag(pflags, &opts.Get.Watch)
 
 	cmd.AddCommand(VirtualService(opts))
 	cmd.AddCommand(RouteTable(opts))
+	cmd.AddCommand(Gateway(opts))
 	cmd.AddCommand(Proxy(opts))
 	cmd.AddCommand(Upstream(opts))
 	cmd.AddCommand(UpstreamGroup(opts))
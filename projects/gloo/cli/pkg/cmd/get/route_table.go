@@ -1,10 +1,13 @@
 package get
 
 import (
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/common"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/printers"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
 	"github.com/spf13/cobra"
 )
 
@@ -15,6 +18,16 @@ func RouteTable(opts *options.Options) *cobra.Command {
 		Short:   "read a route table or list route tables in a namespace",
 		Long:    "usage: glooctl get routetable [NAME] [--namespace=namespace] [-o FORMAT]",
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Get.Watch {
+				client := helpers.MustNamespacedRouteTableClient(opts.Metadata.GetNamespace())
+				return common.WatchResources(common.GetName(args, opts), opts, client.BaseClient(), func(list resources.ResourceList) error {
+					var routeTables v1.RouteTableList
+					for _, res := range list {
+						routeTables = append(routeTables, res.(*v1.RouteTable))
+					}
+					return printers.PrintRouteTables(routeTables, opts.Top.Output)
+				})
+			}
 			routeTables, err := common.GetRouteTables(common.GetName(args, opts), opts)
 			if err != nil {
 				return err
@@ -4,8 +4,11 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/common"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/printers"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/xdsinspection"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
 	"github.com/spf13/cobra"
 )
 
@@ -16,17 +19,30 @@ func Upstream(opts *options.Options) *cobra.Command {
 		Short:   "read an upstream or list upstreams in a namespace",
 		Long:    "usage: glooctl get upstream [NAME] [--namespace=namespace] [-o FORMAT]",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			upstreams, err := common.GetUpstreams(common.GetName(args, opts), opts)
-			if err != nil {
-				return err
-			}
-			var xdsDump *xdsinspection.XdsDump
+			var (
+				xdsDump *xdsinspection.XdsDump
+				err     error
+			)
 			if opts.Top.Output == printers.WIDE {
 				xdsDump, err = xdsinspection.GetGlooXdsDump(opts.Top.Ctx, opts.Proxy.Name, opts.Metadata.Namespace, false)
 				if err != nil {
 					return err
 				}
 			}
+			if opts.Get.Watch {
+				client := helpers.MustNamespacedUpstreamClient(opts.Metadata.GetNamespace())
+				return common.WatchResources(common.GetName(args, opts), opts, client.BaseClient(), func(list resources.ResourceList) error {
+					var upstreams v1.UpstreamList
+					for _, res := range list {
+						upstreams = append(upstreams, res.(*v1.Upstream))
+					}
+					return printers.PrintUpstreams(upstreams, opts.Top.Output, xdsDump)
+				})
+			}
+			upstreams, err := common.GetUpstreams(common.GetName(args, opts), opts)
+			if err != nil {
+				return err
+			}
 			return printers.PrintUpstreams(upstreams, opts.Top.Output, xdsDump)
 		},
 	}
@@ -4,7 +4,10 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/common"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/printers"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
 	"github.com/spf13/cobra"
 )
 
@@ -15,6 +18,16 @@ func UpstreamGroup(opts *options.Options) *cobra.Command {
 		Short:   "read an upstream group or list upstream groups in a namespace",
 		Long:    "usage: glooctl get upstreamgroup [NAME] [--namespace=namespace] [-o FORMAT]",
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Get.Watch {
+				client := helpers.MustNamespacedUpstreamGroupClient(opts.Metadata.GetNamespace())
+				return common.WatchResources(common.GetName(args, opts), opts, client.BaseClient(), func(list resources.ResourceList) error {
+					var upstreamGroups v1.UpstreamGroupList
+					for _, res := range list {
+						upstreamGroups = append(upstreamGroups, res.(*v1.UpstreamGroup))
+					}
+					return printers.PrintUpstreamGroups(upstreamGroups, opts.Top.Output)
+				})
+			}
 			upstreamGroups, err := common.GetUpstreamGroups(common.GetName(args, opts), opts)
 			if err != nil {
 				return err
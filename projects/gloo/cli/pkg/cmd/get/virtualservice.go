@@ -2,10 +2,13 @@ package get
 
 import (
 	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/common"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/printers"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
 
 	"github.com/spf13/cobra"
 )
@@ -17,6 +20,16 @@ func VirtualService(opts *options.Options) *cobra.Command {
 		Short:   "read a virtualservice or list virtualservices in a namespace",
 		Long:    "usage: glooctl get virtualservice [NAME] [--namespace=namespace] [-o FORMAT]",
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Get.Watch {
+				client := helpers.MustNamespacedVirtualServiceClient(opts.Metadata.GetNamespace())
+				return common.WatchResources(common.GetName(args, opts), opts, client.BaseClient(), func(list resources.ResourceList) error {
+					var virtualServices v1.VirtualServiceList
+					for _, res := range list {
+						virtualServices = append(virtualServices, res.(*v1.VirtualService))
+					}
+					return printers.PrintVirtualServices(virtualServices, opts.Top.Output, opts.Metadata.Namespace)
+				})
+			}
 			virtualServices, err := common.GetVirtualServices(common.GetName(args, opts), opts)
 			if err != nil {
 				return err
@@ -40,6 +53,19 @@ func Routes(opts *options.Options) *cobra.Command {
 			if len(args) > 0 {
 				vsName = args[0]
 			}
+			if opts.Get.Watch {
+				name := common.GetName(args, opts)
+				if name == "" {
+					return eris.Errorf("no virtualservice id provided")
+				}
+				client := helpers.MustNamespacedVirtualServiceClient(opts.Metadata.GetNamespace())
+				return common.WatchResources(name, opts, client.BaseClient(), func(list resources.ResourceList) error {
+					if len(list) != 1 {
+						return nil
+					}
+					return printers.PrintRoutes(list[0].(*v1.VirtualService).GetVirtualHost().GetRoutes(), opts.Top.Output)
+				})
+			}
 			virtualServices, err := common.GetVirtualServices(vsName, opts)
 			if err != nil {
 				return err
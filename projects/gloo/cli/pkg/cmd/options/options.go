@@ -3,6 +3,7 @@ package options
 import (
 	"context"
 	"sort"
+	"time"
 
 	rltypes "github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1"
 
@@ -29,6 +30,10 @@ type Options struct {
 	Add       Add
 	Remove    Remove
 	Cluster   Cluster
+	Check     Check
+	Debug     Debug
+	Plugin    Plugin
+	Preview   Preview
 }
 
 type Top struct {
@@ -46,6 +51,23 @@ type Top struct {
 	Consul                 Consul // use consul as config backend
 }
 
+type Check struct {
+	CertExpiryWarning time.Duration // warn about TLS certificates that expire sooner than this
+}
+
+type Debug struct {
+	EventsSince time.Duration // only include the kubernetes events more recent than this in the debug bundle
+}
+
+type Plugin struct {
+	Index string // location of the plugin index, an http(s) address or a file path
+}
+
+type Preview struct {
+	GlooNamespace string // namespace gloo is installed in, the resource namespace is Metadata.Namespace
+	Insecure      bool   // skip verifying the certificate served by the gateway validation webhook
+}
+
 type HelmInstall struct {
 	DryRun                  bool
 	CreateNamespace         bool
@@ -106,6 +128,7 @@ type Upgrade struct {
 
 type Get struct {
 	Selector InputMapStringString
+	Watch    bool // keep printing the resources as they change
 }
 
 type Delete struct {
@@ -137,6 +160,8 @@ type Create struct {
 	InputUpstreamGroup InputUpstreamGroup
 	InputSecret        Secret
 	AuthConfig         InputAuthConfig
+	InputRouteTable    InputRouteTable
+	InputGateway       InputGateway
 	DryRun             bool  // print resource as a kubernetes style yaml and exit without writing to storage
 	Vault              Vault // use vault as secrets backend
 }
@@ -234,7 +259,8 @@ type Remove struct {
 }
 
 type RemoveRoute struct {
-	RemoveIndex uint32
+	RemoveIndex    uint32
+	FromRouteTable bool // remove the route from a route table rather than a virtual service
 }
 
 type InputVirtualService struct {
@@ -244,6 +270,23 @@ type InputVirtualService struct {
 	AuthConfig  AuthConfig
 }
 
+type InputRouteTable struct {
+	Weight int32
+}
+
+type InputGateway struct {
+	BindAddress                   string
+	BindPort                      uint32
+	Ssl                           bool
+	UseProxyProto                 bool
+	ProxyNames                    []string
+	PerConnectionBufferLimitBytes uint32
+	// create a tcp gateway rather than an http gateway
+	Tcp                      bool
+	VirtualServiceSelector   InputMapStringString
+	VirtualServiceNamespaces []string
+}
+
 type InputAuthConfig struct {
 	OIDCAuth   OIDCAuth
 	ApiKeyAuth ApiKeyAuth
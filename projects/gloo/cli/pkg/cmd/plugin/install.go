@@ -0,0 +1,112 @@
+package plugin
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/flagutils"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/pluginutils"
+	"github.com/solo-io/go-utils/cliutils"
+	"github.com/spf13/cobra"
+)
+
+func InstallCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   constants.PLUGIN_INSTALL_COMMAND.Use,
+		Short: constants.PLUGIN_INSTALL_COMMAND.Short,
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return InstallPlugins(opts, pluginutils.NewInstaller(pluginutils.PluginDir()), args, os.Stdout)
+		},
+	}
+	flagutils.AddPluginIndexFlag(cmd.PersistentFlags(), &opts.Plugin.Index)
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
+
+func RemoveCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     constants.PLUGIN_REMOVE_COMMAND.Use,
+		Aliases: constants.PLUGIN_REMOVE_COMMAND.Aliases,
+		Short:   constants.PLUGIN_REMOVE_COMMAND.Short,
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RemovePlugins(pluginutils.NewInstaller(pluginutils.PluginDir()), args, os.Stdout)
+		},
+	}
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
+
+func UpgradeCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   constants.PLUGIN_UPGRADE_COMMAND.Use,
+		Short: constants.PLUGIN_UPGRADE_COMMAND.Short,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return UpgradePlugins(opts, pluginutils.NewInstaller(pluginutils.PluginDir()), args, os.Stdout)
+		},
+	}
+	flagutils.AddPluginIndexFlag(cmd.PersistentFlags(), &opts.Plugin.Index)
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
+
+func InstallPlugins(opts *options.Options, installer *pluginutils.Installer, names []string, w io.Writer) error {
+	index, err := pluginutils.LoadIndex(opts.Plugin.Index)
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		receipt, err := installer.Install(index, name)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "Installed plugin %v %v, run it with `glooctl %v`\n", receipt.Name, receipt.Version, receipt.Name)
+	}
+	return nil
+}
+
+func RemovePlugins(installer *pluginutils.Installer, names []string, w io.Writer) error {
+	for _, name := range names {
+		if err := installer.Remove(name); err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "Removed plugin %v\n", name)
+	}
+	return nil
+}
+
+func UpgradePlugins(opts *options.Options, installer *pluginutils.Installer, names []string, w io.Writer) error {
+	if len(names) == 0 {
+		installed, err := installer.Installed()
+		if err != nil {
+			return err
+		}
+		if len(installed) == 0 {
+			return eris.Errorf("no plugins are installed in %v", installer.Dir)
+		}
+		for _, receipt := range installed {
+			names = append(names, receipt.Name)
+		}
+	}
+	index, err := pluginutils.LoadIndex(opts.Plugin.Index)
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		receipt, upgraded, err := installer.Upgrade(index, name)
+		if err != nil {
+			return err
+		}
+		if upgraded {
+			fmt.Fprintf(w, "Upgraded plugin %v to %v\n", receipt.Name, receipt.Version)
+		} else {
+			fmt.Fprintf(w, "Plugin %v is up to date (%v)\n", receipt.Name, receipt.Version)
+		}
+	}
+	return nil
+}
@@ -29,6 +29,7 @@ import (
 	"strings"
 
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/pluginutils"
 	"github.com/spf13/cobra"
 )
 
@@ -45,7 +46,7 @@ func (o *PluginListOptions) Complete(cmd *cobra.Command) error {
 		seenPlugins: make(map[string]string),
 	}
 
-	o.PluginPaths = filepath.SplitList(os.Getenv("PATH"))
+	o.PluginPaths = pluginutils.SearchDirs()
 	return nil
 }
 
@@ -105,7 +106,7 @@ func (o *PluginListOptions) Run() error {
 	}
 
 	if !pluginsFound {
-		pluginErrors = append(pluginErrors, fmt.Errorf("unable to find any glooctl plugins in your PATH"))
+		pluginErrors = append(pluginErrors, fmt.Errorf("unable to find any glooctl plugins in the plugin directory or your PATH"))
 	}
 
 	if pluginWarnings > 0 {
@@ -26,7 +26,12 @@ func RootCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.
 		},
 	}
 
-	cmd.AddCommand(list.RootCmd(opts))
+	cmd.AddCommand(
+		list.RootCmd(opts),
+		InstallCmd(opts),
+		RemoveCmd(opts),
+		UpgradeCmd(opts),
+	)
 
 	cliutils.ApplyOptions(cmd, optionsFunc)
 	return cmd
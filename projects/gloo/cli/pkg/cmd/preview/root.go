@@ -0,0 +1,220 @@
+package preview
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/cliutil"
+	"github.com/solo-io/gloo/projects/gateway/pkg/defaults"
+	"github.com/solo-io/gloo/projects/gateway/pkg/services/k8sadmisssion"
+	"github.com/solo-io/gloo/projects/gateway/pkg/validation"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/flagutils"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/printers"
+	"github.com/solo-io/go-utils/cliutils"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	gatewayDeployment = "deploy/gateway"
+	gatewayService    = "gateway"
+	// the name of the webhook configuration created by the helm chart is suffixed with the install namespace
+	webhookConfigurationPrefix = "gloo-gateway-validation-webhook-"
+)
+
+func RootCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   constants.PREVIEW_COMMAND.Use,
+		Short: constants.PREVIEW_COMMAND.Short,
+		Long:  constants.PREVIEW_COMMAND.Long,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Top.File == "" {
+				return eris.Errorf("a file containing a Virtual Service or Route Table must be provided with --%v", flagutils.FileFlag)
+			}
+			preview, err := previewFile(opts, cmd.Flag("namespace").Changed)
+			if err != nil {
+				return err
+			}
+			return printers.PrintImpactPreview(preview, opts.Top.Output, os.Stdout)
+		},
+	}
+	pflags := cmd.PersistentFlags()
+	flagutils.AddNamespaceFlag(pflags, &opts.Metadata.Namespace)
+	flagutils.AddFileFlag(pflags, &opts.Top.File)
+	flagutils.AddOutputFlag(pflags, &opts.Top.Output)
+	flagutils.AddVerboseFlag(pflags, opts)
+	flagutils.AddPreviewFlags(pflags, &opts.Preview)
+
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
+
+// port-forwards to the gateway deployment in the gloo namespace and posts the file to its preview endpoint
+func previewFile(opts *options.Options, namespaceFlagSet bool) (*validation.ImpactPreview, error) {
+	file, err := cliutil.GetResource(opts.Top.File)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	body, err := ioutil.ReadAll(file)
+	if err != nil {
+		return nil, err
+	}
+	body, err = setNamespace(body, opts.Metadata.Namespace, namespaceFlagSet)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig := &tls.Config{InsecureSkipVerify: true}
+	if !opts.Preview.Insecure {
+		tlsConfig, err = webhookTlsConfig(opts.Preview.GlooNamespace)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	freePort, err := cliutil.GetFreePort()
+	if err != nil {
+		return nil, err
+	}
+	localPort := strconv.Itoa(freePort)
+	portFwdCmd, err := cliutil.PortForward(opts.Preview.GlooNamespace, gatewayDeployment, localPort,
+		strconv.Itoa(defaults.ValidationWebhookBindPort), opts.Top.Verbose)
+	if err != nil {
+		return nil, err
+	}
+	if portFwdCmd.Process != nil {
+		defer portFwdCmd.Process.Release()
+		defer portFwdCmd.Process.Kill()
+	}
+
+	return postPreview(opts.Top.Ctx, "https://localhost:"+localPort+k8sadmisssion.PreviewPath, body, tlsConfig)
+}
+
+// resources without a namespace are previewed in the namespace given with --namespace. as with kubectl, it is
+// an error to give a namespace different from the one of the resource.
+func setNamespace(body []byte, namespace string, namespaceFlagSet bool) ([]byte, error) {
+	var resource map[string]interface{}
+	if err := yaml.Unmarshal(body, &resource); err != nil {
+		return nil, eris.Wrapf(err, "could not parse resource")
+	}
+	if resource == nil {
+		return nil, eris.Errorf("no resource found in --%v", flagutils.FileFlag)
+	}
+	metadata, _ := resource["metadata"].(map[string]interface{})
+	if metadata == nil {
+		metadata = map[string]interface{}{}
+		resource["metadata"] = metadata
+	}
+	resourceNamespace, _ := metadata["namespace"].(string)
+	switch {
+	case resourceNamespace == "":
+		metadata["namespace"] = namespace
+		return yaml.Marshal(resource)
+	case namespaceFlagSet && resourceNamespace != namespace:
+		return nil, eris.Errorf("the namespace of the resource (%v) does not match the namespace given with "+
+			"--namespace (%v)", resourceNamespace, namespace)
+	}
+	return body, nil
+}
+
+// the gateway serves the certificate issued for the gateway service by the certgen job, whose CA is the
+// bundle of the validating webhook configuration. the certificate is verified for the service's name rather
+// than the host we reach it on through the port-forward.
+func webhookTlsConfig(glooNamespace string) (*tls.Config, error) {
+	kube, err := helpers.KubeClient()
+	if err != nil {
+		return nil, err
+	}
+	vwcName := webhookConfigurationPrefix + glooNamespace
+	vwc, err := kube.AdmissionregistrationV1beta1().ValidatingWebhookConfigurations().Get(vwcName, metav1.GetOptions{})
+	if err != nil {
+		return nil, eris.Wrapf(err, "could not get the CA of the Gateway validation webhook, "+
+			"use --insecure to skip verifying its certificate")
+	}
+	roots := x509.NewCertPool()
+	var found bool
+	for _, webhook := range vwc.Webhooks {
+		if roots.AppendCertsFromPEM(webhook.ClientConfig.CABundle) {
+			found = true
+		}
+	}
+	if !found {
+		return nil, eris.Errorf("ValidatingWebhookConfiguration %v has no CA bundle, "+
+			"use --insecure to skip verifying the certificate of the Gateway validation webhook", vwcName)
+	}
+	return &tls.Config{
+		RootCAs:    roots,
+		ServerName: fmt.Sprintf("%v.%v.svc", gatewayService, glooNamespace),
+	}, nil
+}
+
+func postPreview(ctx context.Context, url string, body []byte, tlsConfig *tls.Config) (*validation.ImpactPreview, error) {
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: tlsConfig}}
+
+	localCtx, cancel := context.WithTimeout(ctx, time.Second*30)
+	defer cancel()
+
+	// wait for port-forward to be ready
+	var (
+		resp *http.Response
+		err  error
+	)
+	for {
+		var req *http.Request
+		req, err = http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", k8sadmisssion.ApplicationYaml)
+		resp, err = client.Do(req.WithContext(localCtx))
+		if err == nil {
+			break
+		}
+		if isCertificateError(err) {
+			return nil, eris.Wrapf(err, "could not verify the certificate of the gateway")
+		}
+		select {
+		case <-localCtx.Done():
+			return nil, eris.Wrapf(err, "timed out connecting to the gateway")
+		case <-time.After(time.Millisecond * 250):
+		}
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, eris.Errorf("gateway could not preview the change (%v): %s", resp.Status, bytes.TrimSpace(respBody))
+	}
+
+	var preview validation.ImpactPreview
+	if err := json.Unmarshal(respBody, &preview); err != nil {
+		return nil, eris.Wrapf(err, "could not parse preview")
+	}
+	return &preview, nil
+}
+
+// unlike connection errors while the port-forward starts, certificate errors are not retried
+func isCertificateError(err error) bool {
+	return errors.As(err, new(x509.UnknownAuthorityError)) ||
+		errors.As(err, new(x509.HostnameError)) ||
+		errors.As(err, new(x509.CertificateInvalidError))
+}
@@ -0,0 +1,138 @@
+package preview
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gateway/pkg/services/k8sadmisssion"
+	"github.com/solo-io/gloo/projects/gateway/pkg/validation"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"k8s.io/api/admissionregistration/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Preview", func() {
+
+	var (
+		srv       *httptest.Server
+		received  []byte
+		handler   http.HandlerFunc
+		tlsConfig *tls.Config
+	)
+
+	BeforeEach(func() {
+		received = nil
+		srv = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.URL.Path).To(Equal(k8sadmisssion.PreviewPath))
+			Expect(r.Header.Get("Content-Type")).To(Equal(k8sadmisssion.ApplicationYaml))
+			received, _ = ioutil.ReadAll(r.Body)
+			handler(w, r)
+		}))
+		roots := x509.NewCertPool()
+		roots.AddCert(srv.Certificate())
+		// the name the httptest certificate is issued for
+		tlsConfig = &tls.Config{RootCAs: roots, ServerName: "example.com"}
+	})
+	AfterEach(func() {
+		srv.Close()
+	})
+
+	It("posts the resource and parses the preview", func() {
+		expected := &validation.ImpactPreview{Proxies: []*validation.ProxyImpact{{
+			Proxy:      core.ResourceRef{Name: "gateway-proxy", Namespace: "gloo-system"},
+			XdsChanges: []validation.ResourceChange{{Type: "Cluster", Name: "default-petstore-8080_gloo-system", Change: validation.Added}},
+		}}}
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewEncoder(w).Encode(expected)
+		}
+
+		preview, err := postPreview(context.TODO(), srv.URL+k8sadmisssion.PreviewPath, []byte("kind: VirtualService"), tlsConfig)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(preview).To(Equal(expected))
+		Expect(string(received)).To(Equal("kind: VirtualService"))
+	})
+
+	It("returns the error reported by the gateway", func() {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "previewing changes to Gateway is not supported", http.StatusBadRequest)
+		}
+
+		_, err := postPreview(context.TODO(), srv.URL+k8sadmisssion.PreviewPath, []byte("kind: Gateway"), tlsConfig)
+		Expect(err).To(MatchError(ContainSubstring("previewing changes to Gateway is not supported")))
+		Expect(err).To(MatchError(ContainSubstring("400 Bad Request")))
+	})
+
+	It("rejects a certificate which isn't issued for the gateway", func() {
+		tlsConfig.ServerName = "gateway.gloo-system.svc"
+		_, err := postPreview(context.TODO(), srv.URL+k8sadmisssion.PreviewPath, []byte("kind: VirtualService"), tlsConfig)
+		Expect(err).To(MatchError(ContainSubstring("could not verify the certificate of the gateway")))
+		Expect(received).To(BeNil())
+	})
+
+	Context("webhook CA", func() {
+		BeforeEach(func() {
+			helpers.UseMemoryClients()
+		})
+		AfterEach(func() {
+			helpers.UseDefaultClients()
+		})
+
+		It("verifies the gateway certificate against the CA bundle of the webhook configuration", func() {
+			caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+			_, err := helpers.MustKubeClient().AdmissionregistrationV1beta1().ValidatingWebhookConfigurations().Create(&v1beta1.ValidatingWebhookConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "gloo-gateway-validation-webhook-gloo-system"},
+				Webhooks: []v1beta1.ValidatingWebhook{{
+					Name:         "gateway.gloo-system.svc",
+					ClientConfig: v1beta1.WebhookClientConfig{CABundle: caBundle},
+				}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			config, err := webhookTlsConfig("gloo-system")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.InsecureSkipVerify).To(BeFalse())
+			Expect(config.ServerName).To(Equal("gateway.gloo-system.svc"))
+
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte("{}"))
+			}
+			config.ServerName = "example.com"
+			_, err = postPreview(context.TODO(), srv.URL+k8sadmisssion.PreviewPath, []byte("kind: VirtualService"), config)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("suggests --insecure if the webhook configuration has no CA bundle", func() {
+			_, err := webhookTlsConfig("gloo-system")
+			Expect(err).To(MatchError(ContainSubstring("use --insecure")))
+		})
+	})
+
+	Context("namespace", func() {
+		It("previews resources without a namespace in the given namespace", func() {
+			body, err := setNamespace([]byte("kind: VirtualService\nmetadata:\n  name: vs\n"), "default", false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(body)).To(Equal("kind: VirtualService\nmetadata:\n  name: vs\n  namespace: default\n"))
+		})
+
+		It("keeps the namespace of the resource if --namespace isn't set", func() {
+			resource := []byte("kind: VirtualService\nmetadata:\n  name: vs\n  namespace: team\n")
+			body, err := setNamespace(resource, "gloo-system", false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(body).To(Equal(resource))
+		})
+
+		It("rejects a resource in another namespace than the one given", func() {
+			_, err := setNamespace([]byte("kind: VirtualService\nmetadata:\n  name: vs\n  namespace: team\n"), "default", true)
+			Expect(err).To(MatchError(ContainSubstring("does not match the namespace given with --namespace")))
+		})
+	})
+})
@@ -18,7 +18,7 @@ func Route(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Co
 	cmd := &cobra.Command{
 		Use:     "route",
 		Aliases: []string{"r", "routes"},
-		Short:   "Remove a Route from a Virtual Service",
+		Short:   "Remove a Route from a Virtual Service or Route Table",
 		Long: "Routes match patterns on requests and indicate the type of action to take when a proxy receives " +
 			"a matching request. Requests can be broken down into their Match and Action components. " +
 			"The order of routes within a Virtual Service matters. The first route in the virtual service " +
@@ -26,7 +26,8 @@ func Route(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Co
 			"If no virtual service is specified for this command, glooctl add route will attempt to add it to a " +
 			"default virtualservice with domain '*'. if one does not exist, it will be created for you.\n\n" +
 			"" +
-			"Usage: `glooctl rm route [--name virtual-service-name] [--namespace namespace] [--index x]`",
+			"Use --from-route-table to remove the route from a route table instead.\n\n" +
+			"Usage: `glooctl rm route [--name virtual-service-name] [--namespace namespace] [--index x] [--from-route-table]`",
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			if opts.Top.Interactive {
 				if err := surveyutils.RemoveRouteFlagsInteractive(opts); err != nil {
@@ -48,6 +49,9 @@ func Route(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Co
 }
 
 func removeRoute(opts *options.Options) error {
+	if opts.Remove.Route.FromRouteTable {
+		return removeRouteFromRouteTable(opts)
+	}
 	index := int(opts.Remove.Route.RemoveIndex)
 	if opts.Metadata.Name == "" {
 		return errors.Errorf("name of the target virtual service cannot be empty")
@@ -76,3 +80,33 @@ func removeRoute(opts *options.Options) error {
 	_ = printers.PrintVirtualServices(gatewayv1.VirtualServiceList{out}, opts.Top.Output, opts.Metadata.Namespace)
 	return nil
 }
+
+func removeRouteFromRouteTable(opts *options.Options) error {
+	index := int(opts.Remove.Route.RemoveIndex)
+	if opts.Metadata.Name == "" {
+		return errors.Errorf("name of the target route table cannot be empty")
+	}
+
+	rtClient := helpers.MustNamespacedRouteTableClient(opts.Metadata.GetNamespace())
+	rt, err := rtClient.Read(opts.Metadata.Namespace, opts.Metadata.Name, clients.ReadOpts{Ctx: opts.Top.Ctx})
+	if err != nil {
+		return errors.Wrapf(err, "reading route table %v", opts.Metadata.Ref())
+	}
+
+	if routeCount := len(rt.Routes); index >= routeCount {
+		return errors.Errorf("%v is greater than the number of routes on %v (%v)", index, rt.Metadata.Ref(), routeCount)
+	}
+
+	rt.Routes = append(rt.Routes[:index], rt.Routes[index+1:]...)
+
+	out, err := rtClient.Write(rt, clients.WriteOpts{
+		Ctx:               opts.Top.Ctx,
+		OverwriteExisting: true,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "writing updated route table")
+	}
+
+	_ = printers.PrintRouteTables(gatewayv1.RouteTableList{out}, opts.Top.Output)
+	return nil
+}
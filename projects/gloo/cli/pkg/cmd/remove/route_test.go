@@ -40,4 +40,33 @@ var _ = Describe("Remove Route", func() {
 		Expect(err).NotTo(HaveOccurred())
 		Expect(vs.VirtualHost.Routes).To(HaveLen(0))
 	})
+
+	It("should remove a route from a route table", func() {
+		rt, err := helpers.MustRouteTableClient().Write(&gatewayv1.RouteTable{
+			Metadata: core.Metadata{Namespace: "gloo-system", Name: "tacos"},
+			Routes: []*gatewayv1.Route{
+				routehelpers.MakeGatewayRoute(routehelpers.RegexPath, 5),
+				routehelpers.MakeGatewayRoute(routehelpers.PrefixPath, 3),
+			},
+		}, clients.WriteOpts{Ctx: context.TODO()})
+		Expect(err).NotTo(HaveOccurred())
+
+		err = testutils.Glooctl(fmt.Sprintf("remove route --name %v --namespace %v -x 0 --from-route-table", rt.Metadata.Name, rt.Metadata.Namespace))
+		Expect(err).NotTo(HaveOccurred())
+
+		rt, err = helpers.MustRouteTableClient().Read(rt.Metadata.Namespace, rt.Metadata.Name, clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rt.Routes).To(HaveLen(1))
+		Expect(rt.Routes[0]).To(Equal(routehelpers.MakeGatewayRoute(routehelpers.PrefixPath, 3)))
+	})
+
+	It("should error when the index is out of range for a route table", func() {
+		_, err := helpers.MustRouteTableClient().Write(&gatewayv1.RouteTable{
+			Metadata: core.Metadata{Namespace: "gloo-system", Name: "tacos"},
+		}, clients.WriteOpts{Ctx: context.TODO()})
+		Expect(err).NotTo(HaveOccurred())
+
+		err = testutils.Glooctl("remove route --name tacos --namespace gloo-system -x 0 --from-route-table")
+		Expect(err).To(HaveOccurred())
+	})
 })
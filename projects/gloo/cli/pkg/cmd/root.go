@@ -4,14 +4,15 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"os/exec"
 
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/dashboard"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/debug"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/demo"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/federation"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/plugin"
-	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
-	"k8s.io/kubernetes/pkg/kubectl/cmd"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/preview"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/pluginutils"
 
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/add"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/check"
@@ -63,13 +64,18 @@ func App(opts *options.Options, preRunFuncs []PreRunFunc, optionsFunc ...cliutil
 	args := os.Args
 	if len(args) > 1 {
 		cmdPathPieces := args[1:]
-		pluginHandler := cmd.NewDefaultPluginHandler(constants.ValidExtensionPrefixes)
 
 		// If the given subcommand does not exist, look for a suitable plugin executable
 		if _, _, err := app.Find(cmdPathPieces); err != nil {
-			if err := cmd.HandlePluginCommand(pluginHandler, cmdPathPieces); err != nil {
-				fmt.Printf("%v\n", err)
-				os.Exit(1)
+			if invocation, ok := pluginutils.LookupPlugin(pluginutils.SearchDirs(), cmdPathPieces); ok {
+				if err := invocation.Run(); err != nil {
+					if exitErr, ok := err.(*exec.ExitError); ok {
+						os.Exit(exitErr.ExitCode())
+					}
+					fmt.Printf("%v\n", err)
+					os.Exit(1)
+				}
+				os.Exit(0)
 			}
 		}
 	}
@@ -108,6 +114,7 @@ func GlooCli() *cobra.Command {
 			debug.RootCmd(opts),
 			versioncmd.RootCmd(opts),
 			dashboard.RootCmd(opts),
+			preview.RootCmd(opts),
 			federation.RootCmd(opts),
 			plugin.RootCmd(opts),
 			completionCmd(),
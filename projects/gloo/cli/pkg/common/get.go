@@ -54,6 +54,29 @@ func GetRouteTables(name string, opts *options.Options) (v1.RouteTableList, erro
 	return routeTableList, nil
 }
 
+func GetGateways(name string, opts *options.Options) (v1.GatewayList, error) {
+	var gatewayList v1.GatewayList
+
+	gatewayClient := helpers.MustNamespacedGatewayClient(opts.Metadata.GetNamespace())
+	if name == "" {
+		gateways, err := gatewayClient.List(opts.Metadata.Namespace,
+			clients.ListOpts{Ctx: opts.Top.Ctx, Selector: opts.Get.Selector.MustMap()})
+		if err != nil {
+			return nil, err
+		}
+		gatewayList = append(gatewayList, gateways...)
+	} else {
+		gateway, err := gatewayClient.Read(opts.Metadata.Namespace, name, clients.ReadOpts{Ctx: opts.Top.Ctx})
+		if err != nil {
+			return nil, err
+		}
+		opts.Metadata.Name = name
+		gatewayList = append(gatewayList, gateway)
+	}
+
+	return gatewayList, nil
+}
+
 func GetUpstreams(name string, opts *options.Options) (gloov1.UpstreamList, error) {
 	var list gloov1.UpstreamList
 
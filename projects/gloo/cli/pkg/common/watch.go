@@ -0,0 +1,54 @@
+package common
+
+import (
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+)
+
+// WatchResources watches the resources of the client in the namespace of the options (or only the named resource,
+// if a name is provided), and prints them each time they change until the context of the options is cancelled.
+func WatchResources(name string, opts *options.Options, client clients.ResourceClient, print func(resources.ResourceList) error) error {
+	ctx := opts.Top.Ctx
+	watch, errs, err := client.Watch(opts.Metadata.Namespace, clients.WatchOpts{
+		Ctx:      ctx,
+		Selector: opts.Get.Selector.MustMap(),
+	})
+	if err != nil {
+		return err
+	}
+	if name != "" {
+		opts.Metadata.Name = name
+	}
+
+	var (
+		previous resources.ResourceList
+		printed  bool
+	)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err, ok := <-errs:
+			if !ok {
+				return nil
+			}
+			return err
+		case list, ok := <-watch:
+			if !ok {
+				return nil
+			}
+			if name != "" {
+				list = list.FilterByNames([]string{name})
+			}
+			// the watch resyncs periodically, only print when something changed
+			if printed && list.Equal(previous) {
+				continue
+			}
+			previous, printed = list, true
+			if err := print(list); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package common_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/common"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("WatchResources", func() {
+
+	var (
+		ctx      context.Context
+		cancel   context.CancelFunc
+		opts     *options.Options
+		rtClient v1.RouteTableClient
+		printed  chan []string
+		done     chan error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		opts = &options.Options{
+			Top:      options.Top{Ctx: ctx},
+			Metadata: core.Metadata{Namespace: "gloo-system"},
+		}
+		var err error
+		rtClient, err = v1.NewRouteTableClient(&factory.MemoryResourceClientFactory{Cache: memory.NewInMemoryResourceCache()})
+		Expect(err).NotTo(HaveOccurred())
+		printed = make(chan []string, 10)
+		done = make(chan error, 1)
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	watch := func(name string) {
+		go func() {
+			defer GinkgoRecover()
+			done <- common.WatchResources(name, opts, rtClient.BaseClient(), func(list resources.ResourceList) error {
+				printed <- list.Names()
+				return nil
+			})
+		}()
+	}
+
+	write := func(name string) {
+		rt := &v1.RouteTable{Metadata: core.Metadata{Namespace: "gloo-system", Name: name}}
+		if existing, err := rtClient.Read("gloo-system", name, clients.ReadOpts{}); err == nil {
+			rt = existing
+		}
+		rt.Routes = append(rt.Routes, &v1.Route{Name: "route"})
+		_, err := rtClient.Write(rt, clients.WriteOpts{OverwriteExisting: true})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	It("prints the resources each time they change", func() {
+		watch("")
+		Eventually(printed).Should(Receive(BeEmpty()))
+
+		write("a")
+		Eventually(printed).Should(Receive(Equal([]string{"a"})))
+
+		write("b")
+		Eventually(printed).Should(Receive(Equal([]string{"a", "b"})))
+
+		cancel()
+		Eventually(done).Should(Receive(BeNil()))
+	})
+
+	It("only prints the named resource", func() {
+		watch("a")
+		Eventually(printed).Should(Receive(BeEmpty()))
+
+		write("b")
+		write("a")
+		Eventually(printed).Should(Receive(Equal([]string{"a"})))
+
+		write("a")
+		Eventually(printed).Should(Receive(Equal([]string{"a"})))
+		Expect(opts.Metadata.Name).To(Equal("a"))
+	})
+
+	It("does not print again if nothing changed", func() {
+		watch("")
+		Eventually(printed).Should(Receive(BeEmpty()))
+		Consistently(printed, "1s").ShouldNot(Receive())
+	})
+})
@@ -20,6 +20,11 @@ var (
 		Aliases: []string{"rt", "routetables"},
 	}
 
+	GATEWAY_COMMAND = cobra.Command{
+		Use:     "gateway",
+		Aliases: []string{"gw", "gateways"},
+	}
+
 	UPSTREAM_COMMAND = cobra.Command{
 		Use:     "upstream",
 		Aliases: []string{"u", "us", "upstreams"},
@@ -84,6 +89,11 @@ var (
 		Short: "Dump YAML representing the current Gloo state (requires Gloo running on Kubernetes)",
 	}
 
+	DEBUG_BUNDLE_COMMAND = cobra.Command{
+		Use:   "bundle",
+		Short: "Collect Gloo resources, served xDS, Envoy config dumps and stats, metrics, events and logs into a tarball (requires Gloo running on Kubernetes)",
+	}
+
 	DELETE_COMMAND = cobra.Command{
 		Use:     "delete",
 		Aliases: []string{"d"},
@@ -168,6 +178,14 @@ var (
 		Long:    "Get the version of Glooctl and Gloo",
 	}
 
+	PREVIEW_COMMAND = cobra.Command{
+		Use:   "preview",
+		Short: "Preview the impact of applying a Virtual Service or Route Table (requires Gloo running on Kubernetes)",
+		Long: "Sends the Virtual Service or Route Table in the given file to the Gateway, which applies it to its " +
+			"latest snapshot without writing it and reports the listeners, virtual hosts, routes and Envoy resources " +
+			"that would change on each proxy. Requires the Gateway validation webhook to be enabled.",
+	}
+
 	DASHBOARD_COMMAND = cobra.Command{
 		Use:     "dashboard",
 		Aliases: []string{"ui"},
@@ -205,11 +223,29 @@ var (
 		Use:   "plugin",
 		Short: "Commands for interacting with glooctl plugins",
 		Long: "Commands for interacting with glooctl plugins. Glooctl plugins are arbitrary binary executables " +
-			"in your path with the prefix 'glooctl-'.",
+			"with the prefix 'glooctl-' in the plugin directory ($GLOOCTL_PLUGIN_DIR, or $HOME/.gloo/plugins by default) " +
+			"or in your path. `glooctl foo` runs the glooctl-foo plugin with the namespace, kubeconfig and output format " +
+			"of the invocation in the GLOOCTL_NAMESPACE, GLOOCTL_KUBECONFIG and GLOOCTL_OUTPUT environment variables.",
 	}
 
 	PLUGIN_LIST_COMMAND = cobra.Command{
 		Use:   "list",
 		Short: "List available glooctl plugins",
 	}
+
+	PLUGIN_INSTALL_COMMAND = cobra.Command{
+		Use:   "install NAME...",
+		Short: "Install glooctl plugins from the plugin index into the plugin directory",
+	}
+
+	PLUGIN_REMOVE_COMMAND = cobra.Command{
+		Use:     "remove NAME...",
+		Aliases: []string{"rm", "uninstall"},
+		Short:   "Remove glooctl plugins installed with glooctl plugin install",
+	}
+
+	PLUGIN_UPGRADE_COMMAND = cobra.Command{
+		Use:   "upgrade [NAME...]",
+		Short: "Upgrade installed glooctl plugins to the version in the plugin index, all of them if no name is given",
+	}
 )
@@ -9,6 +9,17 @@ const (
 	// This annotation is present on resources that are included in the chart only to clean up hooks.
 	// We use it to filter out those resources wherever that it necessary.
 	HookCleanupResourceAnnotation = "solo.io/hook-cleanup"
+	// glooctl extension binaries read the namespace and kubeconfig of the glooctl invocation from these variables,
+	// plugins also read the output format
+	NamespaceEnvVar  = "GLOOCTL_NAMESPACE"
+	KubeConfigEnvVar = "GLOOCTL_KUBECONFIG"
+	OutputEnvVar     = "GLOOCTL_OUTPUT"
+	// glooctl plugins are executables named glooctl-<name> in the plugin directory or within the user's PATH
+	PluginPrefix = "glooctl-"
+	// overrides the directory that glooctl plugins are installed into
+	PluginDirEnvVar = "GLOOCTL_PLUGIN_DIR"
+	// glooctl check runs the executables with this prefix within the user's PATH (e.g. "glooctl-check-foo") as additional checks
+	CheckExtensionPrefix = "glooctl-check-"
 )
 
 var (
@@ -0,0 +1,16 @@
+package flagutils
+
+import (
+	"time"
+
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/printers"
+	"github.com/spf13/pflag"
+)
+
+func AddCheckOutputFlag(set *pflag.FlagSet, outputType *printers.OutputType) {
+	set.VarP(outputType, OutputFlag, "o", "output format: (table, json, yaml). json and yaml print a report of every check")
+}
+
+func AddCertExpiryWarningFlag(set *pflag.FlagSet, duration *time.Duration) {
+	set.DurationVar(duration, "cert-expiry-warning", 30*24*time.Hour, "warn about TLS certificates that expire within this duration")
+}
@@ -21,6 +21,10 @@ func AddOutputFlag(set *pflag.FlagSet, outputType *printers.OutputType) {
 	set.VarP(outputType, OutputFlag, "o", "output format: (yaml, json, table, kube-yaml, wide)")
 }
 
+func AddWatchFlag(set *pflag.FlagSet, watch *bool) {
+	set.BoolVarP(watch, "watch", "w", false, "after listing the requested resources, watch them and print them again each time they change")
+}
+
 func AddFileFlag(set *pflag.FlagSet, strptr *string) {
 	set.StringVarP(strptr, FileFlag, "f", "", "file to be read or written to")
 }
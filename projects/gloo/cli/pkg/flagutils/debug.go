@@ -1,6 +1,8 @@
 package flagutils
 
 import (
+	"time"
+
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
 	"github.com/spf13/pflag"
 )
@@ -9,3 +11,7 @@ func AddDebugFlags(set *pflag.FlagSet, top *options.Top) {
 	set.BoolVar(&top.Zip, "zip", false, "save logs to a tar file (specify location with -f)")
 	set.BoolVar(&top.ErrorsOnly, "errors-only", false, "filter for error logs only")
 }
+
+func AddDebugBundleFlags(set *pflag.FlagSet, debug *options.Debug) {
+	set.DurationVar(&debug.EventsSince, "events-since", time.Hour, "include the kubernetes events of the last duration")
+}
@@ -0,0 +1,27 @@
+package flagutils
+
+import (
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/spf13/pflag"
+)
+
+// the defaults match the http gateway installed with gloo
+func AddGatewayFlags(set *pflag.FlagSet, gateway *options.InputGateway) {
+	set.StringVar(&gateway.BindAddress, "bind-address", "::", "the bind address the gateway should serve traffic on")
+	set.Uint32Var(&gateway.BindPort, "bind-port", 8080, "the port the gateway should serve traffic on")
+	set.BoolVar(&gateway.Ssl, "ssl", false, "if set, only serve virtual services with ssl configured. otherwise, only serve virtual services without ssl configured")
+	set.BoolVar(&gateway.UseProxyProto, "use-proxy-proto", false, "enable the proxy protocol on the gateway's listener")
+	set.StringSliceVar(&gateway.ProxyNames, "proxy-names", []string{"gateway-proxy"}, "names of the proxies to apply the gateway to")
+	set.Uint32Var(&gateway.PerConnectionBufferLimitBytes, "per-connection-buffer-limit-bytes", 0,
+		"soft limit on the size of the listener's new connection read and write buffers. if 0, the envoy default is used")
+	set.BoolVar(&gateway.Tcp, "tcp", false, "create a tcp gateway rather than an http gateway")
+	set.StringSliceVar(&gateway.VirtualServiceSelector.Entries, "vs-selector", []string{},
+		"labels of the virtual services to serve on this http gateway. format: 'key=value'. if empty, all virtual services are served")
+	set.StringSliceVar(&gateway.VirtualServiceNamespaces, "vs-namespaces", []string{},
+		"restrict the virtual services served on this http gateway to these namespaces. if empty or '*', all namespaces are searched")
+}
+
+func AddRouteTableFlags(set *pflag.FlagSet, routeTable *options.InputRouteTable) {
+	set.Int32Var(&routeTable.Weight, "weight", 0, "the weight of the route table, used to order route tables selected "+
+		"by the same delegate action. route tables with lower weights are processed first")
+}
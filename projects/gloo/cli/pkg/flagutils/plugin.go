@@ -0,0 +1,10 @@
+package flagutils
+
+import (
+	"github.com/spf13/pflag"
+)
+
+func AddPluginIndexFlag(set *pflag.FlagSet, index *string) {
+	set.StringVar(index, "index", "", "location of the plugin index, an http(s) address or a file path. "+
+		"Defaults to the pluginIndex value of the glooctl config file")
+}
@@ -0,0 +1,13 @@
+package flagutils
+
+import (
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	"github.com/spf13/pflag"
+)
+
+func AddPreviewFlags(set *pflag.FlagSet, preview *options.Preview) {
+	set.StringVar(&preview.GlooNamespace, "gloo-namespace", defaults.GlooSystem, "namespace Gloo is installed in")
+	set.BoolVar(&preview.Insecure, "insecure", false, "skip verifying the certificate served by the Gateway "+
+		"validation webhook")
+}
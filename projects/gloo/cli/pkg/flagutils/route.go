@@ -28,11 +28,15 @@ func AddRouteFlags(set *pflag.FlagSet, route *options.InputRoute) {
 	set.StringVarP(&route.Destination.Upstream.Namespace, "dest-namespace", "s", defaults.GlooSystem,
 		"namespace of the destination upstream for this route")
 
-	// TODO(marco): add delegate selector
 	set.StringVar(&route.Destination.Delegate.Single.Name, "delegate-name", "",
 		"name of the delegated RouteTable for this route")
 	set.StringVar(&route.Destination.Delegate.Single.Namespace, "delegate-namespace", defaults.GlooSystem,
 		"namespace of the delegated RouteTable for this route")
+	set.StringToStringVar(&route.Destination.Delegate.Selector.Labels, "delegate-label-selector", nil,
+		"delegate to the RouteTables with these labels. use instead of --delegate-name")
+	set.StringSliceVar(&route.Destination.Delegate.Selector.Namespaces, "delegate-selector-namespaces", nil,
+		"namespaces of the RouteTables selected by --delegate-label-selector. use '*' for all namespaces. "+
+			"if empty, only the namespace of the virtual service or route table is searched")
 
 	set.StringVarP(&route.UpstreamGroup.Name, "upstream-group-name", "", "",
 		"name of the upstream group destination for this route")
@@ -58,6 +62,7 @@ func AddRouteFlags(set *pflag.FlagSet, route *options.InputRoute) {
 }
 
 func RemoveRouteFlags(set *pflag.FlagSet, route *options.RemoveRoute) {
-	set.Uint32VarP(&route.RemoveIndex, "index", "x", 0, "remove the route with this index in the virtual service "+
-		"route list")
+	set.Uint32VarP(&route.RemoveIndex, "index", "x", 0, "remove the route with this index in the virtual service's "+
+		"or route table's route list")
+	set.BoolVar(&route.FromRouteTable, "from-route-table", false, "remove the route from a route table rather than a virtual service")
 }
@@ -0,0 +1,32 @@
+package pluginutils
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
+)
+
+// PluginDir returns the directory that `glooctl plugin install` installs plugins into: $GLOOCTL_PLUGIN_DIR if set,
+// $HOME/.gloo/plugins otherwise.
+func PluginDir() string {
+	if dir := os.Getenv(constants.PluginDirEnvVar); dir != "" {
+		return dir
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".gloo", "plugins")
+}
+
+// SearchDirs returns the directories searched for plugins, in order: the plugin directory if it exists, then the PATH.
+func SearchDirs() []string {
+	var dirs []string
+	if dir := PluginDir(); dir != "" {
+		if info, err := os.Stat(dir); err == nil && info.IsDir() {
+			dirs = append(dirs, dir)
+		}
+	}
+	return append(dirs, filepath.SplitList(os.Getenv("PATH"))...)
+}
@@ -0,0 +1,122 @@
+package pluginutils
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
+	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+)
+
+// Invocation is the execution of a plugin in place of an unknown glooctl subcommand.
+type Invocation struct {
+	// the plugin executable
+	Path string
+	// the arguments that follow the plugin name, e.g. ["--bar"] for `glooctl foo --bar`
+	Args []string
+	// the glooctl options of the invocation, as GLOOCTL_* environment variables
+	Env []string
+}
+
+// the glooctl flags that may precede the name of a plugin. They are not passed to the plugin as arguments, but as
+// environment variables.
+var pluginEnvFlags = map[string]string{
+	"-n":           constants.NamespaceEnvVar,
+	"--namespace":  constants.NamespaceEnvVar,
+	"--kubeconfig": constants.KubeConfigEnvVar,
+	"-o":           constants.OutputEnvVar,
+	"--output":     constants.OutputEnvVar,
+}
+
+// LookupPlugin finds the plugin that handles the given glooctl arguments, searching the directories in order.
+//
+// Like kubectl, the plugin for `glooctl foo bar --baz` is the first of glooctl-foo-bar and glooctl-foo that exists,
+// and it is invoked with the arguments that follow its name. The namespace, kubeconfig and output format of the
+// invocation are passed in the GLOOCTL_NAMESPACE, GLOOCTL_KUBECONFIG and GLOOCTL_OUTPUT environment variables.
+func LookupPlugin(dirs []string, args []string) (*Invocation, bool) {
+	env := map[string]string{
+		constants.NamespaceEnvVar:  defaults.GlooSystem,
+		constants.KubeConfigEnvVar: "",
+		constants.OutputEnvVar:     "",
+	}
+	for len(args) > 0 && strings.HasPrefix(args[0], "-") {
+		flag := strings.SplitN(args[0], "=", 2)
+		envVar, ok := pluginEnvFlags[flag[0]]
+		if !ok {
+			return nil, false
+		}
+		if len(flag) == 2 {
+			env[envVar] = flag[1]
+			args = args[1:]
+			continue
+		}
+		if len(args) < 2 {
+			return nil, false
+		}
+		env[envVar] = args[1]
+		args = args[2:]
+	}
+
+	var names []string
+	for _, arg := range args {
+		if strings.HasPrefix(arg, "-") {
+			break
+		}
+		names = append(names, strings.Replace(arg, "-", "_", -1))
+	}
+
+	for len(names) > 0 {
+		if path, ok := findExecutable(dirs, constants.PluginPrefix+strings.Join(names, "-")); ok {
+			invocation := &Invocation{
+				Path: path,
+				Args: append([]string{}, args[len(names):]...),
+			}
+			for _, envVar := range []string{constants.NamespaceEnvVar, constants.KubeConfigEnvVar, constants.OutputEnvVar} {
+				invocation.Env = append(invocation.Env, envVar+"="+env[envVar])
+			}
+			return invocation, true
+		}
+		names = names[:len(names)-1]
+	}
+	return nil, false
+}
+
+// Run runs the plugin with the standard streams and environment of glooctl, and returns its *exec.ExitError if it fails.
+func (i *Invocation) Run() error {
+	cmd := exec.Command(i.Path, i.Args...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = append(os.Environ(), i.Env...)
+	return cmd.Run()
+}
+
+func findExecutable(dirs []string, name string) (string, bool) {
+	for _, dir := range dirs {
+		for _, candidate := range executableNames(name) {
+			path := filepath.Join(dir, candidate)
+			if isExecutable(path) {
+				return path, true
+			}
+		}
+	}
+	return "", false
+}
+
+func executableNames(name string) []string {
+	if runtime.GOOS == "windows" {
+		return []string{name + ".exe", name}
+	}
+	return []string{name}
+}
+
+func isExecutable(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil || info.IsDir() {
+		return false
+	}
+	return runtime.GOOS == "windows" || info.Mode()&0111 != 0
+}
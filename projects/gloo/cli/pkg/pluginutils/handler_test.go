@@ -0,0 +1,101 @@
+package pluginutils_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/solo-io/gloo/projects/gloo/cli/pkg/pluginutils"
+)
+
+var _ = Describe("LookupPlugin", func() {
+
+	var (
+		first, second string
+	)
+
+	writePlugin := func(dir, name string, mode os.FileMode) string {
+		path := filepath.Join(dir, name)
+		Expect(ioutil.WriteFile(path, []byte("#!/bin/sh\n"), mode)).To(Succeed())
+		return path
+	}
+
+	BeforeEach(func() {
+		var err error
+		first, err = ioutil.TempDir("", "plugins")
+		Expect(err).NotTo(HaveOccurred())
+		second, err = ioutil.TempDir("", "path")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(first)
+		os.RemoveAll(second)
+	})
+
+	It("finds the longest matching plugin and passes it the remaining args", func() {
+		writePlugin(second, "glooctl-foo", 0755)
+		bar := writePlugin(second, "glooctl-foo-bar", 0755)
+
+		invocation, ok := LookupPlugin([]string{first, second}, []string{"foo", "bar", "baz", "--qux"})
+		Expect(ok).To(BeTrue())
+		Expect(invocation.Path).To(Equal(bar))
+		Expect(invocation.Args).To(Equal([]string{"baz", "--qux"}))
+	})
+
+	It("prefers the earlier directories", func() {
+		plugin := writePlugin(first, "glooctl-foo", 0755)
+		writePlugin(second, "glooctl-foo", 0755)
+
+		invocation, ok := LookupPlugin([]string{first, second}, []string{"foo"})
+		Expect(ok).To(BeTrue())
+		Expect(invocation.Path).To(Equal(plugin))
+	})
+
+	It("ignores files that are not executable", func() {
+		writePlugin(first, "glooctl-foo", 0644)
+
+		_, ok := LookupPlugin([]string{first, second}, []string{"foo"})
+		Expect(ok).To(BeFalse())
+	})
+
+	It("passes the glooctl options as environment variables", func() {
+		writePlugin(first, "glooctl-foo", 0755)
+
+		invocation, ok := LookupPlugin([]string{first}, []string{"--kubeconfig", "/kube/config", "-n", "gloo", "--output=yaml", "foo", "-n", "bar"})
+		Expect(ok).To(BeTrue())
+		Expect(invocation.Args).To(Equal([]string{"-n", "bar"}))
+		Expect(invocation.Env).To(Equal([]string{
+			"GLOOCTL_NAMESPACE=gloo",
+			"GLOOCTL_KUBECONFIG=/kube/config",
+			"GLOOCTL_OUTPUT=yaml",
+		}))
+	})
+
+	It("defaults the namespace to gloo-system", func() {
+		writePlugin(first, "glooctl-foo", 0755)
+
+		invocation, ok := LookupPlugin([]string{first}, []string{"foo"})
+		Expect(ok).To(BeTrue())
+		Expect(invocation.Env).To(ContainElement("GLOOCTL_NAMESPACE=gloo-system"))
+	})
+
+	It("does not handle unknown glooctl flags", func() {
+		writePlugin(first, "glooctl-foo", 0755)
+
+		_, ok := LookupPlugin([]string{first}, []string{"--unknown", "foo"})
+		Expect(ok).To(BeFalse())
+	})
+
+	It("runs the plugin with the environment variables", func() {
+		out := filepath.Join(first, "out")
+		Expect(ioutil.WriteFile(filepath.Join(first, "glooctl-foo"), []byte("#!/bin/sh\necho \"$GLOOCTL_NAMESPACE $@\" > "+out+"\n"), 0755)).To(Succeed())
+
+		invocation, ok := LookupPlugin([]string{first}, []string{"-n", "gloo", "foo", "bar"})
+		Expect(ok).To(BeTrue())
+		Expect(invocation.Run()).To(Succeed())
+		Expect(ioutil.ReadFile(out)).To(Equal([]byte("gloo bar\n")))
+	})
+})
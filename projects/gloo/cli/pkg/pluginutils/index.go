@@ -0,0 +1,111 @@
+package pluginutils
+
+import (
+	"io/ioutil"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/cliutil"
+	"sigs.k8s.io/yaml"
+)
+
+// Index lists the plugins that can be installed with `glooctl plugin install`. For example:
+//
+//	plugins:
+//	- name: fed
+//	  version: v1.0.0
+//	  description: Manage Gloo Federation
+//	  platforms:
+//	  - os: linux
+//	    arch: amd64
+//	    uri: https://example.com/glooctl-fed-linux-amd64
+//	    sha256: 0f3c...
+//
+// Relative platform uris are resolved against the location of the index.
+type Index struct {
+	Plugins []IndexPlugin `json:"plugins"`
+
+	// where the index was loaded from
+	uri string
+}
+
+type IndexPlugin struct {
+	Name        string     `json:"name"`
+	Version     string     `json:"version"`
+	Description string     `json:"description,omitempty"`
+	Platforms   []Platform `json:"platforms"`
+}
+
+// Platform is the build of a plugin for an operating system and architecture.
+type Platform struct {
+	Os     string `json:"os"`
+	Arch   string `json:"arch"`
+	Uri    string `json:"uri"`
+	Sha256 string `json:"sha256"`
+}
+
+// LoadIndex reads the index at the given http(s) address or file path.
+func LoadIndex(uri string) (*Index, error) {
+	if uri == "" {
+		return nil, eris.New("no plugin index configured, set --index or pluginIndex in the glooctl config file")
+	}
+	file, err := cliutil.GetResource(uri)
+	if err != nil {
+		return nil, eris.Wrapf(err, "reading plugin index %v", uri)
+	}
+	defer file.Close()
+	raw, err := ioutil.ReadAll(file)
+	if err != nil {
+		return nil, eris.Wrapf(err, "reading plugin index %v", uri)
+	}
+	index := &Index{uri: uri}
+	if err := yaml.Unmarshal(raw, index); err != nil {
+		return nil, eris.Wrapf(err, "parsing plugin index %v", uri)
+	}
+	return index, nil
+}
+
+// Find returns the plugin with the given name.
+func (i *Index) Find(name string) (*IndexPlugin, error) {
+	for idx := range i.Plugins {
+		if i.Plugins[idx].Name == name {
+			return &i.Plugins[idx], nil
+		}
+	}
+	return nil, eris.Errorf("plugin %v not found in index %v", name, i.uri)
+}
+
+// Platform returns the build of the plugin for the given operating system and architecture.
+func (p *IndexPlugin) Platform(os, arch string) (*Platform, error) {
+	for idx := range p.Platforms {
+		if p.Platforms[idx].Os == os && p.Platforms[idx].Arch == arch {
+			return &p.Platforms[idx], nil
+		}
+	}
+	return nil, eris.Errorf("plugin %v %v is not available for %v/%v", p.Name, p.Version, os, arch)
+}
+
+// resolve returns the absolute location of a uri found in the index.
+func (i *Index) resolve(uri string) (string, error) {
+	if isHttp(uri) || filepath.IsAbs(uri) {
+		return uri, nil
+	}
+	if isHttp(i.uri) {
+		base, err := url.Parse(i.uri)
+		if err != nil {
+			return "", err
+		}
+		ref, err := url.Parse(uri)
+		if err != nil {
+			return "", err
+		}
+		return base.ResolveReference(ref).String(), nil
+	}
+	return filepath.Join(filepath.Dir(i.uri), uri), nil
+}
+
+func isHttp(uri string) bool {
+	return strings.HasPrefix(uri, "http://") || strings.HasPrefix(uri, "https://")
+}
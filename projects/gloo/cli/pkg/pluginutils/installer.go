@@ -0,0 +1,198 @@
+package pluginutils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/cliutil"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
+	"sigs.k8s.io/yaml"
+)
+
+const receiptsDir = "receipts"
+
+// Receipt records a plugin installed by `glooctl plugin install`, so that it can be upgraded.
+type Receipt struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Index   string `json:"index"`
+	Sha256  string `json:"sha256"`
+}
+
+// Installer installs plugins from an index into a plugin directory, as glooctl-<name> executables.
+// Receipts of the installed plugins are kept in the receipts subdirectory.
+type Installer struct {
+	Dir  string
+	Os   string
+	Arch string
+}
+
+func NewInstaller(dir string) *Installer {
+	return &Installer{
+		Dir:  dir,
+		Os:   runtime.GOOS,
+		Arch: runtime.GOARCH,
+	}
+}
+
+// Install installs the plugin with the given name from the index. It fails if the plugin is already installed.
+func (i *Installer) Install(index *Index, name string) (*Receipt, error) {
+	if _, err := i.receipt(name); err == nil {
+		return nil, eris.Errorf("plugin %v is already installed, use `glooctl plugin upgrade %v` to upgrade it", name, name)
+	}
+	return i.install(index, name)
+}
+
+// Upgrade installs the version of the plugin found in the index, unless it is already installed.
+// It returns the new receipt, and whether the plugin changed.
+func (i *Installer) Upgrade(index *Index, name string) (*Receipt, bool, error) {
+	installed, err := i.receipt(name)
+	if err != nil {
+		return nil, false, err
+	}
+	plugin, err := index.Find(name)
+	if err != nil {
+		return nil, false, err
+	}
+	if plugin.Version == installed.Version {
+		return installed, false, nil
+	}
+	receipt, err := i.install(index, name)
+	return receipt, err == nil, err
+}
+
+// Remove removes an installed plugin.
+func (i *Installer) Remove(name string) error {
+	if _, err := i.receipt(name); err != nil {
+		return err
+	}
+	if err := os.Remove(i.executablePath(name)); err != nil && !os.IsNotExist(err) {
+		return eris.Wrapf(err, "removing plugin %v", name)
+	}
+	return os.Remove(i.receiptPath(name))
+}
+
+// Installed returns the receipts of the installed plugins, sorted by name.
+func (i *Installer) Installed() ([]*Receipt, error) {
+	files, err := ioutil.ReadDir(filepath.Join(i.Dir, receiptsDir))
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var receipts []*Receipt
+	for _, file := range files {
+		if file.IsDir() || filepath.Ext(file.Name()) != ".yaml" {
+			continue
+		}
+		receipt, err := i.receipt(strings.TrimSuffix(file.Name(), ".yaml"))
+		if err != nil {
+			return nil, err
+		}
+		receipts = append(receipts, receipt)
+	}
+	sort.Slice(receipts, func(a, b int) bool { return receipts[a].Name < receipts[b].Name })
+	return receipts, nil
+}
+
+func (i *Installer) install(index *Index, name string) (*Receipt, error) {
+	plugin, err := index.Find(name)
+	if err != nil {
+		return nil, err
+	}
+	platform, err := plugin.Platform(i.Os, i.Arch)
+	if err != nil {
+		return nil, err
+	}
+	if platform.Sha256 == "" {
+		return nil, eris.Errorf("plugin %v %v has no sha256 for %v/%v in the index", name, plugin.Version, i.Os, i.Arch)
+	}
+	uri, err := index.resolve(platform.Uri)
+	if err != nil {
+		return nil, eris.Wrapf(err, "resolving the uri of plugin %v", name)
+	}
+	if err := os.MkdirAll(filepath.Join(i.Dir, receiptsDir), 0755); err != nil {
+		return nil, err
+	}
+
+	// download next to the destination, so that the rename that installs the plugin is atomic
+	tmp, err := ioutil.TempFile(i.Dir, constants.PluginPrefix+name+"-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmp.Name())
+	sum, err := download(uri, tmp)
+	if err != nil {
+		return nil, eris.Wrapf(err, "downloading plugin %v from %v", name, uri)
+	}
+	if !strings.EqualFold(sum, platform.Sha256) {
+		return nil, eris.Errorf("sha256 of plugin %v is %v, expected %v", name, sum, platform.Sha256)
+	}
+	if err := os.Chmod(tmp.Name(), 0755); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmp.Name(), i.executablePath(name)); err != nil {
+		return nil, eris.Wrapf(err, "installing plugin %v", name)
+	}
+
+	receipt := &Receipt{
+		Name:    name,
+		Version: plugin.Version,
+		Index:   index.uri,
+		Sha256:  sum,
+	}
+	raw, err := yaml.Marshal(receipt)
+	if err != nil {
+		return nil, err
+	}
+	return receipt, ioutil.WriteFile(i.receiptPath(name), raw, 0644)
+}
+
+// download writes the resource to the file, and returns its sha256.
+func download(uri string, file *os.File) (string, error) {
+	defer file.Close()
+	resource, err := cliutil.GetResource(uri)
+	if err != nil {
+		return "", err
+	}
+	defer resource.Close()
+	hash := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(file, hash), resource); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), file.Close()
+}
+
+func (i *Installer) receipt(name string) (*Receipt, error) {
+	raw, err := ioutil.ReadFile(i.receiptPath(name))
+	if os.IsNotExist(err) {
+		return nil, eris.Errorf("plugin %v is not installed in %v", name, i.Dir)
+	} else if err != nil {
+		return nil, err
+	}
+	var receipt Receipt
+	if err := yaml.Unmarshal(raw, &receipt); err != nil {
+		return nil, eris.Wrapf(err, "reading the receipt of plugin %v", name)
+	}
+	return &receipt, nil
+}
+
+func (i *Installer) receiptPath(name string) string {
+	return filepath.Join(i.Dir, receiptsDir, name+".yaml")
+}
+
+func (i *Installer) executablePath(name string) string {
+	path := filepath.Join(i.Dir, constants.PluginPrefix+name)
+	if i.Os == "windows" {
+		path += ".exe"
+	}
+	return path
+}
@@ -0,0 +1,132 @@
+package pluginutils_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/solo-io/gloo/projects/gloo/cli/pkg/pluginutils"
+)
+
+var _ = Describe("Installer", func() {
+
+	var (
+		repo      string
+		installer *Installer
+	)
+
+	// writes a local index that serves the given version of the foo plugin
+	writeIndex := func(version string) *Index {
+		content := []byte("#!/bin/sh\necho " + version + "\n")
+		Expect(ioutil.WriteFile(filepath.Join(repo, "glooctl-foo-"+version), content, 0644)).To(Succeed())
+		sum := sha256.Sum256(content)
+		index := fmt.Sprintf(`plugins:
+- name: foo
+  version: %v
+  platforms:
+  - os: linux
+    arch: amd64
+    uri: glooctl-foo-%v
+    sha256: %v
+  - os: darwin
+    arch: amd64
+    uri: glooctl-foo-darwin
+    sha256: %v
+`, version, version, hex.EncodeToString(sum[:]), hex.EncodeToString(sum[:]))
+		path := filepath.Join(repo, "index.yaml")
+		Expect(ioutil.WriteFile(path, []byte(index), 0644)).To(Succeed())
+		loaded, err := LoadIndex(path)
+		Expect(err).NotTo(HaveOccurred())
+		return loaded
+	}
+
+	BeforeEach(func() {
+		var err error
+		repo, err = ioutil.TempDir("", "plugin-repo")
+		Expect(err).NotTo(HaveOccurred())
+		dir, err := ioutil.TempDir("", "plugins")
+		Expect(err).NotTo(HaveOccurred())
+		installer = &Installer{Dir: dir, Os: "linux", Arch: "amd64"}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(repo)
+		os.RemoveAll(installer.Dir)
+	})
+
+	It("installs a plugin from a local index", func() {
+		receipt, err := installer.Install(writeIndex("v1.0.0"), "foo")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(receipt.Version).To(Equal("v1.0.0"))
+
+		info, err := os.Stat(filepath.Join(installer.Dir, "glooctl-foo"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.Mode() & 0111).NotTo(BeZero())
+		installed, err := installer.Installed()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(installed).To(Equal([]*Receipt{receipt}))
+
+		_, ok := LookupPlugin([]string{installer.Dir}, []string{"foo"})
+		Expect(ok).To(BeTrue())
+	})
+
+	It("does not install a plugin twice", func() {
+		index := writeIndex("v1.0.0")
+		_, err := installer.Install(index, "foo")
+		Expect(err).NotTo(HaveOccurred())
+		_, err = installer.Install(index, "foo")
+		Expect(err).To(MatchError(ContainSubstring("already installed")))
+	})
+
+	It("rejects a plugin whose sha256 does not match the index", func() {
+		index := writeIndex("v1.0.0")
+		Expect(ioutil.WriteFile(filepath.Join(repo, "glooctl-foo-v1.0.0"), []byte("tampered"), 0644)).To(Succeed())
+
+		_, err := installer.Install(index, "foo")
+		Expect(err).To(MatchError(ContainSubstring("sha256 of plugin foo")))
+		_, err = os.Stat(filepath.Join(installer.Dir, "glooctl-foo"))
+		Expect(os.IsNotExist(err)).To(BeTrue())
+	})
+
+	It("fails for unknown plugins and platforms", func() {
+		index := writeIndex("v1.0.0")
+		_, err := installer.Install(index, "bar")
+		Expect(err).To(MatchError(ContainSubstring("plugin bar not found")))
+
+		installer.Arch = "arm64"
+		_, err = installer.Install(index, "foo")
+		Expect(err).To(MatchError(ContainSubstring("not available for linux/arm64")))
+	})
+
+	It("upgrades a plugin to the version of the index", func() {
+		_, err := installer.Install(writeIndex("v1.0.0"), "foo")
+		Expect(err).NotTo(HaveOccurred())
+
+		receipt, upgraded, err := installer.Upgrade(writeIndex("v1.0.0"), "foo")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(upgraded).To(BeFalse())
+
+		receipt, upgraded, err = installer.Upgrade(writeIndex("v1.1.0"), "foo")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(upgraded).To(BeTrue())
+		Expect(receipt.Version).To(Equal("v1.1.0"))
+		Expect(ioutil.ReadFile(filepath.Join(installer.Dir, "glooctl-foo"))).To(ContainSubstring("echo v1.1.0"))
+	})
+
+	It("removes a plugin", func() {
+		_, err := installer.Install(writeIndex("v1.0.0"), "foo")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(installer.Remove("foo")).To(Succeed())
+		_, err = os.Stat(filepath.Join(installer.Dir, "glooctl-foo"))
+		Expect(os.IsNotExist(err)).To(BeTrue())
+		Expect(installer.Installed()).To(BeEmpty())
+
+		Expect(installer.Remove("foo")).To(MatchError(ContainSubstring("plugin foo is not installed")))
+	})
+})
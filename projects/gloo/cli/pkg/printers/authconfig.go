@@ -16,6 +16,10 @@ func PrintAuthConfigs(authConfigs extauthv1.AuthConfigList, outputType OutputTyp
 	}
 	return cliutils.PrintList(outputType.String(), "", authConfigs,
 		func(data interface{}, w io.Writer) error {
+			if outputType == WIDE {
+				AuthConfigWide(data.(extauthv1.AuthConfigList), w)
+				return nil
+			}
 			AuthConfig(data.(extauthv1.AuthConfigList), w)
 			return nil
 		}, os.Stdout)
@@ -30,24 +34,7 @@ func AuthConfig(list extauthv1.AuthConfigList, w io.Writer) {
 		var authTypes []string
 		name := authConfig.GetMetadata().Name
 		for _, conf := range authConfig.Configs {
-			var authType string
-			switch conf.AuthConfig.(type) {
-			case *extauthv1.AuthConfig_Config_BasicAuth:
-				authType = "Basic Auth"
-			case *extauthv1.AuthConfig_Config_Oauth:
-				authType = "Oauth"
-			case *extauthv1.AuthConfig_Config_ApiKeyAuth:
-				authType = "ApiKey"
-			case *extauthv1.AuthConfig_Config_PluginAuth:
-				authType = "Plugin"
-			case *extauthv1.AuthConfig_Config_OpaAuth:
-				authType = "OPA"
-			case *extauthv1.AuthConfig_Config_Ldap:
-				authType = "LDAP"
-			default:
-				authType = "unknown"
-			}
-			authTypes = append(authTypes, authType)
+			authTypes = append(authTypes, authConfigType(conf))
 		}
 		if len(authTypes) == 0 {
 			authTypes = []string{"N/A"}
@@ -58,3 +45,46 @@ func AuthConfig(list extauthv1.AuthConfigList, w io.Writer) {
 	table.SetAlignment(tablewriter.ALIGN_LEFT)
 	table.Render()
 }
+
+// prints AuthConfigs along with their full statuses using tables to io.Writer
+func AuthConfigWide(list extauthv1.AuthConfigList, w io.Writer) {
+	table := tablewriter.NewWriter(w)
+	table.SetHeader([]string{"AuthConfig", "Type", "Status"})
+
+	for _, authConfig := range list {
+		var authTypes []string
+		for _, conf := range authConfig.Configs {
+			authTypes = append(authTypes, authConfigType(conf))
+		}
+		if len(authTypes) == 0 {
+			authTypes = []string{"N/A"}
+		}
+		appendRows(table,
+			[]string{authConfig.GetMetadata().Name},
+			[]string{strings.Join(authTypes, ",")},
+			statusDetails(authConfig.Status, true),
+		)
+	}
+
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.Render()
+}
+
+func authConfigType(conf *extauthv1.AuthConfig_Config) string {
+	switch conf.AuthConfig.(type) {
+	case *extauthv1.AuthConfig_Config_BasicAuth:
+		return "Basic Auth"
+	case *extauthv1.AuthConfig_Config_Oauth:
+		return "Oauth"
+	case *extauthv1.AuthConfig_Config_ApiKeyAuth:
+		return "ApiKey"
+	case *extauthv1.AuthConfig_Config_PluginAuth:
+		return "Plugin"
+	case *extauthv1.AuthConfig_Config_OpaAuth:
+		return "OPA"
+	case *extauthv1.AuthConfig_Config_Ldap:
+		return "LDAP"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,108 @@
+package printers
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/olekukonko/tablewriter"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/go-utils/cliutils"
+)
+
+func PrintGateways(gateways v1.GatewayList, outputType OutputType) error {
+	if outputType == KUBE_YAML {
+		return PrintKubeCrdList(gateways.AsInputResources(), v1.GatewayCrd)
+	}
+	return cliutils.PrintList(outputType.String(), "", gateways,
+		func(data interface{}, w io.Writer) error {
+			if outputType == WIDE {
+				GatewayWideTable(data.(v1.GatewayList), w)
+				return nil
+			}
+			GatewayTable(data.(v1.GatewayList), w)
+			return nil
+		}, os.Stdout)
+}
+
+// PrintTable prints gateways using tables to io.Writer
+func GatewayTable(list []*v1.Gateway, w io.Writer) {
+	table := tablewriter.NewWriter(w)
+	table.SetHeader([]string{"Gateway", "Type", "Address", "SSL", "Proxies", "Virtual Services", "Status"})
+
+	for _, gw := range list {
+		name := gw.GetMetadata().Name
+		address := fmt.Sprintf("%v:%v", gw.GetBindAddress(), gw.GetBindPort())
+		ssl := fmt.Sprint(gw.GetSsl())
+		proxies := strings.Join(gw.GetProxyNames(), ", ")
+		status := gw.Status.State.String()
+		gatewayType, details := gatewayDetails(gw)
+
+		if len(details) == 0 {
+			details = []string{""}
+		}
+		for i, line := range details {
+			if i == 0 {
+				table.Append([]string{name, gatewayType, address, ssl, proxies, line, status})
+			} else {
+				table.Append([]string{"", "", "", "", "", line, ""})
+			}
+		}
+	}
+
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.Render()
+}
+
+// GatewayWideTable prints gateways along with their full statuses
+func GatewayWideTable(list []*v1.Gateway, w io.Writer) {
+	table := tablewriter.NewWriter(w)
+	table.SetHeader([]string{"Gateway", "Type", "Address", "SSL", "Proxies", "Virtual Services", "Status"})
+
+	for _, gw := range list {
+		gatewayType, details := gatewayDetails(gw)
+		appendRows(table,
+			[]string{gw.GetMetadata().Name},
+			[]string{gatewayType},
+			[]string{fmt.Sprintf("%v:%v", gw.GetBindAddress(), gw.GetBindPort())},
+			[]string{fmt.Sprint(gw.GetSsl())},
+			gw.GetProxyNames(),
+			details,
+			statusDetails(gw.Status, true),
+		)
+	}
+
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.Render()
+}
+
+func gatewayDetails(gw *v1.Gateway) (string, []string) {
+	switch gatewayType := gw.GetGatewayType().(type) {
+	case *v1.Gateway_TcpGateway:
+		return "tcp", nil
+	case *v1.Gateway_HttpGateway:
+		http := gatewayType.HttpGateway
+		var details []string
+		for _, ref := range http.GetVirtualServices() {
+			details = append(details, ref.Key())
+		}
+		if len(http.GetVirtualServiceSelector()) > 0 {
+			var labels []string
+			for k, v := range http.GetVirtualServiceSelector() {
+				labels = append(labels, k+"="+v)
+			}
+			sort.Strings(labels)
+			details = append(details, "selector: "+strings.Join(labels, ","))
+		}
+		if len(http.GetVirtualServiceNamespaces()) > 0 {
+			details = append(details, "namespaces: "+strings.Join(http.GetVirtualServiceNamespaces(), ","))
+		}
+		if len(details) == 0 {
+			details = []string{"*"}
+		}
+		return "http", details
+	}
+	return "", nil
+}
@@ -0,0 +1,66 @@
+package printers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/olekukonko/tablewriter"
+	"github.com/solo-io/gloo/projects/gateway/pkg/validation"
+)
+
+func PrintImpactPreview(preview *validation.ImpactPreview, outputType OutputType, w io.Writer) error {
+	switch outputType {
+	case JSON:
+		data, err := json.MarshalIndent(preview, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(data))
+		return err
+	case YAML, KUBE_YAML:
+		data, err := yaml.Marshal(preview)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprint(w, string(data))
+		return err
+	}
+	ImpactPreview(preview, w)
+	return nil
+}
+
+// prints the changes to each proxy using tables to io.Writer
+func ImpactPreview(preview *validation.ImpactPreview, w io.Writer) {
+	if len(preview.Proxies) == 0 {
+		fmt.Fprintln(w, "No proxies would be changed")
+		return
+	}
+
+	table := tablewriter.NewWriter(w)
+	table.SetHeader([]string{"Proxy", "Config", "Type", "Name", "Change"})
+
+	for _, impact := range preview.Proxies {
+		proxy := impact.Proxy.Key()
+		for _, change := range impact.ProxyChanges {
+			table.Append([]string{proxy, "proxy", change.Type, change.Name, string(change.Change)})
+		}
+		for _, change := range impact.XdsChanges {
+			table.Append([]string{proxy, "envoy", change.Type, change.Name, string(change.Change)})
+		}
+	}
+
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.Render()
+
+	for _, impact := range preview.Proxies {
+		if len(impact.Errors) > 0 {
+			fmt.Fprintf(w, "Errors on %v:\n  %v\n", impact.Proxy.Key(), strings.Join(impact.Errors, "\n  "))
+		}
+		if len(impact.Warnings) > 0 {
+			fmt.Fprintf(w, "Warnings on %v:\n  %v\n", impact.Proxy.Key(), strings.Join(impact.Warnings, "\n  "))
+		}
+	}
+}
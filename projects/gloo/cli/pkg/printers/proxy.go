@@ -17,6 +17,10 @@ func PrintProxies(proxies v1.ProxyList, outputType OutputType) error {
 	}
 	return cliutils.PrintList(outputType.String(), "", proxies,
 		func(data interface{}, w io.Writer) error {
+			if outputType == WIDE {
+				ProxyWideTable(data.(v1.ProxyList), w)
+				return nil
+			}
 			ProxyTable(data.(v1.ProxyList), w)
 			return nil
 		}, os.Stdout)
@@ -57,3 +61,29 @@ func ProxyTable(list v1.ProxyList, w io.Writer) {
 	table.SetAlignment(tablewriter.ALIGN_LEFT)
 	table.Render()
 }
+
+// ProxyWideTable prints proxies along with their full statuses, including the statuses of their routes
+func ProxyWideTable(list v1.ProxyList, w io.Writer) {
+	table := tablewriter.NewWriter(w)
+	table.SetHeader([]string{"Proxy", "Listeners", "Virtual Hosts", "Status"})
+
+	for _, proxy := range list {
+		var (
+			listeners []string
+			vhCount   int
+		)
+		for _, listener := range proxy.Listeners {
+			listeners = append(listeners, fmt.Sprintf("%v (%v:%v)", listener.Name, listener.BindAddress, listener.BindPort))
+			vhCount += len(listener.GetHttpListener().GetVirtualHosts())
+		}
+		appendRows(table,
+			[]string{proxy.GetMetadata().Name},
+			listeners,
+			[]string{strconv.Itoa(vhCount)},
+			statusDetails(proxy.Status, true),
+		)
+	}
+
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.Render()
+}
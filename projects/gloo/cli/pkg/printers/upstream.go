@@ -5,6 +5,7 @@ import (
 	"io"
 	"os"
 	"sort"
+	"strconv"
 
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/xdsinspection"
 	plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
@@ -21,6 +22,10 @@ func PrintUpstreams(upstreams v1.UpstreamList, outputType OutputType, xdsDump *x
 	}
 	return cliutils.PrintList(outputType.String(), "", upstreams,
 		func(data interface{}, w io.Writer) error {
+			if outputType == WIDE {
+				UpstreamWideTable(xdsDump, data.(v1.UpstreamList), w)
+				return nil
+			}
 			UpstreamTable(xdsDump, data.(v1.UpstreamList), w)
 			return nil
 		}, os.Stdout)
@@ -55,6 +60,25 @@ func UpstreamTable(xdsDump *xdsinspection.XdsDump, upstreams []*v1.Upstream, w i
 	table.Render()
 }
 
+// UpstreamWideTable prints upstreams along with their full statuses and the number of functions discovered on them
+func UpstreamWideTable(xdsDump *xdsinspection.XdsDump, upstreams []*v1.Upstream, w io.Writer) {
+	table := tablewriter.NewWriter(w)
+	table.SetHeader([]string{"Upstream", "type", "status", "functions", "details"})
+
+	for _, us := range upstreams {
+		appendRows(table,
+			[]string{us.GetMetadata().Name},
+			[]string{upstreamType(us)},
+			statusDetails(us.Status, true),
+			[]string{strconv.Itoa(upstreamFunctionCount(us))},
+			upstreamDetails(us, xdsDump),
+		)
+	}
+
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.Render()
+}
+
 func upstreamType(up *v1.Upstream) string {
 	if up == nil {
 		return "Invalid"
@@ -69,6 +93,8 @@ func upstreamType(up *v1.Upstream) string {
 		return "Consul"
 	case *v1.Upstream_AwsEc2:
 		return "AWS EC2"
+	case *v1.Upstream_AwsEcs:
+		return "AWS ECS"
 	case *v1.Upstream_Kube:
 		return "Kubernetes"
 	case *v1.Upstream_Static:
@@ -117,6 +143,15 @@ func upstreamDetails(up *v1.Upstream, xdsDump *xdsinspection.XdsDump) []string {
 		add(
 			instances...,
 		)
+	case *v1.Upstream_AwsEcs:
+		add(
+			fmt.Sprintf("region:         %v", usType.AwsEcs.Region),
+			fmt.Sprintf("role:           %v", usType.AwsEcs.RoleArn),
+			fmt.Sprintf("cluster:        %v", usType.AwsEcs.Cluster),
+			fmt.Sprintf("service:        %v", usType.AwsEcs.ServiceName),
+			fmt.Sprintf("container:      %v", usType.AwsEcs.ContainerName),
+			fmt.Sprintf("container port: %v", usType.AwsEcs.ContainerPort),
+		)
 	case *v1.Upstream_Azure:
 		var functions []string
 		for _, fn := range usType.Azure.Functions {
@@ -16,6 +16,10 @@ func PrintUpstreamGroups(upstreamGroups v1.UpstreamGroupList, outputType OutputT
 	}
 	return cliutils.PrintList(outputType.String(), "", upstreamGroups,
 		func(data interface{}, w io.Writer) error {
+			if outputType == WIDE {
+				UpstreamGroupWideTable(data.(v1.UpstreamGroupList), w)
+				return nil
+			}
 			UpstreamGroupTable(data.(v1.UpstreamGroupList), w)
 			return nil
 		}, os.Stdout)
@@ -53,6 +57,24 @@ func UpstreamGroupTable(upstreamGroups []*v1.UpstreamGroup, w io.Writer) {
 	table.Render()
 }
 
+// UpstreamGroupWideTable prints upstream groups along with their full statuses
+func UpstreamGroupWideTable(upstreamGroups []*v1.UpstreamGroup, w io.Writer) {
+	table := tablewriter.NewWriter(w)
+	table.SetHeader([]string{"Upstream Group", "status", "total weight", "details"})
+
+	for _, ug := range upstreamGroups {
+		appendRows(table,
+			[]string{ug.GetMetadata().Name},
+			statusDetails(ug.Status, true),
+			[]string{fmt.Sprint(totalWeight(ug))},
+			upstreamGroupDetails(ug),
+		)
+	}
+
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.Render()
+}
+
 func totalWeight(ug *v1.UpstreamGroup) uint32 {
 	weight := uint32(0)
 	for _, us := range ug.Destinations {
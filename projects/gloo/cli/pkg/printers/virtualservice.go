@@ -7,8 +7,10 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/solo-io/gloo/projects/gateway/pkg/reporting"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
 	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
@@ -27,6 +29,10 @@ func PrintVirtualServices(virtualServices v1.VirtualServiceList, outputType Outp
 	}
 	return cliutils.PrintList(outputType.String(), "", virtualServices,
 		func(data interface{}, w io.Writer) error {
+			if outputType == WIDE {
+				VirtualServiceWideTable(data.(v1.VirtualServiceList), w)
+				return nil
+			}
 			VirtualServiceTable(data.(v1.VirtualServiceList), w, namespace)
 			return nil
 		}, os.Stdout)
@@ -38,6 +44,10 @@ func PrintRouteTables(routeTables v1.RouteTableList, outputType OutputType) erro
 	}
 	return cliutils.PrintList(outputType.String(), "", routeTables,
 		func(data interface{}, w io.Writer) error {
+			if outputType == WIDE {
+				RouteTableWideTable(data.(v1.RouteTableList), w)
+				return nil
+			}
 			RouteTableTable(data.(v1.RouteTableList), w)
 			return nil
 		}, os.Stdout)
@@ -54,7 +64,7 @@ func VirtualServiceTable(list []*v1.VirtualService, w io.Writer, namespace strin
 		domains := domains(v)
 		ssl := sslConfig(v)
 		status := getStatus(v, namespace)
-		routes := routeList(v.GetVirtualHost().GetRoutes())
+		routes := routeList(v.GetVirtualHost().GetRoutes(), v.Status.SubresourceStatuses)
 		plugins := vhPlugins(v)
 
 		if len(routes) == 0 {
@@ -74,6 +84,27 @@ func VirtualServiceTable(list []*v1.VirtualService, w io.Writer, namespace strin
 	table.Render()
 }
 
+// VirtualServiceWideTable prints virtual services along with their full statuses and the proxies they were applied to
+func VirtualServiceWideTable(list []*v1.VirtualService, w io.Writer) {
+	table := tablewriter.NewWriter(w)
+	table.SetHeader([]string{"Virtual Service", "Display Name", "Domains", "SSL", "Proxies", "Status", "Routes"})
+
+	for _, v := range list {
+		appendRows(table,
+			[]string{v.GetMetadata().Name},
+			[]string{v.GetDisplayName()},
+			v.GetVirtualHost().GetDomains(),
+			[]string{sslConfig(v)},
+			owningProxies(v.Status),
+			statusDetails(v.Status, false),
+			routeList(v.GetVirtualHost().GetRoutes(), v.Status.SubresourceStatuses),
+		)
+	}
+
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.Render()
+}
+
 // PrintTable prints virtual services using tables to io.Writer
 func RouteTableTable(list []*v1.RouteTable, w io.Writer) {
 	table := tablewriter.NewWriter(w)
@@ -81,7 +112,7 @@ func RouteTableTable(list []*v1.RouteTable, w io.Writer) {
 
 	for _, rt := range list {
 		name := rt.GetMetadata().Name
-		routes := routeList(rt.GetRoutes())
+		routes := routeList(rt.GetRoutes(), rt.Status.SubresourceStatuses)
 		status := getRouteTableStatus(rt)
 
 		if len(routes) == 0 {
@@ -100,6 +131,29 @@ func RouteTableTable(list []*v1.RouteTable, w io.Writer) {
 	table.Render()
 }
 
+// RouteTableWideTable prints route tables along with their full statuses and the proxies they were applied to
+func RouteTableWideTable(list []*v1.RouteTable, w io.Writer) {
+	table := tablewriter.NewWriter(w)
+	table.SetHeader([]string{"Route Table", "Weight", "Proxies", "Status", "Routes"})
+
+	for _, rt := range list {
+		var weight string
+		if rt.GetWeight() != nil {
+			weight = strconv.Itoa(int(rt.GetWeight().GetValue()))
+		}
+		appendRows(table,
+			[]string{rt.GetMetadata().Name},
+			[]string{weight},
+			owningProxies(rt.Status),
+			statusDetails(rt.Status, false),
+			routeList(rt.GetRoutes(), rt.Status.SubresourceStatuses),
+		)
+	}
+
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.Render()
+}
+
 func getRouteTableStatus(vs *v1.RouteTable) string {
 
 	// If the virtual service has not yet been accepted, don't clutter the status with the other errors.
@@ -113,9 +167,10 @@ func getRouteTableStatus(vs *v1.RouteTable) string {
 	// In the future, we may add more.
 	// Either way, we only care if a subresource is in a non-accepted state.
 	// Therefore, only report non-accepted states, include the subresource name.
+	// Route statuses are listed with the routes.
 	subResourceErrorMessages := []string{}
 	for k, v := range vs.Status.SubresourceStatuses {
-		if v.State != core.Status_Accepted {
+		if v.State != core.Status_Accepted && !validation.IsRouteStatusKey(k) {
 			subResourceErrorMessages = append(subResourceErrorMessages, fmt.Sprintf("%v %v: %v", k, v.State.String(), v.Reason))
 		}
 	}
@@ -159,7 +214,7 @@ func getStatus(res resources.InputResource, namespace string) string {
 			}
 		}
 		for k, v := range subresourceStatuses {
-			if v.State != core.Status_Accepted {
+			if v.State != core.Status_Accepted && !validation.IsRouteStatusKey(k) {
 				return resourceStatus.String() + "\n" + genericSubResourceMessage(k, v.State.String())
 			}
 		}
@@ -167,9 +222,10 @@ func getStatus(res resources.InputResource, namespace string) string {
 	}
 
 	// Only report non-accepted states on subresources, include the subresource name.
+	// Route statuses are listed with the routes.
 	subResourceErrorMessages := []string{}
 	for k, v := range subresourceStatuses {
-		if v.State != core.Status_Accepted {
+		if v.State != core.Status_Accepted && !validation.IsRouteStatusKey(k) {
 			subResourceErrorMessages = append(subResourceErrorMessages, fmt.Sprintf("%v %v: %v", k, v.State.String(), v.Reason))
 		}
 	}
@@ -206,17 +262,26 @@ func cleanSubResourceError(eMsg string) string {
 	return eMsg
 }
 
-func routeList(routeList []*v1.Route) []string {
+// lists each route, followed by its errors and warnings if it was not accepted
+func routeList(routeList []*v1.Route, subresourceStatuses map[string]*core.Status) []string {
 	if len(routeList) == 0 {
 		return nil
 	}
 	var routes []string
-	for _, route := range routeList {
+	for i, route := range routeList {
 		var namePrefix string
 		if route.Name != "" {
 			namePrefix = route.Name + ": "
 		}
 		routes = append(routes, fmt.Sprintf("%s%v -> %v", namePrefix, matchersString(route.Matchers), destinationString(route)))
+
+		status, ok := subresourceStatuses[reporting.RouteStatusKey(route, i)]
+		if !ok || status.State == core.Status_Accepted {
+			continue
+		}
+		for _, reason := range strings.Split(status.Reason, "\n") {
+			routes = append(routes, fmt.Sprintf("  %v: %v", status.State.String(), reason))
+		}
 	}
 	return routes
 }
@@ -218,3 +218,41 @@ var _ = Describe("getStatus", func() {
 		}
 	})
 })
+
+var _ = Describe("routeList", func() {
+	It("lists the errors and warnings of routes which were not accepted", func() {
+		routes := []*v1.Route{{Name: "named"}, {}, {}}
+		statuses := map[string]*core.Status{
+			"*v1.Proxy.gloo-system.gateway-proxy": {State: core.Status_Rejected, Reason: "proxy reason"},
+			"*v1.Route.named": {
+				State:  core.Status_Rejected,
+				Reason: "Route Error: ProcessingError. Reason: bad\nRoute Warning: InvalidDestinationWarning. Reason: no upstream",
+			},
+			"*v1.Route.routes[1]": {State: core.Status_Accepted},
+			"*v1.Route.routes[2]": {State: core.Status_Warning, Reason: "Route Warning: InvalidDestinationWarning. Reason: no upstream"},
+		}
+		Expect(routeList(routes, statuses)).To(Equal([]string{
+			"named:  -> ",
+			"  Rejected: Route Error: ProcessingError. Reason: bad",
+			"  Rejected: Route Warning: InvalidDestinationWarning. Reason: no upstream",
+			" -> ",
+			" -> ",
+			"  Warning: Route Warning: InvalidDestinationWarning. Reason: no upstream",
+		}))
+	})
+
+	It("doesn't report route statuses as subresource errors", func() {
+		vs := &v1.VirtualService{
+			Status: core.Status{
+				State: core.Status_Rejected,
+				SubresourceStatuses: map[string]*core.Status{
+					"*v1.Route.routes[0]": {State: core.Status_Rejected, Reason: "bad route"},
+				},
+			},
+		}
+		Expect(getStatus(vs, "gloo-system")).To(Equal(core.Status_Rejected.String()))
+		rt := &v1.RouteTable{Status: vs.Status}
+		rt.Status.State = core.Status_Accepted
+		Expect(getRouteTableStatus(rt)).To(Equal(core.Status_Accepted.String()))
+	})
+})
@@ -0,0 +1,109 @@
+package printers
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/olekukonko/tablewriter"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// the gateway reports the status of each proxy generated from a resource as a subresource status with this prefix
+var proxyStatusKeyPrefix = fmt.Sprintf("%T.", (*v1.Proxy)(nil))
+
+// statusDetails lists the state and reason of a status, followed by the state and reason of each of its subresources.
+// Route statuses are skipped unless includeRouteStatuses is set, as they are usually listed with the routes.
+func statusDetails(status core.Status, includeRouteStatuses bool) []string {
+	details := []string{status.State.String()}
+	if status.Reason != "" {
+		details = append(details, reasonLines("  ", status.Reason)...)
+	}
+	if status.ReportedBy != "" {
+		details = append(details, fmt.Sprintf("reported by: %v", status.ReportedBy))
+	}
+
+	var keys []string
+	for key := range status.SubresourceStatuses {
+		if !includeRouteStatuses && validation.IsRouteStatusKey(key) {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		subresourceStatus := status.SubresourceStatuses[key]
+		details = append(details, fmt.Sprintf("%v: %v", key, subresourceStatus.GetState().String()))
+		if subresourceStatus.GetReason() != "" {
+			details = append(details, reasonLines("  ", subresourceStatus.GetReason())...)
+		}
+	}
+	return details
+}
+
+func reasonLines(indent, reason string) []string {
+	var lines []string
+	for _, line := range strings.Split(strings.TrimSpace(reason), "\n") {
+		lines = append(lines, indent+line)
+	}
+	return lines
+}
+
+// owningProxies lists the proxies which the gateway reported the resource on
+func owningProxies(status core.Status) []string {
+	var proxies []string
+	for key := range status.SubresourceStatuses {
+		if strings.HasPrefix(key, proxyStatusKeyPrefix) {
+			proxies = append(proxies, strings.TrimPrefix(key, proxyStatusKeyPrefix))
+		}
+	}
+	sort.Strings(proxies)
+	return proxies
+}
+
+// upstreamFunctionCount is the number of functions discovered (or configured) on an upstream
+func upstreamFunctionCount(us *v1.Upstream) int {
+	switch usType := us.GetUpstreamType().(type) {
+	case *v1.Upstream_Aws:
+		return len(usType.Aws.GetLambdaFunctions())
+	case *v1.Upstream_Azure:
+		return len(usType.Azure.GetFunctions())
+	case v1.ServiceSpecGetter:
+		switch spec := usType.GetServiceSpec().GetPluginType().(type) {
+		case *plugins.ServiceSpec_Rest:
+			return len(spec.Rest.GetTransformations())
+		case *plugins.ServiceSpec_Grpc:
+			var count int
+			for _, grpcService := range spec.Grpc.GetGrpcServices() {
+				count += len(grpcService.GetFunctionNames())
+			}
+			return count
+		}
+	}
+	return 0
+}
+
+// appendRows appends a row for each line of the tallest cell, leaving the other cells empty once they run out of lines
+func appendRows(table *tablewriter.Table, cells ...[]string) {
+	var rows int
+	for _, cell := range cells {
+		if len(cell) > rows {
+			rows = len(cell)
+		}
+	}
+	if rows == 0 {
+		rows = 1
+	}
+	for i := 0; i < rows; i++ {
+		row := make([]string, len(cells))
+		for j, cell := range cells {
+			if i < len(cell) {
+				row[j] = cell[i]
+			}
+		}
+		table.Append(row)
+	}
+}
@@ -0,0 +1,119 @@
+package printers
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/transformation"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/rest"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("wide output", func() {
+
+	status := core.Status{
+		State:      core.Status_Warning,
+		Reason:     "warning one\nwarning two",
+		ReportedBy: "gateway",
+		SubresourceStatuses: map[string]*core.Status{
+			"*v1.Proxy.gloo-system.public":  {State: core.Status_Accepted},
+			"*v1.Proxy.gloo-system.private": {State: core.Status_Rejected, Reason: "bad listener"},
+			"*v1.Route.my-route":            {State: core.Status_Warning, Reason: "route warning"},
+		},
+	}
+
+	Context("statusDetails", func() {
+
+		It("lists the reason and subresource statuses", func() {
+			Expect(statusDetails(status, false)).To(Equal([]string{
+				"Warning",
+				"  warning one",
+				"  warning two",
+				"reported by: gateway",
+				"*v1.Proxy.gloo-system.private: Rejected",
+				"  bad listener",
+				"*v1.Proxy.gloo-system.public: Accepted",
+			}))
+		})
+
+		It("includes route statuses when asked to", func() {
+			Expect(statusDetails(status, true)).To(ContainElements(
+				"*v1.Route.my-route: Warning",
+				"  route warning",
+			))
+		})
+
+		It("only lists the state of a plain status", func() {
+			Expect(statusDetails(core.Status{State: core.Status_Accepted}, true)).To(Equal([]string{"Accepted"}))
+		})
+	})
+
+	It("lists the owning proxies", func() {
+		Expect(owningProxies(status)).To(Equal([]string{"gloo-system.private", "gloo-system.public"}))
+		Expect(owningProxies(core.Status{})).To(BeEmpty())
+	})
+
+	Context("upstreamFunctionCount", func() {
+
+		It("counts lambda functions", func() {
+			us := &gloov1.Upstream{UpstreamType: &gloov1.Upstream_Aws{Aws: &aws.UpstreamSpec{
+				LambdaFunctions: []*aws.LambdaFunctionSpec{{LambdaFunctionName: "a"}, {LambdaFunctionName: "b"}},
+			}}}
+			Expect(upstreamFunctionCount(us)).To(Equal(2))
+		})
+
+		It("counts rest functions", func() {
+			us := &gloov1.Upstream{UpstreamType: &gloov1.Upstream_Kube{Kube: &kubernetes.UpstreamSpec{
+				ServiceSpec: &plugins.ServiceSpec{PluginType: &plugins.ServiceSpec_Rest{Rest: &rest.ServiceSpec{
+					Transformations: map[string]*transformation.TransformationTemplate{"a": {}, "b": {}, "c": {}},
+				}}},
+			}}}
+			Expect(upstreamFunctionCount(us)).To(Equal(3))
+		})
+
+		It("counts grpc functions across services", func() {
+			us := &gloov1.Upstream{UpstreamType: &gloov1.Upstream_Static{Static: &static.UpstreamSpec{
+				ServiceSpec: &plugins.ServiceSpec{PluginType: &plugins.ServiceSpec_Grpc{Grpc: &grpc.ServiceSpec{
+					GrpcServices: []*grpc.ServiceSpec_GrpcService{
+						{FunctionNames: []string{"a", "b"}},
+						{FunctionNames: []string{"c"}},
+					},
+				}}},
+			}}}
+			Expect(upstreamFunctionCount(us)).To(Equal(3))
+		})
+
+		It("is zero without a service spec", func() {
+			us := &gloov1.Upstream{UpstreamType: &gloov1.Upstream_Static{Static: &static.UpstreamSpec{}}}
+			Expect(upstreamFunctionCount(us)).To(Equal(0))
+		})
+	})
+
+	It("prints the status and proxies of virtual services", func() {
+		vs := &v1.VirtualService{
+			Metadata: core.Metadata{Name: "vs", Namespace: "gloo-system"},
+			VirtualHost: &v1.VirtualHost{
+				Domains: []string{"a.com", "b.com"},
+			},
+			Status: core.Status{
+				State: core.Status_Accepted,
+				SubresourceStatuses: map[string]*core.Status{
+					"*v1.Proxy.gloo-system.gateway-proxy": {State: core.Status_Accepted},
+				},
+			},
+		}
+		out := &bytes.Buffer{}
+		VirtualServiceWideTable([]*v1.VirtualService{vs}, out)
+		Expect(out.String()).To(ContainSubstring("| VIRTUAL SERVICE | DISPLAY NAME | DOMAINS | SSL  |          PROXIES          |"))
+		Expect(out.String()).To(ContainSubstring("| vs              |              | a.com   | none | gloo-system.gateway-proxy | Accepted "))
+		Expect(out.String()).To(ContainSubstring("| *v1.Proxy.gloo-system.gateway-proxy: |"))
+	})
+})
@@ -0,0 +1,58 @@
+package surveyutils
+
+import (
+	"fmt"
+
+	"github.com/solo-io/gloo/pkg/cliutil"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+)
+
+func AddGatewayFlagsInteractive(gw *options.InputGateway) error {
+	if err := cliutil.GetStringInputDefault("the bind address the gateway should serve traffic on: ", &gw.BindAddress, gw.BindAddress); err != nil {
+		return err
+	}
+	if err := cliutil.GetUint32InputDefault("the port the gateway should serve traffic on: ", &gw.BindPort, gw.BindPort); err != nil {
+		return err
+	}
+	if err := cliutil.GetBoolInputDefault("create a tcp gateway rather than an http gateway?", &gw.Tcp, gw.Tcp); err != nil {
+		return err
+	}
+	if err := cliutil.GetBoolInputDefault("only serve virtual services with ssl configured?", &gw.Ssl, gw.Ssl); err != nil {
+		return err
+	}
+	if err := cliutil.GetBoolInputDefault("enable the proxy protocol on the gateway's listener?", &gw.UseProxyProto, gw.UseProxyProto); err != nil {
+		return err
+	}
+
+	var proxyNames []string
+	proxyNamesMsgProvider := func() string {
+		return fmt.Sprintf("Add a proxy to apply the gateway to (empty defaults to %v)? Current proxies %v", gw.ProxyNames, proxyNames)
+	}
+	if err := cliutil.GetStringSliceInputLazyPrompt(proxyNamesMsgProvider, &proxyNames); err != nil {
+		return err
+	}
+	if len(proxyNames) > 0 {
+		gw.ProxyNames = proxyNames
+	}
+
+	if gw.Tcp {
+		return nil
+	}
+
+	selectorMsgProvider := func() string {
+		return fmt.Sprintf("Add a label (key=value) to select the virtual services to serve (empty selects all virtual services)? "+
+			"Current labels %v", gw.VirtualServiceSelector.Entries)
+	}
+	if err := cliutil.GetStringSliceInputLazyPrompt(selectorMsgProvider, &gw.VirtualServiceSelector.Entries); err != nil {
+		return err
+	}
+	namespacesMsgProvider := func() string {
+		return fmt.Sprintf("Add a namespace to search for virtual services (empty searches all namespaces)? "+
+			"Current namespaces %v", gw.VirtualServiceNamespaces)
+	}
+	if err := cliutil.GetStringSliceInputLazyPrompt(namespacesMsgProvider, &gw.VirtualServiceNamespaces); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -225,6 +225,28 @@ func getRestDestinationSpecInteractive(spec *options.RestDestinationSpec, restSp
 }
 
 func AddRouteFlagsInteractive(opts *options.Options) error {
+	if opts.Add.Route.AddToRouteTable {
+		if err := selectRouteTableToAddToInteractive(opts); err != nil {
+			return err
+		}
+	} else if err := selectVirtualServiceToAddToInteractive(opts); err != nil {
+		return err
+	}
+
+	if err := getMatcherInteractive(&opts.Add.Route.Matcher); err != nil {
+		return err
+	}
+	if err := getDestinationInteractive(&opts.Add.Route); err != nil {
+		return err
+	}
+	if err := getPluginsInteractive(&opts.Add.Route.Plugins); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func selectVirtualServiceToAddToInteractive(opts *options.Options) error {
 	// collect vs list
 	vsByKey := make(map[string]core.ResourceRef)
 	vsKeys := []string{"create a new virtualservice"}
@@ -276,21 +298,74 @@ func AddRouteFlagsInteractive(opts *options.Options) error {
 			return err
 		}
 	}
+	return nil
+}
 
-	if err := getMatcherInteractive(&opts.Add.Route.Matcher); err != nil {
-		return err
-	}
-	if err := getDestinationInteractive(&opts.Add.Route); err != nil {
-		return err
+func selectRouteTableToAddToInteractive(opts *options.Options) error {
+	// collect route table list
+	rtByKey := make(map[string]core.ResourceRef)
+	rtKeys := []string{"create a new route table"}
+	var namespaces []string
+	for _, ns := range helpers.MustGetNamespaces() {
+		namespaces = append(namespaces, ns)
+		rtList, err := helpers.MustNamespacedRouteTableClient(ns).List(ns, clients.ListOpts{})
+		if err != nil {
+			return err
+		}
+		for _, rt := range rtList {
+			ref := rt.Metadata.Ref()
+			rtByKey[ref.Key()] = ref
+			rtKeys = append(rtKeys, ref.Key())
+		}
 	}
-	if err := getPluginsInteractive(&opts.Add.Route.Plugins); err != nil {
+
+	var rtKey string
+	if err := cliutil.ChooseFromList(
+		"Choose a Route Table to add the route to: ",
+		&rtKey,
+		rtKeys,
+	); err != nil {
 		return err
 	}
+	opts.Metadata.Name = rtByKey[rtKey].Name
+	opts.Metadata.Namespace = rtByKey[rtKey].Namespace
 
+	if opts.Metadata.Name == "" || opts.Metadata.Namespace == "" {
+		if err := cliutil.GetStringInput("name of the route table: ", &opts.Metadata.Name); err != nil {
+			return err
+		}
+		if err := cliutil.ChooseFromList(
+			"namespace of the route table: ",
+			&opts.Metadata.Namespace,
+			namespaces,
+		); err != nil {
+			return err
+		}
+	} else {
+		// only get the insert index if the route table is predefined
+		if err := cliutil.GetUint32Input(
+			"where do you want to insert the route in the route table's route list? ",
+			&opts.Add.Route.InsertIndex,
+		); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 func RemoveRouteFlagsInteractive(opts *options.Options) error {
+	if opts.Remove.Route.FromRouteTable {
+		rt, err := SelectRouteTableInteractiveWithPrompt(opts, "Choose a Route Table from which to remove the route: ")
+		if err != nil {
+			return err
+		}
+		route, err := SelectRouteFromRouteTableInteractive(rt, "Choose the route you wish to remove: ")
+		if err != nil {
+			return err
+		}
+		opts.Remove.Route.RemoveIndex = uint32(route)
+		return nil
+	}
 	_, route, err := SelectRouteInteractive(opts, "Choose a Virtual Service from which to remove the route: ", "Choose the route you wish to remove: ")
 	if err != nil {
 		return err
@@ -316,9 +391,19 @@ func SelectRouteFromVirtualServiceInteractive(vs *gatewayv1.VirtualService, rout
 	if len(vs.VirtualHost.Routes) == 0 {
 		return 0, errors.Errorf("no routes defined for virtual service %v", vs.Metadata.Ref())
 	}
+	return selectRouteIndexInteractive(vs.VirtualHost.Routes, routePrompt)
+}
+
+func SelectRouteFromRouteTableInteractive(rt *gatewayv1.RouteTable, routePrompt string) (int, error) {
+	if len(rt.Routes) == 0 {
+		return 0, errors.Errorf("no routes defined for route table %v", rt.Metadata.Ref())
+	}
+	return selectRouteIndexInteractive(rt.Routes, routePrompt)
+}
 
+func selectRouteIndexInteractive(routeList []*gatewayv1.Route, routePrompt string) (int, error) {
 	var routes []string
-	for i, r := range vs.VirtualHost.Routes {
+	for i, r := range routeList {
 		routes = append(routes, fmt.Sprintf("%v: %+v", i, matchersString(r.Matchers)))
 	}
 
@@ -387,3 +472,37 @@ func SelectVirtualServiceInteractive(opts *options.Options) error {
 	_, err := SelectVirtualServiceInteractiveWithPrompt(opts, "Choose a Virtual Service: ")
 	return err
 }
+
+func SelectRouteTableInteractiveWithPrompt(opts *options.Options, prompt string) (*gatewayv1.RouteTable, error) {
+	// collect route table list
+	rtByKey := make(map[string]*gatewayv1.RouteTable)
+	var rtKeys []string
+	for _, ns := range helpers.MustGetNamespaces() {
+		rtList, err := helpers.MustNamespacedRouteTableClient(ns).List(ns, clients.ListOpts{Ctx: opts.Top.Ctx})
+		if err != nil {
+			return nil, err
+		}
+		for _, rt := range rtList {
+			ref := rt.Metadata.Ref()
+			rtByKey[ref.Key()] = rt
+			rtKeys = append(rtKeys, ref.Key())
+		}
+	}
+
+	if len(rtKeys) == 0 {
+		return nil, errors.Errorf("no route tables found")
+	}
+
+	var rtKey string
+	if err := cliutil.ChooseFromList(
+		prompt,
+		&rtKey,
+		rtKeys,
+	); err != nil {
+		return nil, err
+	}
+	opts.Metadata.Name = rtByKey[rtKey].Metadata.Name
+	opts.Metadata.Namespace = rtByKey[rtKey].Metadata.Namespace
+
+	return rtByKey[rtKey], nil
+}
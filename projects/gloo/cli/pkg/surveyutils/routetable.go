@@ -0,0 +1,22 @@
+package surveyutils
+
+import (
+	"strconv"
+
+	"github.com/solo-io/gloo/pkg/cliutil"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+)
+
+func AddRouteTableFlagsInteractive(rt *options.InputRouteTable) error {
+	var weight string
+	if err := cliutil.GetStringInputDefault("the weight of the route table (lower weights are processed first): ", &weight,
+		strconv.Itoa(int(rt.Weight))); err != nil {
+		return err
+	}
+	parsed, err := strconv.ParseInt(weight, 10, 32)
+	if err != nil {
+		return err
+	}
+	rt.Weight = int32(parsed)
+	return nil
+}
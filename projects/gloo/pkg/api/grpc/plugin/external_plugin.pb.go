@@ -0,0 +1,723 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: projects/gloo/api/grpc/plugin/external_plugin.proto
+
+package plugin
+
+import (
+	context "context"
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	wasm "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/wasm"
+	core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ProcessUpstreamRequest struct {
+	Upstream *v1.Upstream `protobuf:"bytes,1,opt,name=upstream,proto3" json:"upstream,omitempty"`
+	// the `envoy.api.v2.Cluster` generated for the upstream
+	Cluster              *types.Any `protobuf:"bytes,2,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ProcessUpstreamRequest) Reset()         { *m = ProcessUpstreamRequest{} }
+func (m *ProcessUpstreamRequest) String() string { return proto.CompactTextString(m) }
+func (*ProcessUpstreamRequest) ProtoMessage()    {}
+func (*ProcessUpstreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5266c4e1862d43a0, []int{0}
+}
+func (m *ProcessUpstreamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProcessUpstreamRequest.Unmarshal(m, b)
+}
+func (m *ProcessUpstreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProcessUpstreamRequest.Marshal(b, m, deterministic)
+}
+func (m *ProcessUpstreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProcessUpstreamRequest.Merge(m, src)
+}
+func (m *ProcessUpstreamRequest) XXX_Size() int {
+	return xxx_messageInfo_ProcessUpstreamRequest.Size(m)
+}
+func (m *ProcessUpstreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProcessUpstreamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProcessUpstreamRequest proto.InternalMessageInfo
+
+func (m *ProcessUpstreamRequest) GetUpstream() *v1.Upstream {
+	if m != nil {
+		return m.Upstream
+	}
+	return nil
+}
+
+func (m *ProcessUpstreamRequest) GetCluster() *types.Any {
+	if m != nil {
+		return m.Cluster
+	}
+	return nil
+}
+
+type ProcessUpstreamResponse struct {
+	// if set, the `envoy.api.v2.Cluster` which replaces the generated cluster
+	Cluster              *types.Any `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ProcessUpstreamResponse) Reset()         { *m = ProcessUpstreamResponse{} }
+func (m *ProcessUpstreamResponse) String() string { return proto.CompactTextString(m) }
+func (*ProcessUpstreamResponse) ProtoMessage()    {}
+func (*ProcessUpstreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5266c4e1862d43a0, []int{1}
+}
+func (m *ProcessUpstreamResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProcessUpstreamResponse.Unmarshal(m, b)
+}
+func (m *ProcessUpstreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProcessUpstreamResponse.Marshal(b, m, deterministic)
+}
+func (m *ProcessUpstreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProcessUpstreamResponse.Merge(m, src)
+}
+func (m *ProcessUpstreamResponse) XXX_Size() int {
+	return xxx_messageInfo_ProcessUpstreamResponse.Size(m)
+}
+func (m *ProcessUpstreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProcessUpstreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProcessUpstreamResponse proto.InternalMessageInfo
+
+func (m *ProcessUpstreamResponse) GetCluster() *types.Any {
+	if m != nil {
+		return m.Cluster
+	}
+	return nil
+}
+
+type ProcessVirtualHostRequest struct {
+	// the proxy and the name of the listener containing the virtual host
+	Proxy       *core.ResourceRef `protobuf:"bytes,1,opt,name=proxy,proto3" json:"proxy,omitempty"`
+	Listener    string            `protobuf:"bytes,2,opt,name=listener,proto3" json:"listener,omitempty"`
+	VirtualHost *v1.VirtualHost   `protobuf:"bytes,3,opt,name=virtual_host,json=virtualHost,proto3" json:"virtual_host,omitempty"`
+	// the `envoy.api.v2.route.VirtualHost` generated for the virtual host
+	EnvoyVirtualHost     *types.Any `protobuf:"bytes,4,opt,name=envoy_virtual_host,json=envoyVirtualHost,proto3" json:"envoy_virtual_host,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ProcessVirtualHostRequest) Reset()         { *m = ProcessVirtualHostRequest{} }
+func (m *ProcessVirtualHostRequest) String() string { return proto.CompactTextString(m) }
+func (*ProcessVirtualHostRequest) ProtoMessage()    {}
+func (*ProcessVirtualHostRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5266c4e1862d43a0, []int{2}
+}
+func (m *ProcessVirtualHostRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProcessVirtualHostRequest.Unmarshal(m, b)
+}
+func (m *ProcessVirtualHostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProcessVirtualHostRequest.Marshal(b, m, deterministic)
+}
+func (m *ProcessVirtualHostRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProcessVirtualHostRequest.Merge(m, src)
+}
+func (m *ProcessVirtualHostRequest) XXX_Size() int {
+	return xxx_messageInfo_ProcessVirtualHostRequest.Size(m)
+}
+func (m *ProcessVirtualHostRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProcessVirtualHostRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProcessVirtualHostRequest proto.InternalMessageInfo
+
+func (m *ProcessVirtualHostRequest) GetProxy() *core.ResourceRef {
+	if m != nil {
+		return m.Proxy
+	}
+	return nil
+}
+
+func (m *ProcessVirtualHostRequest) GetListener() string {
+	if m != nil {
+		return m.Listener
+	}
+	return ""
+}
+
+func (m *ProcessVirtualHostRequest) GetVirtualHost() *v1.VirtualHost {
+	if m != nil {
+		return m.VirtualHost
+	}
+	return nil
+}
+
+func (m *ProcessVirtualHostRequest) GetEnvoyVirtualHost() *types.Any {
+	if m != nil {
+		return m.EnvoyVirtualHost
+	}
+	return nil
+}
+
+type ProcessVirtualHostResponse struct {
+	// if set, the `envoy.api.v2.route.VirtualHost` which replaces the generated virtual host
+	EnvoyVirtualHost     *types.Any `protobuf:"bytes,1,opt,name=envoy_virtual_host,json=envoyVirtualHost,proto3" json:"envoy_virtual_host,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ProcessVirtualHostResponse) Reset()         { *m = ProcessVirtualHostResponse{} }
+func (m *ProcessVirtualHostResponse) String() string { return proto.CompactTextString(m) }
+func (*ProcessVirtualHostResponse) ProtoMessage()    {}
+func (*ProcessVirtualHostResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5266c4e1862d43a0, []int{3}
+}
+func (m *ProcessVirtualHostResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProcessVirtualHostResponse.Unmarshal(m, b)
+}
+func (m *ProcessVirtualHostResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProcessVirtualHostResponse.Marshal(b, m, deterministic)
+}
+func (m *ProcessVirtualHostResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProcessVirtualHostResponse.Merge(m, src)
+}
+func (m *ProcessVirtualHostResponse) XXX_Size() int {
+	return xxx_messageInfo_ProcessVirtualHostResponse.Size(m)
+}
+func (m *ProcessVirtualHostResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProcessVirtualHostResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProcessVirtualHostResponse proto.InternalMessageInfo
+
+func (m *ProcessVirtualHostResponse) GetEnvoyVirtualHost() *types.Any {
+	if m != nil {
+		return m.EnvoyVirtualHost
+	}
+	return nil
+}
+
+type ProcessRouteRequest struct {
+	// the proxy and the names of the listener and virtual host containing the route
+	Proxy       *core.ResourceRef `protobuf:"bytes,1,opt,name=proxy,proto3" json:"proxy,omitempty"`
+	Listener    string            `protobuf:"bytes,2,opt,name=listener,proto3" json:"listener,omitempty"`
+	VirtualHost string            `protobuf:"bytes,3,opt,name=virtual_host,json=virtualHost,proto3" json:"virtual_host,omitempty"`
+	Route       *v1.Route         `protobuf:"bytes,4,opt,name=route,proto3" json:"route,omitempty"`
+	// the `envoy.api.v2.route.Route` generated for the route
+	EnvoyRoute           *types.Any `protobuf:"bytes,5,opt,name=envoy_route,json=envoyRoute,proto3" json:"envoy_route,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ProcessRouteRequest) Reset()         { *m = ProcessRouteRequest{} }
+func (m *ProcessRouteRequest) String() string { return proto.CompactTextString(m) }
+func (*ProcessRouteRequest) ProtoMessage()    {}
+func (*ProcessRouteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5266c4e1862d43a0, []int{4}
+}
+func (m *ProcessRouteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProcessRouteRequest.Unmarshal(m, b)
+}
+func (m *ProcessRouteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProcessRouteRequest.Marshal(b, m, deterministic)
+}
+func (m *ProcessRouteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProcessRouteRequest.Merge(m, src)
+}
+func (m *ProcessRouteRequest) XXX_Size() int {
+	return xxx_messageInfo_ProcessRouteRequest.Size(m)
+}
+func (m *ProcessRouteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProcessRouteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProcessRouteRequest proto.InternalMessageInfo
+
+func (m *ProcessRouteRequest) GetProxy() *core.ResourceRef {
+	if m != nil {
+		return m.Proxy
+	}
+	return nil
+}
+
+func (m *ProcessRouteRequest) GetListener() string {
+	if m != nil {
+		return m.Listener
+	}
+	return ""
+}
+
+func (m *ProcessRouteRequest) GetVirtualHost() string {
+	if m != nil {
+		return m.VirtualHost
+	}
+	return ""
+}
+
+func (m *ProcessRouteRequest) GetRoute() *v1.Route {
+	if m != nil {
+		return m.Route
+	}
+	return nil
+}
+
+func (m *ProcessRouteRequest) GetEnvoyRoute() *types.Any {
+	if m != nil {
+		return m.EnvoyRoute
+	}
+	return nil
+}
+
+type ProcessRouteResponse struct {
+	// if set, the `envoy.api.v2.route.Route` which replaces the generated route
+	EnvoyRoute           *types.Any `protobuf:"bytes,1,opt,name=envoy_route,json=envoyRoute,proto3" json:"envoy_route,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ProcessRouteResponse) Reset()         { *m = ProcessRouteResponse{} }
+func (m *ProcessRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*ProcessRouteResponse) ProtoMessage()    {}
+func (*ProcessRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5266c4e1862d43a0, []int{5}
+}
+func (m *ProcessRouteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProcessRouteResponse.Unmarshal(m, b)
+}
+func (m *ProcessRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProcessRouteResponse.Marshal(b, m, deterministic)
+}
+func (m *ProcessRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProcessRouteResponse.Merge(m, src)
+}
+func (m *ProcessRouteResponse) XXX_Size() int {
+	return xxx_messageInfo_ProcessRouteResponse.Size(m)
+}
+func (m *ProcessRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProcessRouteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProcessRouteResponse proto.InternalMessageInfo
+
+func (m *ProcessRouteResponse) GetEnvoyRoute() *types.Any {
+	if m != nil {
+		return m.EnvoyRoute
+	}
+	return nil
+}
+
+type HttpFiltersRequest struct {
+	HttpListener         *v1.HttpListener `protobuf:"bytes,1,opt,name=http_listener,json=httpListener,proto3" json:"http_listener,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *HttpFiltersRequest) Reset()         { *m = HttpFiltersRequest{} }
+func (m *HttpFiltersRequest) String() string { return proto.CompactTextString(m) }
+func (*HttpFiltersRequest) ProtoMessage()    {}
+func (*HttpFiltersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5266c4e1862d43a0, []int{6}
+}
+func (m *HttpFiltersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HttpFiltersRequest.Unmarshal(m, b)
+}
+func (m *HttpFiltersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HttpFiltersRequest.Marshal(b, m, deterministic)
+}
+func (m *HttpFiltersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HttpFiltersRequest.Merge(m, src)
+}
+func (m *HttpFiltersRequest) XXX_Size() int {
+	return xxx_messageInfo_HttpFiltersRequest.Size(m)
+}
+func (m *HttpFiltersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HttpFiltersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HttpFiltersRequest proto.InternalMessageInfo
+
+func (m *HttpFiltersRequest) GetHttpListener() *v1.HttpListener {
+	if m != nil {
+		return m.HttpListener
+	}
+	return nil
+}
+
+type HttpFiltersResponse struct {
+	// the filters to add to the filter chain of the listener
+	Filters              []*HttpFiltersResponse_HttpFilter `protobuf:"bytes,1,rep,name=filters,proto3" json:"filters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
+}
+
+func (m *HttpFiltersResponse) Reset()         { *m = HttpFiltersResponse{} }
+func (m *HttpFiltersResponse) String() string { return proto.CompactTextString(m) }
+func (*HttpFiltersResponse) ProtoMessage()    {}
+func (*HttpFiltersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5266c4e1862d43a0, []int{7}
+}
+func (m *HttpFiltersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HttpFiltersResponse.Unmarshal(m, b)
+}
+func (m *HttpFiltersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HttpFiltersResponse.Marshal(b, m, deterministic)
+}
+func (m *HttpFiltersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HttpFiltersResponse.Merge(m, src)
+}
+func (m *HttpFiltersResponse) XXX_Size() int {
+	return xxx_messageInfo_HttpFiltersResponse.Size(m)
+}
+func (m *HttpFiltersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HttpFiltersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HttpFiltersResponse proto.InternalMessageInfo
+
+func (m *HttpFiltersResponse) GetFilters() []*HttpFiltersResponse_HttpFilter {
+	if m != nil {
+		return m.Filters
+	}
+	return nil
+}
+
+type HttpFiltersResponse_HttpFilter struct {
+	// the name of the filter, e.g. `envoy.filters.http.lua`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the config of the filter, e.g. an `envoy.extensions.filters.http.lua.v3.Lua`
+	TypedConfig *types.Any `protobuf:"bytes,2,opt,name=typed_config,json=typedConfig,proto3" json:"typed_config,omitempty"`
+	// the stage of the filter chain in which the filter is added. Defaults to before the AcceptedStage.
+	Stage                *wasm.FilterStage `protobuf:"bytes,3,opt,name=stage,proto3" json:"stage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *HttpFiltersResponse_HttpFilter) Reset()         { *m = HttpFiltersResponse_HttpFilter{} }
+func (m *HttpFiltersResponse_HttpFilter) String() string { return proto.CompactTextString(m) }
+func (*HttpFiltersResponse_HttpFilter) ProtoMessage()    {}
+func (*HttpFiltersResponse_HttpFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5266c4e1862d43a0, []int{7, 0}
+}
+func (m *HttpFiltersResponse_HttpFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HttpFiltersResponse_HttpFilter.Unmarshal(m, b)
+}
+func (m *HttpFiltersResponse_HttpFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HttpFiltersResponse_HttpFilter.Marshal(b, m, deterministic)
+}
+func (m *HttpFiltersResponse_HttpFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HttpFiltersResponse_HttpFilter.Merge(m, src)
+}
+func (m *HttpFiltersResponse_HttpFilter) XXX_Size() int {
+	return xxx_messageInfo_HttpFiltersResponse_HttpFilter.Size(m)
+}
+func (m *HttpFiltersResponse_HttpFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_HttpFiltersResponse_HttpFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HttpFiltersResponse_HttpFilter proto.InternalMessageInfo
+
+func (m *HttpFiltersResponse_HttpFilter) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *HttpFiltersResponse_HttpFilter) GetTypedConfig() *types.Any {
+	if m != nil {
+		return m.TypedConfig
+	}
+	return nil
+}
+
+func (m *HttpFiltersResponse_HttpFilter) GetStage() *wasm.FilterStage {
+	if m != nil {
+		return m.Stage
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ProcessUpstreamRequest)(nil), "gloo.solo.io.ProcessUpstreamRequest")
+	proto.RegisterType((*ProcessUpstreamResponse)(nil), "gloo.solo.io.ProcessUpstreamResponse")
+	proto.RegisterType((*ProcessVirtualHostRequest)(nil), "gloo.solo.io.ProcessVirtualHostRequest")
+	proto.RegisterType((*ProcessVirtualHostResponse)(nil), "gloo.solo.io.ProcessVirtualHostResponse")
+	proto.RegisterType((*ProcessRouteRequest)(nil), "gloo.solo.io.ProcessRouteRequest")
+	proto.RegisterType((*ProcessRouteResponse)(nil), "gloo.solo.io.ProcessRouteResponse")
+	proto.RegisterType((*HttpFiltersRequest)(nil), "gloo.solo.io.HttpFiltersRequest")
+	proto.RegisterType((*HttpFiltersResponse)(nil), "gloo.solo.io.HttpFiltersResponse")
+	proto.RegisterType((*HttpFiltersResponse_HttpFilter)(nil), "gloo.solo.io.HttpFiltersResponse.HttpFilter")
+}
+
+func init() {
+	proto.RegisterFile("projects/gloo/api/grpc/plugin/external_plugin.proto", fileDescriptor_5266c4e1862d43a0)
+}
+
+var fileDescriptor_5266c4e1862d43a0 = []byte{
+	// 676 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x51, 0x6f, 0xd3, 0x3c,
+	0x14, 0x5d, 0xbe, 0xad, 0xdf, 0xb6, 0xdb, 0x22, 0x90, 0x37, 0x46, 0x9b, 0xa7, 0x2d, 0x62, 0x50,
+	0x24, 0x48, 0xb4, 0x4e, 0x08, 0x21, 0x26, 0x21, 0x86, 0x98, 0x86, 0x04, 0xd2, 0x94, 0x31, 0x90,
+	0x78, 0xe9, 0xb2, 0xe0, 0xa6, 0x66, 0x69, 0x6c, 0x6c, 0xa7, 0xac, 0x12, 0xff, 0x82, 0x07, 0x7e,
+	0x22, 0x12, 0x7f, 0x80, 0x57, 0x54, 0xdb, 0xc9, 0x92, 0x36, 0x6b, 0xc7, 0x03, 0x2f, 0x55, 0xed,
+	0x9c, 0x73, 0xae, 0xcf, 0xd1, 0xf5, 0x35, 0xec, 0x32, 0x4e, 0x3f, 0xe3, 0x50, 0x0a, 0x2f, 0x8a,
+	0x29, 0xf5, 0x02, 0x46, 0xbc, 0x88, 0xb3, 0xd0, 0x63, 0x71, 0x1a, 0x91, 0xc4, 0xc3, 0x17, 0x12,
+	0xf3, 0x24, 0x88, 0xbb, 0x7a, 0xed, 0x32, 0x4e, 0x25, 0x45, 0x8d, 0x31, 0xd6, 0x15, 0x34, 0xa6,
+	0x2e, 0xa1, 0x76, 0x2b, 0xa2, 0x34, 0x8a, 0xb1, 0xa7, 0xbe, 0x9d, 0xa5, 0x3d, 0x2f, 0x48, 0x46,
+	0x1a, 0x68, 0xb7, 0xc6, 0x98, 0x47, 0xe7, 0x44, 0x2a, 0xe1, 0xe1, 0x8e, 0xc7, 0x71, 0xcf, 0x7c,
+	0xda, 0x56, 0xf5, 0xa6, 0xab, 0x0f, 0x77, 0xc6, 0x9b, 0x17, 0x99, 0x42, 0xfb, 0x6a, 0x58, 0xca,
+	0x84, 0xe4, 0x38, 0x18, 0x18, 0xe4, 0xce, 0xd5, 0x48, 0xca, 0x24, 0xa1, 0x89, 0xf0, 0xbe, 0x06,
+	0x62, 0xa0, 0x7e, 0x34, 0xc5, 0xf9, 0x06, 0x1b, 0x47, 0x9c, 0x86, 0x58, 0x88, 0x13, 0xa3, 0xe5,
+	0xe3, 0x2f, 0x29, 0x16, 0x12, 0x75, 0x60, 0x25, 0x93, 0x6f, 0x5a, 0x9b, 0x56, 0xbb, 0xde, 0xd9,
+	0x70, 0x8b, 0xa6, 0xdd, 0x9c, 0x90, 0xe3, 0x90, 0x0b, 0xcb, 0x61, 0x9c, 0x0a, 0x89, 0x79, 0xf3,
+	0x3f, 0x45, 0x59, 0x77, 0x75, 0x32, 0x6e, 0x96, 0x8c, 0xfb, 0x22, 0x19, 0xf9, 0x19, 0xc8, 0x79,
+	0x0d, 0x77, 0xa6, 0xaa, 0x0b, 0x46, 0x13, 0x81, 0x8b, 0x52, 0xd6, 0x75, 0xa4, 0x7e, 0x59, 0xd0,
+	0x32, 0x5a, 0xef, 0x09, 0x97, 0x69, 0x10, 0x1f, 0x52, 0x21, 0x33, 0x33, 0x1e, 0xd4, 0x54, 0xa4,
+	0x46, 0xab, 0xe5, 0x86, 0x94, 0xe3, 0xdc, 0x89, 0x8f, 0x05, 0x4d, 0x79, 0x88, 0x7d, 0xdc, 0xf3,
+	0x35, 0x0e, 0xd9, 0xb0, 0x12, 0x13, 0x21, 0x71, 0x62, 0xac, 0xac, 0xfa, 0xf9, 0x1a, 0xed, 0x41,
+	0x63, 0xa8, 0x4b, 0x74, 0xfb, 0x54, 0xc8, 0xe6, 0xa2, 0xd1, 0x2c, 0xa5, 0x53, 0x3c, 0x44, 0x7d,
+	0x78, 0xb9, 0x40, 0xfb, 0x80, 0x70, 0x32, 0xa4, 0xa3, 0x6e, 0x49, 0x63, 0x69, 0x86, 0xc7, 0x5b,
+	0x0a, 0x5f, 0x10, 0x74, 0x4e, 0xc1, 0xae, 0xf2, 0x6a, 0xa2, 0xab, 0xae, 0x60, 0xfd, 0x55, 0x85,
+	0x9f, 0x16, 0xac, 0x99, 0x12, 0x3e, 0x4d, 0x25, 0xfe, 0x27, 0x41, 0x6e, 0x55, 0x04, 0xb9, 0x5a,
+	0x4e, 0xeb, 0x01, 0xd4, 0xf8, 0xb8, 0xbe, 0x09, 0x68, 0xad, 0x1c, 0xb2, 0x3e, 0x9a, 0x46, 0xa0,
+	0xc7, 0x50, 0xd7, 0xb6, 0x35, 0xa1, 0x36, 0xc3, 0x2f, 0x28, 0xa0, 0x62, 0x3b, 0x6f, 0x61, 0xbd,
+	0x6c, 0xd4, 0xa4, 0x38, 0x21, 0x67, 0x5d, 0x53, 0xee, 0x04, 0xd0, 0xa1, 0x94, 0xec, 0x80, 0xc4,
+	0x12, 0x73, 0x91, 0xc5, 0xf6, 0x1c, 0x6e, 0xf4, 0xa5, 0x64, 0xdd, 0x3c, 0x0a, 0x2d, 0x67, 0x97,
+	0xed, 0x8c, 0x89, 0x6f, 0x0c, 0xc2, 0x6f, 0xf4, 0x0b, 0x2b, 0xe7, 0xb7, 0x05, 0x6b, 0x25, 0x5d,
+	0x73, 0xca, 0x03, 0x58, 0xee, 0xe9, 0xad, 0xa6, 0xb5, 0xb9, 0xd8, 0xae, 0x77, 0x1e, 0x4e, 0x4b,
+	0x4e, 0x70, 0x0a, 0x7b, 0x7e, 0x46, 0xb6, 0x7f, 0x58, 0x00, 0x97, 0xfb, 0x08, 0xc1, 0x52, 0x12,
+	0x0c, 0xb4, 0xeb, 0x55, 0x5f, 0xfd, 0x47, 0x4f, 0xa0, 0x21, 0x47, 0x0c, 0x7f, 0xea, 0x86, 0x34,
+	0xe9, 0x91, 0x68, 0xe6, 0x0d, 0xaf, 0x2b, 0xe4, 0x4b, 0x05, 0x44, 0x7b, 0x50, 0x13, 0x32, 0x88,
+	0xb0, 0xb9, 0x28, 0xf7, 0x5c, 0x35, 0x7f, 0xcc, 0x44, 0x2a, 0x1f, 0x57, 0x97, 0x3f, 0x1e, 0xa3,
+	0x7d, 0x4d, 0xea, 0x7c, 0x5f, 0x84, 0xdb, 0xaf, 0xcc, 0x0c, 0x3e, 0x52, 0x23, 0xf8, 0x18, 0xf3,
+	0x21, 0x09, 0x31, 0x3a, 0x85, 0x9b, 0x13, 0xd3, 0x03, 0xdd, 0x2d, 0xcb, 0x55, 0x8f, 0x36, 0x7b,
+	0x7b, 0x0e, 0x4a, 0xe7, 0xe4, 0x2c, 0x20, 0x02, 0x68, 0xfa, 0x9e, 0xa1, 0xfb, 0x95, 0xf4, 0xe9,
+	0xa9, 0x63, 0xb7, 0xe7, 0x03, 0xf3, 0x52, 0x1f, 0xa0, 0x51, 0x6c, 0x43, 0xb4, 0x55, 0xc9, 0x2d,
+	0xde, 0x45, 0xdb, 0x99, 0x05, 0xc9, 0x85, 0xdf, 0x41, 0xbd, 0xd0, 0x04, 0x68, 0x73, 0x46, 0x7f,
+	0x68, 0xd9, 0xad, 0xb9, 0x1d, 0xe4, 0x2c, 0xec, 0x3f, 0xfb, 0xf8, 0x34, 0x22, 0xb2, 0x9f, 0x9e,
+	0xb9, 0x21, 0x1d, 0x78, 0xea, 0x8d, 0x23, 0xd4, 0xab, 0x78, 0x7f, 0xd8, 0x79, 0x34, 0xf9, 0xa4,
+	0x9e, 0xfd, 0xaf, 0x7a, 0x65, 0xf7, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x28, 0x4a, 0xcb, 0x03,
+	0x7a, 0x07, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ExternalPluginServiceClient is the client API for ExternalPluginService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ExternalPluginServiceClient interface {
+	// Modify the Envoy Cluster generated for an Upstream
+	ProcessUpstream(ctx context.Context, in *ProcessUpstreamRequest, opts ...grpc.CallOption) (*ProcessUpstreamResponse, error)
+	// Modify the Envoy VirtualHost generated for a VirtualHost
+	ProcessVirtualHost(ctx context.Context, in *ProcessVirtualHostRequest, opts ...grpc.CallOption) (*ProcessVirtualHostResponse, error)
+	// Modify the Envoy Route generated for a Route
+	ProcessRoute(ctx context.Context, in *ProcessRouteRequest, opts ...grpc.CallOption) (*ProcessRouteResponse, error)
+	// Add HTTP filters to the filter chain of an HttpListener
+	HttpFilters(ctx context.Context, in *HttpFiltersRequest, opts ...grpc.CallOption) (*HttpFiltersResponse, error)
+}
+
+type externalPluginServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewExternalPluginServiceClient(cc *grpc.ClientConn) ExternalPluginServiceClient {
+	return &externalPluginServiceClient{cc}
+}
+
+func (c *externalPluginServiceClient) ProcessUpstream(ctx context.Context, in *ProcessUpstreamRequest, opts ...grpc.CallOption) (*ProcessUpstreamResponse, error) {
+	out := new(ProcessUpstreamResponse)
+	err := c.cc.Invoke(ctx, "/gloo.solo.io.ExternalPluginService/ProcessUpstream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *externalPluginServiceClient) ProcessVirtualHost(ctx context.Context, in *ProcessVirtualHostRequest, opts ...grpc.CallOption) (*ProcessVirtualHostResponse, error) {
+	out := new(ProcessVirtualHostResponse)
+	err := c.cc.Invoke(ctx, "/gloo.solo.io.ExternalPluginService/ProcessVirtualHost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *externalPluginServiceClient) ProcessRoute(ctx context.Context, in *ProcessRouteRequest, opts ...grpc.CallOption) (*ProcessRouteResponse, error) {
+	out := new(ProcessRouteResponse)
+	err := c.cc.Invoke(ctx, "/gloo.solo.io.ExternalPluginService/ProcessRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *externalPluginServiceClient) HttpFilters(ctx context.Context, in *HttpFiltersRequest, opts ...grpc.CallOption) (*HttpFiltersResponse, error) {
+	out := new(HttpFiltersResponse)
+	err := c.cc.Invoke(ctx, "/gloo.solo.io.ExternalPluginService/HttpFilters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ExternalPluginServiceServer is the server API for ExternalPluginService service.
+type ExternalPluginServiceServer interface {
+	// Modify the Envoy Cluster generated for an Upstream
+	ProcessUpstream(context.Context, *ProcessUpstreamRequest) (*ProcessUpstreamResponse, error)
+	// Modify the Envoy VirtualHost generated for a VirtualHost
+	ProcessVirtualHost(context.Context, *ProcessVirtualHostRequest) (*ProcessVirtualHostResponse, error)
+	// Modify the Envoy Route generated for a Route
+	ProcessRoute(context.Context, *ProcessRouteRequest) (*ProcessRouteResponse, error)
+	// Add HTTP filters to the filter chain of an HttpListener
+	HttpFilters(context.Context, *HttpFiltersRequest) (*HttpFiltersResponse, error)
+}
+
+// UnimplementedExternalPluginServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedExternalPluginServiceServer struct {
+}
+
+func (*UnimplementedExternalPluginServiceServer) ProcessUpstream(ctx context.Context, req *ProcessUpstreamRequest) (*ProcessUpstreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessUpstream not implemented")
+}
+func (*UnimplementedExternalPluginServiceServer) ProcessVirtualHost(ctx context.Context, req *ProcessVirtualHostRequest) (*ProcessVirtualHostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessVirtualHost not implemented")
+}
+func (*UnimplementedExternalPluginServiceServer) ProcessRoute(ctx context.Context, req *ProcessRouteRequest) (*ProcessRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessRoute not implemented")
+}
+func (*UnimplementedExternalPluginServiceServer) HttpFilters(ctx context.Context, req *HttpFiltersRequest) (*HttpFiltersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HttpFilters not implemented")
+}
+
+func RegisterExternalPluginServiceServer(s *grpc.Server, srv ExternalPluginServiceServer) {
+	s.RegisterService(&_ExternalPluginService_serviceDesc, srv)
+}
+
+func _ExternalPluginService_ProcessUpstream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProcessUpstreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExternalPluginServiceServer).ProcessUpstream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gloo.solo.io.ExternalPluginService/ProcessUpstream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExternalPluginServiceServer).ProcessUpstream(ctx, req.(*ProcessUpstreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExternalPluginService_ProcessVirtualHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProcessVirtualHostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExternalPluginServiceServer).ProcessVirtualHost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gloo.solo.io.ExternalPluginService/ProcessVirtualHost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExternalPluginServiceServer).ProcessVirtualHost(ctx, req.(*ProcessVirtualHostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExternalPluginService_ProcessRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProcessRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExternalPluginServiceServer).ProcessRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gloo.solo.io.ExternalPluginService/ProcessRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExternalPluginServiceServer).ProcessRoute(ctx, req.(*ProcessRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExternalPluginService_HttpFilters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HttpFiltersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExternalPluginServiceServer).HttpFilters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gloo.solo.io.ExternalPluginService/HttpFilters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExternalPluginServiceServer).HttpFilters(ctx, req.(*HttpFiltersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ExternalPluginService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gloo.solo.io.ExternalPluginService",
+	HandlerType: (*ExternalPluginServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ProcessUpstream",
+			Handler:    _ExternalPluginService_ProcessUpstream_Handler,
+		},
+		{
+			MethodName: "ProcessVirtualHost",
+			Handler:    _ExternalPluginService_ProcessVirtualHost_Handler,
+		},
+		{
+			MethodName: "ProcessRoute",
+			Handler:    _ExternalPluginService_ProcessRoute_Handler,
+		},
+		{
+			MethodName: "HttpFilters",
+			Handler:    _ExternalPluginService_HttpFilters_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "projects/gloo/api/grpc/plugin/external_plugin.proto",
+}
@@ -54,7 +54,7 @@ func (x ListenerReport_Error_Type) String() string {
 }
 
 func (ListenerReport_Error_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{5, 0, 0}
+	return fileDescriptor_aacaf097b496f502, []int{9, 0, 0}
 }
 
 type HttpListenerReport_Error_Type int32
@@ -76,7 +76,7 @@ func (x HttpListenerReport_Error_Type) String() string {
 }
 
 func (HttpListenerReport_Error_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{6, 0, 0}
+	return fileDescriptor_aacaf097b496f502, []int{10, 0, 0}
 }
 
 type VirtualHostReport_Error_Type int32
@@ -107,7 +107,7 @@ func (x VirtualHostReport_Error_Type) String() string {
 }
 
 func (VirtualHostReport_Error_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{7, 0, 0}
+	return fileDescriptor_aacaf097b496f502, []int{11, 0, 0}
 }
 
 type RouteReport_Error_Type int32
@@ -132,7 +132,7 @@ func (x RouteReport_Error_Type) String() string {
 }
 
 func (RouteReport_Error_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{8, 0, 0}
+	return fileDescriptor_aacaf097b496f502, []int{12, 0, 0}
 }
 
 type RouteReport_Warning_Type int32
@@ -154,7 +154,7 @@ func (x RouteReport_Warning_Type) String() string {
 }
 
 func (RouteReport_Warning_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{8, 1, 0}
+	return fileDescriptor_aacaf097b496f502, []int{12, 1, 0}
 }
 
 type TcpListenerReport_Error_Type int32
@@ -185,7 +185,7 @@ func (x TcpListenerReport_Error_Type) String() string {
 }
 
 func (TcpListenerReport_Error_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{9, 0, 0}
+	return fileDescriptor_aacaf097b496f502, []int{13, 0, 0}
 }
 
 type TcpHostReport_Error_Type int32
@@ -213,7 +213,7 @@ func (x TcpHostReport_Error_Type) String() string {
 }
 
 func (TcpHostReport_Error_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{10, 0, 0}
+	return fileDescriptor_aacaf097b496f502, []int{14, 0, 0}
 }
 
 type ProxyValidationServiceRequest struct {
@@ -292,6 +292,354 @@ func (m *ProxyValidationServiceResponse) GetProxyReport() *ProxyReport {
 	return nil
 }
 
+type ResourceValidationServiceRequest struct {
+	// the resource to write to the snapshot
+	//
+	// Types that are valid to be assigned to Resource:
+	//	*ResourceValidationServiceRequest_Upstream
+	//	*ResourceValidationServiceRequest_UpstreamGroup
+	//	*ResourceValidationServiceRequest_Secret
+	Resource isResourceValidationServiceRequest_Resource `protobuf_oneof:"resource"`
+	// if set, the resource with the metadata of the given resource is removed from the snapshot instead
+	Delete               bool     `protobuf:"varint,4,opt,name=delete,proto3" json:"delete,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResourceValidationServiceRequest) Reset()         { *m = ResourceValidationServiceRequest{} }
+func (m *ResourceValidationServiceRequest) String() string { return proto.CompactTextString(m) }
+func (*ResourceValidationServiceRequest) ProtoMessage()    {}
+func (*ResourceValidationServiceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aacaf097b496f502, []int{2}
+}
+func (m *ResourceValidationServiceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResourceValidationServiceRequest.Unmarshal(m, b)
+}
+func (m *ResourceValidationServiceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResourceValidationServiceRequest.Marshal(b, m, deterministic)
+}
+func (m *ResourceValidationServiceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceValidationServiceRequest.Merge(m, src)
+}
+func (m *ResourceValidationServiceRequest) XXX_Size() int {
+	return xxx_messageInfo_ResourceValidationServiceRequest.Size(m)
+}
+func (m *ResourceValidationServiceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceValidationServiceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceValidationServiceRequest proto.InternalMessageInfo
+
+type isResourceValidationServiceRequest_Resource interface {
+	isResourceValidationServiceRequest_Resource()
+}
+
+type ResourceValidationServiceRequest_Upstream struct {
+	Upstream *v1.Upstream `protobuf:"bytes,1,opt,name=upstream,proto3,oneof" json:"upstream,omitempty"`
+}
+type ResourceValidationServiceRequest_UpstreamGroup struct {
+	UpstreamGroup *v1.UpstreamGroup `protobuf:"bytes,2,opt,name=upstream_group,json=upstreamGroup,proto3,oneof" json:"upstream_group,omitempty"`
+}
+type ResourceValidationServiceRequest_Secret struct {
+	Secret *v1.Secret `protobuf:"bytes,3,opt,name=secret,proto3,oneof" json:"secret,omitempty"`
+}
+
+func (*ResourceValidationServiceRequest_Upstream) isResourceValidationServiceRequest_Resource() {}
+func (*ResourceValidationServiceRequest_UpstreamGroup) isResourceValidationServiceRequest_Resource() {
+}
+func (*ResourceValidationServiceRequest_Secret) isResourceValidationServiceRequest_Resource() {}
+
+func (m *ResourceValidationServiceRequest) GetResource() isResourceValidationServiceRequest_Resource {
+	if m != nil {
+		return m.Resource
+	}
+	return nil
+}
+
+func (m *ResourceValidationServiceRequest) GetUpstream() *v1.Upstream {
+	if x, ok := m.GetResource().(*ResourceValidationServiceRequest_Upstream); ok {
+		return x.Upstream
+	}
+	return nil
+}
+
+func (m *ResourceValidationServiceRequest) GetUpstreamGroup() *v1.UpstreamGroup {
+	if x, ok := m.GetResource().(*ResourceValidationServiceRequest_UpstreamGroup); ok {
+		return x.UpstreamGroup
+	}
+	return nil
+}
+
+func (m *ResourceValidationServiceRequest) GetSecret() *v1.Secret {
+	if x, ok := m.GetResource().(*ResourceValidationServiceRequest_Secret); ok {
+		return x.Secret
+	}
+	return nil
+}
+
+func (m *ResourceValidationServiceRequest) GetDelete() bool {
+	if m != nil {
+		return m.Delete
+	}
+	return false
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ResourceValidationServiceRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ResourceValidationServiceRequest_Upstream)(nil),
+		(*ResourceValidationServiceRequest_UpstreamGroup)(nil),
+		(*ResourceValidationServiceRequest_Secret)(nil),
+	}
+}
+
+type ResourceValidationServiceResponse struct {
+	// a result for each Proxy which has errors or warnings after the change that it did not have before
+	ProxyResults []*ResourceValidationServiceResponse_ProxyResult `protobuf:"bytes,1,rep,name=proxy_results,json=proxyResults,proto3" json:"proxy_results,omitempty"`
+	// errors reported on the submitted resource itself during translation, e.g. for an Upstream
+	// whose cluster could not be generated. errors the resource already had before the change are not included
+	ResourceErrors       []string `protobuf:"bytes,2,rep,name=resource_errors,json=resourceErrors,proto3" json:"resource_errors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResourceValidationServiceResponse) Reset()         { *m = ResourceValidationServiceResponse{} }
+func (m *ResourceValidationServiceResponse) String() string { return proto.CompactTextString(m) }
+func (*ResourceValidationServiceResponse) ProtoMessage()    {}
+func (*ResourceValidationServiceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aacaf097b496f502, []int{3}
+}
+func (m *ResourceValidationServiceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResourceValidationServiceResponse.Unmarshal(m, b)
+}
+func (m *ResourceValidationServiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResourceValidationServiceResponse.Marshal(b, m, deterministic)
+}
+func (m *ResourceValidationServiceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceValidationServiceResponse.Merge(m, src)
+}
+func (m *ResourceValidationServiceResponse) XXX_Size() int {
+	return xxx_messageInfo_ResourceValidationServiceResponse.Size(m)
+}
+func (m *ResourceValidationServiceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceValidationServiceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceValidationServiceResponse proto.InternalMessageInfo
+
+func (m *ResourceValidationServiceResponse) GetProxyResults() []*ResourceValidationServiceResponse_ProxyResult {
+	if m != nil {
+		return m.ProxyResults
+	}
+	return nil
+}
+
+func (m *ResourceValidationServiceResponse) GetResourceErrors() []string {
+	if m != nil {
+		return m.ResourceErrors
+	}
+	return nil
+}
+
+type ResourceValidationServiceResponse_ProxyResult struct {
+	Proxy                *v1.Proxy    `protobuf:"bytes,1,opt,name=proxy,proto3" json:"proxy,omitempty"`
+	ProxyReport          *ProxyReport `protobuf:"bytes,2,opt,name=proxy_report,json=proxyReport,proto3" json:"proxy_report,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ResourceValidationServiceResponse_ProxyResult) Reset() {
+	*m = ResourceValidationServiceResponse_ProxyResult{}
+}
+func (m *ResourceValidationServiceResponse_ProxyResult) String() string {
+	return proto.CompactTextString(m)
+}
+func (*ResourceValidationServiceResponse_ProxyResult) ProtoMessage() {}
+func (*ResourceValidationServiceResponse_ProxyResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aacaf097b496f502, []int{3, 0}
+}
+func (m *ResourceValidationServiceResponse_ProxyResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResourceValidationServiceResponse_ProxyResult.Unmarshal(m, b)
+}
+func (m *ResourceValidationServiceResponse_ProxyResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResourceValidationServiceResponse_ProxyResult.Marshal(b, m, deterministic)
+}
+func (m *ResourceValidationServiceResponse_ProxyResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceValidationServiceResponse_ProxyResult.Merge(m, src)
+}
+func (m *ResourceValidationServiceResponse_ProxyResult) XXX_Size() int {
+	return xxx_messageInfo_ResourceValidationServiceResponse_ProxyResult.Size(m)
+}
+func (m *ResourceValidationServiceResponse_ProxyResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceValidationServiceResponse_ProxyResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceValidationServiceResponse_ProxyResult proto.InternalMessageInfo
+
+func (m *ResourceValidationServiceResponse_ProxyResult) GetProxy() *v1.Proxy {
+	if m != nil {
+		return m.Proxy
+	}
+	return nil
+}
+
+func (m *ResourceValidationServiceResponse_ProxyResult) GetProxyReport() *ProxyReport {
+	if m != nil {
+		return m.ProxyReport
+	}
+	return nil
+}
+
+type ProxyTranslationServiceRequest struct {
+	Proxy                *v1.Proxy `protobuf:"bytes,1,opt,name=proxy,proto3" json:"proxy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ProxyTranslationServiceRequest) Reset()         { *m = ProxyTranslationServiceRequest{} }
+func (m *ProxyTranslationServiceRequest) String() string { return proto.CompactTextString(m) }
+func (*ProxyTranslationServiceRequest) ProtoMessage()    {}
+func (*ProxyTranslationServiceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aacaf097b496f502, []int{4}
+}
+func (m *ProxyTranslationServiceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProxyTranslationServiceRequest.Unmarshal(m, b)
+}
+func (m *ProxyTranslationServiceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProxyTranslationServiceRequest.Marshal(b, m, deterministic)
+}
+func (m *ProxyTranslationServiceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProxyTranslationServiceRequest.Merge(m, src)
+}
+func (m *ProxyTranslationServiceRequest) XXX_Size() int {
+	return xxx_messageInfo_ProxyTranslationServiceRequest.Size(m)
+}
+func (m *ProxyTranslationServiceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProxyTranslationServiceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProxyTranslationServiceRequest proto.InternalMessageInfo
+
+func (m *ProxyTranslationServiceRequest) GetProxy() *v1.Proxy {
+	if m != nil {
+		return m.Proxy
+	}
+	return nil
+}
+
+type ProxyTranslationServiceResponse struct {
+	// the xDS resources generated for the proxy, sorted by type url and name
+	XdsResources         []*ProxyTranslationServiceResponse_XdsResource `protobuf:"bytes,1,rep,name=xds_resources,json=xdsResources,proto3" json:"xds_resources,omitempty"`
+	ProxyReport          *ProxyReport                                   `protobuf:"bytes,2,opt,name=proxy_report,json=proxyReport,proto3" json:"proxy_report,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
+	XXX_unrecognized     []byte                                         `json:"-"`
+	XXX_sizecache        int32                                          `json:"-"`
+}
+
+func (m *ProxyTranslationServiceResponse) Reset()         { *m = ProxyTranslationServiceResponse{} }
+func (m *ProxyTranslationServiceResponse) String() string { return proto.CompactTextString(m) }
+func (*ProxyTranslationServiceResponse) ProtoMessage()    {}
+func (*ProxyTranslationServiceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aacaf097b496f502, []int{5}
+}
+func (m *ProxyTranslationServiceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProxyTranslationServiceResponse.Unmarshal(m, b)
+}
+func (m *ProxyTranslationServiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProxyTranslationServiceResponse.Marshal(b, m, deterministic)
+}
+func (m *ProxyTranslationServiceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProxyTranslationServiceResponse.Merge(m, src)
+}
+func (m *ProxyTranslationServiceResponse) XXX_Size() int {
+	return xxx_messageInfo_ProxyTranslationServiceResponse.Size(m)
+}
+func (m *ProxyTranslationServiceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProxyTranslationServiceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProxyTranslationServiceResponse proto.InternalMessageInfo
+
+func (m *ProxyTranslationServiceResponse) GetXdsResources() []*ProxyTranslationServiceResponse_XdsResource {
+	if m != nil {
+		return m.XdsResources
+	}
+	return nil
+}
+
+func (m *ProxyTranslationServiceResponse) GetProxyReport() *ProxyReport {
+	if m != nil {
+		return m.ProxyReport
+	}
+	return nil
+}
+
+type ProxyTranslationServiceResponse_XdsResource struct {
+	// the xDS type url of the resource, e.g. type.googleapis.com/envoy.api.v2.Cluster
+	TypeUrl string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	// the name of the resource
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// a hash of the resource, which changes when the resource does. the resource itself is not
+	// returned, as it may contain secrets
+	Hash                 uint64   `protobuf:"varint,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProxyTranslationServiceResponse_XdsResource) Reset() {
+	*m = ProxyTranslationServiceResponse_XdsResource{}
+}
+func (m *ProxyTranslationServiceResponse_XdsResource) String() string {
+	return proto.CompactTextString(m)
+}
+func (*ProxyTranslationServiceResponse_XdsResource) ProtoMessage() {}
+func (*ProxyTranslationServiceResponse_XdsResource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aacaf097b496f502, []int{5, 0}
+}
+func (m *ProxyTranslationServiceResponse_XdsResource) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProxyTranslationServiceResponse_XdsResource.Unmarshal(m, b)
+}
+func (m *ProxyTranslationServiceResponse_XdsResource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProxyTranslationServiceResponse_XdsResource.Marshal(b, m, deterministic)
+}
+func (m *ProxyTranslationServiceResponse_XdsResource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProxyTranslationServiceResponse_XdsResource.Merge(m, src)
+}
+func (m *ProxyTranslationServiceResponse_XdsResource) XXX_Size() int {
+	return xxx_messageInfo_ProxyTranslationServiceResponse_XdsResource.Size(m)
+}
+func (m *ProxyTranslationServiceResponse_XdsResource) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProxyTranslationServiceResponse_XdsResource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProxyTranslationServiceResponse_XdsResource proto.InternalMessageInfo
+
+func (m *ProxyTranslationServiceResponse_XdsResource) GetTypeUrl() string {
+	if m != nil {
+		return m.TypeUrl
+	}
+	return ""
+}
+
+func (m *ProxyTranslationServiceResponse_XdsResource) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ProxyTranslationServiceResponse_XdsResource) GetHash() uint64 {
+	if m != nil {
+		return m.Hash
+	}
+	return 0
+}
+
 type NotifyOnResyncRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -302,7 +650,7 @@ func (m *NotifyOnResyncRequest) Reset()         { *m = NotifyOnResyncRequest{} }
 func (m *NotifyOnResyncRequest) String() string { return proto.CompactTextString(m) }
 func (*NotifyOnResyncRequest) ProtoMessage()    {}
 func (*NotifyOnResyncRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{2}
+	return fileDescriptor_aacaf097b496f502, []int{6}
 }
 func (m *NotifyOnResyncRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NotifyOnResyncRequest.Unmarshal(m, b)
@@ -332,7 +680,7 @@ func (m *NotifyOnResyncResponse) Reset()         { *m = NotifyOnResyncResponse{}
 func (m *NotifyOnResyncResponse) String() string { return proto.CompactTextString(m) }
 func (*NotifyOnResyncResponse) ProtoMessage()    {}
 func (*NotifyOnResyncResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{3}
+	return fileDescriptor_aacaf097b496f502, []int{7}
 }
 func (m *NotifyOnResyncResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NotifyOnResyncResponse.Unmarshal(m, b)
@@ -369,7 +717,7 @@ func (m *ProxyReport) Reset()         { *m = ProxyReport{} }
 func (m *ProxyReport) String() string { return proto.CompactTextString(m) }
 func (*ProxyReport) ProtoMessage()    {}
 func (*ProxyReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{4}
+	return fileDescriptor_aacaf097b496f502, []int{8}
 }
 func (m *ProxyReport) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProxyReport.Unmarshal(m, b)
@@ -412,7 +760,7 @@ func (m *ListenerReport) Reset()         { *m = ListenerReport{} }
 func (m *ListenerReport) String() string { return proto.CompactTextString(m) }
 func (*ListenerReport) ProtoMessage()    {}
 func (*ListenerReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{5}
+	return fileDescriptor_aacaf097b496f502, []int{9}
 }
 func (m *ListenerReport) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListenerReport.Unmarshal(m, b)
@@ -497,7 +845,7 @@ func (m *ListenerReport_Error) Reset()         { *m = ListenerReport_Error{} }
 func (m *ListenerReport_Error) String() string { return proto.CompactTextString(m) }
 func (*ListenerReport_Error) ProtoMessage()    {}
 func (*ListenerReport_Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{5, 0}
+	return fileDescriptor_aacaf097b496f502, []int{9, 0}
 }
 func (m *ListenerReport_Error) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListenerReport_Error.Unmarshal(m, b)
@@ -544,7 +892,7 @@ func (m *HttpListenerReport) Reset()         { *m = HttpListenerReport{} }
 func (m *HttpListenerReport) String() string { return proto.CompactTextString(m) }
 func (*HttpListenerReport) ProtoMessage()    {}
 func (*HttpListenerReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{6}
+	return fileDescriptor_aacaf097b496f502, []int{10}
 }
 func (m *HttpListenerReport) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HttpListenerReport.Unmarshal(m, b)
@@ -593,7 +941,7 @@ func (m *HttpListenerReport_Error) Reset()         { *m = HttpListenerReport_Err
 func (m *HttpListenerReport_Error) String() string { return proto.CompactTextString(m) }
 func (*HttpListenerReport_Error) ProtoMessage()    {}
 func (*HttpListenerReport_Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{6, 0}
+	return fileDescriptor_aacaf097b496f502, []int{10, 0}
 }
 func (m *HttpListenerReport_Error) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HttpListenerReport_Error.Unmarshal(m, b)
@@ -640,7 +988,7 @@ func (m *VirtualHostReport) Reset()         { *m = VirtualHostReport{} }
 func (m *VirtualHostReport) String() string { return proto.CompactTextString(m) }
 func (*VirtualHostReport) ProtoMessage()    {}
 func (*VirtualHostReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{7}
+	return fileDescriptor_aacaf097b496f502, []int{11}
 }
 func (m *VirtualHostReport) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VirtualHostReport.Unmarshal(m, b)
@@ -689,7 +1037,7 @@ func (m *VirtualHostReport_Error) Reset()         { *m = VirtualHostReport_Error
 func (m *VirtualHostReport_Error) String() string { return proto.CompactTextString(m) }
 func (*VirtualHostReport_Error) ProtoMessage()    {}
 func (*VirtualHostReport_Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{7, 0}
+	return fileDescriptor_aacaf097b496f502, []int{11, 0}
 }
 func (m *VirtualHostReport_Error) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VirtualHostReport_Error.Unmarshal(m, b)
@@ -737,7 +1085,7 @@ func (m *RouteReport) Reset()         { *m = RouteReport{} }
 func (m *RouteReport) String() string { return proto.CompactTextString(m) }
 func (*RouteReport) ProtoMessage()    {}
 func (*RouteReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{8}
+	return fileDescriptor_aacaf097b496f502, []int{12}
 }
 func (m *RouteReport) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RouteReport.Unmarshal(m, b)
@@ -786,7 +1134,7 @@ func (m *RouteReport_Error) Reset()         { *m = RouteReport_Error{} }
 func (m *RouteReport_Error) String() string { return proto.CompactTextString(m) }
 func (*RouteReport_Error) ProtoMessage()    {}
 func (*RouteReport_Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{8, 0}
+	return fileDescriptor_aacaf097b496f502, []int{12, 0}
 }
 func (m *RouteReport_Error) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RouteReport_Error.Unmarshal(m, b)
@@ -835,7 +1183,7 @@ func (m *RouteReport_Warning) Reset()         { *m = RouteReport_Warning{} }
 func (m *RouteReport_Warning) String() string { return proto.CompactTextString(m) }
 func (*RouteReport_Warning) ProtoMessage()    {}
 func (*RouteReport_Warning) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{8, 1}
+	return fileDescriptor_aacaf097b496f502, []int{12, 1}
 }
 func (m *RouteReport_Warning) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RouteReport_Warning.Unmarshal(m, b)
@@ -882,7 +1230,7 @@ func (m *TcpListenerReport) Reset()         { *m = TcpListenerReport{} }
 func (m *TcpListenerReport) String() string { return proto.CompactTextString(m) }
 func (*TcpListenerReport) ProtoMessage()    {}
 func (*TcpListenerReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{9}
+	return fileDescriptor_aacaf097b496f502, []int{13}
 }
 func (m *TcpListenerReport) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TcpListenerReport.Unmarshal(m, b)
@@ -931,7 +1279,7 @@ func (m *TcpListenerReport_Error) Reset()         { *m = TcpListenerReport_Error
 func (m *TcpListenerReport_Error) String() string { return proto.CompactTextString(m) }
 func (*TcpListenerReport_Error) ProtoMessage()    {}
 func (*TcpListenerReport_Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{9, 0}
+	return fileDescriptor_aacaf097b496f502, []int{13, 0}
 }
 func (m *TcpListenerReport_Error) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TcpListenerReport_Error.Unmarshal(m, b)
@@ -977,7 +1325,7 @@ func (m *TcpHostReport) Reset()         { *m = TcpHostReport{} }
 func (m *TcpHostReport) String() string { return proto.CompactTextString(m) }
 func (*TcpHostReport) ProtoMessage()    {}
 func (*TcpHostReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{10}
+	return fileDescriptor_aacaf097b496f502, []int{14}
 }
 func (m *TcpHostReport) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TcpHostReport.Unmarshal(m, b)
@@ -1019,7 +1367,7 @@ func (m *TcpHostReport_Error) Reset()         { *m = TcpHostReport_Error{} }
 func (m *TcpHostReport_Error) String() string { return proto.CompactTextString(m) }
 func (*TcpHostReport_Error) ProtoMessage()    {}
 func (*TcpHostReport_Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{10, 0}
+	return fileDescriptor_aacaf097b496f502, []int{14, 0}
 }
 func (m *TcpHostReport_Error) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TcpHostReport_Error.Unmarshal(m, b)
@@ -1063,6 +1411,12 @@ func init() {
 	proto.RegisterEnum("gloo.solo.io.TcpHostReport_Error_Type", TcpHostReport_Error_Type_name, TcpHostReport_Error_Type_value)
 	proto.RegisterType((*ProxyValidationServiceRequest)(nil), "gloo.solo.io.ProxyValidationServiceRequest")
 	proto.RegisterType((*ProxyValidationServiceResponse)(nil), "gloo.solo.io.ProxyValidationServiceResponse")
+	proto.RegisterType((*ResourceValidationServiceRequest)(nil), "gloo.solo.io.ResourceValidationServiceRequest")
+	proto.RegisterType((*ResourceValidationServiceResponse)(nil), "gloo.solo.io.ResourceValidationServiceResponse")
+	proto.RegisterType((*ResourceValidationServiceResponse_ProxyResult)(nil), "gloo.solo.io.ResourceValidationServiceResponse.ProxyResult")
+	proto.RegisterType((*ProxyTranslationServiceRequest)(nil), "gloo.solo.io.ProxyTranslationServiceRequest")
+	proto.RegisterType((*ProxyTranslationServiceResponse)(nil), "gloo.solo.io.ProxyTranslationServiceResponse")
+	proto.RegisterType((*ProxyTranslationServiceResponse_XdsResource)(nil), "gloo.solo.io.ProxyTranslationServiceResponse.XdsResource")
 	proto.RegisterType((*NotifyOnResyncRequest)(nil), "gloo.solo.io.NotifyOnResyncRequest")
 	proto.RegisterType((*NotifyOnResyncResponse)(nil), "gloo.solo.io.NotifyOnResyncResponse")
 	proto.RegisterType((*ProxyReport)(nil), "gloo.solo.io.ProxyReport")
@@ -1086,62 +1440,82 @@ func init() {
 }
 
 var fileDescriptor_aacaf097b496f502 = []byte{
-	// 878 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xcf, 0x52, 0x2b, 0x45,
-	0x14, 0xc6, 0x33, 0x09, 0x17, 0xf5, 0x04, 0x72, 0x43, 0x27, 0x37, 0x84, 0xc1, 0xeb, 0x0d, 0x23,
-	0x20, 0x8a, 0x4e, 0x34, 0x5a, 0x25, 0xa2, 0x40, 0x15, 0x42, 0x89, 0x16, 0x62, 0x18, 0x22, 0x56,
-	0xb9, 0x30, 0x35, 0x0c, 0x4d, 0xd2, 0x9a, 0x4c, 0x0f, 0xdd, 0x9d, 0x68, 0x16, 0xee, 0xdc, 0xfb,
-	0x06, 0x2e, 0xdd, 0xf9, 0x10, 0x56, 0xe9, 0x03, 0xb8, 0x75, 0xe5, 0xce, 0x77, 0x70, 0x65, 0x65,
-	0xa6, 0x33, 0x64, 0xfe, 0x24, 0x33, 0xcb, 0xbb, 0xec, 0xce, 0x39, 0x5f, 0x7f, 0xfd, 0x3b, 0x27,
-	0x3d, 0x07, 0xf6, 0x1c, 0x46, 0xbf, 0xc5, 0x96, 0xe0, 0xf5, 0x4e, 0x8f, 0xd2, 0xba, 0xe9, 0x90,
-	0x7a, 0x87, 0x39, 0x56, 0x7d, 0x68, 0xf6, 0xc8, 0xad, 0x29, 0x08, 0xb5, 0xeb, 0x0e, 0xa3, 0x3f,
-	0x8c, 0xda, 0x0f, 0x1b, 0xba, 0xc3, 0xa8, 0xa0, 0x68, 0x69, 0x9c, 0xa0, 0x73, 0xda, 0xa3, 0x3a,
-	0xa1, 0xea, 0x96, 0x9b, 0x1e, 0x15, 0x1b, 0xbe, 0xe3, 0xe5, 0x7b, 0x49, 0xda, 0x67, 0xf0, 0xb4,
-	0x39, 0x5e, 0x5e, 0xfb, 0x6a, 0x57, 0x98, 0x0d, 0x89, 0x85, 0x0d, 0x7c, 0x3f, 0xc0, 0x5c, 0xa0,
-	0xd7, 0xe1, 0x91, 0x1b, 0x5f, 0x55, 0x6a, 0xca, 0x4e, 0xbe, 0x51, 0xd2, 0xa7, 0x4f, 0xd1, 0xdd,
-	0x5c, 0xc3, 0x8b, 0xd0, 0xbe, 0x81, 0x57, 0x66, 0x69, 0x71, 0x87, 0xda, 0x1c, 0xa3, 0x8f, 0x60,
-	0xc9, 0x33, 0xcf, 0xb0, 0x43, 0x99, 0x90, 0x9a, 0x6b, 0x71, 0x9a, 0x6e, 0x80, 0x91, 0x77, 0x1e,
-	0x16, 0xda, 0x2a, 0x3c, 0xb9, 0xa0, 0x82, 0xdc, 0x8d, 0xbe, 0xb0, 0x0d, 0xcc, 0x47, 0xb6, 0x25,
-	0x3d, 0x6a, 0x55, 0xa8, 0x84, 0x7f, 0xf0, 0x0e, 0xd4, 0xae, 0x21, 0x3f, 0x25, 0x87, 0x3e, 0x81,
-	0x62, 0x8f, 0x70, 0x81, 0x6d, 0xcc, 0xa4, 0x05, 0x5e, 0x55, 0x6a, 0xb9, 0x9d, 0x7c, 0xe3, 0xe5,
-	0xa0, 0x87, 0x73, 0x19, 0x25, 0x6d, 0x3c, 0xee, 0x05, 0xd6, 0x5c, 0xfb, 0x2b, 0x07, 0x85, 0x60,
-	0x0c, 0xda, 0x87, 0x45, 0xcc, 0x18, 0x65, 0xbc, 0x9a, 0x75, 0x15, 0xb5, 0x79, 0x8a, 0xfa, 0xe9,
-	0x38, 0xd4, 0x90, 0x19, 0xa8, 0x05, 0xe5, 0xae, 0x10, 0x4e, 0x3b, 0x64, 0xae, 0x9a, 0x73, 0xf9,
-	0xd4, 0x82, 0x4a, 0x67, 0x42, 0x38, 0x41, 0xb5, 0xb3, 0x8c, 0x81, 0xba, 0x91, 0x5d, 0x74, 0x09,
-	0x25, 0x61, 0x45, 0x45, 0x17, 0x5c, 0xd1, 0x67, 0x41, 0xd1, 0x96, 0x15, 0xd5, 0x5c, 0x11, 0xe1,
-	0x4d, 0xf5, 0x77, 0x05, 0x1e, 0xb9, 0xd6, 0xd1, 0x87, 0xb0, 0x20, 0x46, 0x0e, 0x76, 0x4b, 0x58,
-	0x68, 0xbc, 0x96, 0x7c, 0x59, 0xbd, 0x35, 0x72, 0xb0, 0xe1, 0x26, 0xa1, 0x0a, 0x2c, 0x32, 0x6c,
-	0x72, 0x6a, 0x57, 0xb3, 0x35, 0x65, 0xe7, 0x25, 0x43, 0xae, 0x34, 0x0b, 0x16, 0x5a, 0xde, 0xef,
-	0xe8, 0xc2, 0xec, 0xe3, 0x0b, 0x2a, 0xbe, 0xb4, 0xc9, 0xfd, 0x00, 0xbb, 0x02, 0xc5, 0x0c, 0x52,
-	0xa1, 0x72, 0x4c, 0xec, 0xdb, 0x26, 0x65, 0x22, 0xf4, 0x9b, 0x82, 0x10, 0x14, 0xae, 0xae, 0xce,
-	0x3f, 0xa6, 0xf6, 0x1d, 0xe9, 0x78, 0x7b, 0x59, 0x54, 0x82, 0xc7, 0x4d, 0x46, 0x2d, 0xcc, 0x39,
-	0xb1, 0xe5, 0x66, 0xee, 0xb8, 0x02, 0x65, 0x1f, 0xc9, 0xd8, 0x8d, 0xe4, 0xa2, 0xfd, 0x9a, 0x05,
-	0x14, 0x65, 0x8b, 0x0e, 0xfd, 0xba, 0x7a, 0x9d, 0xb2, 0x9d, 0x54, 0x8d, 0x50, 0x6d, 0x2f, 0xa1,
-	0x3c, 0x24, 0x4c, 0x0c, 0xcc, 0x5e, 0xbb, 0x4b, 0xb9, 0xf0, 0xfb, 0xce, 0xeb, 0x92, 0x50, 0x19,
-	0xae, 0xbd, 0xc8, 0x33, 0xca, 0x85, 0x6c, 0x3d, 0x34, 0x0c, 0x6f, 0x71, 0xf5, 0xc7, 0x49, 0x11,
-	0x8e, 0x02, 0x45, 0xd8, 0x4d, 0xe7, 0x2c, 0x4d, 0x21, 0xd6, 0x65, 0x21, 0x62, 0x00, 0x66, 0xb4,
-	0xbf, 0xb3, 0xb0, 0x12, 0x31, 0x8a, 0x0e, 0x42, 0x9c, 0xb6, 0x12, 0x6e, 0x16, 0xc2, 0x74, 0x08,
-	0xcb, 0x8c, 0x0e, 0x04, 0x0e, 0xf1, 0x09, 0xbd, 0x0d, 0xc6, 0x38, 0x44, 0x92, 0x59, 0x62, 0x0f,
-	0x0b, 0xae, 0xfe, 0xe9, 0x77, 0xe6, 0x61, 0x00, 0xca, 0x1b, 0xa9, 0x6c, 0xa4, 0x61, 0x72, 0x9b,
-	0xd0, 0x9c, 0x6b, 0xf0, 0xe4, 0x84, 0xf6, 0x4d, 0x62, 0xf3, 0x48, 0x6f, 0xc6, 0x60, 0xcc, 0xa2,
-	0x32, 0x14, 0x4f, 0xfb, 0x8e, 0x18, 0x79, 0x49, 0xb2, 0x3b, 0xb5, 0x5f, 0x72, 0x90, 0x9f, 0xba,
-	0x25, 0x7a, 0x3f, 0x84, 0xf5, 0xd9, 0x4c, 0x20, 0x21, 0xa0, 0x07, 0xf0, 0xe2, 0xf7, 0x26, 0xb3,
-	0x89, 0xdd, 0x99, 0xb0, 0xdc, 0x98, 0x9d, 0xfa, 0x95, 0x17, 0x69, 0xf8, 0x29, 0xea, 0xcf, 0x3e,
-	0xcf, 0xbd, 0x00, 0xcf, 0xcd, 0x84, 0xf3, 0xd3, 0x90, 0x7c, 0x4f, 0x92, 0x5c, 0x85, 0xd2, 0xa7,
-	0xb6, 0xfb, 0x1d, 0xfb, 0xdc, 0x14, 0x56, 0x17, 0xb3, 0x09, 0xca, 0x18, 0x5e, 0x8a, 0xfa, 0x93,
-	0x02, 0x2f, 0x48, 0x9f, 0x68, 0x3f, 0xe0, 0x69, 0x3b, 0xf1, 0x62, 0x69, 0x5c, 0x6d, 0x49, 0x57,
-	0x4f, 0x61, 0x4d, 0xba, 0x3a, 0xc1, 0x5c, 0x10, 0xdb, 0xfd, 0x92, 0x49, 0x9d, 0x62, 0x46, 0xfb,
-	0x27, 0x0b, 0x2b, 0x91, 0xd7, 0x32, 0xa9, 0xfb, 0x23, 0x09, 0xa1, 0x62, 0x9d, 0x42, 0x71, 0xfc,
-	0x54, 0xc7, 0x3c, 0x10, 0xeb, 0x11, 0xa1, 0xa9, 0xc7, 0xa1, 0x20, 0xa6, 0x97, 0x5c, 0xfd, 0x23,
-	0xdd, 0x9f, 0x60, 0x86, 0x9b, 0xe7, 0xe5, 0x85, 0xd6, 0xfe, 0x53, 0x60, 0x39, 0x70, 0x51, 0xf4,
-	0x41, 0xe8, 0xe3, 0xba, 0x31, 0x87, 0x4a, 0x10, 0xad, 0xfa, 0x9b, 0xcf, 0x64, 0x6e, 0xd3, 0xc4,
-	0x48, 0xa4, 0xe1, 0xd1, 0x4c, 0xe0, 0xb1, 0x0e, 0xab, 0xd1, 0x66, 0x9a, 0xf7, 0x2c, 0x34, 0xfe,
-	0x55, 0xa0, 0x12, 0x3f, 0x46, 0xa1, 0x36, 0x14, 0x82, 0x73, 0x0e, 0x7a, 0x35, 0x78, 0x89, 0xd8,
-	0xf1, 0x48, 0xdd, 0x9c, 0x1f, 0x24, 0x47, 0xa5, 0xcc, 0xdb, 0x0a, 0xea, 0xc1, 0xb2, 0x3c, 0x15,
-	0xbb, 0x16, 0xd0, 0x6e, 0xcc, 0x68, 0x36, 0x6b, 0x54, 0x54, 0xdf, 0x4c, 0x17, 0x3c, 0x39, 0xef,
-	0xf8, 0xe8, 0xeb, 0x83, 0x0e, 0x11, 0xdd, 0xc1, 0x8d, 0x6e, 0xd1, 0x7e, 0x7d, 0x9c, 0xf6, 0x16,
-	0xa1, 0xf5, 0x98, 0xb9, 0xd5, 0xf9, 0xae, 0x13, 0x37, 0x08, 0xdf, 0x2c, 0xba, 0x33, 0xec, 0xbb,
-	0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x49, 0x1d, 0x72, 0x24, 0x34, 0x0b, 0x00, 0x00,
+	// 1188 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4b, 0x73, 0x1b, 0x45,
+	0x17, 0xd5, 0x2b, 0x8e, 0x73, 0x65, 0xc9, 0x72, 0xdb, 0x91, 0xe5, 0xf1, 0x97, 0xcf, 0xf2, 0x10,
+	0x3b, 0x86, 0x24, 0x12, 0x98, 0x54, 0x91, 0x07, 0x76, 0xaa, 0x8c, 0x5d, 0x11, 0x10, 0x8c, 0x33,
+	0x7e, 0x40, 0xb1, 0x88, 0x98, 0x8c, 0x3a, 0xd2, 0xc0, 0x68, 0x7a, 0xd2, 0xdd, 0x23, 0xac, 0x05,
+	0x3b, 0xf6, 0x14, 0x7f, 0x80, 0x25, 0x3b, 0x7e, 0x04, 0x55, 0xb0, 0xa6, 0xd8, 0xb2, 0xe2, 0x37,
+	0xb0, 0x64, 0x45, 0x4d, 0x4f, 0x8f, 0xac, 0x79, 0xe8, 0xe1, 0xca, 0x86, 0x9d, 0xba, 0xfb, 0xde,
+	0xd3, 0xe7, 0x9e, 0x7b, 0xa6, 0xd5, 0x0d, 0xf7, 0x1d, 0x4a, 0xbe, 0xc2, 0x06, 0x67, 0xf5, 0xb6,
+	0x45, 0x48, 0x5d, 0x77, 0xcc, 0x7a, 0x9b, 0x3a, 0x46, 0xbd, 0xa7, 0x5b, 0x66, 0x4b, 0xe7, 0x26,
+	0xb1, 0xeb, 0x0e, 0x25, 0xe7, 0xfd, 0xe6, 0xc5, 0x44, 0xcd, 0xa1, 0x84, 0x13, 0x34, 0xe7, 0x25,
+	0xd4, 0x18, 0xb1, 0x48, 0xcd, 0x24, 0xca, 0x86, 0x48, 0x8f, 0x83, 0xf5, 0xde, 0xf1, 0xf3, 0xfd,
+	0x24, 0x65, 0x6b, 0x74, 0x98, 0xeb, 0x30, 0x4e, 0xb1, 0xde, 0x95, 0x91, 0x9b, 0xa3, 0x23, 0x19,
+	0x36, 0x28, 0xe6, 0x7e, 0x9c, 0xfa, 0x11, 0xdc, 0x38, 0xf2, 0x36, 0x38, 0x1b, 0xf0, 0x3b, 0xc6,
+	0xb4, 0x67, 0x1a, 0x58, 0xc3, 0xaf, 0x5c, 0xcc, 0x38, 0x7a, 0x13, 0xae, 0x08, 0x06, 0x95, 0x74,
+	0x35, 0xbd, 0x95, 0xdf, 0x5e, 0xac, 0x0d, 0xf3, 0xae, 0x89, 0x5c, 0xcd, 0x8f, 0x50, 0x9f, 0xc3,
+	0xff, 0x47, 0x61, 0x31, 0x87, 0xd8, 0x0c, 0xa3, 0xf7, 0x61, 0xce, 0x97, 0x83, 0x62, 0x87, 0x50,
+	0x2e, 0x31, 0x57, 0x92, 0x30, 0x45, 0x80, 0x96, 0x77, 0x2e, 0x06, 0xea, 0xdf, 0x69, 0xa8, 0x6a,
+	0x98, 0x11, 0x97, 0x1a, 0x78, 0x24, 0xdf, 0x7b, 0x30, 0x1b, 0x48, 0x21, 0xe1, 0xcb, 0x61, 0xf8,
+	0x53, 0xb9, 0xda, 0x48, 0x69, 0x83, 0x48, 0xb4, 0x0f, 0xc5, 0xe0, 0x77, 0xb3, 0x4d, 0x89, 0xeb,
+	0x54, 0x32, 0x22, 0x77, 0x35, 0x39, 0xf7, 0x89, 0x17, 0xd2, 0x48, 0x69, 0x05, 0x77, 0x78, 0x02,
+	0xd5, 0x60, 0xc6, 0x17, 0xb7, 0x92, 0x15, 0xd9, 0x4b, 0xe1, 0xec, 0x63, 0xb1, 0xd6, 0x48, 0x69,
+	0x32, 0x0a, 0x95, 0x61, 0xa6, 0x85, 0x2d, 0xcc, 0x71, 0x25, 0x57, 0x4d, 0x6f, 0xcd, 0x6a, 0x72,
+	0xb4, 0x07, 0x30, 0x4b, 0x65, 0x9d, 0xea, 0x4f, 0x19, 0x58, 0x1f, 0x53, 0xb4, 0x14, 0xf6, 0x4b,
+	0x28, 0x04, 0xc2, 0x32, 0xd7, 0xe2, 0xac, 0x92, 0xae, 0x66, 0xb7, 0xf2, 0xdb, 0x8f, 0xc2, 0x04,
+	0x26, 0xe2, 0x04, 0xda, 0x7b, 0x18, 0xda, 0x9c, 0x73, 0x31, 0x60, 0xe8, 0x16, 0xcc, 0x07, 0x9c,
+	0x9a, 0x98, 0x52, 0x42, 0x59, 0x25, 0x53, 0xcd, 0x6e, 0x5d, 0xd3, 0x8a, 0xc1, 0xf4, 0x81, 0x98,
+	0x55, 0x7a, 0x90, 0x1f, 0x42, 0xb9, 0x84, 0x7f, 0x62, 0xee, 0xc8, 0x5c, 0xca, 0x1d, 0x1f, 0x4b,
+	0xf7, 0x9d, 0x50, 0xdd, 0x66, 0xd6, 0x6b, 0x5a, 0xf9, 0x87, 0x0c, 0xac, 0x8d, 0x44, 0x93, 0x9a,
+	0x3f, 0x87, 0xc2, 0x79, 0x8b, 0x35, 0x83, 0xf2, 0x03, 0xcd, 0x1f, 0x24, 0xc0, 0x8e, 0x46, 0xa9,
+	0x7d, 0xde, 0x62, 0x41, 0x5b, 0xb4, 0xb9, 0xf3, 0x8b, 0x01, 0x7b, 0x3d, 0x39, 0x94, 0x23, 0xc8,
+	0x0f, 0x41, 0xa3, 0x15, 0x98, 0xe5, 0x7d, 0x07, 0x37, 0x5d, 0x6a, 0x89, 0xf2, 0xaf, 0x69, 0x57,
+	0xbd, 0xf1, 0x29, 0xb5, 0x10, 0x82, 0x9c, 0xad, 0x77, 0xb1, 0xc0, 0xbf, 0xa6, 0x89, 0xdf, 0xde,
+	0x5c, 0x47, 0x67, 0x1d, 0xe1, 0xe3, 0x9c, 0x26, 0x7e, 0xab, 0xcb, 0x70, 0xfd, 0x90, 0x70, 0xf3,
+	0x65, 0xff, 0x53, 0x5b, 0xc3, 0xac, 0x6f, 0x1b, 0x52, 0x57, 0xb5, 0x02, 0xe5, 0xe8, 0x82, 0x5f,
+	0x9c, 0x7a, 0x36, 0xf0, 0x82, 0xc7, 0x09, 0x3d, 0x81, 0x92, 0x65, 0x32, 0x8e, 0x6d, 0x4c, 0x65,
+	0x51, 0x81, 0x68, 0xff, 0x0b, 0x57, 0xf5, 0x54, 0x46, 0xc9, 0xc2, 0xe6, 0xad, 0xd0, 0x98, 0xa9,
+	0x7f, 0x64, 0xa1, 0x18, 0x8e, 0x41, 0x0f, 0x61, 0x66, 0xc8, 0x96, 0xf9, 0x6d, 0x75, 0x1c, 0x62,
+	0x4d, 0x78, 0x55, 0x93, 0x19, 0xe8, 0x04, 0x96, 0x3a, 0x9c, 0x3b, 0xcd, 0x08, 0x39, 0xf9, 0x15,
+	0x57, 0xc3, 0x48, 0x0d, 0xce, 0x9d, 0x30, 0x5a, 0x23, 0xa5, 0xa1, 0x4e, 0x6c, 0x16, 0x3d, 0x83,
+	0x45, 0x6e, 0xc4, 0x41, 0x73, 0x02, 0x74, 0x2d, 0x0c, 0x7a, 0x62, 0xc4, 0x31, 0x17, 0x78, 0x74,
+	0x52, 0xf9, 0x25, 0x0d, 0x57, 0x04, 0x75, 0xf4, 0x08, 0x72, 0x5e, 0xff, 0x44, 0x2f, 0x8b, 0xdb,
+	0xb7, 0x26, 0x17, 0x5b, 0x3b, 0xe9, 0x3b, 0x58, 0x13, 0x49, 0xde, 0xb9, 0x43, 0xb1, 0xce, 0x88,
+	0x2d, 0x7b, 0x2e, 0x47, 0xaa, 0x01, 0xb9, 0x13, 0x7f, 0x1d, 0x1d, 0xea, 0x5d, 0x7c, 0x48, 0xf8,
+	0xa9, 0x6d, 0xbe, 0x72, 0xfd, 0x2f, 0xbb, 0x94, 0x42, 0x0a, 0x94, 0xf7, 0x4c, 0xbb, 0x75, 0x44,
+	0x28, 0x8f, 0xac, 0xa5, 0x11, 0x82, 0xe2, 0xf1, 0xf1, 0xd3, 0x0f, 0x88, 0xfd, 0xd2, 0x6c, 0xfb,
+	0x73, 0x19, 0xb4, 0x08, 0xf3, 0x47, 0x94, 0x18, 0x98, 0x31, 0xd3, 0x96, 0x93, 0xd9, 0xbd, 0x32,
+	0x2c, 0x0d, 0x24, 0x11, 0x96, 0xf4, 0x75, 0xf1, 0x0e, 0x3a, 0x14, 0xd7, 0x16, 0xed, 0x0e, 0xfa,
+	0xea, 0x3b, 0x65, 0x73, 0x52, 0x37, 0x22, 0xbd, 0x7d, 0x06, 0x4b, 0x3d, 0x93, 0x72, 0x57, 0xb7,
+	0x9a, 0x1d, 0xc2, 0xf8, 0xc0, 0x77, 0xbe, 0x4b, 0x22, 0x6d, 0x38, 0xf3, 0x23, 0x1b, 0x84, 0x71,
+	0x69, 0x3d, 0xd4, 0x8b, 0x4e, 0x31, 0xe5, 0xdb, 0xa0, 0x09, 0x8f, 0x43, 0x4d, 0xb8, 0x3d, 0x1d,
+	0xb3, 0x69, 0x1a, 0xb1, 0x2a, 0x1b, 0x91, 0x20, 0x60, 0x4a, 0xfd, 0x33, 0x03, 0x0b, 0x31, 0xa2,
+	0x68, 0x27, 0xa2, 0xd3, 0xc6, 0x84, 0xca, 0x22, 0x32, 0xed, 0x42, 0x81, 0x12, 0x97, 0xe3, 0x88,
+	0x3e, 0x91, 0xd3, 0x46, 0xf3, 0x42, 0xa4, 0x32, 0x73, 0xf4, 0x62, 0xc0, 0x94, 0xdf, 0x06, 0xce,
+	0xdc, 0x0d, 0x89, 0xf2, 0xd6, 0x54, 0x34, 0xa6, 0xd1, 0xa4, 0x35, 0xc1, 0x9c, 0x2b, 0x70, 0x7d,
+	0x9f, 0x74, 0x75, 0xd3, 0x66, 0x31, 0x6f, 0x26, 0xc8, 0x98, 0x41, 0x4b, 0x50, 0x3a, 0xe8, 0x3a,
+	0xbc, 0xef, 0x27, 0x49, 0x77, 0xaa, 0x3f, 0x66, 0x21, 0x3f, 0x54, 0x25, 0x7a, 0x2f, 0x22, 0xeb,
+	0xda, 0x48, 0x41, 0x22, 0x82, 0xee, 0xc0, 0xec, 0x37, 0x3a, 0xb5, 0x4d, 0xbb, 0x1d, 0x68, 0xb9,
+	0x3e, 0x3a, 0xf5, 0x33, 0x3f, 0x52, 0x1b, 0xa4, 0x28, 0xdf, 0x0f, 0xf4, 0xbc, 0x1f, 0xd2, 0xf3,
+	0xe6, 0x84, 0xfd, 0xa7, 0x51, 0xf2, 0x9e, 0x54, 0x72, 0x19, 0x16, 0x3f, 0xb4, 0xc5, 0xc5, 0xf4,
+	0x13, 0x9d, 0x1b, 0x1d, 0x4c, 0x03, 0x29, 0x13, 0xf4, 0x4a, 0x2b, 0xdf, 0xa5, 0xe1, 0xaa, 0xe4,
+	0x89, 0x1e, 0x86, 0x38, 0x6d, 0x4e, 0x2c, 0x6c, 0x1a, 0x56, 0x1b, 0x92, 0xd5, 0x0d, 0x58, 0x91,
+	0xac, 0xf6, 0x31, 0xe3, 0xa6, 0x2d, 0xfe, 0x35, 0x25, 0x4e, 0x29, 0xa5, 0xfe, 0x95, 0x81, 0x85,
+	0xd8, 0x69, 0x39, 0xc9, 0xfd, 0xb1, 0x84, 0x48, 0xb3, 0x0e, 0xa0, 0xe4, 0x1d, 0xd5, 0x09, 0x07,
+	0xc4, 0x6a, 0x0c, 0x68, 0xe8, 0x70, 0x28, 0xf2, 0xe1, 0x21, 0x53, 0x7e, 0x9d, 0xee, 0x23, 0x18,
+	0xc1, 0xe6, 0xbf, 0x72, 0x42, 0xab, 0xff, 0xa4, 0xa1, 0x10, 0x2a, 0x14, 0x3d, 0x88, 0xfc, 0xb9,
+	0xae, 0x8f, 0x51, 0x25, 0x2c, 0xad, 0xf2, 0xf3, 0x40, 0x93, 0xb1, 0xa6, 0x49, 0x80, 0x98, 0x46,
+	0x8f, 0xa3, 0x09, 0x7a, 0xac, 0xc2, 0x72, 0xdc, 0x4c, 0xe3, 0x8e, 0x85, 0xed, 0xdf, 0xb3, 0x50,
+	0x4e, 0x7e, 0xc5, 0xa0, 0x26, 0x14, 0xc3, 0xf7, 0x1c, 0xf4, 0x46, 0xb8, 0x88, 0xc4, 0xeb, 0x91,
+	0x72, 0x73, 0x7c, 0x90, 0xbc, 0x2a, 0xa5, 0xde, 0x4e, 0x23, 0x0b, 0x0a, 0x72, 0x57, 0x2c, 0x28,
+	0xa0, 0xdb, 0x09, 0x97, 0xbd, 0x51, 0x2f, 0x1f, 0xe5, 0xce, 0x74, 0xc1, 0xc1, 0x7e, 0xc8, 0x85,
+	0x52, 0xb0, 0xdb, 0xe0, 0x9a, 0x58, 0x9b, 0xfa, 0xc1, 0xe0, 0xef, 0x59, 0xbf, 0xe4, 0x03, 0x43,
+	0x4d, 0x21, 0x02, 0xc5, 0xe0, 0x3a, 0x2c, 0xab, 0xbc, 0x33, 0xe5, 0x8d, 0xd9, 0xdf, 0xf2, 0xee,
+	0xa5, 0xee, 0xd7, 0x6a, 0x6a, 0xef, 0xf1, 0x17, 0x3b, 0x6d, 0x93, 0x77, 0xdc, 0x17, 0x35, 0x83,
+	0x74, 0xeb, 0x5e, 0xde, 0x5d, 0x93, 0xd4, 0x13, 0xde, 0xc7, 0xce, 0xd7, 0xed, 0xa4, 0x17, 0xfc,
+	0x8b, 0x19, 0xf1, 0x54, 0x7e, 0xf7, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0f, 0x51, 0x86, 0x6e,
+	0xed, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1160,6 +1534,12 @@ type ProxyValidationServiceClient interface {
 	NotifyOnResync(ctx context.Context, in *NotifyOnResyncRequest, opts ...grpc.CallOption) (ProxyValidationService_NotifyOnResyncClient, error)
 	// Submit a proxy for validation
 	ValidateProxy(ctx context.Context, in *ProxyValidationServiceRequest, opts ...grpc.CallOption) (*ProxyValidationServiceResponse, error)
+	// Submit a change to a resource referenced by proxies (e.g. an Upstream or a Secret) for validation
+	// against every Proxy in the current snapshot
+	ValidateResource(ctx context.Context, in *ResourceValidationServiceRequest, opts ...grpc.CallOption) (*ResourceValidationServiceResponse, error)
+	// Translate a proxy against the current snapshot and return the names and hashes of the generated Envoy xDS resources.
+	// The snapshot is not modified.
+	TranslateProxy(ctx context.Context, in *ProxyTranslationServiceRequest, opts ...grpc.CallOption) (*ProxyTranslationServiceResponse, error)
 }
 
 type proxyValidationServiceClient struct {
@@ -1211,12 +1591,36 @@ func (c *proxyValidationServiceClient) ValidateProxy(ctx context.Context, in *Pr
 	return out, nil
 }
 
+func (c *proxyValidationServiceClient) ValidateResource(ctx context.Context, in *ResourceValidationServiceRequest, opts ...grpc.CallOption) (*ResourceValidationServiceResponse, error) {
+	out := new(ResourceValidationServiceResponse)
+	err := c.cc.Invoke(ctx, "/gloo.solo.io.ProxyValidationService/ValidateResource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyValidationServiceClient) TranslateProxy(ctx context.Context, in *ProxyTranslationServiceRequest, opts ...grpc.CallOption) (*ProxyTranslationServiceResponse, error) {
+	out := new(ProxyTranslationServiceResponse)
+	err := c.cc.Invoke(ctx, "/gloo.solo.io.ProxyValidationService/TranslateProxy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProxyValidationServiceServer is the server API for ProxyValidationService service.
 type ProxyValidationServiceServer interface {
 	// Notify the client whenever the Proxy Validation Service resyncs
 	NotifyOnResync(*NotifyOnResyncRequest, ProxyValidationService_NotifyOnResyncServer) error
 	// Submit a proxy for validation
 	ValidateProxy(context.Context, *ProxyValidationServiceRequest) (*ProxyValidationServiceResponse, error)
+	// Submit a change to a resource referenced by proxies (e.g. an Upstream or a Secret) for validation
+	// against every Proxy in the current snapshot
+	ValidateResource(context.Context, *ResourceValidationServiceRequest) (*ResourceValidationServiceResponse, error)
+	// Translate a proxy against the current snapshot and return the names and hashes of the generated Envoy xDS resources.
+	// The snapshot is not modified.
+	TranslateProxy(context.Context, *ProxyTranslationServiceRequest) (*ProxyTranslationServiceResponse, error)
 }
 
 // UnimplementedProxyValidationServiceServer can be embedded to have forward compatible implementations.
@@ -1229,6 +1633,12 @@ func (*UnimplementedProxyValidationServiceServer) NotifyOnResync(req *NotifyOnRe
 func (*UnimplementedProxyValidationServiceServer) ValidateProxy(ctx context.Context, req *ProxyValidationServiceRequest) (*ProxyValidationServiceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidateProxy not implemented")
 }
+func (*UnimplementedProxyValidationServiceServer) ValidateResource(ctx context.Context, req *ResourceValidationServiceRequest) (*ResourceValidationServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateResource not implemented")
+}
+func (*UnimplementedProxyValidationServiceServer) TranslateProxy(ctx context.Context, req *ProxyTranslationServiceRequest) (*ProxyTranslationServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TranslateProxy not implemented")
+}
 
 func RegisterProxyValidationServiceServer(s *grpc.Server, srv ProxyValidationServiceServer) {
 	s.RegisterService(&_ProxyValidationService_serviceDesc, srv)
@@ -1273,6 +1683,42 @@ func _ProxyValidationService_ValidateProxy_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProxyValidationService_ValidateResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResourceValidationServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyValidationServiceServer).ValidateResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gloo.solo.io.ProxyValidationService/ValidateResource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyValidationServiceServer).ValidateResource(ctx, req.(*ResourceValidationServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProxyValidationService_TranslateProxy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProxyTranslationServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyValidationServiceServer).TranslateProxy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gloo.solo.io.ProxyValidationService/TranslateProxy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyValidationServiceServer).TranslateProxy(ctx, req.(*ProxyTranslationServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ProxyValidationService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "gloo.solo.io.ProxyValidationService",
 	HandlerType: (*ProxyValidationServiceServer)(nil),
@@ -1281,6 +1727,14 @@ var _ProxyValidationService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ValidateProxy",
 			Handler:    _ProxyValidationService_ValidateProxy_Handler,
 		},
+		{
+			MethodName: "ValidateResource",
+			Handler:    _ProxyValidationService_ValidateResource_Handler,
+		},
+		{
+			MethodName: "TranslateProxy",
+			Handler:    _ProxyValidationService_TranslateProxy_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -0,0 +1,223 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/aws/ecs/aws_ecs.proto
+
+package ecs
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	math "math"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Upstream Spec for AWS ECS Upstreams
+// ECS Upstreams represent the running tasks of an ECS service (or of all the services in a cluster)
+// for a particular AWS Account (IAM Role or User account) in a particular region.
+// Task addresses and ports are resolved periodically, so tasks may come and go without updating the upstream.
+type UpstreamSpec struct {
+	// The AWS Region where the desired ECS cluster exists
+	Region string `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	// Optional, if not set, Gloo will try to use the default AWS secret specified by environment variables.
+	// If a secret is not provided, the environment must specify both the AWS access key and secret.
+	// The environment variables used to indicate the AWS account can be:
+	// - for the access key: "AWS_ACCESS_KEY_ID" or "AWS_ACCESS_KEY"
+	// - for the secret: "AWS_SECRET_ACCESS_KEY" or "AWS_SECRET_KEY"
+	// If set, a [Gloo Secret Ref](https://gloo.solo.io/introduction/concepts/#Secrets) to an AWS Secret
+	// AWS Secrets can be created with `glooctl secret create aws ...`
+	// If the secret is created manually, it must conform to the following structure:
+	//  ```
+	//  access_key: <aws access key>
+	//  secret_key: <aws secret key>
+	//  ```
+	// Gloo will create the ECS and EC2 API clients with this credential. You may choose to use a credential with
+	// limited access in conjunction with a Role, specified by its Amazon Resource Number (ARN).
+	SecretRef *core.ResourceRef `protobuf:"bytes,2,opt,name=secret_ref,json=secretRef,proto3" json:"secret_ref,omitempty"`
+	// Optional, Amazon Resource Number (ARN) referring to IAM Role that should be assumed when the Upstream
+	// queries for running tasks.
+	// If provided, Gloo will create the API clients with the provided role. If not provided, Gloo will not assume
+	// a role.
+	RoleArn string `protobuf:"bytes,3,opt,name=role_arn,json=roleArn,proto3" json:"role_arn,omitempty"`
+	// The name or ARN of the ECS cluster the tasks run in
+	Cluster string `protobuf:"bytes,4,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// Optional, the name of the ECS service whose tasks should be selected.
+	// If not set, all the running tasks in the cluster are selected.
+	ServiceName string `protobuf:"bytes,5,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	// Optional, the name of the container in the task that should receive traffic.
+	// If not set, the first container of the task is used.
+	ContainerName string `protobuf:"bytes,6,opt,name=container_name,json=containerName,proto3" json:"container_name,omitempty"`
+	// The port the container listens on. Defaults to port 80.
+	// Tasks using the `awsvpc` network mode (including all Fargate tasks) are reached on this port at the
+	// task's private IP address. For the other network modes, the host port mapped to this container port
+	// is used, at the private IP address of the container instance running the task.
+	ContainerPort        uint32   `protobuf:"varint,7,opt,name=container_port,json=containerPort,proto3" json:"container_port,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpstreamSpec) Reset()         { *m = UpstreamSpec{} }
+func (m *UpstreamSpec) String() string { return proto.CompactTextString(m) }
+func (*UpstreamSpec) ProtoMessage()    {}
+func (*UpstreamSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_578c725082508558, []int{0}
+}
+func (m *UpstreamSpec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpstreamSpec.Unmarshal(m, b)
+}
+func (m *UpstreamSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpstreamSpec.Marshal(b, m, deterministic)
+}
+func (m *UpstreamSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpstreamSpec.Merge(m, src)
+}
+func (m *UpstreamSpec) XXX_Size() int {
+	return xxx_messageInfo_UpstreamSpec.Size(m)
+}
+func (m *UpstreamSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpstreamSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpstreamSpec proto.InternalMessageInfo
+
+func (m *UpstreamSpec) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *UpstreamSpec) GetSecretRef() *core.ResourceRef {
+	if m != nil {
+		return m.SecretRef
+	}
+	return nil
+}
+
+func (m *UpstreamSpec) GetRoleArn() string {
+	if m != nil {
+		return m.RoleArn
+	}
+	return ""
+}
+
+func (m *UpstreamSpec) GetCluster() string {
+	if m != nil {
+		return m.Cluster
+	}
+	return ""
+}
+
+func (m *UpstreamSpec) GetServiceName() string {
+	if m != nil {
+		return m.ServiceName
+	}
+	return ""
+}
+
+func (m *UpstreamSpec) GetContainerName() string {
+	if m != nil {
+		return m.ContainerName
+	}
+	return ""
+}
+
+func (m *UpstreamSpec) GetContainerPort() uint32 {
+	if m != nil {
+		return m.ContainerPort
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*UpstreamSpec)(nil), "aws_ecs.options.gloo.solo.io.UpstreamSpec")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/v1/options/aws/ecs/aws_ecs.proto", fileDescriptor_578c725082508558)
+}
+
+var fileDescriptor_578c725082508558 = []byte{
+	// 334 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xc1, 0x4a, 0x03, 0x31,
+	0x10, 0x86, 0xd9, 0x5a, 0x5b, 0x9b, 0xb6, 0x1e, 0x82, 0xc8, 0xb6, 0x88, 0x54, 0x41, 0xe8, 0xc5,
+	0x0d, 0xea, 0xc5, 0xa3, 0x7a, 0xec, 0x41, 0x64, 0xc5, 0x8b, 0x97, 0x65, 0x1b, 0x66, 0xd7, 0xd8,
+	0xdd, 0x9d, 0x30, 0x49, 0x6b, 0x9f, 0xc0, 0x67, 0xf1, 0x11, 0x7c, 0x1e, 0xdf, 0xc1, 0xbb, 0x6c,
+	0xb2, 0x15, 0x41, 0x04, 0x4f, 0xc9, 0xff, 0xff, 0xdf, 0x4c, 0x86, 0x0c, 0x9b, 0xe5, 0xca, 0x3e,
+	0x2d, 0xe7, 0x91, 0xc4, 0x52, 0x18, 0x2c, 0xf0, 0x54, 0xa1, 0xc8, 0x0b, 0x44, 0xa1, 0x09, 0x9f,
+	0x41, 0x5a, 0xe3, 0x55, 0xaa, 0x95, 0x58, 0x9d, 0x09, 0xd4, 0x56, 0x61, 0x65, 0x44, 0xfa, 0x62,
+	0x04, 0x48, 0x77, 0x26, 0x20, 0x4d, 0xa4, 0x09, 0x2d, 0xf2, 0x83, 0x8d, 0x6c, 0xb0, 0xa8, 0x2e,
+	0x8d, 0xea, 0xae, 0x91, 0xc2, 0xf1, 0x5e, 0x8e, 0x39, 0x3a, 0x50, 0xd4, 0x37, 0x5f, 0x33, 0xe6,
+	0xb0, 0xb6, 0xde, 0x84, 0xb5, 0x6d, 0xbc, 0x91, 0x1b, 0x64, 0xa1, 0xec, 0xe6, 0x59, 0x82, 0xcc,
+	0x47, 0xc7, 0xaf, 0x2d, 0x36, 0x78, 0xd0, 0xc6, 0x12, 0xa4, 0xe5, 0xbd, 0x06, 0xc9, 0xf7, 0x59,
+	0x87, 0x20, 0x57, 0x58, 0x85, 0xc1, 0x24, 0x98, 0xf6, 0xe2, 0x46, 0xf1, 0x4b, 0xc6, 0x0c, 0x48,
+	0x02, 0x9b, 0x10, 0x64, 0x61, 0x6b, 0x12, 0x4c, 0xfb, 0xe7, 0xa3, 0x48, 0x22, 0xc1, 0x66, 0xa0,
+	0x28, 0x06, 0x83, 0x4b, 0x92, 0x10, 0x43, 0x16, 0xf7, 0x3c, 0x1c, 0x43, 0xc6, 0x47, 0x6c, 0x87,
+	0xb0, 0x80, 0x24, 0xa5, 0x2a, 0xdc, 0x72, 0x3d, 0xbb, 0xb5, 0xbe, 0xa6, 0x8a, 0x87, 0xac, 0x2b,
+	0x8b, 0xa5, 0xb1, 0x40, 0x61, 0xdb, 0x27, 0x8d, 0xe4, 0x47, 0x6c, 0x60, 0x80, 0x56, 0x4a, 0x42,
+	0x52, 0xa5, 0x25, 0x84, 0xdb, 0x2e, 0xee, 0x37, 0xde, 0x6d, 0x5a, 0x02, 0x3f, 0x61, 0xbb, 0x12,
+	0x2b, 0x9b, 0xaa, 0x0a, 0xc8, 0x43, 0x1d, 0x07, 0x0d, 0xbf, 0xdd, 0xdf, 0x98, 0x46, 0xb2, 0x61,
+	0x77, 0x12, 0x4c, 0x87, 0x3f, 0xb0, 0x3b, 0x24, 0x7b, 0x33, 0x7b, 0xff, 0x6c, 0x07, 0x6f, 0x1f,
+	0x87, 0xc1, 0xe3, 0xd5, 0xff, 0x36, 0xa8, 0x17, 0xf9, 0x1f, 0x5b, 0x9c, 0x77, 0xdc, 0xdf, 0x5e,
+	0x7c, 0x05, 0x00, 0x00, 0xff, 0xff, 0xef, 0x86, 0x6d, 0x62, 0x0c, 0x02, 0x00, 0x00,
+}
+
+func (this *UpstreamSpec) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpstreamSpec)
+	if !ok {
+		that2, ok := that.(UpstreamSpec)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Region != that1.Region {
+		return false
+	}
+	if !this.SecretRef.Equal(that1.SecretRef) {
+		return false
+	}
+	if this.RoleArn != that1.RoleArn {
+		return false
+	}
+	if this.Cluster != that1.Cluster {
+		return false
+	}
+	if this.ServiceName != that1.ServiceName {
+		return false
+	}
+	if this.ContainerName != that1.ContainerName {
+		return false
+	}
+	if this.ContainerPort != that1.ContainerPort {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
@@ -66,7 +66,7 @@ func (x Settings_DiscoveryOptions_FdsMode) String() string {
 }
 
 func (Settings_DiscoveryOptions_FdsMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_bd7533c2495e1752, []int{0, 7, 0}
+	return fileDescriptor_bd7533c2495e1752, []int{0, 8, 0}
 }
 
 // Represents global settings for all the Gloo components.
@@ -87,6 +87,7 @@ type Settings struct {
 	//	*Settings_KubernetesConfigSource
 	//	*Settings_DirectoryConfigSource
 	//	*Settings_ConsulKvSource
+	//	*Settings_EtcdKvSource
 	ConfigSource isSettings_ConfigSource `protobuf_oneof:"config_source"`
 	// Determines where Gloo will read/write secrets from/to.
 	//
@@ -101,6 +102,7 @@ type Settings struct {
 	//	*Settings_KubernetesArtifactSource
 	//	*Settings_DirectoryArtifactSource
 	//	*Settings_ConsulKvArtifactSource
+	//	*Settings_EtcdKvArtifactSource
 	ArtifactSource isSettings_ArtifactSource `protobuf_oneof:"artifact_source"`
 	// How frequently to resync watches, etc
 	RefreshRate *types.Duration `protobuf:"bytes,12,opt,name=refresh_rate,json=refreshRate,proto3" json:"refresh_rate,omitempty"`
@@ -120,6 +122,8 @@ type Settings struct {
 	Gateway *GatewayOptions `protobuf:"bytes,25,opt,name=gateway,proto3" json:"gateway,omitempty"`
 	// Options to configure Gloo's integration with [HashiCorp Consul](https://www.consul.io/).
 	Consul *Settings_ConsulConfiguration `protobuf:"bytes,20,opt,name=consul,proto3" json:"consul,omitempty"`
+	// Options to configure how Gloo connects to [etcd](https://etcd.io/).
+	Etcd *Settings_EtcdConfiguration `protobuf:"bytes,30,opt,name=etcd,proto3" json:"etcd,omitempty"`
 	// Options to configure Gloo's integration with [Kubernetes](https://www.kubernetes.io/).
 	Kubernetes *Settings_KubernetesConfiguration `protobuf:"bytes,22,opt,name=kubernetes,proto3" json:"kubernetes,omitempty"`
 	// Extensions will be passed along from Listeners, Gateways, VirtualServices, Routes, and Route tables to the
@@ -198,6 +202,9 @@ type Settings_DirectoryConfigSource struct {
 type Settings_ConsulKvSource struct {
 	ConsulKvSource *Settings_ConsulKv `protobuf:"bytes,21,opt,name=consul_kv_source,json=consulKvSource,proto3,oneof" json:"consul_kv_source,omitempty"`
 }
+type Settings_EtcdKvSource struct {
+	EtcdKvSource *Settings_EtcdKv `protobuf:"bytes,31,opt,name=etcd_kv_source,json=etcdKvSource,proto3,oneof" json:"etcd_kv_source,omitempty"`
+}
 type Settings_KubernetesSecretSource struct {
 	KubernetesSecretSource *Settings_KubernetesSecrets `protobuf:"bytes,6,opt,name=kubernetes_secret_source,json=kubernetesSecretSource,proto3,oneof" json:"kubernetes_secret_source,omitempty"`
 }
@@ -216,16 +223,21 @@ type Settings_DirectoryArtifactSource struct {
 type Settings_ConsulKvArtifactSource struct {
 	ConsulKvArtifactSource *Settings_ConsulKv `protobuf:"bytes,23,opt,name=consul_kv_artifact_source,json=consulKvArtifactSource,proto3,oneof" json:"consul_kv_artifact_source,omitempty"`
 }
+type Settings_EtcdKvArtifactSource struct {
+	EtcdKvArtifactSource *Settings_EtcdKv `protobuf:"bytes,32,opt,name=etcd_kv_artifact_source,json=etcdKvArtifactSource,proto3,oneof" json:"etcd_kv_artifact_source,omitempty"`
+}
 
 func (*Settings_KubernetesConfigSource) isSettings_ConfigSource()     {}
 func (*Settings_DirectoryConfigSource) isSettings_ConfigSource()      {}
 func (*Settings_ConsulKvSource) isSettings_ConfigSource()             {}
+func (*Settings_EtcdKvSource) isSettings_ConfigSource()               {}
 func (*Settings_KubernetesSecretSource) isSettings_SecretSource()     {}
 func (*Settings_VaultSecretSource) isSettings_SecretSource()          {}
 func (*Settings_DirectorySecretSource) isSettings_SecretSource()      {}
 func (*Settings_KubernetesArtifactSource) isSettings_ArtifactSource() {}
 func (*Settings_DirectoryArtifactSource) isSettings_ArtifactSource()  {}
 func (*Settings_ConsulKvArtifactSource) isSettings_ArtifactSource()   {}
+func (*Settings_EtcdKvArtifactSource) isSettings_ArtifactSource()     {}
 
 func (m *Settings) GetConfigSource() isSettings_ConfigSource {
 	if m != nil {
@@ -281,6 +293,13 @@ func (m *Settings) GetConsulKvSource() *Settings_ConsulKv {
 	return nil
 }
 
+func (m *Settings) GetEtcdKvSource() *Settings_EtcdKv {
+	if x, ok := m.GetConfigSource().(*Settings_EtcdKvSource); ok {
+		return x.EtcdKvSource
+	}
+	return nil
+}
+
 func (m *Settings) GetKubernetesSecretSource() *Settings_KubernetesSecrets {
 	if x, ok := m.GetSecretSource().(*Settings_KubernetesSecretSource); ok {
 		return x.KubernetesSecretSource
@@ -323,6 +342,13 @@ func (m *Settings) GetConsulKvArtifactSource() *Settings_ConsulKv {
 	return nil
 }
 
+func (m *Settings) GetEtcdKvArtifactSource() *Settings_EtcdKv {
+	if x, ok := m.GetArtifactSource().(*Settings_EtcdKvArtifactSource); ok {
+		return x.EtcdKvArtifactSource
+	}
+	return nil
+}
+
 func (m *Settings) GetRefreshRate() *types.Duration {
 	if m != nil {
 		return m.RefreshRate
@@ -379,6 +405,13 @@ func (m *Settings) GetConsul() *Settings_ConsulConfiguration {
 	return nil
 }
 
+func (m *Settings) GetEtcd() *Settings_EtcdConfiguration {
+	if m != nil {
+		return m.Etcd
+	}
+	return nil
+}
+
 func (m *Settings) GetKubernetes() *Settings_KubernetesConfiguration {
 	if m != nil {
 		return m.Kubernetes
@@ -441,12 +474,14 @@ func (*Settings) XXX_OneofWrappers() []interface{} {
 		(*Settings_KubernetesConfigSource)(nil),
 		(*Settings_DirectoryConfigSource)(nil),
 		(*Settings_ConsulKvSource)(nil),
+		(*Settings_EtcdKvSource)(nil),
 		(*Settings_KubernetesSecretSource)(nil),
 		(*Settings_VaultSecretSource)(nil),
 		(*Settings_DirectorySecretSource)(nil),
 		(*Settings_KubernetesArtifactSource)(nil),
 		(*Settings_DirectoryArtifactSource)(nil),
 		(*Settings_ConsulKvArtifactSource)(nil),
+		(*Settings_EtcdKvArtifactSource)(nil),
 	}
 }
 
@@ -515,6 +550,8 @@ var xxx_messageInfo_Settings_KubernetesSecrets proto.InternalMessageInfo
 // Use [HashiCorp Vault](https://www.vaultproject.io/) as storage for secret data.
 type Settings_VaultSecrets struct {
 	// the Token used to authenticate to Vault
+	// if the token is renewable, it is renewed before it expires
+	// exactly one of `token`, `kubernetesAuth` and `appRoleAuth` must be set
 	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	// address is the address of the Vault server. This should be a complete
 	// URL such as http://solo.io
@@ -537,10 +574,20 @@ type Settings_VaultSecrets struct {
 	// all keys stored in Vault will begin with this Vault
 	// this can be used to run multiple instances of Gloo against the same Consul cluster
 	// defaults to `gloo`
-	RootKey              string   `protobuf:"bytes,9,opt,name=root_key,json=rootKey,proto3" json:"root_key,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	RootKey string `protobuf:"bytes,9,opt,name=root_key,json=rootKey,proto3" json:"root_key,omitempty"`
+	// the mount path of the KV version 2 secrets engine storing the secrets
+	// defaults to `secret`
+	PathPrefix string `protobuf:"bytes,10,opt,name=path_prefix,json=pathPrefix,proto3" json:"path_prefix,omitempty"`
+	// how often to poll Vault for changes to the secrets
+	// defaults to the Settings' refreshRate
+	RefreshRate *types.Duration `protobuf:"bytes,11,opt,name=refresh_rate,json=refreshRate,proto3" json:"refresh_rate,omitempty"`
+	// authenticate to Vault with the Kubernetes service account of the Gloo pod instead of a static token
+	KubernetesAuth *Settings_VaultSecrets_KubernetesAuth `protobuf:"bytes,12,opt,name=kubernetes_auth,json=kubernetesAuth,proto3" json:"kubernetes_auth,omitempty"`
+	// authenticate to Vault with an AppRole instead of a static token
+	AppRoleAuth          *Settings_VaultSecrets_AppRoleAuth `protobuf:"bytes,13,opt,name=app_role_auth,json=appRoleAuth,proto3" json:"app_role_auth,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_unrecognized     []byte                             `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
 }
 
 func (m *Settings_VaultSecrets) Reset()         { *m = Settings_VaultSecrets{} }
@@ -630,6 +677,165 @@ func (m *Settings_VaultSecrets) GetRootKey() string {
 	return ""
 }
 
+func (m *Settings_VaultSecrets) GetPathPrefix() string {
+	if m != nil {
+		return m.PathPrefix
+	}
+	return ""
+}
+
+func (m *Settings_VaultSecrets) GetRefreshRate() *types.Duration {
+	if m != nil {
+		return m.RefreshRate
+	}
+	return nil
+}
+
+func (m *Settings_VaultSecrets) GetKubernetesAuth() *Settings_VaultSecrets_KubernetesAuth {
+	if m != nil {
+		return m.KubernetesAuth
+	}
+	return nil
+}
+
+func (m *Settings_VaultSecrets) GetAppRoleAuth() *Settings_VaultSecrets_AppRoleAuth {
+	if m != nil {
+		return m.AppRoleAuth
+	}
+	return nil
+}
+
+// Log in to Vault with the [Kubernetes auth method](https://www.vaultproject.io/docs/auth/kubernetes).
+// The token obtained is renewed before it expires, and Gloo logs in again once it can no longer be renewed.
+type Settings_VaultSecrets_KubernetesAuth struct {
+	// the Vault role to log in with
+	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	// the mount path of the Kubernetes auth method
+	// defaults to `kubernetes`
+	MountPath string `protobuf:"bytes,2,opt,name=mount_path,json=mountPath,proto3" json:"mount_path,omitempty"`
+	// the path to the service account token file
+	// defaults to `/var/run/secrets/kubernetes.io/serviceaccount/token`
+	ServiceAccountTokenPath string   `protobuf:"bytes,3,opt,name=service_account_token_path,json=serviceAccountTokenPath,proto3" json:"service_account_token_path,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
+	XXX_unrecognized        []byte   `json:"-"`
+	XXX_sizecache           int32    `json:"-"`
+}
+
+func (m *Settings_VaultSecrets_KubernetesAuth) Reset()         { *m = Settings_VaultSecrets_KubernetesAuth{} }
+func (m *Settings_VaultSecrets_KubernetesAuth) String() string { return proto.CompactTextString(m) }
+func (*Settings_VaultSecrets_KubernetesAuth) ProtoMessage()    {}
+func (*Settings_VaultSecrets_KubernetesAuth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{0, 2, 0}
+}
+func (m *Settings_VaultSecrets_KubernetesAuth) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Settings_VaultSecrets_KubernetesAuth.Unmarshal(m, b)
+}
+func (m *Settings_VaultSecrets_KubernetesAuth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Settings_VaultSecrets_KubernetesAuth.Marshal(b, m, deterministic)
+}
+func (m *Settings_VaultSecrets_KubernetesAuth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Settings_VaultSecrets_KubernetesAuth.Merge(m, src)
+}
+func (m *Settings_VaultSecrets_KubernetesAuth) XXX_Size() int {
+	return xxx_messageInfo_Settings_VaultSecrets_KubernetesAuth.Size(m)
+}
+func (m *Settings_VaultSecrets_KubernetesAuth) XXX_DiscardUnknown() {
+	xxx_messageInfo_Settings_VaultSecrets_KubernetesAuth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Settings_VaultSecrets_KubernetesAuth proto.InternalMessageInfo
+
+func (m *Settings_VaultSecrets_KubernetesAuth) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *Settings_VaultSecrets_KubernetesAuth) GetMountPath() string {
+	if m != nil {
+		return m.MountPath
+	}
+	return ""
+}
+
+func (m *Settings_VaultSecrets_KubernetesAuth) GetServiceAccountTokenPath() string {
+	if m != nil {
+		return m.ServiceAccountTokenPath
+	}
+	return ""
+}
+
+// Log in to Vault with the [AppRole auth method](https://www.vaultproject.io/docs/auth/approle).
+// The token obtained is renewed before it expires, and Gloo logs in again once it can no longer be renewed.
+type Settings_VaultSecrets_AppRoleAuth struct {
+	// the RoleID of the AppRole
+	RoleId string `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	// the SecretID of the AppRole
+	SecretId string `protobuf:"bytes,2,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
+	// the path to a file containing the SecretID of the AppRole, read on every login
+	// takes precedence over `secretId`
+	SecretIdPath string `protobuf:"bytes,3,opt,name=secret_id_path,json=secretIdPath,proto3" json:"secret_id_path,omitempty"`
+	// the mount path of the AppRole auth method
+	// defaults to `approle`
+	MountPath            string   `protobuf:"bytes,4,opt,name=mount_path,json=mountPath,proto3" json:"mount_path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Settings_VaultSecrets_AppRoleAuth) Reset()         { *m = Settings_VaultSecrets_AppRoleAuth{} }
+func (m *Settings_VaultSecrets_AppRoleAuth) String() string { return proto.CompactTextString(m) }
+func (*Settings_VaultSecrets_AppRoleAuth) ProtoMessage()    {}
+func (*Settings_VaultSecrets_AppRoleAuth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{0, 2, 1}
+}
+func (m *Settings_VaultSecrets_AppRoleAuth) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Settings_VaultSecrets_AppRoleAuth.Unmarshal(m, b)
+}
+func (m *Settings_VaultSecrets_AppRoleAuth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Settings_VaultSecrets_AppRoleAuth.Marshal(b, m, deterministic)
+}
+func (m *Settings_VaultSecrets_AppRoleAuth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Settings_VaultSecrets_AppRoleAuth.Merge(m, src)
+}
+func (m *Settings_VaultSecrets_AppRoleAuth) XXX_Size() int {
+	return xxx_messageInfo_Settings_VaultSecrets_AppRoleAuth.Size(m)
+}
+func (m *Settings_VaultSecrets_AppRoleAuth) XXX_DiscardUnknown() {
+	xxx_messageInfo_Settings_VaultSecrets_AppRoleAuth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Settings_VaultSecrets_AppRoleAuth proto.InternalMessageInfo
+
+func (m *Settings_VaultSecrets_AppRoleAuth) GetRoleId() string {
+	if m != nil {
+		return m.RoleId
+	}
+	return ""
+}
+
+func (m *Settings_VaultSecrets_AppRoleAuth) GetSecretId() string {
+	if m != nil {
+		return m.SecretId
+	}
+	return ""
+}
+
+func (m *Settings_VaultSecrets_AppRoleAuth) GetSecretIdPath() string {
+	if m != nil {
+		return m.SecretIdPath
+	}
+	return ""
+}
+
+func (m *Settings_VaultSecrets_AppRoleAuth) GetMountPath() string {
+	if m != nil {
+		return m.MountPath
+	}
+	return ""
+}
+
 // Use [HashiCorp Consul Key-Value](https://www.consul.io/api/kv.html/) as storage for config data.
 // Configuration options for connecting to Consul can be configured in the Settings' root
 // `consul` field
@@ -674,6 +880,50 @@ func (m *Settings_ConsulKv) GetRootKey() string {
 	return ""
 }
 
+// Use [etcd](https://etcd.io/) as storage for config data.
+// Configuration options for connecting to etcd can be configured in the Settings' root
+// `etcd` field
+type Settings_EtcdKv struct {
+	// all keys stored in etcd will begin with this prefix
+	// this can be used to run multiple instances of Gloo against the same etcd cluster
+	// defaults to `gloo`
+	RootKey              string   `protobuf:"bytes,1,opt,name=root_key,json=rootKey,proto3" json:"root_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Settings_EtcdKv) Reset()         { *m = Settings_EtcdKv{} }
+func (m *Settings_EtcdKv) String() string { return proto.CompactTextString(m) }
+func (*Settings_EtcdKv) ProtoMessage()    {}
+func (*Settings_EtcdKv) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{0, 4}
+}
+func (m *Settings_EtcdKv) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Settings_EtcdKv.Unmarshal(m, b)
+}
+func (m *Settings_EtcdKv) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Settings_EtcdKv.Marshal(b, m, deterministic)
+}
+func (m *Settings_EtcdKv) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Settings_EtcdKv.Merge(m, src)
+}
+func (m *Settings_EtcdKv) XXX_Size() int {
+	return xxx_messageInfo_Settings_EtcdKv.Size(m)
+}
+func (m *Settings_EtcdKv) XXX_DiscardUnknown() {
+	xxx_messageInfo_Settings_EtcdKv.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Settings_EtcdKv proto.InternalMessageInfo
+
+func (m *Settings_EtcdKv) GetRootKey() string {
+	if m != nil {
+		return m.RootKey
+	}
+	return ""
+}
+
 // Use Kubernetes ConfigMaps as storage.
 type Settings_KubernetesConfigmaps struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -685,7 +935,7 @@ func (m *Settings_KubernetesConfigmaps) Reset()         { *m = Settings_Kubernet
 func (m *Settings_KubernetesConfigmaps) String() string { return proto.CompactTextString(m) }
 func (*Settings_KubernetesConfigmaps) ProtoMessage()    {}
 func (*Settings_KubernetesConfigmaps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bd7533c2495e1752, []int{0, 4}
+	return fileDescriptor_bd7533c2495e1752, []int{0, 5}
 }
 func (m *Settings_KubernetesConfigmaps) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Settings_KubernetesConfigmaps.Unmarshal(m, b)
@@ -708,17 +958,25 @@ var xxx_messageInfo_Settings_KubernetesConfigmaps proto.InternalMessageInfo
 // As an alternative to Kubernetes CRDs, Gloo is able to store resources in a local file system.
 // This option determines the root of the directory tree used to this end.
 type Settings_Directory struct {
-	Directory            string   `protobuf:"bytes,1,opt,name=directory,proto3" json:"directory,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Directory string `protobuf:"bytes,1,opt,name=directory,proto3" json:"directory,omitempty"`
+	// write the statuses of resources to `<name>.yaml.status` files next to the resource files, rather than into
+	// the resource files themselves, so that the resource files are only ever written by their authors
+	StatusFiles bool `protobuf:"varint,2,opt,name=status_files,json=statusFiles,proto3" json:"status_files,omitempty"`
+	// Only supported by the `directory_secret_source`.
+	// Decrypt the secret files which are encrypted with OpenPGP, ASCII-armored or not, e.g. with
+	// `gpg --symmetric` or `gpg --encrypt`. Secret files which are not encrypted are read as they are.
+	// Gloo does not write secrets to the directory when this is set.
+	Decryption           *Settings_Directory_Decryption `protobuf:"bytes,3,opt,name=decryption,proto3" json:"decryption,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
 }
 
 func (m *Settings_Directory) Reset()         { *m = Settings_Directory{} }
 func (m *Settings_Directory) String() string { return proto.CompactTextString(m) }
 func (*Settings_Directory) ProtoMessage()    {}
 func (*Settings_Directory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bd7533c2495e1752, []int{0, 5}
+	return fileDescriptor_bd7533c2495e1752, []int{0, 6}
 }
 func (m *Settings_Directory) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Settings_Directory.Unmarshal(m, b)
@@ -745,6 +1003,70 @@ func (m *Settings_Directory) GetDirectory() string {
 	return ""
 }
 
+func (m *Settings_Directory) GetStatusFiles() bool {
+	if m != nil {
+		return m.StatusFiles
+	}
+	return false
+}
+
+func (m *Settings_Directory) GetDecryption() *Settings_Directory_Decryption {
+	if m != nil {
+		return m.Decryption
+	}
+	return nil
+}
+
+type Settings_Directory_Decryption struct {
+	// path to a file whose first line is the passphrase to decrypt the files encrypted with a passphrase,
+	// and the private keys protected by a passphrase
+	PassphraseFile string `protobuf:"bytes,1,opt,name=passphrase_file,json=passphraseFile,proto3" json:"passphrase_file,omitempty"`
+	// path to a file holding the OpenPGP private keys, ASCII-armored or not, to decrypt the files encrypted to
+	// their public keys with. Only RSA and ElGamal keys are supported.
+	PrivateKeyFile       string   `protobuf:"bytes,2,opt,name=private_key_file,json=privateKeyFile,proto3" json:"private_key_file,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Settings_Directory_Decryption) Reset()         { *m = Settings_Directory_Decryption{} }
+func (m *Settings_Directory_Decryption) String() string { return proto.CompactTextString(m) }
+func (*Settings_Directory_Decryption) ProtoMessage()    {}
+func (*Settings_Directory_Decryption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{0, 6, 0}
+}
+func (m *Settings_Directory_Decryption) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Settings_Directory_Decryption.Unmarshal(m, b)
+}
+func (m *Settings_Directory_Decryption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Settings_Directory_Decryption.Marshal(b, m, deterministic)
+}
+func (m *Settings_Directory_Decryption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Settings_Directory_Decryption.Merge(m, src)
+}
+func (m *Settings_Directory_Decryption) XXX_Size() int {
+	return xxx_messageInfo_Settings_Directory_Decryption.Size(m)
+}
+func (m *Settings_Directory_Decryption) XXX_DiscardUnknown() {
+	xxx_messageInfo_Settings_Directory_Decryption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Settings_Directory_Decryption proto.InternalMessageInfo
+
+func (m *Settings_Directory_Decryption) GetPassphraseFile() string {
+	if m != nil {
+		return m.PassphraseFile
+	}
+	return ""
+}
+
+func (m *Settings_Directory_Decryption) GetPrivateKeyFile() string {
+	if m != nil {
+		return m.PrivateKeyFile
+	}
+	return ""
+}
+
 type Settings_KnativeOptions struct {
 	// Address of the clusteringress proxy.
 	// If empty, it will default to clusteringress-proxy.$POD_NAMESPACE.svc.cluster.local.
@@ -767,7 +1089,7 @@ func (m *Settings_KnativeOptions) Reset()         { *m = Settings_KnativeOptions
 func (m *Settings_KnativeOptions) String() string { return proto.CompactTextString(m) }
 func (*Settings_KnativeOptions) ProtoMessage()    {}
 func (*Settings_KnativeOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bd7533c2495e1752, []int{0, 6}
+	return fileDescriptor_bd7533c2495e1752, []int{0, 7}
 }
 func (m *Settings_KnativeOptions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Settings_KnativeOptions.Unmarshal(m, b)
@@ -819,7 +1141,7 @@ func (m *Settings_DiscoveryOptions) Reset()         { *m = Settings_DiscoveryOpt
 func (m *Settings_DiscoveryOptions) String() string { return proto.CompactTextString(m) }
 func (*Settings_DiscoveryOptions) ProtoMessage()    {}
 func (*Settings_DiscoveryOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bd7533c2495e1752, []int{0, 7}
+	return fileDescriptor_bd7533c2495e1752, []int{0, 8}
 }
 func (m *Settings_DiscoveryOptions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Settings_DiscoveryOptions.Unmarshal(m, b)
@@ -908,7 +1230,7 @@ func (m *Settings_ConsulConfiguration) Reset()         { *m = Settings_ConsulCon
 func (m *Settings_ConsulConfiguration) String() string { return proto.CompactTextString(m) }
 func (*Settings_ConsulConfiguration) ProtoMessage()    {}
 func (*Settings_ConsulConfiguration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bd7533c2495e1752, []int{0, 8}
+	return fileDescriptor_bd7533c2495e1752, []int{0, 9}
 }
 func (m *Settings_ConsulConfiguration) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Settings_ConsulConfiguration.Unmarshal(m, b)
@@ -1052,7 +1374,7 @@ func (m *Settings_ConsulConfiguration_ServiceDiscoveryOptions) String() string {
 }
 func (*Settings_ConsulConfiguration_ServiceDiscoveryOptions) ProtoMessage() {}
 func (*Settings_ConsulConfiguration_ServiceDiscoveryOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bd7533c2495e1752, []int{0, 8, 0}
+	return fileDescriptor_bd7533c2495e1752, []int{0, 9, 0}
 }
 func (m *Settings_ConsulConfiguration_ServiceDiscoveryOptions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Settings_ConsulConfiguration_ServiceDiscoveryOptions.Unmarshal(m, b)
@@ -1079,20 +1401,128 @@ func (m *Settings_ConsulConfiguration_ServiceDiscoveryOptions) GetDataCenters()
 	return nil
 }
 
+// Provides the parameters used to connect to etcd, when it is used as a config or artifact source.
+type Settings_EtcdConfiguration struct {
+	// The gRPC addresses of the etcd members, e.g. `https://etcd-0:2379`.
+	// Defaults to 127.0.0.1:2379.
+	Endpoints []string `protobuf:"bytes,1,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	// The name of the etcd user to authenticate as. Leave empty if etcd authentication is disabled.
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// The password of the etcd user.
+	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	// CaFile is the optional path to the CA certificate used to verify the etcd members.
+	CaFile string `protobuf:"bytes,4,opt,name=ca_file,json=caFile,proto3" json:"ca_file,omitempty"`
+	// CertFile is the optional path to the certificate Gloo presents to etcd for TLS client auth.
+	// If this is set then you need to also set KeyFile.
+	CertFile string `protobuf:"bytes,5,opt,name=cert_file,json=certFile,proto3" json:"cert_file,omitempty"`
+	// KeyFile is the optional path to the private key for etcd communication.
+	// If this is set then you need to also set CertFile.
+	KeyFile string `protobuf:"bytes,6,opt,name=key_file,json=keyFile,proto3" json:"key_file,omitempty"`
+	// InsecureSkipVerify if set to true will disable TLS host verification.
+	InsecureSkipVerify *types.BoolValue `protobuf:"bytes,7,opt,name=insecure_skip_verify,json=insecureSkipVerify,proto3" json:"insecure_skip_verify,omitempty"`
+	// How long to wait for each request to etcd before failing it. Defaults to 5s.
+	RequestTimeout       *types.Duration `protobuf:"bytes,8,opt,name=request_timeout,json=requestTimeout,proto3" json:"request_timeout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *Settings_EtcdConfiguration) Reset()         { *m = Settings_EtcdConfiguration{} }
+func (m *Settings_EtcdConfiguration) String() string { return proto.CompactTextString(m) }
+func (*Settings_EtcdConfiguration) ProtoMessage()    {}
+func (*Settings_EtcdConfiguration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{0, 10}
+}
+func (m *Settings_EtcdConfiguration) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Settings_EtcdConfiguration.Unmarshal(m, b)
+}
+func (m *Settings_EtcdConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Settings_EtcdConfiguration.Marshal(b, m, deterministic)
+}
+func (m *Settings_EtcdConfiguration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Settings_EtcdConfiguration.Merge(m, src)
+}
+func (m *Settings_EtcdConfiguration) XXX_Size() int {
+	return xxx_messageInfo_Settings_EtcdConfiguration.Size(m)
+}
+func (m *Settings_EtcdConfiguration) XXX_DiscardUnknown() {
+	xxx_messageInfo_Settings_EtcdConfiguration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Settings_EtcdConfiguration proto.InternalMessageInfo
+
+func (m *Settings_EtcdConfiguration) GetEndpoints() []string {
+	if m != nil {
+		return m.Endpoints
+	}
+	return nil
+}
+
+func (m *Settings_EtcdConfiguration) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *Settings_EtcdConfiguration) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *Settings_EtcdConfiguration) GetCaFile() string {
+	if m != nil {
+		return m.CaFile
+	}
+	return ""
+}
+
+func (m *Settings_EtcdConfiguration) GetCertFile() string {
+	if m != nil {
+		return m.CertFile
+	}
+	return ""
+}
+
+func (m *Settings_EtcdConfiguration) GetKeyFile() string {
+	if m != nil {
+		return m.KeyFile
+	}
+	return ""
+}
+
+func (m *Settings_EtcdConfiguration) GetInsecureSkipVerify() *types.BoolValue {
+	if m != nil {
+		return m.InsecureSkipVerify
+	}
+	return nil
+}
+
+func (m *Settings_EtcdConfiguration) GetRequestTimeout() *types.Duration {
+	if m != nil {
+		return m.RequestTimeout
+	}
+	return nil
+}
+
 // Provides overrides for the default configuration parameters used to interact with Kubernetes.
 type Settings_KubernetesConfiguration struct {
 	// Rate limits for the kubernetes clients
-	RateLimits           *Settings_KubernetesConfiguration_RateLimits `protobuf:"bytes,1,opt,name=rate_limits,json=rateLimits,proto3" json:"rate_limits,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
-	XXX_unrecognized     []byte                                       `json:"-"`
-	XXX_sizecache        int32                                        `json:"-"`
+	RateLimits *Settings_KubernetesConfiguration_RateLimits `protobuf:"bytes,1,opt,name=rate_limits,json=rateLimits,proto3" json:"rate_limits,omitempty"`
+	// Leader election for highly available deployments
+	LeaderElection       *Settings_KubernetesConfiguration_LeaderElection `protobuf:"bytes,2,opt,name=leader_election,json=leaderElection,proto3" json:"leader_election,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                         `json:"-"`
+	XXX_unrecognized     []byte                                           `json:"-"`
+	XXX_sizecache        int32                                            `json:"-"`
 }
 
 func (m *Settings_KubernetesConfiguration) Reset()         { *m = Settings_KubernetesConfiguration{} }
 func (m *Settings_KubernetesConfiguration) String() string { return proto.CompactTextString(m) }
 func (*Settings_KubernetesConfiguration) ProtoMessage()    {}
 func (*Settings_KubernetesConfiguration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bd7533c2495e1752, []int{0, 9}
+	return fileDescriptor_bd7533c2495e1752, []int{0, 11}
 }
 func (m *Settings_KubernetesConfiguration) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Settings_KubernetesConfiguration.Unmarshal(m, b)
@@ -1119,6 +1549,13 @@ func (m *Settings_KubernetesConfiguration) GetRateLimits() *Settings_KubernetesC
 	return nil
 }
 
+func (m *Settings_KubernetesConfiguration) GetLeaderElection() *Settings_KubernetesConfiguration_LeaderElection {
+	if m != nil {
+		return m.LeaderElection
+	}
+	return nil
+}
+
 type Settings_KubernetesConfiguration_RateLimits struct {
 	// The maximum queries-per-second Gloo can make to the Kubernetes API Server.
 	QPS float32 `protobuf:"fixed32,1,opt,name=QPS,proto3" json:"QPS,omitempty"`
@@ -1138,7 +1575,7 @@ func (m *Settings_KubernetesConfiguration_RateLimits) String() string {
 }
 func (*Settings_KubernetesConfiguration_RateLimits) ProtoMessage() {}
 func (*Settings_KubernetesConfiguration_RateLimits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bd7533c2495e1752, []int{0, 9, 0}
+	return fileDescriptor_bd7533c2495e1752, []int{0, 11, 0}
 }
 func (m *Settings_KubernetesConfiguration_RateLimits) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Settings_KubernetesConfiguration_RateLimits.Unmarshal(m, b)
@@ -1172,29 +1609,104 @@ func (m *Settings_KubernetesConfiguration_RateLimits) GetBurst() uint32 {
 	return 0
 }
 
-// Settings specific to the gloo (Envoy xDS server) controller
-type GlooOptions struct {
-	// Where the `gloo` xDS server should bind. Defaults to `0.0.0.0:9977`
-	XdsBindAddr string `protobuf:"bytes,1,opt,name=xds_bind_addr,json=xdsBindAddr,proto3" json:"xds_bind_addr,omitempty"`
-	// Where the `gloo` validation server should bind. Defaults to `0.0.0.0:9988`
-	ValidationBindAddr string `protobuf:"bytes,2,opt,name=validation_bind_addr,json=validationBindAddr,proto3" json:"validation_bind_addr,omitempty"`
-	// Default circuit breaker configuration to use for upstream requests,
-	// when not provided by specific upstream.
-	CircuitBreakers *CircuitBreakerConfig `protobuf:"bytes,3,opt,name=circuit_breakers,json=circuitBreakers,proto3" json:"circuit_breakers,omitempty"`
-	// Timeout to get initial snapshot of resources. If set to zero, Gloo will not wait for initial
-	// snapshot - if nonzero and gloo could not fetch it's initial snapshot before the timeout
-	// reached, gloo will panic. If unset, Gloo defaults to 5 minutes.
-	EndpointsWarmingTimeout *types.Duration         `protobuf:"bytes,4,opt,name=endpoints_warming_timeout,json=endpointsWarmingTimeout,proto3" json:"endpoints_warming_timeout,omitempty"`
-	AwsOptions              *GlooOptions_AWSOptions `protobuf:"bytes,5,opt,name=aws_options,json=awsOptions,proto3" json:"aws_options,omitempty"`
-	// set these options to fine-tune the way Gloo handles invalid user configuration
-	InvalidConfigPolicy *GlooOptions_InvalidConfigPolicy `protobuf:"bytes,6,opt,name=invalid_config_policy,json=invalidConfigPolicy,proto3" json:"invalid_config_policy,omitempty"`
-	// Gloo allows you to directly reference a Kubernetes service as a routing destination. To enable this feature,
-	// Gloo scans the cluster for Kubernetes services and creates a special type of in-memory Upstream to represent them.
-	// If the cluster contains a lot of services and you do not restrict the namespaces Gloo is watching, this can result
-	// in significant overhead. If you do not plan on using this feature, you can use this flag to turn it off.
-	DisableKubernetesDestinations bool `protobuf:"varint,7,opt,name=disable_kubernetes_destinations,json=disableKubernetesDestinations,proto3" json:"disable_kubernetes_destinations,omitempty"`
-	// Default policy for grpc-web.
-	// set to true if you do not wish grpc-web to be automatically enabled.
+// Elect a leader among the replicas of each of gloo, gateway and discovery using Kubernetes Leases.
+// Every replica serves xDS and validation, but only the leader writes statuses and Proxies,
+// and only the leader runs discovery.
+type Settings_KubernetesConfiguration_LeaderElection struct {
+	// Enable leader election. The Leases are written to the discovery namespace.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// How long replicas wait before taking over the Lease of a leader which stopped renewing it.
+	// Defaults to 15s.
+	LeaseDuration *types.Duration `protobuf:"bytes,2,opt,name=lease_duration,json=leaseDuration,proto3" json:"lease_duration,omitempty"`
+	// How long the leader keeps retrying to renew its Lease before it stops leading.
+	// Must be shorter than the lease duration. Defaults to 10s.
+	RenewDeadline *types.Duration `protobuf:"bytes,3,opt,name=renew_deadline,json=renewDeadline,proto3" json:"renew_deadline,omitempty"`
+	// How often replicas try to acquire or renew the Lease. Defaults to 2s.
+	RetryPeriod          *types.Duration `protobuf:"bytes,4,opt,name=retry_period,json=retryPeriod,proto3" json:"retry_period,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *Settings_KubernetesConfiguration_LeaderElection) Reset() {
+	*m = Settings_KubernetesConfiguration_LeaderElection{}
+}
+func (m *Settings_KubernetesConfiguration_LeaderElection) String() string {
+	return proto.CompactTextString(m)
+}
+func (*Settings_KubernetesConfiguration_LeaderElection) ProtoMessage() {}
+func (*Settings_KubernetesConfiguration_LeaderElection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{0, 11, 1}
+}
+func (m *Settings_KubernetesConfiguration_LeaderElection) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Settings_KubernetesConfiguration_LeaderElection.Unmarshal(m, b)
+}
+func (m *Settings_KubernetesConfiguration_LeaderElection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Settings_KubernetesConfiguration_LeaderElection.Marshal(b, m, deterministic)
+}
+func (m *Settings_KubernetesConfiguration_LeaderElection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Settings_KubernetesConfiguration_LeaderElection.Merge(m, src)
+}
+func (m *Settings_KubernetesConfiguration_LeaderElection) XXX_Size() int {
+	return xxx_messageInfo_Settings_KubernetesConfiguration_LeaderElection.Size(m)
+}
+func (m *Settings_KubernetesConfiguration_LeaderElection) XXX_DiscardUnknown() {
+	xxx_messageInfo_Settings_KubernetesConfiguration_LeaderElection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Settings_KubernetesConfiguration_LeaderElection proto.InternalMessageInfo
+
+func (m *Settings_KubernetesConfiguration_LeaderElection) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *Settings_KubernetesConfiguration_LeaderElection) GetLeaseDuration() *types.Duration {
+	if m != nil {
+		return m.LeaseDuration
+	}
+	return nil
+}
+
+func (m *Settings_KubernetesConfiguration_LeaderElection) GetRenewDeadline() *types.Duration {
+	if m != nil {
+		return m.RenewDeadline
+	}
+	return nil
+}
+
+func (m *Settings_KubernetesConfiguration_LeaderElection) GetRetryPeriod() *types.Duration {
+	if m != nil {
+		return m.RetryPeriod
+	}
+	return nil
+}
+
+// Settings specific to the gloo (Envoy xDS server) controller
+type GlooOptions struct {
+	// Where the `gloo` xDS server should bind. Defaults to `0.0.0.0:9977`
+	XdsBindAddr string `protobuf:"bytes,1,opt,name=xds_bind_addr,json=xdsBindAddr,proto3" json:"xds_bind_addr,omitempty"`
+	// Where the `gloo` validation server should bind. Defaults to `0.0.0.0:9988`
+	ValidationBindAddr string `protobuf:"bytes,2,opt,name=validation_bind_addr,json=validationBindAddr,proto3" json:"validation_bind_addr,omitempty"`
+	// Default circuit breaker configuration to use for upstream requests,
+	// when not provided by specific upstream.
+	CircuitBreakers *CircuitBreakerConfig `protobuf:"bytes,3,opt,name=circuit_breakers,json=circuitBreakers,proto3" json:"circuit_breakers,omitempty"`
+	// Timeout to get initial snapshot of resources. If set to zero, Gloo will not wait for initial
+	// snapshot - if nonzero and gloo could not fetch it's initial snapshot before the timeout
+	// reached, gloo will panic. If unset, Gloo defaults to 5 minutes.
+	EndpointsWarmingTimeout *types.Duration         `protobuf:"bytes,4,opt,name=endpoints_warming_timeout,json=endpointsWarmingTimeout,proto3" json:"endpoints_warming_timeout,omitempty"`
+	AwsOptions              *GlooOptions_AWSOptions `protobuf:"bytes,5,opt,name=aws_options,json=awsOptions,proto3" json:"aws_options,omitempty"`
+	// set these options to fine-tune the way Gloo handles invalid user configuration
+	InvalidConfigPolicy *GlooOptions_InvalidConfigPolicy `protobuf:"bytes,6,opt,name=invalid_config_policy,json=invalidConfigPolicy,proto3" json:"invalid_config_policy,omitempty"`
+	// Gloo allows you to directly reference a Kubernetes service as a routing destination. To enable this feature,
+	// Gloo scans the cluster for Kubernetes services and creates a special type of in-memory Upstream to represent them.
+	// If the cluster contains a lot of services and you do not restrict the namespaces Gloo is watching, this can result
+	// in significant overhead. If you do not plan on using this feature, you can use this flag to turn it off.
+	DisableKubernetesDestinations bool `protobuf:"varint,7,opt,name=disable_kubernetes_destinations,json=disableKubernetesDestinations,proto3" json:"disable_kubernetes_destinations,omitempty"`
+	// Default policy for grpc-web.
+	// set to true if you do not wish grpc-web to be automatically enabled.
 	// set to false if you wish grpc-web enabled unless disabled on the listener level.
 	// If not specified, defaults to `false`.
 	DisableGrpcWeb *types.BoolValue `protobuf:"bytes,8,opt,name=disable_grpc_web,json=disableGrpcWeb,proto3" json:"disable_grpc_web,omitempty"`
@@ -1209,10 +1721,23 @@ type GlooOptions struct {
 	RegexMaxProgramSize *types.UInt32Value `protobuf:"bytes,10,opt,name=regex_max_program_size,json=regexMaxProgramSize,proto3" json:"regex_max_program_size,omitempty"`
 	// (Enterprise Only): Where the `gloo` REST xDS server should bind. Used by Gloo Federation.
 	// Defaults to `0.0.0.0:9976`
-	RestXdsBindAddr      string   `protobuf:"bytes,11,opt,name=rest_xds_bind_addr,json=restXdsBindAddr,proto3" json:"rest_xds_bind_addr,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	RestXdsBindAddr string                            `protobuf:"bytes,11,opt,name=rest_xds_bind_addr,json=restXdsBindAddr,proto3" json:"rest_xds_bind_addr,omitempty"`
+	XdsValidation   *GlooOptions_XdsValidationOptions `protobuf:"bytes,12,opt,name=xds_validation,json=xdsValidation,proto3" json:"xds_validation,omitempty"`
+	XdsAuth         *GlooOptions_XdsAuthOptions       `protobuf:"bytes,13,opt,name=xds_auth,json=xdsAuth,proto3" json:"xds_auth,omitempty"`
+	// Serve the certificates and CAs of the TLS Secrets referenced by a `secretRef` over SDS, on the xDS
+	// (ADS) connection to `gloo`, instead of inlining them in the listener and cluster configuration.
+	// Listeners and clusters then reference the secrets by name, so rotating a certificate only pushes the
+	// changed secret to Envoy and does not drain connections. Each Envoy is only sent the secrets its
+	// configuration references.
+	EnableSdsSecrets bool `protobuf:"varint,14,opt,name=enable_sds_secrets,json=enableSdsSecrets,proto3" json:"enable_sds_secrets,omitempty"`
+	// Out-of-process plugins that are called, in order, after the built-in plugins when translating Proxies.
+	// They can modify the Envoy clusters, virtual hosts and routes generated for Upstreams, VirtualHosts and Routes,
+	// and add HTTP filters to HttpListeners. A plugin that fails or times out causes an error on the resource
+	// it was called for.
+	ExternalPlugins      []*GlooOptions_ExternalPlugin `protobuf:"bytes,15,rep,name=external_plugins,json=externalPlugins,proto3" json:"external_plugins,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
 }
 
 func (m *GlooOptions) Reset()         { *m = GlooOptions{} }
@@ -1316,6 +1841,34 @@ func (m *GlooOptions) GetRestXdsBindAddr() string {
 	return ""
 }
 
+func (m *GlooOptions) GetXdsValidation() *GlooOptions_XdsValidationOptions {
+	if m != nil {
+		return m.XdsValidation
+	}
+	return nil
+}
+
+func (m *GlooOptions) GetXdsAuth() *GlooOptions_XdsAuthOptions {
+	if m != nil {
+		return m.XdsAuth
+	}
+	return nil
+}
+
+func (m *GlooOptions) GetEnableSdsSecrets() bool {
+	if m != nil {
+		return m.EnableSdsSecrets
+	}
+	return false
+}
+
+func (m *GlooOptions) GetExternalPlugins() []*GlooOptions_ExternalPlugin {
+	if m != nil {
+		return m.ExternalPlugins
+	}
+	return nil
+}
+
 type GlooOptions_AWSOptions struct {
 	// Types that are valid to be assigned to CredentialsFetcher:
 	//	*GlooOptions_AWSOptions_EnableCredentialsDiscovey
@@ -1461,6 +2014,262 @@ func (m *GlooOptions_InvalidConfigPolicy) GetInvalidRouteResponseBody() string {
 	return ""
 }
 
+// Options for validating the Envoy configuration generated for a Proxy in the `gloo` validation server,
+// in addition to the Proxy translation itself. Errors are reported on the Proxy report, so that the
+// Gateway validation webhook rejects configuration which Envoy would NACK.
+type GlooOptions_XdsValidationOptions struct {
+	// Validate every generated xDS resource (including typed filter configs) against the constraints
+	// declared in the Envoy API, e.g. header name formats, required fields and value ranges.
+	EnableApiValidation bool `protobuf:"varint,1,opt,name=enable_api_validation,json=enableApiValidation,proto3" json:"enable_api_validation,omitempty"`
+	// Path to an Envoy binary (e.g. `/usr/local/bin/envoy`). If set, the generated resources are
+	// rendered into a static bootstrap and checked with `envoy --mode validate`, which also catches
+	// errors that can't be expressed by the API constraints, such as regexes exceeding the program size
+	// limit or conflicting filter configurations. The binary must support every filter used by Gloo.
+	EnvoyBinaryPath string `protobuf:"bytes,2,opt,name=envoy_binary_path,json=envoyBinaryPath,proto3" json:"envoy_binary_path,omitempty"`
+	// Timeout for a single `envoy --mode validate` run. Defaults to 10 seconds.
+	EnvoyValidationTimeout *types.Duration `protobuf:"bytes,3,opt,name=envoy_validation_timeout,json=envoyValidationTimeout,proto3" json:"envoy_validation_timeout,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}        `json:"-"`
+	XXX_unrecognized       []byte          `json:"-"`
+	XXX_sizecache          int32           `json:"-"`
+}
+
+func (m *GlooOptions_XdsValidationOptions) Reset()         { *m = GlooOptions_XdsValidationOptions{} }
+func (m *GlooOptions_XdsValidationOptions) String() string { return proto.CompactTextString(m) }
+func (*GlooOptions_XdsValidationOptions) ProtoMessage()    {}
+func (*GlooOptions_XdsValidationOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{1, 2}
+}
+func (m *GlooOptions_XdsValidationOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GlooOptions_XdsValidationOptions.Unmarshal(m, b)
+}
+func (m *GlooOptions_XdsValidationOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GlooOptions_XdsValidationOptions.Marshal(b, m, deterministic)
+}
+func (m *GlooOptions_XdsValidationOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GlooOptions_XdsValidationOptions.Merge(m, src)
+}
+func (m *GlooOptions_XdsValidationOptions) XXX_Size() int {
+	return xxx_messageInfo_GlooOptions_XdsValidationOptions.Size(m)
+}
+func (m *GlooOptions_XdsValidationOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_GlooOptions_XdsValidationOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GlooOptions_XdsValidationOptions proto.InternalMessageInfo
+
+func (m *GlooOptions_XdsValidationOptions) GetEnableApiValidation() bool {
+	if m != nil {
+		return m.EnableApiValidation
+	}
+	return false
+}
+
+func (m *GlooOptions_XdsValidationOptions) GetEnvoyBinaryPath() string {
+	if m != nil {
+		return m.EnvoyBinaryPath
+	}
+	return ""
+}
+
+func (m *GlooOptions_XdsValidationOptions) GetEnvoyValidationTimeout() *types.Duration {
+	if m != nil {
+		return m.EnvoyValidationTimeout
+	}
+	return nil
+}
+
+// Authentication and authorization of the clients (Envoys and Gloo extensions) of the `gloo` xDS server.
+// When set, every xDS request must come from an authenticated client bound to the role
+// (`node.metadata.role`, i.e. `<namespace>~<name>` of the Proxy) that it requests. Other requests are rejected,
+// logged and counted in the `gloo.solo.io/xds/auth_denied` metric. The options only apply to the gRPC xDS server.
+type GlooOptions_XdsAuthOptions struct {
+	// Serve xDS over TLS with the PEM-encoded certificate and key in these files,
+	// e.g. mounted from a `kubernetes.io/tls` Secret.
+	ServerCertFile string `protobuf:"bytes,1,opt,name=server_cert_file,json=serverCertFile,proto3" json:"server_cert_file,omitempty"`
+	ServerKeyFile  string `protobuf:"bytes,2,opt,name=server_key_file,json=serverKeyFile,proto3" json:"server_key_file,omitempty"`
+	// Verify client certificates against the PEM-encoded CA certificates in this file. Requires the server
+	// certificate. A client authenticated by certificate is identified by each URI SAN (e.g. its SPIFFE ID)
+	// and DNS SAN of the certificate.
+	ClientCaFile string `protobuf:"bytes,3,opt,name=client_ca_file,json=clientCaFile,proto3" json:"client_ca_file,omitempty"`
+	// Authenticate clients that send a Kubernetes service account token as `authorization: Bearer <token>`
+	// gRPC metadata, with a TokenReview. Such a client is identified as
+	// `system:serviceaccount:<namespace>:<name>`.
+	ServiceAccountTokens bool                                      `protobuf:"varint,4,opt,name=service_account_tokens,json=serviceAccountTokens,proto3" json:"service_account_tokens,omitempty"`
+	RoleBindings         []*GlooOptions_XdsAuthOptions_RoleBinding `protobuf:"bytes,5,rep,name=role_bindings,json=roleBindings,proto3" json:"role_bindings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
+}
+
+func (m *GlooOptions_XdsAuthOptions) Reset()         { *m = GlooOptions_XdsAuthOptions{} }
+func (m *GlooOptions_XdsAuthOptions) String() string { return proto.CompactTextString(m) }
+func (*GlooOptions_XdsAuthOptions) ProtoMessage()    {}
+func (*GlooOptions_XdsAuthOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{1, 3}
+}
+func (m *GlooOptions_XdsAuthOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GlooOptions_XdsAuthOptions.Unmarshal(m, b)
+}
+func (m *GlooOptions_XdsAuthOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GlooOptions_XdsAuthOptions.Marshal(b, m, deterministic)
+}
+func (m *GlooOptions_XdsAuthOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GlooOptions_XdsAuthOptions.Merge(m, src)
+}
+func (m *GlooOptions_XdsAuthOptions) XXX_Size() int {
+	return xxx_messageInfo_GlooOptions_XdsAuthOptions.Size(m)
+}
+func (m *GlooOptions_XdsAuthOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_GlooOptions_XdsAuthOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GlooOptions_XdsAuthOptions proto.InternalMessageInfo
+
+func (m *GlooOptions_XdsAuthOptions) GetServerCertFile() string {
+	if m != nil {
+		return m.ServerCertFile
+	}
+	return ""
+}
+
+func (m *GlooOptions_XdsAuthOptions) GetServerKeyFile() string {
+	if m != nil {
+		return m.ServerKeyFile
+	}
+	return ""
+}
+
+func (m *GlooOptions_XdsAuthOptions) GetClientCaFile() string {
+	if m != nil {
+		return m.ClientCaFile
+	}
+	return ""
+}
+
+func (m *GlooOptions_XdsAuthOptions) GetServiceAccountTokens() bool {
+	if m != nil {
+		return m.ServiceAccountTokens
+	}
+	return false
+}
+
+func (m *GlooOptions_XdsAuthOptions) GetRoleBindings() []*GlooOptions_XdsAuthOptions_RoleBinding {
+	if m != nil {
+		return m.RoleBindings
+	}
+	return nil
+}
+
+// Binds client identities to the roles they may request.
+type GlooOptions_XdsAuthOptions_RoleBinding struct {
+	// Client identities, may contain `*` wildcards, e.g. `spiffe://cluster.local/ns/team-a/sa/*`.
+	// A `*` does not match across `/`.
+	Identities []string `protobuf:"bytes,1,rep,name=identities,proto3" json:"identities,omitempty"`
+	// The roles these identities may request, may contain `*` wildcards, e.g. `team-a~*`.
+	Roles                []string `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GlooOptions_XdsAuthOptions_RoleBinding) Reset() {
+	*m = GlooOptions_XdsAuthOptions_RoleBinding{}
+}
+func (m *GlooOptions_XdsAuthOptions_RoleBinding) String() string { return proto.CompactTextString(m) }
+func (*GlooOptions_XdsAuthOptions_RoleBinding) ProtoMessage()    {}
+func (*GlooOptions_XdsAuthOptions_RoleBinding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{1, 3, 0}
+}
+func (m *GlooOptions_XdsAuthOptions_RoleBinding) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GlooOptions_XdsAuthOptions_RoleBinding.Unmarshal(m, b)
+}
+func (m *GlooOptions_XdsAuthOptions_RoleBinding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GlooOptions_XdsAuthOptions_RoleBinding.Marshal(b, m, deterministic)
+}
+func (m *GlooOptions_XdsAuthOptions_RoleBinding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GlooOptions_XdsAuthOptions_RoleBinding.Merge(m, src)
+}
+func (m *GlooOptions_XdsAuthOptions_RoleBinding) XXX_Size() int {
+	return xxx_messageInfo_GlooOptions_XdsAuthOptions_RoleBinding.Size(m)
+}
+func (m *GlooOptions_XdsAuthOptions_RoleBinding) XXX_DiscardUnknown() {
+	xxx_messageInfo_GlooOptions_XdsAuthOptions_RoleBinding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GlooOptions_XdsAuthOptions_RoleBinding proto.InternalMessageInfo
+
+func (m *GlooOptions_XdsAuthOptions_RoleBinding) GetIdentities() []string {
+	if m != nil {
+		return m.Identities
+	}
+	return nil
+}
+
+func (m *GlooOptions_XdsAuthOptions_RoleBinding) GetRoles() []string {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+// An out-of-process translation plugin, which implements the `gloo.solo.io.ExternalPluginService` gRPC service.
+type GlooOptions_ExternalPlugin struct {
+	// Name of the plugin, included in the errors it causes on resources.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// gRPC address of the plugin, e.g. `my-plugin.gloo-system.svc.cluster.local:9000`.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// Timeout for a single call to the plugin. Defaults to 1 second.
+	Timeout              *types.Duration `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GlooOptions_ExternalPlugin) Reset()         { *m = GlooOptions_ExternalPlugin{} }
+func (m *GlooOptions_ExternalPlugin) String() string { return proto.CompactTextString(m) }
+func (*GlooOptions_ExternalPlugin) ProtoMessage()    {}
+func (*GlooOptions_ExternalPlugin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{1, 4}
+}
+func (m *GlooOptions_ExternalPlugin) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GlooOptions_ExternalPlugin.Unmarshal(m, b)
+}
+func (m *GlooOptions_ExternalPlugin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GlooOptions_ExternalPlugin.Marshal(b, m, deterministic)
+}
+func (m *GlooOptions_ExternalPlugin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GlooOptions_ExternalPlugin.Merge(m, src)
+}
+func (m *GlooOptions_ExternalPlugin) XXX_Size() int {
+	return xxx_messageInfo_GlooOptions_ExternalPlugin.Size(m)
+}
+func (m *GlooOptions_ExternalPlugin) XXX_DiscardUnknown() {
+	xxx_messageInfo_GlooOptions_ExternalPlugin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GlooOptions_ExternalPlugin proto.InternalMessageInfo
+
+func (m *GlooOptions_ExternalPlugin) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GlooOptions_ExternalPlugin) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GlooOptions_ExternalPlugin) GetTimeout() *types.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return nil
+}
+
 // Settings specific to the Gateway controller
 type GatewayOptions struct {
 	// Address of the `gloo` config validation server. Defaults to `gloo:9988`.
@@ -1631,18 +2440,28 @@ func init() {
 	proto.RegisterType((*Settings_KubernetesCrds)(nil), "gloo.solo.io.Settings.KubernetesCrds")
 	proto.RegisterType((*Settings_KubernetesSecrets)(nil), "gloo.solo.io.Settings.KubernetesSecrets")
 	proto.RegisterType((*Settings_VaultSecrets)(nil), "gloo.solo.io.Settings.VaultSecrets")
+	proto.RegisterType((*Settings_VaultSecrets_KubernetesAuth)(nil), "gloo.solo.io.Settings.VaultSecrets.KubernetesAuth")
+	proto.RegisterType((*Settings_VaultSecrets_AppRoleAuth)(nil), "gloo.solo.io.Settings.VaultSecrets.AppRoleAuth")
 	proto.RegisterType((*Settings_ConsulKv)(nil), "gloo.solo.io.Settings.ConsulKv")
+	proto.RegisterType((*Settings_EtcdKv)(nil), "gloo.solo.io.Settings.EtcdKv")
 	proto.RegisterType((*Settings_KubernetesConfigmaps)(nil), "gloo.solo.io.Settings.KubernetesConfigmaps")
 	proto.RegisterType((*Settings_Directory)(nil), "gloo.solo.io.Settings.Directory")
+	proto.RegisterType((*Settings_Directory_Decryption)(nil), "gloo.solo.io.Settings.Directory.Decryption")
 	proto.RegisterType((*Settings_KnativeOptions)(nil), "gloo.solo.io.Settings.KnativeOptions")
 	proto.RegisterType((*Settings_DiscoveryOptions)(nil), "gloo.solo.io.Settings.DiscoveryOptions")
 	proto.RegisterType((*Settings_ConsulConfiguration)(nil), "gloo.solo.io.Settings.ConsulConfiguration")
 	proto.RegisterType((*Settings_ConsulConfiguration_ServiceDiscoveryOptions)(nil), "gloo.solo.io.Settings.ConsulConfiguration.ServiceDiscoveryOptions")
+	proto.RegisterType((*Settings_EtcdConfiguration)(nil), "gloo.solo.io.Settings.EtcdConfiguration")
 	proto.RegisterType((*Settings_KubernetesConfiguration)(nil), "gloo.solo.io.Settings.KubernetesConfiguration")
 	proto.RegisterType((*Settings_KubernetesConfiguration_RateLimits)(nil), "gloo.solo.io.Settings.KubernetesConfiguration.RateLimits")
+	proto.RegisterType((*Settings_KubernetesConfiguration_LeaderElection)(nil), "gloo.solo.io.Settings.KubernetesConfiguration.LeaderElection")
 	proto.RegisterType((*GlooOptions)(nil), "gloo.solo.io.GlooOptions")
 	proto.RegisterType((*GlooOptions_AWSOptions)(nil), "gloo.solo.io.GlooOptions.AWSOptions")
 	proto.RegisterType((*GlooOptions_InvalidConfigPolicy)(nil), "gloo.solo.io.GlooOptions.InvalidConfigPolicy")
+	proto.RegisterType((*GlooOptions_XdsValidationOptions)(nil), "gloo.solo.io.GlooOptions.XdsValidationOptions")
+	proto.RegisterType((*GlooOptions_XdsAuthOptions)(nil), "gloo.solo.io.GlooOptions.XdsAuthOptions")
+	proto.RegisterType((*GlooOptions_XdsAuthOptions_RoleBinding)(nil), "gloo.solo.io.GlooOptions.XdsAuthOptions.RoleBinding")
+	proto.RegisterType((*GlooOptions_ExternalPlugin)(nil), "gloo.solo.io.GlooOptions.ExternalPlugin")
 	proto.RegisterType((*GatewayOptions)(nil), "gloo.solo.io.GatewayOptions")
 	proto.RegisterType((*GatewayOptions_ValidationOptions)(nil), "gloo.solo.io.GatewayOptions.ValidationOptions")
 }
@@ -1652,158 +2471,207 @@ func init() {
 }
 
 var fileDescriptor_bd7533c2495e1752 = []byte{
-	// 2416 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x59, 0xcb, 0x72, 0x1b, 0xc7,
-	0x15, 0x15, 0x28, 0x4a, 0x04, 0x2e, 0xf8, 0x6c, 0x52, 0xe4, 0x10, 0xa4, 0x28, 0x99, 0x89, 0x13,
-	0xd9, 0x2e, 0x0f, 0x1c, 0xda, 0x71, 0x1c, 0x3f, 0xca, 0x21, 0x28, 0xd2, 0x64, 0x28, 0x39, 0xf2,
-	0x40, 0x16, 0x53, 0xae, 0x54, 0xa6, 0x1a, 0x33, 0x0d, 0xb0, 0x83, 0xc1, 0xf4, 0x54, 0x77, 0x03,
-	0x24, 0xbc, 0xf4, 0x3e, 0xab, 0x54, 0x16, 0xf9, 0x83, 0x54, 0xf9, 0x07, 0xf2, 0x01, 0x59, 0x24,
-	0xcb, 0x7c, 0x40, 0xbc, 0xc8, 0x17, 0xc4, 0xa9, 0xca, 0x2a, 0x9b, 0x54, 0x3f, 0xe6, 0x01, 0x90,
-	0x80, 0xa8, 0x0d, 0x0b, 0xdd, 0xf7, 0x9e, 0xd3, 0xdd, 0xb7, 0x6f, 0xdf, 0xc7, 0x10, 0x3e, 0xea,
-	0x50, 0x79, 0xde, 0x6f, 0xb9, 0x01, 0xeb, 0xd5, 0x05, 0x8b, 0xd8, 0xdb, 0x94, 0xd5, 0x3b, 0x11,
-	0x63, 0xf5, 0x84, 0xb3, 0xdf, 0x91, 0x40, 0x0a, 0x33, 0xc2, 0x09, 0xad, 0x0f, 0x7e, 0x52, 0x17,
-	0x44, 0x4a, 0x1a, 0x77, 0x84, 0x9b, 0x70, 0x26, 0x19, 0x9a, 0x57, 0x32, 0x57, 0xc1, 0x5c, 0xca,
-	0x6a, 0x6b, 0x1d, 0xd6, 0x61, 0x5a, 0x50, 0x57, 0xbf, 0x8c, 0x4e, 0x0d, 0x91, 0x4b, 0x69, 0x26,
-	0xc9, 0xa5, 0xb4, 0x73, 0x3b, 0x7a, 0xa5, 0x2e, 0x95, 0x29, 0x6f, 0x8f, 0x48, 0x1c, 0x62, 0x89,
-	0xad, 0x7c, 0x7b, 0x5c, 0x2e, 0x24, 0x96, 0x7d, 0x31, 0x09, 0x9d, 0x8e, 0xad, 0xfc, 0xcd, 0xc9,
-	0xfb, 0x27, 0x97, 0x92, 0xc4, 0x82, 0xb2, 0x38, 0xe5, 0x3a, 0x9a, 0xa2, 0x1b, 0x4b, 0xc2, 0x13,
-	0x4e, 0x05, 0xa9, 0xb3, 0x44, 0x2a, 0x4c, 0x9d, 0x63, 0x49, 0x22, 0xda, 0xa3, 0x32, 0xff, 0x65,
-	0x79, 0x0e, 0x5f, 0x89, 0x87, 0x5c, 0x4a, 0xdc, 0x97, 0xe7, 0x76, 0x47, 0xea, 0xa7, 0xa5, 0xf9,
-	0xf8, 0xd5, 0xb6, 0xd3, 0xc2, 0x81, 0xfe, 0x63, 0xd1, 0x53, 0x2e, 0x2e, 0xa0, 0x3c, 0xe8, 0x53,
-	0xe9, 0xb7, 0x38, 0xc1, 0x5d, 0xc2, 0x2d, 0x60, 0x7f, 0x02, 0x40, 0x99, 0x89, 0xc7, 0x38, 0xaa,
-	0x93, 0x78, 0xc0, 0x86, 0x05, 0xab, 0xd5, 0xf1, 0x85, 0xa8, 0xb7, 0x69, 0x24, 0x33, 0x8a, 0x9d,
-	0x0e, 0x63, 0x9d, 0x88, 0xd4, 0xf5, 0xa8, 0xd5, 0x6f, 0xd7, 0xc3, 0x3e, 0xc7, 0x6a, 0x7b, 0x93,
-	0xe4, 0x17, 0x1c, 0x27, 0x09, 0xe1, 0xf6, 0x02, 0x76, 0x7f, 0x7f, 0x1f, 0xca, 0x4d, 0xeb, 0x55,
-	0xa8, 0x0e, 0xab, 0x21, 0x15, 0x01, 0x1b, 0x10, 0x3e, 0xf4, 0x63, 0xdc, 0x23, 0x22, 0xc1, 0x01,
-	0x71, 0x4a, 0x0f, 0x4b, 0x8f, 0x2a, 0x1e, 0xca, 0x44, 0x9f, 0xa7, 0x12, 0xf4, 0x06, 0x2c, 0x5f,
-	0x60, 0x19, 0x9c, 0xe7, 0xca, 0xc2, 0x99, 0x79, 0x78, 0xfb, 0x51, 0xc5, 0x5b, 0xd2, 0xf3, 0x99,
-	0xa6, 0x40, 0x18, 0x9c, 0x6e, 0xbf, 0x45, 0x78, 0x4c, 0x24, 0x11, 0x7e, 0xc0, 0xe2, 0x36, 0xed,
-	0xf8, 0x82, 0xf5, 0x79, 0x40, 0x9c, 0xd9, 0x87, 0xa5, 0x47, 0xd5, 0xbd, 0xd7, 0xdd, 0xa2, 0x3b,
-	0xbb, 0xe9, 0xae, 0xdc, 0xd3, 0x0c, 0x76, 0xc0, 0x43, 0x71, 0x7c, 0xcb, 0x5b, 0xcf, 0x89, 0x0e,
-	0x34, 0x4f, 0x53, 0xd3, 0xa0, 0xaf, 0x60, 0x23, 0xa4, 0x9c, 0x04, 0x92, 0xf1, 0xe1, 0xd8, 0x0a,
-	0x77, 0xf4, 0x0a, 0x0f, 0x27, 0xac, 0xf0, 0x38, 0x45, 0x1d, 0xdf, 0xf2, 0xee, 0x65, 0x14, 0x23,
-	0xdc, 0xa7, 0xb0, 0x1c, 0xb0, 0x58, 0xf4, 0x23, 0xbf, 0x3b, 0x48, 0x49, 0xef, 0x69, 0xd2, 0x07,
-	0x13, 0x48, 0x0f, 0xb4, 0xfa, 0xe9, 0xe0, 0xf8, 0x96, 0xb7, 0x18, 0xd8, 0xdf, 0x96, 0x2c, 0x1c,
-	0xb1, 0x85, 0x20, 0x01, 0x27, 0x32, 0x25, 0xbd, 0xab, 0x49, 0x1f, 0xbd, 0xd4, 0x16, 0x4d, 0x8d,
-	0x12, 0xc7, 0xa5, 0xa2, 0x39, 0xcc, 0xa4, 0x5d, 0xe5, 0x4b, 0x58, 0x1d, 0xe0, 0x7e, 0x24, 0xc7,
-	0x16, 0x98, 0xd3, 0x0b, 0xfc, 0x60, 0xc2, 0x02, 0x2f, 0x14, 0x22, 0xe7, 0x5e, 0x19, 0xe4, 0xe3,
-	0xeb, 0xac, 0x3c, 0x4a, 0x5d, 0xbe, 0xa1, 0x95, 0x4b, 0x05, 0x2b, 0x8f, 0x70, 0x77, 0xa1, 0x56,
-	0x30, 0x0c, 0xe6, 0x92, 0xb6, 0x71, 0x90, 0xd1, 0x57, 0x34, 0xfd, 0x5b, 0x2f, 0x77, 0x13, 0x7d,
-	0x71, 0x3d, 0x9c, 0x88, 0xe3, 0x19, 0xaf, 0x60, 0xe9, 0x7d, 0xcb, 0x67, 0x17, 0xfb, 0x2d, 0x6c,
-	0xe6, 0x07, 0x19, 0x5f, 0x0b, 0x6e, 0x78, 0x94, 0x19, 0x2f, 0xb7, 0xc6, 0x18, 0xff, 0x6f, 0x60,
-	0x33, 0x77, 0x99, 0x71, 0xfe, 0x8d, 0x9b, 0xf9, 0xce, 0x8c, 0xb7, 0x9e, 0xfa, 0xce, 0x18, 0xfb,
-	0xc7, 0x30, 0xcf, 0x49, 0x9b, 0x13, 0x71, 0xee, 0xab, 0x60, 0xe8, 0xcc, 0x6b, 0xc2, 0x4d, 0xd7,
-	0xbc, 0x77, 0x37, 0x7d, 0xef, 0xee, 0x63, 0x1b, 0x0f, 0xbc, 0xaa, 0x55, 0xf7, 0xb0, 0x24, 0x68,
-	0x13, 0xca, 0x21, 0x19, 0xf8, 0x3d, 0x16, 0x12, 0x67, 0xe1, 0x61, 0xe9, 0x51, 0xd9, 0x9b, 0x0b,
-	0xc9, 0xe0, 0x29, 0x0b, 0x09, 0x72, 0x60, 0x2e, 0xa2, 0x71, 0x97, 0xf0, 0xd0, 0x59, 0x31, 0x12,
-	0x3b, 0x44, 0x9f, 0xc2, 0x5c, 0x37, 0xc6, 0x92, 0x0e, 0x88, 0x83, 0xa6, 0xbf, 0x58, 0xa3, 0xf5,
-	0x2b, 0x13, 0x27, 0xbd, 0x14, 0x85, 0x0e, 0xa1, 0x92, 0x05, 0x11, 0x67, 0x55, 0x53, 0xfc, 0x78,
-	0xa2, 0x85, 0xad, 0x5e, 0x4a, 0x92, 0x23, 0xd1, 0xdb, 0x30, 0xab, 0x40, 0x8e, 0x93, 0x1e, 0xb9,
-	0xc8, 0xf0, 0x59, 0xc4, 0x58, 0x8a, 0xd1, 0x6a, 0xe8, 0x7d, 0x98, 0xeb, 0x60, 0x49, 0x2e, 0xf0,
-	0xd0, 0xd9, 0xd4, 0x88, 0xed, 0x31, 0x84, 0x11, 0x66, 0xbb, 0xb5, 0xca, 0xa8, 0x01, 0x77, 0x8d,
-	0xed, 0x9d, 0x35, 0x0d, 0x7b, 0x73, 0xea, 0x65, 0x19, 0xa7, 0x4b, 0x8d, 0x6d, 0x91, 0xe8, 0x73,
-	0x80, 0xdc, 0xff, 0x9c, 0x75, 0xcd, 0xe3, 0xde, 0xd0, 0x81, 0x53, 0xae, 0x02, 0x03, 0xfa, 0x00,
-	0x20, 0xcf, 0x06, 0xce, 0xb2, 0xe6, 0x73, 0x46, 0xf9, 0x0e, 0x33, 0xb9, 0x57, 0xd0, 0x45, 0x4f,
-	0xa1, 0x92, 0x25, 0x4d, 0xa7, 0xa6, 0x81, 0x75, 0x37, 0x4f, 0xa3, 0x36, 0xa7, 0x8d, 0x6f, 0x8d,
-	0x0f, 0x68, 0x40, 0xd2, 0x1d, 0x7a, 0x39, 0x03, 0x6a, 0xc2, 0x72, 0x36, 0xf0, 0x05, 0xe1, 0x03,
-	0xc2, 0x9d, 0x2d, 0x1b, 0xba, 0x5e, 0xca, 0x6a, 0xe9, 0x96, 0x32, 0xc5, 0xa6, 0x26, 0x40, 0x3f,
-	0x83, 0x59, 0x95, 0x4e, 0x9d, 0x6d, 0x1b, 0xa2, 0x74, 0x6e, 0x9d, 0xce, 0xa1, 0x01, 0xe8, 0x23,
-	0x98, 0xb3, 0x89, 0xdc, 0xb9, 0xaf, 0xb1, 0xaf, 0xb9, 0x79, 0xbe, 0x9e, 0x80, 0x4c, 0x11, 0xe8,
-	0x03, 0x28, 0xa7, 0xf5, 0x8f, 0xb3, 0xa8, 0xd1, 0xeb, 0x6e, 0xc0, 0x38, 0xc9, 0x20, 0x4f, 0xad,
-	0xb4, 0x31, 0xfb, 0xb7, 0xef, 0x1e, 0xdc, 0xf2, 0x32, 0x6d, 0x74, 0x0a, 0x77, 0x4d, 0x65, 0xe4,
-	0x2c, 0x69, 0xdc, 0xda, 0x28, 0xae, 0xa9, 0x65, 0x8d, 0xfb, 0x7f, 0xf9, 0xef, 0x6c, 0x49, 0x21,
-	0xff, 0xf3, 0xdd, 0x83, 0x15, 0x49, 0x84, 0x0c, 0x69, 0xbb, 0xfd, 0xe1, 0x2e, 0xed, 0xc4, 0x8c,
-	0x93, 0x5d, 0xcf, 0x52, 0xd4, 0x96, 0x61, 0x71, 0x34, 0xd3, 0xd5, 0x56, 0x61, 0xe5, 0x4a, 0xbc,
-	0xaf, 0x7d, 0x3b, 0x03, 0xf3, 0xc5, 0x20, 0x8d, 0xd6, 0xe0, 0x8e, 0x64, 0x5d, 0x12, 0xdb, 0x34,
-	0x6d, 0x06, 0xea, 0x15, 0xe3, 0x30, 0xe4, 0x44, 0xa8, 0x84, 0xac, 0xe6, 0xd3, 0x21, 0xda, 0x80,
-	0xb9, 0x00, 0xfb, 0x01, 0xe1, 0xd2, 0xb9, 0xad, 0x25, 0x77, 0x03, 0x7c, 0x40, 0xb8, 0xb4, 0x82,
-	0x04, 0xcb, 0x73, 0x9d, 0x90, 0xb5, 0xe0, 0x19, 0x96, 0xe7, 0xe8, 0x01, 0x54, 0x83, 0x88, 0x92,
-	0x58, 0x1a, 0xd4, 0x1d, 0x2d, 0x04, 0x33, 0xa5, 0x91, 0xf7, 0xc1, 0x8e, 0xfc, 0x2e, 0x19, 0xea,
-	0x0c, 0x56, 0xf1, 0x2a, 0x66, 0xe6, 0x94, 0x0c, 0xd1, 0x8f, 0x60, 0x49, 0x46, 0xc2, 0x7a, 0x89,
-	0x2e, 0x15, 0x74, 0x12, 0xaa, 0x78, 0x0b, 0x32, 0x12, 0xe6, 0xea, 0x55, 0xa1, 0x80, 0xde, 0x87,
-	0x32, 0x8d, 0x05, 0x09, 0xfa, 0x3c, 0x4d, 0x25, 0xb5, 0x2b, 0xe1, 0xac, 0xc1, 0x58, 0xf4, 0x02,
-	0x47, 0x7d, 0xe2, 0x65, 0xba, 0x2a, 0x98, 0x71, 0xc6, 0xcc, 0xe2, 0x15, 0x73, 0x58, 0x35, 0x3e,
-	0x25, 0xc3, 0xda, 0xeb, 0x50, 0x4e, 0x63, 0xe9, 0x88, 0x5a, 0x69, 0x54, 0x6d, 0x1d, 0xd6, 0xae,
-	0x4b, 0x1f, 0xb5, 0x37, 0xa0, 0x92, 0x85, 0x7a, 0xb4, 0xad, 0xa2, 0x97, 0x1d, 0x58, 0x82, 0x7c,
-	0xa2, 0xf6, 0xcf, 0x12, 0x2c, 0x8e, 0xc6, 0x3d, 0xb4, 0x0f, 0xf7, 0x83, 0xa8, 0x2f, 0x24, 0xe1,
-	0x3e, 0x8d, 0x3b, 0xca, 0xf8, 0x7e, 0xc2, 0xd9, 0xe5, 0xd0, 0x4f, 0x6f, 0xc6, 0x90, 0xd4, 0xac,
-	0xd2, 0x89, 0xd1, 0x79, 0xa6, 0x54, 0xf6, 0xed, 0x65, 0x1d, 0xc0, 0x8e, 0x0d, 0x9e, 0x7e, 0x5a,
-	0x14, 0x8e, 0x71, 0x98, 0xdb, 0xdd, 0xb2, 0x5a, 0x87, 0x56, 0x69, 0x12, 0x09, 0x8d, 0xaf, 0x25,
-	0xb9, 0x3d, 0x42, 0x72, 0x12, 0x5f, 0x25, 0xa9, 0xfd, 0xb1, 0x04, 0xcb, 0xe3, 0x41, 0x19, 0xfd,
-	0x12, 0xca, 0xed, 0x50, 0x98, 0x34, 0xa2, 0x0e, 0xb3, 0xb8, 0x57, 0xbf, 0x61, 0x3c, 0x77, 0x8f,
-	0x42, 0xa1, 0xd2, 0x8d, 0x37, 0xd7, 0x36, 0x3f, 0x76, 0x7f, 0x0a, 0x73, 0x76, 0x0e, 0x2d, 0x40,
-	0xa5, 0xf1, 0x64, 0xff, 0xe0, 0xf4, 0xc9, 0x49, 0xf3, 0xf9, 0xf2, 0x2d, 0x35, 0x3c, 0x3b, 0x3e,
-	0x79, 0x7e, 0xa8, 0x87, 0x25, 0x34, 0x0f, 0xe5, 0xc7, 0x27, 0xcd, 0xfd, 0xc6, 0x93, 0xc3, 0xc7,
-	0xcb, 0x33, 0xb5, 0x7f, 0xdc, 0x81, 0xd5, 0x6b, 0x22, 0x30, 0xda, 0xce, 0x1f, 0x80, 0x36, 0x73,
-	0x63, 0xc6, 0x29, 0xe5, 0x8f, 0xe0, 0x35, 0x98, 0x3f, 0x97, 0x32, 0xc9, 0x0c, 0xb0, 0xa0, 0x0d,
-	0x50, 0x55, 0x73, 0xa9, 0xd5, 0x1e, 0x40, 0x35, 0x8c, 0x45, 0xa6, 0xb1, 0x68, 0xbc, 0x3e, 0x8c,
-	0x45, 0xaa, 0x70, 0x0a, 0x6b, 0x4a, 0x21, 0x61, 0x51, 0x44, 0xe3, 0x8e, 0x31, 0xed, 0x00, 0x47,
-	0x36, 0x16, 0x4c, 0xc9, 0xc4, 0x28, 0x8c, 0xc5, 0x33, 0x83, 0x3a, 0xb1, 0x20, 0xb4, 0x03, 0xa0,
-	0x42, 0x4a, 0xa0, 0xc3, 0x96, 0xbd, 0xd4, 0xc2, 0x0c, 0xaa, 0x41, 0xb9, 0x2f, 0xd4, 0xad, 0xf4,
-	0x88, 0xbd, 0xad, 0x6c, 0xac, 0x64, 0x09, 0x16, 0xe2, 0x82, 0xf1, 0xd0, 0xbe, 0xdc, 0x6c, 0x9c,
-	0x47, 0x87, 0x3b, 0xc5, 0xe8, 0x60, 0x9e, 0x7a, 0x9b, 0x46, 0xc4, 0xbe, 0xd6, 0xbb, 0x01, 0x3e,
-	0xa2, 0x11, 0x29, 0xc6, 0x80, 0xb9, 0x91, 0x18, 0xb0, 0x05, 0x15, 0xf5, 0xf8, 0x0d, 0xa6, 0x6c,
-	0x16, 0x51, 0x13, 0x1a, 0xb5, 0x09, 0xe5, 0x2e, 0x19, 0x1a, 0x99, 0x7d, 0x80, 0x5d, 0x32, 0xd4,
-	0xa2, 0x27, 0xb0, 0x96, 0xbe, 0x53, 0x5f, 0x74, 0x69, 0xe2, 0x0f, 0x08, 0xa7, 0xed, 0xa1, 0xad,
-	0xaf, 0xa6, 0xbd, 0x6f, 0x94, 0xe2, 0x9a, 0x5d, 0x9a, 0xbc, 0xd0, 0x28, 0xf4, 0x3e, 0x54, 0x2e,
-	0x30, 0x95, 0xbe, 0xa4, 0x3d, 0xe2, 0x54, 0x5f, 0x66, 0xe7, 0xb2, 0xd2, 0x7d, 0x4e, 0x7b, 0x04,
-	0x31, 0x58, 0x11, 0x26, 0x97, 0xf9, 0x79, 0x01, 0x62, 0x2a, 0xa6, 0xc6, 0xcd, 0xb3, 0x7a, 0x9a,
-	0x0f, 0xaf, 0xd4, 0x26, 0xcb, 0x62, 0x4c, 0x50, 0xfb, 0x18, 0x36, 0x26, 0x28, 0x2b, 0xd7, 0x53,
-	0xf7, 0xea, 0x9b, 0x8b, 0x55, 0xde, 0xa9, 0xfa, 0xa5, 0xaa, 0x9a, 0x3b, 0x30, 0x53, 0xb5, 0x6f,
-	0x4b, 0xb0, 0x31, 0xa1, 0x1a, 0x40, 0x5f, 0x41, 0x55, 0xa5, 0x4d, 0x5f, 0xe7, 0x4d, 0xe3, 0xdb,
-	0xd5, 0xbd, 0x9f, 0xbf, 0x5a, 0x49, 0xe1, 0xaa, 0x1a, 0xf0, 0x89, 0x26, 0xf0, 0x80, 0x67, 0xbf,
-	0x6b, 0xef, 0x01, 0xe4, 0x12, 0xb4, 0x0c, 0xb7, 0xbf, 0x78, 0xd6, 0xd4, 0x2b, 0xcc, 0x78, 0xea,
-	0xa7, 0x72, 0xa6, 0x56, 0x9f, 0x0b, 0xa9, 0xfd, 0x73, 0xc1, 0x33, 0x83, 0x0f, 0xd7, 0xbf, 0xf9,
-	0x7e, 0x76, 0x16, 0x66, 0x84, 0xfc, 0xe6, 0xfb, 0x59, 0x40, 0xe5, 0xf4, 0x1b, 0x45, 0x63, 0x09,
-	0x16, 0x46, 0x9a, 0x30, 0x35, 0x31, 0xd2, 0x2f, 0x34, 0x56, 0x60, 0x69, 0xac, 0x2e, 0xde, 0xfd,
-	0x77, 0x05, 0xaa, 0x85, 0x12, 0x0e, 0xed, 0xc2, 0xc2, 0x65, 0x28, 0xfc, 0x16, 0x8d, 0x43, 0xfd,
-	0x14, 0x6d, 0xcc, 0xac, 0x5e, 0x86, 0xa2, 0x41, 0xe3, 0x50, 0xbd, 0x45, 0xf4, 0x0e, 0xac, 0x0d,
-	0x70, 0x44, 0x43, 0x7d, 0xb6, 0x82, 0xaa, 0x79, 0x45, 0x28, 0x97, 0x65, 0x88, 0xa7, 0xb0, 0x3c,
-	0xd6, 0x91, 0x9b, 0x18, 0x58, 0xdd, 0xdb, 0x1d, 0xb5, 0xe4, 0x81, 0xd1, 0x6a, 0x18, 0x25, 0x63,
-	0x44, 0x6f, 0x29, 0x18, 0x99, 0x15, 0xe8, 0x4b, 0xd8, 0x24, 0x71, 0x98, 0x30, 0x1a, 0x4b, 0xe1,
-	0x5f, 0x60, 0xde, 0x53, 0xf1, 0x40, 0xf9, 0x28, 0xeb, 0x4b, 0xdb, 0xdc, 0x4e, 0x71, 0xd3, 0x8d,
-	0x0c, 0x7b, 0x66, 0xa0, 0xcf, 0x0d, 0x12, 0x1d, 0x42, 0x15, 0x5f, 0x08, 0xdf, 0x16, 0x40, 0xb6,
-	0x87, 0xfd, 0xe1, 0xc4, 0x72, 0xd7, 0xdd, 0x3f, 0x6b, 0xa6, 0x1e, 0x09, 0xf8, 0x42, 0xa4, 0x26,
-	0xc4, 0x70, 0x8f, 0xc6, 0xda, 0x08, 0x69, 0x53, 0x9c, 0xb0, 0x88, 0x06, 0x43, 0xdb, 0x6a, 0xbe,
-	0x3d, 0x99, 0xf0, 0xc4, 0xc0, 0xcc, 0xb1, 0x9f, 0x69, 0x90, 0xb7, 0x4a, 0xaf, 0x4e, 0xa2, 0x23,
-	0x78, 0x10, 0x52, 0x81, 0x5b, 0x11, 0xf1, 0x0b, 0xfd, 0x5b, 0x48, 0x84, 0xa4, 0x31, 0x36, 0xbb,
-	0x9f, 0xd3, 0xbd, 0xc4, 0x7d, 0xab, 0x96, 0x3b, 0xe6, 0xe3, 0x82, 0x12, 0x7a, 0x0c, 0xcb, 0x29,
-	0x4f, 0x87, 0x27, 0x81, 0x7f, 0x41, 0x5a, 0x37, 0xa8, 0x04, 0x16, 0x2d, 0xe6, 0x33, 0x9e, 0x04,
-	0x67, 0xa4, 0x85, 0x02, 0x78, 0x98, 0xb2, 0x98, 0x34, 0xd7, 0xc1, 0xbc, 0x85, 0x3b, 0xc4, 0x0f,
-	0x58, 0x14, 0x91, 0x40, 0x2d, 0x65, 0x7b, 0xc9, 0x69, 0xac, 0xe9, 0x56, 0x75, 0x16, 0xfc, 0xcc,
-	0x30, 0x1c, 0x64, 0x04, 0xe8, 0x0b, 0x58, 0xe7, 0xa4, 0x43, 0x2e, 0xfd, 0x1e, 0xbe, 0x54, 0xcb,
-	0x74, 0x38, 0xee, 0xf9, 0x82, 0x7e, 0x9d, 0xb6, 0x8e, 0xdb, 0x57, 0xa8, 0xbf, 0x3c, 0x89, 0xe5,
-	0xbb, 0x7b, 0x86, 0x7c, 0x55, 0x63, 0x9f, 0xe2, 0xcb, 0x67, 0x06, 0xd9, 0xa4, 0x5f, 0x13, 0xf4,
-	0x16, 0x20, 0x4e, 0x84, 0xf4, 0x47, 0x1d, 0xbe, 0xaa, 0xbd, 0x78, 0x49, 0x49, 0x7e, 0x9d, 0x3b,
-	0x7d, 0xed, 0x7f, 0x25, 0x80, 0xfc, 0xc2, 0xd1, 0x2f, 0x60, 0x8b, 0xc4, 0xfa, 0xc8, 0x01, 0x27,
-	0x21, 0x89, 0x25, 0xc5, 0x91, 0x48, 0x83, 0x9d, 0x29, 0x57, 0xca, 0xc7, 0xb7, 0xbc, 0x4d, 0xa3,
-	0x74, 0x90, 0xeb, 0xd8, 0xf8, 0x34, 0x44, 0x7f, 0x28, 0xc1, 0x56, 0x1a, 0x24, 0x71, 0x10, 0xb0,
-	0xbe, 0xaa, 0xf7, 0x72, 0x3d, 0xfd, 0x9a, 0xaa, 0x7b, 0x5f, 0xb8, 0xfa, 0x9b, 0x94, 0x6b, 0x3c,
-	0xc9, 0xb5, 0xdf, 0xa2, 0x54, 0xde, 0x74, 0x95, 0xaf, 0x46, 0xb8, 0xd7, 0x0a, 0xb1, 0x3b, 0xd8,
-	0x53, 0xce, 0xf8, 0x44, 0x0f, 0x8c, 0xa3, 0xa4, 0xb1, 0x73, 0xdf, 0x30, 0x17, 0x36, 0xa0, 0x76,
-	0x25, 0x26, 0x09, 0x1b, 0xf7, 0x60, 0xb5, 0x78, 0xa0, 0x36, 0x91, 0xc1, 0x39, 0xe1, 0xb5, 0xbf,
-	0x97, 0x60, 0xf5, 0x1a, 0xef, 0x44, 0xef, 0xa9, 0x5b, 0x49, 0x22, 0x1c, 0xa8, 0x52, 0xc7, 0xf8,
-	0x3c, 0x67, 0x7d, 0xd5, 0x7b, 0x69, 0x0b, 0x78, 0x6b, 0x56, 0x6a, 0xb1, 0x9e, 0x96, 0xa1, 0x4f,
-	0x60, 0x6b, 0x44, 0xdb, 0xe7, 0x44, 0x24, 0x2c, 0x16, 0xca, 0x63, 0x42, 0x62, 0xa3, 0x9d, 0x43,
-	0x0b, 0x18, 0xcf, 0x2a, 0x1c, 0xa8, 0x72, 0x65, 0x32, 0xbc, 0xc5, 0xc2, 0xa1, 0x4d, 0xd7, 0xd7,
-	0xc2, 0x1b, 0x2c, 0x1c, 0xee, 0xfe, 0xf5, 0x0e, 0x2c, 0x8e, 0xf6, 0xa0, 0xea, 0x18, 0x85, 0x88,
-	0x66, 0x0b, 0xe7, 0x42, 0xf8, 0x2b, 0xc4, 0x3b, 0x53, 0x3f, 0xeb, 0xa8, 0xf6, 0x39, 0x40, 0x3e,
-	0x6f, 0xe3, 0x99, 0x3b, 0xad, 0xd7, 0x75, 0x5f, 0x64, 0xea, 0x59, 0xe0, 0xc8, 0x19, 0xd0, 0x31,
-	0xbc, 0xc6, 0x09, 0x0e, 0x7d, 0xdb, 0x10, 0x0b, 0xbf, 0xcd, 0x59, 0xcf, 0xc7, 0x51, 0x54, 0xfc,
-	0xdc, 0x37, 0x6b, 0xde, 0xb5, 0x52, 0xb4, 0xe4, 0xe2, 0x88, 0xb3, 0xde, 0x7e, 0x14, 0x15, 0x3e,
-	0xfe, 0x1d, 0xc1, 0x0e, 0x8e, 0x34, 0x85, 0x60, 0x5c, 0x5a, 0x2b, 0x49, 0xed, 0xae, 0xf6, 0x7a,
-	0x54, 0x70, 0x2b, 0xeb, 0x1a, 0xad, 0x66, 0x34, 0x9b, 0x8c, 0x4b, 0x6d, 0xab, 0xe7, 0x4a, 0xcd,
-	0x5c, 0x54, 0xed, 0x4f, 0xb7, 0x61, 0xe5, 0xca, 0x9e, 0xd1, 0xa7, 0xb0, 0x6d, 0xde, 0xf9, 0x04,
-	0x9b, 0x99, 0x3c, 0xb0, 0xa9, 0x75, 0x5e, 0x5c, 0x67, 0xb8, 0x4f, 0x60, 0xab, 0x00, 0xbd, 0x20,
-	0xad, 0x73, 0xc6, 0xba, 0xbe, 0xea, 0x59, 0x0a, 0x6d, 0x92, 0x93, 0xab, 0x9c, 0x19, 0x8d, 0xe7,
-	0x91, 0xd0, 0xed, 0xcf, 0x47, 0x50, 0x9b, 0x00, 0x57, 0xad, 0x86, 0xa9, 0xc8, 0x36, 0xae, 0x43,
-	0xab, 0xe6, 0xe8, 0x00, 0x76, 0x4c, 0x27, 0xe8, 0xab, 0x8b, 0x2a, 0x1e, 0xa1, 0x8d, 0x69, 0xa4,
-	0x5a, 0x21, 0x6d, 0x1a, 0x6f, 0xcb, 0x68, 0xa9, 0xf0, 0x9c, 0x9f, 0xe1, 0xc8, 0xa8, 0xa0, 0x4f,
-	0x61, 0xc1, 0xda, 0x17, 0x07, 0x01, 0x49, 0xa4, 0x0d, 0xed, 0xd3, 0xc2, 0xdb, 0xbc, 0x01, 0xec,
-	0x6b, 0x7d, 0xb4, 0x0f, 0x8b, 0x38, 0x8a, 0xd8, 0x85, 0xca, 0x5e, 0xb1, 0xca, 0xde, 0xf6, 0x33,
-	0xe1, 0x34, 0x86, 0x05, 0x8d, 0x38, 0xb3, 0x80, 0xc6, 0x87, 0xaa, 0xcd, 0xfd, 0xf3, 0xbf, 0x76,
-	0x4a, 0x5f, 0xbd, 0x73, 0xb3, 0xff, 0x69, 0x24, 0xdd, 0x8e, 0xfd, 0x3c, 0xde, 0xba, 0xab, 0xe9,
-	0xdf, 0xfd, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x58, 0xfa, 0x70, 0x05, 0x0e, 0x19, 0x00, 0x00,
+	// 3195 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x5a, 0x4b, 0x77, 0x23, 0x47,
+	0x15, 0x1e, 0xf9, 0x29, 0x5d, 0xd9, 0x92, 0x5d, 0xf6, 0xd8, 0xed, 0xf6, 0x8c, 0xed, 0x71, 0x5e,
+	0xc3, 0x84, 0xc8, 0x61, 0x32, 0x84, 0x90, 0x99, 0x9c, 0xc4, 0xb2, 0x3d, 0xb1, 0xf1, 0x4c, 0x70,
+	0x5a, 0xf3, 0x08, 0x81, 0x43, 0x9f, 0x52, 0x77, 0x49, 0x6e, 0xd4, 0xea, 0x6e, 0xaa, 0x4a, 0xb2,
+	0x95, 0x15, 0x27, 0x2b, 0xf6, 0x1c, 0x16, 0x6c, 0x59, 0xb1, 0x67, 0x93, 0x1f, 0xc0, 0x82, 0xd7,
+	0x86, 0x1f, 0x40, 0x16, 0xac, 0xd9, 0xc0, 0x39, 0xd9, 0xc0, 0x86, 0x53, 0x8f, 0x7e, 0xc9, 0x96,
+	0xc6, 0xc3, 0xc6, 0xa7, 0xab, 0xea, 0x7e, 0x5f, 0xbd, 0x6e, 0xdd, 0xfb, 0x55, 0xc9, 0x70, 0xbf,
+	0xed, 0xf1, 0xd3, 0x5e, 0xb3, 0xe6, 0x84, 0xdd, 0x1d, 0x16, 0xfa, 0xe1, 0x5b, 0x5e, 0xb8, 0xd3,
+	0xf6, 0xc3, 0x70, 0x27, 0xa2, 0xe1, 0xcf, 0x88, 0xc3, 0x99, 0x2a, 0xe1, 0xc8, 0xdb, 0xe9, 0x7f,
+	0x67, 0x87, 0x11, 0xce, 0xbd, 0xa0, 0xcd, 0x6a, 0x11, 0x0d, 0x79, 0x88, 0xe6, 0x44, 0x5b, 0x4d,
+	0xc0, 0x6a, 0x5e, 0x68, 0x2e, 0xb7, 0xc3, 0x76, 0x28, 0x1b, 0x76, 0xc4, 0x97, 0xb2, 0x31, 0x11,
+	0x39, 0xe7, 0xaa, 0x92, 0x9c, 0x73, 0x5d, 0xb7, 0x21, 0x7b, 0xea, 0x78, 0x3c, 0xe6, 0xed, 0x12,
+	0x8e, 0x5d, 0xcc, 0xb1, 0x6e, 0xbf, 0x31, 0xdc, 0xce, 0x38, 0xe6, 0x3d, 0x36, 0x0a, 0x1d, 0x97,
+	0x75, 0xfb, 0x9d, 0xd1, 0xe3, 0x27, 0xe7, 0x9c, 0x04, 0xcc, 0x0b, 0x83, 0x98, 0xeb, 0xe1, 0x18,
+	0xdb, 0x80, 0x13, 0x1a, 0x51, 0x8f, 0x91, 0x9d, 0x30, 0xe2, 0x02, 0xb3, 0x43, 0x31, 0x27, 0xbe,
+	0xd7, 0xf5, 0x78, 0xfa, 0xa5, 0x79, 0x0e, 0x5e, 0x8a, 0x87, 0x9c, 0x73, 0xdc, 0xe3, 0xa7, 0x7a,
+	0x44, 0xe2, 0x53, 0xd3, 0x3c, 0x78, 0xb9, 0xe1, 0x34, 0xb1, 0x23, 0xff, 0x68, 0xf4, 0x98, 0x8d,
+	0x73, 0x3c, 0xea, 0xf4, 0x3c, 0x6e, 0x37, 0x29, 0xc1, 0x1d, 0x42, 0x35, 0x60, 0x77, 0x04, 0x40,
+	0x2c, 0x13, 0x0d, 0xb0, 0xbf, 0x43, 0x82, 0x7e, 0x38, 0xc8, 0xac, 0xda, 0x0e, 0x3e, 0x63, 0x3b,
+	0x2d, 0xcf, 0xe7, 0x09, 0xc5, 0x46, 0x3b, 0x0c, 0xdb, 0x3e, 0xd9, 0x91, 0xa5, 0x66, 0xaf, 0xb5,
+	0xe3, 0xf6, 0x28, 0x16, 0xc3, 0x1b, 0xd5, 0x7e, 0x46, 0x71, 0x14, 0x11, 0xaa, 0x37, 0x60, 0xfb,
+	0xb7, 0x77, 0xa0, 0xd8, 0xd0, 0x5e, 0x85, 0x76, 0x60, 0xc9, 0xf5, 0x98, 0x13, 0xf6, 0x09, 0x1d,
+	0xd8, 0x01, 0xee, 0x12, 0x16, 0x61, 0x87, 0x18, 0x85, 0xad, 0xc2, 0xed, 0x92, 0x85, 0x92, 0xa6,
+	0x4f, 0xe2, 0x16, 0xf4, 0x2d, 0x58, 0x38, 0xc3, 0xdc, 0x39, 0x4d, 0x8d, 0x99, 0x31, 0xb1, 0x35,
+	0x79, 0xbb, 0x64, 0x55, 0x65, 0x7d, 0x62, 0xc9, 0x10, 0x06, 0xa3, 0xd3, 0x6b, 0x12, 0x1a, 0x10,
+	0x4e, 0x98, 0xed, 0x84, 0x41, 0xcb, 0x6b, 0xdb, 0x2c, 0xec, 0x51, 0x87, 0x18, 0x53, 0x5b, 0x85,
+	0xdb, 0xe5, 0xbb, 0xaf, 0xd5, 0xb2, 0xee, 0x5c, 0x8b, 0x47, 0x55, 0x3b, 0x4e, 0x60, 0x7b, 0xd4,
+	0x65, 0x87, 0xd7, 0xac, 0x95, 0x94, 0x68, 0x4f, 0xf2, 0x34, 0x24, 0x0d, 0xfa, 0x1c, 0x56, 0x5d,
+	0x8f, 0x12, 0x87, 0x87, 0x74, 0x30, 0xd4, 0xc3, 0xb4, 0xec, 0x61, 0x6b, 0x44, 0x0f, 0xfb, 0x31,
+	0xea, 0xf0, 0x9a, 0x75, 0x3d, 0xa1, 0xc8, 0x71, 0x1f, 0xc3, 0x82, 0x13, 0x06, 0xac, 0xe7, 0xdb,
+	0x9d, 0x7e, 0x4c, 0x7a, 0x5d, 0x92, 0x6e, 0x8e, 0x20, 0xdd, 0x93, 0xe6, 0xc7, 0xfd, 0xc3, 0x6b,
+	0x56, 0xc5, 0xd1, 0xdf, 0x9a, 0xec, 0x00, 0x2a, 0x84, 0x3b, 0x6e, 0x86, 0x6a, 0x53, 0x52, 0xdd,
+	0x1c, 0x41, 0x75, 0xc0, 0x1d, 0x57, 0x12, 0xcd, 0x11, 0xf9, 0xa5, 0x69, 0xdc, 0xdc, 0x92, 0x32,
+	0xe2, 0x50, 0xc2, 0x63, 0xc2, 0x19, 0x49, 0x78, 0xfb, 0x85, 0x4b, 0xda, 0x90, 0x28, 0x76, 0x58,
+	0xc8, 0xae, 0xaa, 0xaa, 0xd4, 0xbd, 0x3c, 0x85, 0xa5, 0x3e, 0xee, 0xf9, 0x7c, 0xa8, 0x83, 0x59,
+	0xd9, 0xc1, 0x2b, 0x23, 0x3a, 0x78, 0x26, 0x10, 0x29, 0xf7, 0x62, 0x3f, 0x2d, 0x5f, 0xb6, 0x59,
+	0x79, 0xea, 0xe2, 0x15, 0x37, 0xab, 0x90, 0xd9, 0xac, 0x1c, 0x77, 0x07, 0xcc, 0xcc, 0xc2, 0x60,
+	0xca, 0xbd, 0x16, 0x76, 0x12, 0xfa, 0x92, 0xa4, 0x7f, 0xf3, 0xc5, 0xde, 0x26, 0xf7, 0xbf, 0x8b,
+	0x23, 0x76, 0x38, 0x61, 0x65, 0x56, 0x7a, 0x57, 0xf3, 0xe9, 0xce, 0x7e, 0x0a, 0x6b, 0xe9, 0x44,
+	0x86, 0xfb, 0x82, 0x2b, 0x4e, 0x65, 0xc2, 0x4a, 0x57, 0x63, 0x88, 0xff, 0x27, 0xb0, 0x96, 0x7a,
+	0xde, 0x30, 0xff, 0xea, 0xd5, 0x5c, 0x70, 0xc2, 0x5a, 0x89, 0x5d, 0x70, 0x88, 0xfd, 0x19, 0xac,
+	0xc6, 0xae, 0x38, 0xcc, 0xbd, 0x75, 0x15, 0x9f, 0x9c, 0xb0, 0x96, 0x95, 0x4f, 0x0e, 0xf1, 0x3e,
+	0x80, 0x39, 0x4a, 0x5a, 0x94, 0xb0, 0x53, 0x5b, 0xc4, 0x6a, 0x63, 0x4e, 0x92, 0xad, 0xd5, 0x54,
+	0x38, 0xaa, 0xc5, 0xe1, 0xa8, 0xb6, 0xaf, 0xc3, 0x95, 0x55, 0xd6, 0xe6, 0x16, 0xe6, 0x04, 0xad,
+	0x41, 0xd1, 0x25, 0x7d, 0xbb, 0x1b, 0xba, 0xc4, 0x98, 0xdf, 0x2a, 0xdc, 0x2e, 0x5a, 0xb3, 0x2e,
+	0xe9, 0x3f, 0x0e, 0x5d, 0x82, 0x0c, 0x98, 0xf5, 0xbd, 0xa0, 0x43, 0xa8, 0x6b, 0x2c, 0xaa, 0x16,
+	0x5d, 0x44, 0x1f, 0xc2, 0x6c, 0x27, 0xc0, 0xdc, 0xeb, 0x13, 0x03, 0x8d, 0x0f, 0x28, 0xca, 0xea,
+	0x87, 0x2a, 0x8c, 0x5b, 0x31, 0x0a, 0x1d, 0x40, 0x29, 0x89, 0x71, 0xc6, 0x92, 0xa4, 0x78, 0x63,
+	0xe4, 0xce, 0x69, 0xbb, 0x98, 0x24, 0x45, 0xa2, 0xb7, 0x60, 0x4a, 0x80, 0x0c, 0x23, 0x9e, 0x72,
+	0x96, 0xe1, 0x63, 0x3f, 0x0c, 0x63, 0x8c, 0x34, 0x43, 0xef, 0xc2, 0x6c, 0x1b, 0x73, 0x72, 0x86,
+	0x07, 0xc6, 0x9a, 0x44, 0xdc, 0x18, 0x42, 0xa8, 0xc6, 0x64, 0xb4, 0xda, 0x18, 0xd5, 0x61, 0x46,
+	0xed, 0xa9, 0xb1, 0x2c, 0x61, 0x77, 0xc6, 0x3a, 0x81, 0x72, 0xe6, 0x78, 0xb1, 0x35, 0x12, 0x3d,
+	0x80, 0x29, 0xb1, 0x7b, 0xc6, 0xc6, 0xd8, 0x68, 0x21, 0xb6, 0x3a, 0x8f, 0x97, 0x28, 0xf4, 0x09,
+	0x40, 0x7a, 0x2a, 0x8c, 0x15, 0xc9, 0x51, 0xbb, 0xe2, 0xb1, 0x8a, 0x99, 0x32, 0x0c, 0xe8, 0x3d,
+	0x80, 0x34, 0xd5, 0x19, 0x0b, 0x92, 0xcf, 0xc8, 0xf3, 0x1d, 0x24, 0xed, 0x56, 0xc6, 0x16, 0x3d,
+	0x86, 0x52, 0xa2, 0x08, 0x0c, 0x53, 0x02, 0x77, 0x6a, 0xa9, 0x46, 0xd0, 0x09, 0x7b, 0x78, 0x68,
+	0xb4, 0xef, 0x39, 0x24, 0x1e, 0xa1, 0x95, 0x32, 0xa0, 0x06, 0x2c, 0x24, 0x05, 0x9b, 0x11, 0xda,
+	0x27, 0xd4, 0x58, 0xd7, 0x4b, 0xf4, 0x42, 0x56, 0x4d, 0x57, 0x4d, 0x0c, 0x1b, 0x92, 0x00, 0x7d,
+	0x0f, 0xa6, 0x84, 0x56, 0x30, 0x6e, 0xe8, 0xc0, 0x29, 0x85, 0xc3, 0x78, 0x0e, 0x09, 0x40, 0xf7,
+	0x61, 0x56, 0xab, 0x14, 0xe3, 0xa6, 0xc4, 0xde, 0xaa, 0xa5, 0x62, 0x64, 0x04, 0x32, 0x46, 0xa0,
+	0xf7, 0xa0, 0x18, 0x8b, 0x3b, 0xa3, 0x22, 0xd1, 0x2b, 0x35, 0x27, 0xa4, 0x24, 0x81, 0x3c, 0xd6,
+	0xad, 0xf5, 0xa9, 0x3f, 0x7e, 0xbd, 0x79, 0xcd, 0x4a, 0xac, 0xd1, 0x31, 0xcc, 0x28, 0xd9, 0x67,
+	0x54, 0x25, 0x6e, 0x39, 0x8f, 0x6b, 0xc8, 0xb6, 0xfa, 0xcd, 0xaf, 0xbe, 0x99, 0x2a, 0x08, 0xe4,
+	0xbf, 0xbf, 0xde, 0x5c, 0xe4, 0x84, 0x71, 0xd7, 0x6b, 0xb5, 0xde, 0xdf, 0xf6, 0xda, 0x41, 0x48,
+	0xc9, 0xb6, 0xa5, 0x29, 0xcc, 0x05, 0xa8, 0xe4, 0xd3, 0xb8, 0xb9, 0x04, 0x8b, 0x17, 0xb2, 0x90,
+	0xf9, 0xe7, 0x19, 0x98, 0xcb, 0xa6, 0x0e, 0xb4, 0x0c, 0xd3, 0x3c, 0xec, 0x90, 0x40, 0x6b, 0x10,
+	0x55, 0x10, 0x31, 0x00, 0xbb, 0x2e, 0x25, 0x4c, 0xa8, 0x0d, 0x51, 0x1f, 0x17, 0xd1, 0x2a, 0xcc,
+	0x3a, 0xd8, 0x76, 0x08, 0xe5, 0xc6, 0xa4, 0x6c, 0x99, 0x71, 0xf0, 0x1e, 0xa1, 0x5c, 0x37, 0x44,
+	0x98, 0x9f, 0x4a, 0xb5, 0x21, 0x1b, 0x4e, 0x30, 0x3f, 0x45, 0x9b, 0x50, 0x76, 0x7c, 0x8f, 0x04,
+	0x5c, 0xa1, 0xa6, 0x65, 0x23, 0xa8, 0x2a, 0x89, 0xbc, 0x09, 0xba, 0x64, 0x77, 0xc8, 0x40, 0xe6,
+	0xd5, 0x92, 0x55, 0x52, 0x35, 0xc7, 0x64, 0x80, 0x5e, 0x87, 0x2a, 0xf7, 0x99, 0xf6, 0x12, 0xa9,
+	0x83, 0x64, 0x6a, 0x2c, 0x59, 0xf3, 0xdc, 0x67, 0x6a, 0xeb, 0x85, 0x0a, 0x42, 0xef, 0x42, 0xd1,
+	0x0b, 0x18, 0x71, 0x7a, 0x34, 0x4e, 0x70, 0xe6, 0x85, 0x60, 0x58, 0x0f, 0x43, 0xff, 0x19, 0xf6,
+	0x7b, 0xc4, 0x4a, 0x6c, 0x45, 0x28, 0xa4, 0x61, 0xa8, 0x3a, 0x2f, 0xa9, 0xc9, 0x8a, 0xb2, 0xe8,
+	0x7a, 0x13, 0xca, 0x62, 0x42, 0x76, 0x44, 0x49, 0xcb, 0x3b, 0x97, 0xb9, 0xa6, 0x64, 0x81, 0xa8,
+	0x3a, 0x91, 0x35, 0x17, 0x82, 0x70, 0xf9, 0xa5, 0x82, 0xf0, 0x8f, 0xa1, 0x9a, 0xcd, 0xa2, 0xc2,
+	0xff, 0x54, 0x14, 0xbf, 0x7b, 0x85, 0xa4, 0x9f, 0x39, 0xf0, 0xbb, 0x3d, 0x7e, 0x6a, 0x55, 0x3a,
+	0xb9, 0x32, 0x6a, 0xc0, 0x3c, 0x8e, 0x22, 0x9b, 0x86, 0x3e, 0x51, 0xd4, 0xf3, 0xfa, 0xd4, 0x5e,
+	0x81, 0x7a, 0x37, 0x8a, 0xac, 0xd0, 0x27, 0x92, 0xb7, 0x8c, 0xd3, 0x82, 0xf9, 0x8b, 0x42, 0xd6,
+	0xcd, 0x64, 0x3f, 0x08, 0xa6, 0x44, 0x1f, 0xda, 0x7f, 0xe4, 0xb7, 0xd8, 0xd1, 0x6e, 0xd8, 0x0b,
+	0xb8, 0x72, 0x07, 0xe5, 0x41, 0x25, 0x59, 0x23, 0x3d, 0xe2, 0x3e, 0x98, 0x4c, 0xc5, 0x06, 0x1b,
+	0x3b, 0x8e, 0x34, 0x94, 0x6e, 0xa7, 0xcc, 0x95, 0x5b, 0xad, 0x6a, 0x8b, 0x5d, 0x65, 0xf0, 0x44,
+	0xb4, 0x0b, 0xb0, 0xf9, 0xcb, 0x02, 0x94, 0x33, 0xe3, 0x13, 0x7e, 0x27, 0xe7, 0xe8, 0xb9, 0x7a,
+	0x08, 0x33, 0xa2, 0x78, 0xe4, 0xa2, 0x75, 0x28, 0x69, 0xd5, 0xe3, 0xb9, 0x7a, 0x0c, 0x45, 0x55,
+	0x71, 0xe4, 0xa2, 0x57, 0xa1, 0x92, 0x34, 0x66, 0xbb, 0x9d, 0x8b, 0x2d, 0xe4, 0x40, 0xf3, 0xf3,
+	0x98, 0x1a, 0x9a, 0x87, 0xf9, 0x1a, 0x14, 0x63, 0x01, 0x90, 0xf3, 0xa2, 0x42, 0xce, 0x8b, 0xcc,
+	0x57, 0x60, 0x46, 0xe5, 0xf2, 0x71, 0x46, 0x2b, 0xb0, 0x7c, 0x99, 0x30, 0x32, 0xff, 0x53, 0x80,
+	0x52, 0xa2, 0x62, 0xd0, 0x0d, 0x91, 0x40, 0x75, 0x41, 0x33, 0xa4, 0x15, 0xe8, 0x16, 0xcc, 0xa9,
+	0x68, 0x60, 0xb7, 0x3c, 0x9f, 0xa8, 0xa3, 0x5b, 0xb4, 0xca, 0xaa, 0xee, 0xa1, 0xa8, 0x42, 0xc7,
+	0x00, 0x2e, 0x71, 0xe8, 0x40, 0x46, 0x45, 0x39, 0xe7, 0xd1, 0x42, 0x2d, 0xe9, 0xb6, 0xb6, 0x9f,
+	0x40, 0xac, 0x0c, 0xdc, 0xb4, 0x01, 0xd2, 0x16, 0xf4, 0x06, 0x54, 0x23, 0xcc, 0x58, 0x74, 0x4a,
+	0x31, 0x23, 0x72, 0x04, 0x7a, 0x84, 0x95, 0xb4, 0x5a, 0x0c, 0x02, 0xdd, 0x86, 0x85, 0x88, 0x7a,
+	0x7d, 0xcc, 0x89, 0x58, 0x08, 0x65, 0x39, 0xa1, 0x2d, 0x55, 0xfd, 0x31, 0x19, 0x08, 0x4b, 0xf3,
+	0xef, 0xc2, 0xdd, 0x72, 0x5a, 0x02, 0xed, 0xc2, 0x4d, 0xc7, 0xef, 0x31, 0x4e, 0xa8, 0xed, 0x05,
+	0x6d, 0x11, 0x92, 0xec, 0x88, 0x86, 0xe7, 0x03, 0x3b, 0x8e, 0x57, 0xaa, 0x4f, 0x53, 0x1b, 0x1d,
+	0x29, 0x9b, 0x13, 0x61, 0xb2, 0xab, 0x43, 0xd8, 0x1e, 0x6c, 0x68, 0x41, 0x62, 0xc7, 0xf7, 0xc0,
+	0x21, 0x0e, 0x35, 0x9a, 0x75, 0x6d, 0x75, 0xa0, 0x8d, 0x46, 0x91, 0x78, 0xc1, 0xa5, 0x24, 0x93,
+	0x39, 0x92, 0xa3, 0xe0, 0x22, 0x89, 0xf9, 0xeb, 0x02, 0x2c, 0x0c, 0x0b, 0x1d, 0xf4, 0x03, 0x28,
+	0xb6, 0x5c, 0xa6, 0xa4, 0x99, 0x98, 0x4c, 0x65, 0xe4, 0x99, 0x1d, 0x86, 0xd6, 0x1e, 0xba, 0x4c,
+	0x48, 0x38, 0x6b, 0xb6, 0xa5, 0x3e, 0xb6, 0xbf, 0x0b, 0xb3, 0xba, 0x0e, 0xcd, 0x43, 0xa9, 0xfe,
+	0x68, 0x77, 0xef, 0xf8, 0xd1, 0x51, 0xe3, 0xc9, 0xc2, 0x35, 0x51, 0x7c, 0x7e, 0x78, 0xf4, 0xe4,
+	0x40, 0x16, 0x0b, 0x68, 0x0e, 0x8a, 0xfb, 0x47, 0x8d, 0xdd, 0xfa, 0xa3, 0x83, 0xfd, 0x85, 0x09,
+	0xf3, 0x6f, 0xd3, 0xb0, 0x74, 0x89, 0xaa, 0x41, 0x37, 0xd2, 0xb4, 0x20, 0x97, 0xb9, 0x3e, 0x61,
+	0x14, 0xd2, 0xd4, 0x70, 0x0b, 0xe6, 0x4e, 0x39, 0x8f, 0x92, 0x05, 0x98, 0x97, 0x0b, 0x50, 0x16,
+	0x75, 0xf1, 0xaa, 0x6d, 0x42, 0xd9, 0x0d, 0x58, 0x62, 0x51, 0x51, 0x01, 0xd5, 0x0d, 0x58, 0x6c,
+	0x70, 0x0c, 0xcb, 0xc2, 0x20, 0x0a, 0x7d, 0xdf, 0x0b, 0xda, 0x6a, 0x69, 0xfb, 0xd8, 0xd7, 0x19,
+	0x72, 0x4c, 0x60, 0x45, 0x6e, 0xc0, 0x4e, 0x14, 0xea, 0x48, 0x83, 0xd0, 0x06, 0x80, 0x48, 0xb4,
+	0x8e, 0x4c, 0xe6, 0x7a, 0x53, 0x33, 0x35, 0xc8, 0x84, 0x62, 0x8f, 0x89, 0x5d, 0xe9, 0x12, 0xbd,
+	0x5b, 0x49, 0x59, 0xb4, 0x09, 0xb7, 0x3d, 0x0b, 0xa9, 0xab, 0x0f, 0x7e, 0x52, 0x4e, 0x73, 0xe6,
+	0x74, 0x36, 0x67, 0xaa, 0x04, 0x28, 0xbd, 0x79, 0x26, 0x4e, 0x80, 0xd2, 0xdf, 0x33, 0x99, 0x71,
+	0x36, 0x97, 0x19, 0xd7, 0xa1, 0x24, 0x52, 0xa2, 0xc2, 0x14, 0x55, 0x27, 0xa2, 0x42, 0xa2, 0xd6,
+	0xa0, 0x98, 0x9c, 0x0e, 0x9d, 0x96, 0x3a, 0xea, 0x58, 0xa0, 0x47, 0xb0, 0x1c, 0x67, 0x2f, 0x9b,
+	0x75, 0xbc, 0xc8, 0xee, 0x13, 0xea, 0xb5, 0x06, 0xfa, 0x2e, 0x34, 0x2e, 0xeb, 0xa1, 0x18, 0xd7,
+	0xe8, 0x78, 0xd1, 0x33, 0x89, 0x42, 0xef, 0x42, 0xe9, 0x0c, 0x7b, 0xdc, 0xe6, 0x5e, 0xf7, 0x0a,
+	0x09, 0xac, 0x28, 0x6c, 0x9f, 0x78, 0x5d, 0x82, 0x42, 0x58, 0x8c, 0xa3, 0x78, 0x2a, 0xea, 0x55,
+	0xfe, 0xaa, 0x5f, 0x5d, 0x29, 0xc7, 0x2a, 0xf1, 0x82, 0xde, 0x5f, 0x60, 0x43, 0x0d, 0xe6, 0x03,
+	0x58, 0x1d, 0x61, 0x2c, 0x5c, 0x4f, 0xec, 0xab, 0xad, 0x36, 0x56, 0x78, 0xe7, 0xa4, 0x70, 0x3d,
+	0x51, 0xb7, 0xa7, 0xaa, 0xcc, 0xbf, 0x4e, 0xc0, 0xe2, 0x05, 0x9d, 0x2d, 0x02, 0x2a, 0x09, 0xdc,
+	0x28, 0xf4, 0x02, 0x1e, 0xa3, 0xd2, 0x8a, 0x9c, 0x83, 0x4c, 0x8c, 0x71, 0x90, 0xc9, 0x21, 0x07,
+	0xc9, 0xb8, 0xc2, 0x54, 0xce, 0x15, 0x72, 0x3b, 0x3e, 0x3d, 0x66, 0xc7, 0x67, 0xae, 0xb6, 0xe3,
+	0xb3, 0xff, 0xd7, 0x8e, 0xd7, 0xa1, 0x4a, 0xc9, 0xcf, 0x7b, 0x84, 0xa9, 0x4d, 0x0f, 0x7b, 0x5c,
+	0x0b, 0xa6, 0x31, 0xfb, 0x5e, 0xd1, 0x88, 0x27, 0x0a, 0x60, 0x7e, 0x39, 0x05, 0xab, 0x23, 0xae,
+	0x1c, 0xe8, 0x73, 0x28, 0x0b, 0x35, 0x64, 0x4b, 0x71, 0xae, 0x42, 0x45, 0xf9, 0xee, 0xf7, 0x5f,
+	0xee, 0xde, 0x52, 0x13, 0x0a, 0xe9, 0x91, 0x24, 0xb0, 0x80, 0x26, 0xdf, 0xa8, 0x05, 0x55, 0x9f,
+	0x60, 0x97, 0x50, 0x9b, 0xf8, 0xc4, 0x91, 0x59, 0x6c, 0x42, 0xf2, 0x7f, 0xf0, 0x92, 0xfc, 0x8f,
+	0x24, 0xcb, 0x81, 0x26, 0xb1, 0x2a, 0x7e, 0xae, 0x6c, 0xde, 0x03, 0x48, 0x47, 0x80, 0x16, 0x60,
+	0xf2, 0xd3, 0x93, 0x86, 0x9c, 0xc9, 0x84, 0x25, 0x3e, 0x45, 0x0c, 0x68, 0xf6, 0x28, 0xe3, 0xb2,
+	0xf7, 0x79, 0x4b, 0x15, 0xcc, 0x7f, 0x16, 0xa0, 0x92, 0x27, 0x16, 0x52, 0x9a, 0x04, 0xb8, 0xe9,
+	0x13, 0xa5, 0x4f, 0x8a, 0x56, 0x5c, 0x44, 0x1f, 0x81, 0xe8, 0x94, 0x11, 0x3b, 0x7e, 0x51, 0xd4,
+	0x33, 0x19, 0xb3, 0x0b, 0xf3, 0x12, 0x10, 0x17, 0x05, 0x03, 0x25, 0x01, 0x39, 0xb3, 0x5d, 0x82,
+	0x5d, 0xdf, 0x0b, 0x88, 0xce, 0xe8, 0xe3, 0x18, 0x24, 0x60, 0x5f, 0xdb, 0x2b, 0x01, 0xcb, 0xe9,
+	0xc0, 0x8e, 0x08, 0xf5, 0x42, 0x57, 0x3f, 0x14, 0x8e, 0x17, 0xb0, 0x9c, 0x0e, 0x4e, 0xa4, 0xf5,
+	0xfb, 0xe8, 0xcb, 0x7f, 0x4d, 0x55, 0x60, 0x82, 0x71, 0x54, 0x8c, 0x1f, 0xce, 0xeb, 0x55, 0x98,
+	0xcf, 0xbd, 0x0c, 0x8a, 0x8a, 0xdc, 0xeb, 0x53, 0x7d, 0x11, 0xaa, 0x43, 0x2f, 0x21, 0xdb, 0xbf,
+	0x5f, 0x84, 0x72, 0xe6, 0xe2, 0x8e, 0xb6, 0x61, 0xfe, 0xdc, 0x65, 0x76, 0xd3, 0x0b, 0x5c, 0x99,
+	0x2c, 0x74, 0x56, 0x2f, 0x9f, 0xbb, 0xac, 0xee, 0x05, 0xae, 0xc8, 0x16, 0xe8, 0x6d, 0x58, 0xee,
+	0x63, 0xdf, 0x73, 0xe5, 0xb8, 0x32, 0xa6, 0xea, 0xa0, 0xa2, 0xb4, 0x2d, 0x41, 0x3c, 0x86, 0x85,
+	0xa1, 0x67, 0x62, 0xa6, 0x17, 0x6c, 0x3b, 0xef, 0x3c, 0x7b, 0xca, 0xaa, 0xae, 0x8c, 0x94, 0xdf,
+	0x58, 0x55, 0x27, 0x57, 0xcb, 0xd0, 0x53, 0x58, 0x4b, 0x42, 0x85, 0x7d, 0x86, 0x69, 0x57, 0x64,
+	0xac, 0xf8, 0x40, 0xbd, 0x70, 0x21, 0x57, 0x13, 0xec, 0x73, 0x05, 0xd5, 0x27, 0x0b, 0x1d, 0x40,
+	0x19, 0x9f, 0x31, 0x5b, 0x5f, 0x5c, 0xf5, 0xc3, 0xea, 0xab, 0x23, 0x1f, 0x39, 0x6a, 0xbb, 0xcf,
+	0x1b, 0x71, 0xcc, 0x04, 0x7c, 0xc6, 0xe2, 0x25, 0xc4, 0x70, 0xdd, 0x0b, 0xe4, 0x22, 0xc4, 0x2f,
+	0xb5, 0x51, 0xe8, 0x7b, 0xce, 0x40, 0x3f, 0x5c, 0xbe, 0x35, 0x9a, 0xf0, 0x48, 0xc1, 0xd4, 0xb4,
+	0x4f, 0x24, 0xc8, 0x5a, 0xf2, 0x2e, 0x56, 0xa2, 0x87, 0xb0, 0xe9, 0x7a, 0x4c, 0x38, 0xb3, 0x9d,
+	0xb9, 0xc7, 0xb8, 0x84, 0x71, 0x2f, 0xc0, 0x6a, 0xf4, 0xb3, 0xd2, 0xe5, 0x6f, 0x6a, 0xb3, 0xf4,
+	0x2c, 0xee, 0x67, 0x8c, 0xd0, 0x3e, 0x2c, 0xc4, 0x3c, 0x6d, 0x1a, 0x39, 0xf6, 0x19, 0x69, 0x5e,
+	0xe1, 0x06, 0x57, 0xd1, 0x98, 0x8f, 0x69, 0xe4, 0x3c, 0x27, 0x4d, 0xe4, 0xc0, 0x56, 0xcc, 0xa2,
+	0x84, 0x58, 0x1b, 0xd3, 0x26, 0x6e, 0x13, 0xdb, 0x09, 0xfd, 0x38, 0x54, 0x94, 0x5e, 0xc8, 0x1a,
+	0x0f, 0x55, 0xea, 0xb4, 0x8f, 0x15, 0xc3, 0x5e, 0x42, 0x80, 0x3e, 0x85, 0x15, 0x4a, 0xda, 0xe4,
+	0xdc, 0xee, 0xe2, 0x73, 0xd1, 0x4d, 0x9b, 0xe2, 0xae, 0xcd, 0xbc, 0x2f, 0xe2, 0x87, 0xc8, 0x1b,
+	0x17, 0xa8, 0x9f, 0x1e, 0x05, 0xfc, 0x9d, 0xbb, 0x8a, 0x7c, 0x49, 0x62, 0x1f, 0xe3, 0xf3, 0x13,
+	0x85, 0x6c, 0x78, 0x5f, 0x10, 0xf4, 0x26, 0x20, 0x2a, 0x42, 0x71, 0xde, 0xe1, 0xcb, 0xd2, 0x8b,
+	0xab, 0xa2, 0xe5, 0xb3, 0x8c, 0xd3, 0x3f, 0x85, 0x8a, 0xb0, 0x4b, 0x9d, 0x5b, 0x67, 0xdc, 0xda,
+	0xe8, 0xed, 0xfc, 0xcc, 0x65, 0xcf, 0x12, 0xf3, 0xd8, 0x53, 0xc4, 0xf1, 0x4a, 0x6b, 0xd1, 0x1e,
+	0x14, 0x05, 0x6d, 0xe6, 0x9e, 0x78, 0x7b, 0x2c, 0xa1, 0xb8, 0x79, 0x25, 0xef, 0x65, 0xe7, 0xaa,
+	0x8c, 0xbe, 0x0d, 0x48, 0x85, 0x36, 0x9b, 0xb9, 0xf1, 0x6b, 0xb9, 0xd2, 0x78, 0x45, 0x6b, 0x41,
+	0xb5, 0x34, 0xdc, 0xf8, 0x25, 0x02, 0x35, 0x60, 0x21, 0x55, 0xdf, 0x7e, 0xaf, 0xed, 0x05, 0xcc,
+	0xa8, 0x6e, 0x4d, 0x8e, 0xef, 0x3a, 0x91, 0xe2, 0x12, 0x60, 0x55, 0x49, 0xae, 0xcc, 0xcc, 0xff,
+	0x16, 0x00, 0xd2, 0xf3, 0x80, 0x3e, 0x82, 0x75, 0x3d, 0x22, 0x87, 0x12, 0x97, 0x04, 0xdc, 0xc3,
+	0x3e, 0x8b, 0xd5, 0x8a, 0xba, 0x40, 0x15, 0x0f, 0xaf, 0x59, 0x6b, 0xca, 0x68, 0x2f, 0xb5, 0xd1,
+	0x02, 0x63, 0x80, 0x7e, 0x55, 0x80, 0xf5, 0xe1, 0xbb, 0x6a, 0x86, 0x4b, 0x47, 0xec, 0x4f, 0x6b,
+	0xf2, 0x77, 0xa4, 0x9a, 0x3a, 0x68, 0x35, 0xfd, 0xfb, 0x91, 0x10, 0xbe, 0x35, 0x71, 0x94, 0x7d,
+	0xdc, 0x6d, 0xba, 0xb8, 0xd6, 0xbf, 0x2b, 0xce, 0xea, 0x23, 0x59, 0x50, 0xe7, 0x28, 0x16, 0x3f,
+	0xfa, 0x92, 0x9b, 0x19, 0x80, 0x18, 0x15, 0x1b, 0xd5, 0x58, 0xbf, 0x0e, 0x4b, 0xd9, 0x09, 0xb5,
+	0x08, 0x77, 0x4e, 0x09, 0x35, 0xff, 0x54, 0x80, 0xa5, 0x4b, 0x0e, 0x2f, 0xba, 0x27, 0x9c, 0x36,
+	0xf2, 0xb1, 0x23, 0xee, 0x2a, 0x2a, 0x24, 0xd0, 0xb0, 0xc7, 0x09, 0xd3, 0x19, 0x69, 0x59, 0xb7,
+	0x6a, 0xac, 0x25, 0xdb, 0xd0, 0x07, 0xb0, 0x9e, 0xb3, 0xb6, 0x29, 0x61, 0x51, 0x18, 0x30, 0x71,
+	0xa0, 0x5c, 0xa2, 0xf3, 0x9e, 0xe1, 0x65, 0x30, 0x96, 0x36, 0xd8, 0x13, 0xf7, 0x8d, 0xd1, 0xf0,
+	0x66, 0xe8, 0x0e, 0xb4, 0x64, 0xba, 0x14, 0x5e, 0x0f, 0xdd, 0x81, 0xf9, 0x97, 0x02, 0x2c, 0x5f,
+	0xe6, 0xb9, 0xe8, 0x2e, 0x5c, 0xd7, 0x7b, 0x8a, 0x23, 0x2f, 0x7b, 0x10, 0xd4, 0x5c, 0x96, 0x54,
+	0xe3, 0x6e, 0xe4, 0x65, 0xdc, 0xfb, 0x0e, 0x2c, 0xca, 0x0d, 0x12, 0xe7, 0x0b, 0x8b, 0x64, 0x97,
+	0x3e, 0x4b, 0x54, 0x65, 0x43, 0x5d, 0xd6, 0x4b, 0x51, 0xde, 0x00, 0x43, 0xd9, 0x66, 0x92, 0x4b,
+	0x1c, 0xd4, 0x5f, 0x98, 0x5d, 0x57, 0x24, 0x34, 0xed, 0x39, 0x56, 0x4b, 0x5f, 0x4f, 0x40, 0x25,
+	0x7f, 0x6c, 0xc4, 0x2d, 0x58, 0x3f, 0x69, 0xa5, 0x8a, 0x50, 0xdf, 0x97, 0x55, 0xfd, 0x5e, 0xac,
+	0x0b, 0x5f, 0x87, 0xaa, 0xb6, 0x1c, 0xba, 0x2e, 0xcf, 0xab, 0x6a, 0x7d, 0x5b, 0x46, 0xaf, 0x42,
+	0x25, 0x7e, 0x68, 0xd3, 0xe2, 0x53, 0xbf, 0x69, 0xe8, 0xb7, 0x36, 0x25, 0x41, 0xef, 0xc1, 0xca,
+	0xa5, 0x8f, 0x2f, 0x4c, 0xa6, 0xac, 0xa2, 0xb5, 0x7c, 0xc9, 0xc3, 0x0b, 0x43, 0x3f, 0x82, 0x79,
+	0xf9, 0xca, 0x22, 0x02, 0x94, 0x48, 0xf3, 0xc6, 0xb4, 0x3c, 0xaa, 0xf7, 0xae, 0x1a, 0x25, 0x6a,
+	0x56, 0xe8, 0x93, 0xba, 0x02, 0x5b, 0x73, 0x34, 0x2d, 0x30, 0x73, 0x0f, 0xca, 0x99, 0x46, 0x71,
+	0x69, 0xf3, 0xa4, 0x67, 0x73, 0x8f, 0xc4, 0x92, 0x3c, 0x53, 0x23, 0x84, 0x97, 0x80, 0xc7, 0x3f,
+	0x83, 0xaa, 0x82, 0xc9, 0xa0, 0x92, 0x8f, 0x0d, 0x08, 0xc1, 0x94, 0xd4, 0xed, 0xfa, 0x5d, 0x4a,
+	0x6a, 0xf6, 0xd1, 0xcf, 0x9a, 0xef, 0xc0, 0xec, 0x95, 0x37, 0x39, 0xb6, 0xdc, 0xfe, 0xc3, 0x34,
+	0x54, 0xf2, 0x3f, 0x1e, 0x88, 0xd5, 0xcd, 0xf8, 0x8d, 0xde, 0xb6, 0x8c, 0x82, 0xc9, 0x48, 0x16,
+	0xf5, 0x74, 0x29, 0xa3, 0xfa, 0x27, 0x00, 0x19, 0x47, 0x9e, 0xbc, 0x34, 0xa2, 0xe7, 0xfa, 0xa9,
+	0x5d, 0x8c, 0xe8, 0x19, 0x06, 0x74, 0x08, 0xb7, 0x28, 0xc1, 0xae, 0xad, 0x7f, 0xc9, 0x60, 0x76,
+	0x8b, 0x86, 0x5d, 0x1b, 0xfb, 0x7e, 0xf6, 0x67, 0x64, 0xb5, 0xdd, 0x37, 0x85, 0xa1, 0x26, 0x67,
+	0x0f, 0x69, 0xd8, 0xdd, 0xf5, 0xfd, 0xcc, 0x8f, 0xca, 0x0f, 0x61, 0x03, 0xfb, 0x92, 0x82, 0x85,
+	0x94, 0xeb, 0x93, 0xcc, 0xe5, 0xf1, 0xd3, 0x21, 0x44, 0xe8, 0x93, 0xa2, 0x7c, 0x08, 0x30, 0x95,
+	0x65, 0x23, 0xa4, 0x5c, 0x9e, 0xe7, 0x27, 0xc2, 0x4c, 0x05, 0x13, 0xf3, 0x37, 0x93, 0xb0, 0x78,
+	0xf1, 0x2c, 0x7f, 0x08, 0x37, 0x54, 0xaa, 0x1e, 0xb1, 0x66, 0x6a, 0x93, 0xd6, 0xa4, 0xcd, 0xb3,
+	0xcb, 0x16, 0xee, 0x03, 0x58, 0xcf, 0x40, 0xcf, 0x48, 0xf3, 0x34, 0x0c, 0x3b, 0x36, 0xf7, 0x59,
+	0xf6, 0x85, 0xda, 0x48, 0x4d, 0x9e, 0x2b, 0x8b, 0x27, 0x3e, 0x93, 0x2f, 0xcf, 0xf7, 0xc1, 0x1c,
+	0x01, 0xef, 0x90, 0x81, 0xbe, 0xba, 0xad, 0x5e, 0x86, 0x3e, 0x26, 0x03, 0xb4, 0x07, 0x1b, 0xea,
+	0x11, 0xde, 0x16, 0x1b, 0x95, 0x9d, 0x42, 0x0b, 0x7b, 0x7e, 0x8f, 0xaa, 0x0b, 0x5e, 0xd1, 0x5a,
+	0x57, 0x56, 0xe2, 0x6c, 0xa4, 0x73, 0x78, 0xa8, 0x4c, 0xd0, 0x87, 0x30, 0xaf, 0xd7, 0x17, 0x3b,
+	0x0e, 0x89, 0xb8, 0x56, 0x67, 0xe3, 0x14, 0xca, 0x9c, 0x02, 0xec, 0x4a, 0x7b, 0xb4, 0x0b, 0x15,
+	0xec, 0xfb, 0xe1, 0x99, 0x10, 0xa0, 0x81, 0x3c, 0x99, 0x2f, 0xbe, 0x13, 0xce, 0x4b, 0xc4, 0x73,
+	0x0d, 0xa8, 0xbf, 0xff, 0xd5, 0x37, 0x53, 0x85, 0xdf, 0xfd, 0x63, 0xa3, 0xf0, 0xf9, 0xdb, 0x57,
+	0xfb, 0x5f, 0x99, 0xa8, 0xd3, 0xd6, 0xff, 0x76, 0xd1, 0x9c, 0x91, 0xf4, 0xef, 0xfc, 0x2f, 0x00,
+	0x00, 0xff, 0xff, 0x40, 0xc5, 0x0a, 0xb9, 0x66, 0x23, 0x00, 0x00,
 }
 
 func (this *Settings) Equal(that interface{}) bool {
@@ -1887,6 +2755,9 @@ func (this *Settings) Equal(that interface{}) bool {
 	if !this.Consul.Equal(that1.Consul) {
 		return false
 	}
+	if !this.Etcd.Equal(that1.Etcd) {
+		return false
+	}
 	if !this.Kubernetes.Equal(that1.Kubernetes) {
 		return false
 	}
@@ -1988,6 +2859,30 @@ func (this *Settings_ConsulKvSource) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *Settings_EtcdKvSource) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Settings_EtcdKvSource)
+	if !ok {
+		that2, ok := that.(Settings_EtcdKvSource)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.EtcdKvSource.Equal(that1.EtcdKvSource) {
+		return false
+	}
+	return true
+}
 func (this *Settings_KubernetesSecretSource) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -2132,6 +3027,30 @@ func (this *Settings_ConsulKvArtifactSource) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *Settings_EtcdKvArtifactSource) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Settings_EtcdKvArtifactSource)
+	if !ok {
+		that2, ok := that.(Settings_EtcdKvArtifactSource)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.EtcdKvArtifactSource.Equal(that1.EtcdKvArtifactSource) {
+		return false
+	}
+	return true
+}
 func (this *Settings_KubernetesCrds) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -2226,19 +3145,31 @@ func (this *Settings_VaultSecrets) Equal(that interface{}) bool {
 	if this.RootKey != that1.RootKey {
 		return false
 	}
+	if this.PathPrefix != that1.PathPrefix {
+		return false
+	}
+	if !this.RefreshRate.Equal(that1.RefreshRate) {
+		return false
+	}
+	if !this.KubernetesAuth.Equal(that1.KubernetesAuth) {
+		return false
+	}
+	if !this.AppRoleAuth.Equal(that1.AppRoleAuth) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *Settings_ConsulKv) Equal(that interface{}) bool {
+func (this *Settings_VaultSecrets_KubernetesAuth) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Settings_ConsulKv)
+	that1, ok := that.(*Settings_VaultSecrets_KubernetesAuth)
 	if !ok {
-		that2, ok := that.(Settings_ConsulKv)
+		that2, ok := that.(Settings_VaultSecrets_KubernetesAuth)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2250,7 +3181,13 @@ func (this *Settings_ConsulKv) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.RootKey != that1.RootKey {
+	if this.Role != that1.Role {
+		return false
+	}
+	if this.MountPath != that1.MountPath {
+		return false
+	}
+	if this.ServiceAccountTokenPath != that1.ServiceAccountTokenPath {
 		return false
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
@@ -2258,14 +3195,14 @@ func (this *Settings_ConsulKv) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *Settings_KubernetesConfigmaps) Equal(that interface{}) bool {
+func (this *Settings_VaultSecrets_AppRoleAuth) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Settings_KubernetesConfigmaps)
+	that1, ok := that.(*Settings_VaultSecrets_AppRoleAuth)
 	if !ok {
-		that2, ok := that.(Settings_KubernetesConfigmaps)
+		that2, ok := that.(Settings_VaultSecrets_AppRoleAuth)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2277,14 +3214,104 @@ func (this *Settings_KubernetesConfigmaps) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+	if this.RoleId != that1.RoleId {
 		return false
 	}
-	return true
-}
-func (this *Settings_Directory) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+	if this.SecretId != that1.SecretId {
+		return false
+	}
+	if this.SecretIdPath != that1.SecretIdPath {
+		return false
+	}
+	if this.MountPath != that1.MountPath {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *Settings_ConsulKv) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Settings_ConsulKv)
+	if !ok {
+		that2, ok := that.(Settings_ConsulKv)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RootKey != that1.RootKey {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *Settings_EtcdKv) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Settings_EtcdKv)
+	if !ok {
+		that2, ok := that.(Settings_EtcdKv)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RootKey != that1.RootKey {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *Settings_KubernetesConfigmaps) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Settings_KubernetesConfigmaps)
+	if !ok {
+		that2, ok := that.(Settings_KubernetesConfigmaps)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *Settings_Directory) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
 
 	that1, ok := that.(*Settings_Directory)
@@ -2304,6 +3331,42 @@ func (this *Settings_Directory) Equal(that interface{}) bool {
 	if this.Directory != that1.Directory {
 		return false
 	}
+	if this.StatusFiles != that1.StatusFiles {
+		return false
+	}
+	if !this.Decryption.Equal(that1.Decryption) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *Settings_Directory_Decryption) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Settings_Directory_Decryption)
+	if !ok {
+		that2, ok := that.(Settings_Directory_Decryption)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PassphraseFile != that1.PassphraseFile {
+		return false
+	}
+	if this.PrivateKeyFile != that1.PrivateKeyFile {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -2470,6 +3533,59 @@ func (this *Settings_ConsulConfiguration_ServiceDiscoveryOptions) Equal(that int
 	}
 	return true
 }
+func (this *Settings_EtcdConfiguration) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Settings_EtcdConfiguration)
+	if !ok {
+		that2, ok := that.(Settings_EtcdConfiguration)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Endpoints) != len(that1.Endpoints) {
+		return false
+	}
+	for i := range this.Endpoints {
+		if this.Endpoints[i] != that1.Endpoints[i] {
+			return false
+		}
+	}
+	if this.Username != that1.Username {
+		return false
+	}
+	if this.Password != that1.Password {
+		return false
+	}
+	if this.CaFile != that1.CaFile {
+		return false
+	}
+	if this.CertFile != that1.CertFile {
+		return false
+	}
+	if this.KeyFile != that1.KeyFile {
+		return false
+	}
+	if !this.InsecureSkipVerify.Equal(that1.InsecureSkipVerify) {
+		return false
+	}
+	if !this.RequestTimeout.Equal(that1.RequestTimeout) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
 func (this *Settings_KubernetesConfiguration) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -2492,6 +3608,9 @@ func (this *Settings_KubernetesConfiguration) Equal(that interface{}) bool {
 	if !this.RateLimits.Equal(that1.RateLimits) {
 		return false
 	}
+	if !this.LeaderElection.Equal(that1.LeaderElection) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -2527,6 +3646,42 @@ func (this *Settings_KubernetesConfiguration_RateLimits) Equal(that interface{})
 	}
 	return true
 }
+func (this *Settings_KubernetesConfiguration_LeaderElection) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Settings_KubernetesConfiguration_LeaderElection)
+	if !ok {
+		that2, ok := that.(Settings_KubernetesConfiguration_LeaderElection)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if !this.LeaseDuration.Equal(that1.LeaseDuration) {
+		return false
+	}
+	if !this.RenewDeadline.Equal(that1.RenewDeadline) {
+		return false
+	}
+	if !this.RetryPeriod.Equal(that1.RetryPeriod) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
 func (this *GlooOptions) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -2579,6 +3734,23 @@ func (this *GlooOptions) Equal(that interface{}) bool {
 	if this.RestXdsBindAddr != that1.RestXdsBindAddr {
 		return false
 	}
+	if !this.XdsValidation.Equal(that1.XdsValidation) {
+		return false
+	}
+	if !this.XdsAuth.Equal(that1.XdsAuth) {
+		return false
+	}
+	if this.EnableSdsSecrets != that1.EnableSdsSecrets {
+		return false
+	}
+	if len(this.ExternalPlugins) != len(that1.ExternalPlugins) {
+		return false
+	}
+	for i := range this.ExternalPlugins {
+		if !this.ExternalPlugins[i].Equal(that1.ExternalPlugins[i]) {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -2698,6 +3870,156 @@ func (this *GlooOptions_InvalidConfigPolicy) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *GlooOptions_XdsValidationOptions) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GlooOptions_XdsValidationOptions)
+	if !ok {
+		that2, ok := that.(GlooOptions_XdsValidationOptions)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.EnableApiValidation != that1.EnableApiValidation {
+		return false
+	}
+	if this.EnvoyBinaryPath != that1.EnvoyBinaryPath {
+		return false
+	}
+	if !this.EnvoyValidationTimeout.Equal(that1.EnvoyValidationTimeout) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *GlooOptions_XdsAuthOptions) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GlooOptions_XdsAuthOptions)
+	if !ok {
+		that2, ok := that.(GlooOptions_XdsAuthOptions)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ServerCertFile != that1.ServerCertFile {
+		return false
+	}
+	if this.ServerKeyFile != that1.ServerKeyFile {
+		return false
+	}
+	if this.ClientCaFile != that1.ClientCaFile {
+		return false
+	}
+	if this.ServiceAccountTokens != that1.ServiceAccountTokens {
+		return false
+	}
+	if len(this.RoleBindings) != len(that1.RoleBindings) {
+		return false
+	}
+	for i := range this.RoleBindings {
+		if !this.RoleBindings[i].Equal(that1.RoleBindings[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *GlooOptions_XdsAuthOptions_RoleBinding) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GlooOptions_XdsAuthOptions_RoleBinding)
+	if !ok {
+		that2, ok := that.(GlooOptions_XdsAuthOptions_RoleBinding)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Identities) != len(that1.Identities) {
+		return false
+	}
+	for i := range this.Identities {
+		if this.Identities[i] != that1.Identities[i] {
+			return false
+		}
+	}
+	if len(this.Roles) != len(that1.Roles) {
+		return false
+	}
+	for i := range this.Roles {
+		if this.Roles[i] != that1.Roles[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *GlooOptions_ExternalPlugin) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GlooOptions_ExternalPlugin)
+	if !ok {
+		that2, ok := that.(GlooOptions_ExternalPlugin)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if !this.Timeout.Equal(that1.Timeout) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
 func (this *GatewayOptions) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -15,6 +15,7 @@ import (
 	core1 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/api/v2/core"
 	aws "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws"
 	ec2 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws/ec2"
+	ecs "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws/ecs"
 	azure "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/azure"
 	consul "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/consul"
 	kubernetes "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
@@ -72,6 +73,7 @@ type Upstream struct {
 	//	*Upstream_Azure
 	//	*Upstream_Consul
 	//	*Upstream_AwsEc2
+	//	*Upstream_AwsEcs
 	UpstreamType isUpstream_UpstreamType `protobuf_oneof:"upstream_type"`
 	// Failover endpoints for this upstream. If omitted (the default) no failovers will be applied.
 	Failover *Failover `protobuf:"bytes,18,opt,name=failover,proto3" json:"failover,omitempty"`
@@ -144,6 +146,9 @@ type Upstream_Consul struct {
 type Upstream_AwsEc2 struct {
 	AwsEc2 *ec2.UpstreamSpec `protobuf:"bytes,17,opt,name=aws_ec2,json=awsEc2,proto3,oneof" json:"aws_ec2,omitempty"`
 }
+type Upstream_AwsEcs struct {
+	AwsEcs *ecs.UpstreamSpec `protobuf:"bytes,21,opt,name=aws_ecs,json=awsEcs,proto3,oneof" json:"aws_ecs,omitempty"`
+}
 
 func (*Upstream_Kube) isUpstream_UpstreamType()   {}
 func (*Upstream_Static) isUpstream_UpstreamType() {}
@@ -152,6 +157,7 @@ func (*Upstream_Aws) isUpstream_UpstreamType()    {}
 func (*Upstream_Azure) isUpstream_UpstreamType()  {}
 func (*Upstream_Consul) isUpstream_UpstreamType() {}
 func (*Upstream_AwsEc2) isUpstream_UpstreamType() {}
+func (*Upstream_AwsEcs) isUpstream_UpstreamType() {}
 
 func (m *Upstream) GetUpstreamType() isUpstream_UpstreamType {
 	if m != nil {
@@ -279,6 +285,13 @@ func (m *Upstream) GetAwsEc2() *ec2.UpstreamSpec {
 	return nil
 }
 
+func (m *Upstream) GetAwsEcs() *ecs.UpstreamSpec {
+	if x, ok := m.GetUpstreamType().(*Upstream_AwsEcs); ok {
+		return x.AwsEcs
+	}
+	return nil
+}
+
 func (m *Upstream) GetFailover() *Failover {
 	if m != nil {
 		return m.Failover
@@ -310,6 +323,7 @@ func (*Upstream) XXX_OneofWrappers() []interface{} {
 		(*Upstream_Azure)(nil),
 		(*Upstream_Consul)(nil),
 		(*Upstream_AwsEc2)(nil),
+		(*Upstream_AwsEcs)(nil),
 	}
 }
 
@@ -364,71 +378,71 @@ func init() {
 }
 
 var fileDescriptor_b74df493149f644d = []byte{
-	// 1010 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0xdd, 0x6e, 0xdb, 0x36,
-	0x1c, 0xc5, 0xeb, 0xc6, 0x49, 0x63, 0x26, 0x59, 0x62, 0x2e, 0x58, 0x85, 0xac, 0x73, 0x02, 0x0f,
-	0x58, 0xb3, 0x0e, 0xa1, 0x56, 0x07, 0x43, 0x3b, 0x0f, 0x1d, 0x06, 0x3b, 0x19, 0x32, 0x34, 0xdd,
-	0x00, 0x19, 0xdd, 0xb0, 0xdd, 0x08, 0x34, 0x4d, 0xdb, 0x9c, 0x19, 0x51, 0x10, 0x29, 0x3b, 0xce,
-	0x65, 0x5f, 0x61, 0x2f, 0xb1, 0x47, 0xd8, 0x23, 0xec, 0x7e, 0xf7, 0xbd, 0xd8, 0x1b, 0x74, 0xc0,
-	0xee, 0x07, 0x7e, 0xc8, 0xf1, 0x47, 0x5d, 0x6b, 0x17, 0xb6, 0x44, 0xf2, 0x9c, 0x9f, 0x28, 0xea,
-	0xcf, 0x23, 0x81, 0xaf, 0x7a, 0x4c, 0xf5, 0xd3, 0x36, 0x22, 0xe2, 0xca, 0x97, 0x82, 0x8b, 0x13,
-	0x26, 0xfc, 0x1e, 0x17, 0xc2, 0x8f, 0x13, 0xf1, 0x2b, 0x25, 0x4a, 0xda, 0x16, 0x8e, 0x99, 0x3f,
-	0x7c, 0xec, 0xa7, 0xb1, 0x54, 0x09, 0xc5, 0x57, 0x28, 0x4e, 0x84, 0x12, 0x70, 0x5b, 0x8f, 0x21,
-	0x6d, 0x43, 0x4c, 0x1c, 0xec, 0xf7, 0x44, 0x4f, 0x98, 0x01, 0x5f, 0x9f, 0x59, 0xcd, 0x01, 0xa4,
-	0xd7, 0xca, 0x76, 0xd2, 0x6b, 0xe5, 0xfa, 0x2a, 0xe6, 0x4a, 0x03, 0xa6, 0x32, 0xee, 0x15, 0x55,
-	0xb8, 0x83, 0x15, 0x76, 0xe3, 0x1f, 0x2f, 0x9f, 0x81, 0x94, 0xdc, 0x89, 0xde, 0x31, 0x4d, 0xc2,
-	0x12, 0x92, 0x32, 0x15, 0xb6, 0x13, 0x8a, 0x07, 0x34, 0x71, 0x86, 0x93, 0xe5, 0x06, 0x2e, 0x70,
-	0x27, 0x6c, 0x63, 0x8e, 0x23, 0x32, 0x91, 0x3f, 0x7a, 0x07, 0x5f, 0x44, 0x11, 0x25, 0x8a, 0x89,
-	0xc8, 0x69, 0xcf, 0x96, 0x68, 0xe9, 0xb5, 0xa2, 0x49, 0x84, 0xb9, 0x4f, 0xa3, 0xa1, 0x18, 0x5b,
-	0x7b, 0xcd, 0x27, 0x22, 0xa1, 0x7e, 0x9f, 0x62, 0xae, 0xfa, 0x21, 0xe9, 0x53, 0x32, 0x70, 0x94,
-	0x07, 0xf3, 0xcb, 0x22, 0x15, 0x56, 0xa9, 0x74, 0xa3, 0x97, 0xff, 0xef, 0x1a, 0x3c, 0x95, 0x8a,
-	0x26, 0xbe, 0x48, 0x15, 0x67, 0x34, 0x09, 0x3b, 0x54, 0xcd, 0xcc, 0x78, 0xe1, 0x11, 0x64, 0x6d,
-	0x37, 0xfe, 0xc5, 0xf2, 0xbb, 0x17, 0xb1, 0xe6, 0x48, 0x33, 0x3b, 0x46, 0xdc, 0xc1, 0xd9, 0x1e,
-	0xaf, 0xb6, 0xc5, 0x2c, 0xa6, 0xe6, 0xcf, 0x59, 0x9e, 0xad, 0xb6, 0x0c, 0xd2, 0x36, 0x4d, 0x22,
-	0xaa, 0xe8, 0xf4, 0xe9, 0xea, 0x32, 0xc8, 0xec, 0x78, 0x64, 0x7e, 0xce, 0x70, 0x9a, 0xc3, 0x70,
-	0x93, 0x26, 0xd4, 0xfe, 0xe7, 0x5f, 0x0e, 0x22, 0x22, 0x99, 0x72, 0x77, 0x70, 0xb6, 0x27, 0xf9,
-	0x26, 0x47, 0x49, 0x4d, 0x1f, 0x43, 0x4a, 0x6a, 0xce, 0xf8, 0x70, 0xa5, 0xd1, 0x09, 0x8f, 0x97,
-	0x0b, 0xbb, 0x98, 0x71, 0x31, 0x9c, 0xd4, 0x73, 0xa5, 0x27, 0x44, 0x8f, 0x53, 0xdf, 0xb4, 0xda,
-	0x69, 0xd7, 0x1f, 0x25, 0x38, 0x8e, 0x69, 0xe2, 0x48, 0xd5, 0xbf, 0xb6, 0xc0, 0xe6, 0x4b, 0xb7,
-	0xbf, 0xe1, 0x73, 0xb0, 0x61, 0x8b, 0xcf, 0x2b, 0x1c, 0x15, 0x8e, 0xb7, 0x6a, 0xfb, 0x48, 0x17,
-	0x6d, 0xb6, 0xd5, 0x51, 0xcb, 0x8c, 0x35, 0x3e, 0xfa, 0xe3, 0xdf, 0x62, 0xe1, 0xcf, 0xd7, 0x87,
-	0x77, 0xfe, 0x79, 0x7d, 0x58, 0x56, 0x54, 0xaa, 0x0e, 0xeb, 0x76, 0xeb, 0x55, 0xd6, 0x8b, 0x44,
-	0x42, 0xab, 0x81, 0x43, 0xc0, 0xa7, 0x60, 0x33, 0xdb, 0xe0, 0xde, 0x5d, 0x83, 0xfb, 0x60, 0x16,
-	0xf7, 0xc2, 0x8d, 0x36, 0x8a, 0x1a, 0x16, 0x4c, 0xd4, 0xf0, 0x7b, 0x00, 0x3b, 0x4c, 0x12, 0x7d,
-	0x17, 0xe3, 0x70, 0xc2, 0x58, 0x33, 0x8c, 0x43, 0x34, 0x9d, 0x3e, 0xe8, 0x2c, 0xd3, 0x65, 0xb0,
-	0xa0, 0xdc, 0x99, 0xef, 0x82, 0x5f, 0x03, 0x20, 0x25, 0x0f, 0x89, 0x88, 0xba, 0xac, 0xe7, 0x15,
-	0xdf, 0xc6, 0xc9, 0x96, 0xa0, 0x25, 0x79, 0xd3, 0xc8, 0x82, 0x92, 0xcc, 0x4e, 0xe1, 0x0b, 0xb0,
-	0x37, 0x97, 0x2d, 0xd2, 0x5b, 0x37, 0x94, 0xea, 0x2c, 0xa5, 0x69, 0x55, 0x0d, 0x2b, 0x72, 0xa0,
-	0x5d, 0x32, 0xd3, 0x2b, 0x61, 0x00, 0xf6, 0x67, 0x92, 0x27, 0x9b, 0xd8, 0x86, 0x41, 0x1e, 0xcd,
-	0x22, 0x2f, 0x05, 0xee, 0x34, 0x9c, 0xd0, 0x01, 0x21, 0x5f, 0xe8, 0x83, 0xcf, 0x41, 0xf9, 0x36,
-	0x9e, 0x32, 0xe0, 0x3d, 0x03, 0xac, 0xcc, 0xcd, 0x71, 0x22, 0x73, 0xb8, 0x3d, 0x32, 0xd7, 0x03,
-	0x9b, 0x60, 0x67, 0x3a, 0xa7, 0xa4, 0xb7, 0x79, 0xb4, 0x66, 0x40, 0x26, 0x6b, 0x10, 0x8e, 0x19,
-	0x1a, 0xd6, 0xec, 0xb3, 0xbc, 0x30, 0xba, 0xa6, 0x96, 0x05, 0xdb, 0xfd, 0xdb, 0x86, 0x84, 0x2d,
-	0x50, 0x5e, 0x48, 0x21, 0xaf, 0x64, 0x66, 0xf4, 0xc9, 0x1c, 0xc8, 0x86, 0x16, 0xfa, 0xc1, 0xca,
-	0xcf, 0x32, 0x75, 0xb0, 0x27, 0xe6, 0x7a, 0xe0, 0x13, 0x50, 0x4a, 0x25, 0x0d, 0xfb, 0x4a, 0xc5,
-	0x35, 0x0f, 0x18, 0xd8, 0x01, 0xb2, 0x15, 0x8e, 0xb2, 0x0a, 0x47, 0x0d, 0x21, 0xf8, 0x8f, 0x98,
-	0xa7, 0x34, 0xd8, 0x4c, 0x25, 0xbd, 0xd0, 0x5a, 0xd8, 0x04, 0x45, 0x9d, 0x21, 0xde, 0x96, 0xf1,
-	0x9c, 0xa0, 0xa9, 0x40, 0xc9, 0x76, 0xd6, 0xdb, 0xeb, 0x21, 0xa6, 0xe4, 0xe2, 0x4e, 0x60, 0xcc,
-	0xb0, 0x69, 0xb7, 0x07, 0x23, 0xde, 0xb6, 0xc1, 0x7c, 0x8a, 0x5c, 0x0a, 0xe6, 0x41, 0x38, 0x2b,
-	0x7c, 0x06, 0x8a, 0x3a, 0x06, 0xbd, 0x1d, 0x83, 0x78, 0x88, 0x4c, 0x26, 0xe6, 0x9a, 0x83, 0x56,
-	0xc2, 0x3a, 0x58, 0xc3, 0x23, 0xe9, 0xbd, 0xe7, 0x16, 0x52, 0x07, 0x5c, 0x1e, 0xb3, 0x36, 0xc1,
-	0x6f, 0xc0, 0xba, 0x49, 0x37, 0x6f, 0xd7, 0xb8, 0x8f, 0x91, 0xcd, 0xba, 0x3c, 0x7e, 0x6b, 0xd4,
-	0x2b, 0x60, 0x93, 0xce, 0xdb, 0x73, 0x2b, 0xe0, 0x82, 0x2f, 0xd7, 0x0a, 0x58, 0x2d, 0x3c, 0x07,
-	0xf7, 0x5c, 0xec, 0x79, 0x65, 0x43, 0x79, 0x84, 0xb2, 0x18, 0xcc, 0x85, 0xc1, 0x23, 0x79, 0x4e,
-	0x6a, 0xb0, 0x06, 0x36, 0xb3, 0xac, 0xf3, 0xa0, 0xcb, 0x97, 0x19, 0xdf, 0xb7, 0x6e, 0x34, 0x98,
-	0xe8, 0xe0, 0xcf, 0xe0, 0x80, 0x45, 0x4c, 0x31, 0xcc, 0x43, 0xcb, 0x0c, 0x47, 0x2c, 0xea, 0x88,
-	0x51, 0x28, 0xd9, 0x0d, 0xf5, 0xde, 0x37, 0x94, 0x07, 0x0b, 0x05, 0xf5, 0xf2, 0xbb, 0x48, 0x9d,
-	0xd6, 0x6c, 0x49, 0xdd, 0x77, 0xfe, 0x96, 0xb1, 0xff, 0x64, 0xdc, 0x2d, 0x76, 0x43, 0x21, 0x06,
-	0x95, 0x0c, 0x3d, 0xb5, 0x13, 0xa7, 0xf1, 0xfb, 0x39, 0xf0, 0x1f, 0x3a, 0xc6, 0xed, 0x2e, 0xbd,
-	0xbd, 0x44, 0xfd, 0xfe, 0xab, 0x37, 0xc5, 0x22, 0xb8, 0x9b, 0xca, 0x57, 0x6f, 0x8a, 0x5b, 0xb0,
-	0x94, 0x7d, 0x96, 0xc9, 0xc6, 0x2e, 0xd8, 0xc9, 0x1a, 0xa1, 0x1a, 0xc7, 0xb4, 0xfa, 0x5b, 0x01,
-	0x94, 0x17, 0xa2, 0x51, 0x3f, 0x3d, 0x8e, 0xdb, 0x94, 0xeb, 0x78, 0xd7, 0x1b, 0xfa, 0xb3, 0x15,
-	0x59, 0x8a, 0x2e, 0x8d, 0xfa, 0x3c, 0x52, 0xc9, 0x38, 0x70, 0xd6, 0x83, 0x2f, 0xc1, 0xd6, 0x54,
-	0x37, 0xdc, 0x03, 0x6b, 0x03, 0x3a, 0x36, 0xef, 0x8b, 0x52, 0xa0, 0x4f, 0xe1, 0x3e, 0x58, 0x1f,
-	0xea, 0x7b, 0x31, 0xa1, 0x5f, 0x0a, 0x6c, 0xa3, 0x7e, 0xf7, 0x69, 0xa1, 0x51, 0xd7, 0x2f, 0x8e,
-	0xdf, 0xff, 0xae, 0x14, 0x7e, 0xf9, 0x3c, 0xdf, 0xf7, 0x67, 0x3c, 0xe8, 0xb9, 0xd7, 0x5a, 0x7b,
-	0xc3, 0x2c, 0xd7, 0xe9, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x17, 0x11, 0x0e, 0x9f, 0xba, 0x0a,
-	0x00, 0x00,
+	// 1019 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0xdd, 0x6e, 0x23, 0x35,
+	0x1c, 0xc5, 0x37, 0x6d, 0xda, 0x6d, 0xdc, 0x96, 0x36, 0xde, 0x02, 0xa3, 0xb2, 0xb4, 0x55, 0x91,
+	0xd8, 0xb2, 0xa8, 0x1e, 0x36, 0x15, 0xda, 0xa5, 0x68, 0x11, 0x4a, 0x5a, 0x54, 0xb4, 0x5d, 0x90,
+	0xa6, 0x5a, 0x10, 0xdc, 0x8c, 0x1c, 0xc7, 0x4d, 0x4c, 0xdc, 0xf1, 0x68, 0xec, 0x69, 0x9a, 0x5e,
+	0xf2, 0x0a, 0x48, 0x3c, 0x03, 0x8f, 0xc0, 0x23, 0xf0, 0x14, 0x7b, 0xc1, 0x1b, 0x80, 0xc4, 0x3d,
+	0xf2, 0x57, 0x3e, 0x37, 0x9b, 0xe9, 0x45, 0x32, 0x63, 0xfb, 0x9c, 0xdf, 0x78, 0x9c, 0xbf, 0x8f,
+	0x03, 0xbe, 0x6c, 0x33, 0xd5, 0xc9, 0x9b, 0x88, 0x88, 0xab, 0x50, 0x0a, 0x2e, 0x0e, 0x99, 0x08,
+	0xdb, 0x5c, 0x88, 0x30, 0xcd, 0xc4, 0x2f, 0x94, 0x28, 0x69, 0x5b, 0x38, 0x65, 0xe1, 0xf5, 0x93,
+	0x30, 0x4f, 0xa5, 0xca, 0x28, 0xbe, 0x42, 0x69, 0x26, 0x94, 0x80, 0x6b, 0x7a, 0x0c, 0x69, 0x1b,
+	0x62, 0x62, 0x7b, 0xab, 0x2d, 0xda, 0xc2, 0x0c, 0x84, 0xfa, 0xce, 0x6a, 0xb6, 0x21, 0xbd, 0x51,
+	0xb6, 0x93, 0xde, 0x28, 0xd7, 0xb7, 0x63, 0x9e, 0xd4, 0x65, 0xca, 0x73, 0xaf, 0xa8, 0xc2, 0x2d,
+	0xac, 0xb0, 0x1b, 0xff, 0x68, 0xf6, 0x0c, 0xa4, 0xe4, 0x4e, 0xf4, 0x96, 0x69, 0x12, 0x96, 0x91,
+	0x9c, 0xa9, 0xb8, 0x99, 0x51, 0xdc, 0xa5, 0x99, 0x33, 0x1c, 0xce, 0x36, 0x70, 0x81, 0x5b, 0x71,
+	0x13, 0x73, 0x9c, 0x90, 0x81, 0xfc, 0xf1, 0x5b, 0xf8, 0x22, 0x49, 0x28, 0x51, 0x4c, 0x24, 0x4e,
+	0x7b, 0x32, 0x43, 0x4b, 0x6f, 0x14, 0xcd, 0x12, 0xcc, 0x43, 0x9a, 0x5c, 0x8b, 0xbe, 0xb5, 0xd7,
+	0x42, 0x22, 0x32, 0x1a, 0x76, 0x28, 0xe6, 0xaa, 0x13, 0x93, 0x0e, 0x25, 0x5d, 0x47, 0x79, 0x38,
+	0xb9, 0x2c, 0x52, 0x61, 0x95, 0x4b, 0x37, 0x7a, 0x7e, 0xb7, 0x67, 0xf0, 0x5c, 0x2a, 0x9a, 0x85,
+	0x22, 0x57, 0x9c, 0xd1, 0x2c, 0x6e, 0x51, 0x35, 0x36, 0xe3, 0xa9, 0x9f, 0xc0, 0xb7, 0xdd, 0xf8,
+	0xe7, 0xb3, 0xdf, 0x5e, 0xa4, 0x9a, 0x23, 0xcd, 0xec, 0x18, 0x71, 0x17, 0x67, 0x7b, 0x32, 0xdf,
+	0x96, 0xb2, 0x94, 0x9a, 0x2f, 0x67, 0x79, 0x3e, 0xdf, 0xd2, 0xcd, 0x9b, 0x34, 0x4b, 0xa8, 0xa2,
+	0xa3, 0xb7, 0xf3, 0xcb, 0xc0, 0xdb, 0x71, 0xcf, 0x7c, 0x9c, 0xe1, 0xa8, 0x80, 0xe1, 0x36, 0xcf,
+	0xa8, 0xfd, 0x2e, 0xbe, 0x1c, 0x44, 0x24, 0x32, 0xe7, 0xee, 0xe2, 0x6c, 0x4f, 0x8b, 0x4d, 0x8e,
+	0x92, 0x9a, 0xbe, 0xc6, 0x94, 0xd4, 0xee, 0x6a, 0x94, 0xce, 0xe8, 0xdf, 0xee, 0xd1, 0x5c, 0xa3,
+	0x13, 0x1e, 0xcc, 0x16, 0x5e, 0x62, 0xc6, 0xc5, 0xf5, 0x60, 0x23, 0xec, 0xb4, 0x85, 0x68, 0x73,
+	0x1a, 0x9a, 0x56, 0x33, 0xbf, 0x0c, 0x7b, 0x19, 0x4e, 0x53, 0x9a, 0x39, 0xd2, 0xfe, 0xef, 0x6b,
+	0x60, 0xe5, 0x95, 0x0b, 0x06, 0xf8, 0x02, 0x2c, 0xdb, 0xaa, 0x0d, 0x4a, 0x7b, 0xa5, 0x83, 0xd5,
+	0xda, 0x16, 0xd2, 0xd5, 0xee, 0x33, 0x02, 0x5d, 0x98, 0xb1, 0xfa, 0x87, 0x7f, 0xfe, 0x57, 0x2e,
+	0xfd, 0xf5, 0x7a, 0xf7, 0xde, 0xbf, 0xaf, 0x77, 0xab, 0x8a, 0x4a, 0xd5, 0x62, 0x97, 0x97, 0xc7,
+	0xfb, 0xac, 0x9d, 0x88, 0x8c, 0xee, 0x47, 0x0e, 0x01, 0x9f, 0x81, 0x15, 0x9f, 0x0c, 0xc1, 0x82,
+	0xc1, 0xbd, 0x37, 0x8e, 0x7b, 0xe9, 0x46, 0xeb, 0x65, 0x0d, 0x8b, 0x06, 0x6a, 0xf8, 0x1d, 0x80,
+	0x2d, 0x26, 0x89, 0x7e, 0x8b, 0x7e, 0x3c, 0x60, 0x2c, 0x1a, 0xc6, 0x2e, 0x1a, 0x8d, 0x2d, 0x74,
+	0xe2, 0x75, 0x1e, 0x16, 0x55, 0x5b, 0x93, 0x5d, 0xf0, 0x2b, 0x00, 0xa4, 0xe4, 0x31, 0x11, 0xc9,
+	0x25, 0x6b, 0x07, 0xe5, 0x37, 0x71, 0xfc, 0x12, 0x5c, 0x48, 0xde, 0x30, 0xb2, 0xa8, 0x22, 0xfd,
+	0x2d, 0x7c, 0x09, 0x36, 0x27, 0x42, 0x49, 0x06, 0x4b, 0x86, 0xb2, 0x3f, 0x4e, 0x69, 0x58, 0x55,
+	0xdd, 0x8a, 0x1c, 0x68, 0x83, 0x8c, 0xf5, 0x4a, 0x18, 0x81, 0xad, 0xb1, 0xc8, 0xf2, 0x13, 0x5b,
+	0x36, 0xc8, 0xbd, 0x71, 0xe4, 0xb9, 0xc0, 0xad, 0xba, 0x13, 0x3a, 0x20, 0xe4, 0x53, 0x7d, 0xf0,
+	0x05, 0xa8, 0x0e, 0x73, 0xcd, 0x03, 0xef, 0x1b, 0xe0, 0xce, 0xc4, 0x1c, 0x07, 0x32, 0x87, 0xdb,
+	0x24, 0x13, 0x3d, 0xb0, 0x01, 0xd6, 0x47, 0x03, 0x4e, 0x06, 0x2b, 0x7b, 0x8b, 0x06, 0x64, 0x42,
+	0x0a, 0xe1, 0x94, 0xa1, 0xeb, 0x9a, 0xfd, 0x2d, 0xcf, 0x8c, 0xae, 0xa1, 0x65, 0xd1, 0x5a, 0x67,
+	0xd8, 0x90, 0xf0, 0x02, 0x54, 0xa7, 0xe2, 0x2b, 0xa8, 0x98, 0x19, 0x7d, 0x3c, 0x01, 0xb2, 0x69,
+	0x87, 0xbe, 0xb7, 0xf2, 0x13, 0xaf, 0x8e, 0x36, 0xc5, 0x44, 0x0f, 0x7c, 0x0a, 0x2a, 0xb9, 0xa4,
+	0x71, 0x47, 0xa9, 0xb4, 0x16, 0x00, 0x03, 0xdb, 0x46, 0xb6, 0xc2, 0x91, 0xaf, 0x70, 0x54, 0x17,
+	0x82, 0xff, 0x80, 0x79, 0x4e, 0xa3, 0x95, 0x5c, 0xd2, 0x33, 0xad, 0x85, 0x0d, 0x50, 0xd6, 0xe1,
+	0x13, 0xac, 0x1a, 0xcf, 0x21, 0x1a, 0x49, 0x22, 0xbf, 0xb3, 0xde, 0x5c, 0x0f, 0x29, 0x25, 0x67,
+	0xf7, 0x22, 0x63, 0x86, 0x0d, 0xbb, 0x3d, 0x18, 0x09, 0xd6, 0x0c, 0xe6, 0x13, 0xe4, 0xe2, 0xb3,
+	0x08, 0xc2, 0x59, 0xe1, 0x73, 0x50, 0xd6, 0xf9, 0x19, 0xac, 0x1b, 0xc4, 0x23, 0x64, 0xc2, 0xb4,
+	0xd0, 0x1c, 0xb4, 0x12, 0x1e, 0x83, 0x45, 0xdc, 0x93, 0xc1, 0x3b, 0x6e, 0x21, 0x75, 0x32, 0x16,
+	0x31, 0x6b, 0x13, 0xfc, 0x1a, 0x2c, 0x99, 0x58, 0x0c, 0x36, 0x8c, 0xfb, 0x00, 0xd9, 0x90, 0x2c,
+	0xe2, 0xb7, 0x46, 0xbd, 0x02, 0x36, 0x22, 0x83, 0x4d, 0xb7, 0x02, 0x2e, 0x31, 0x0b, 0xad, 0x80,
+	0xd5, 0xc2, 0x53, 0x70, 0xdf, 0xe5, 0x65, 0x50, 0x35, 0x94, 0xc7, 0xc8, 0xe7, 0x67, 0x21, 0x0c,
+	0xee, 0xc9, 0x53, 0x52, 0x1b, 0x62, 0x64, 0xf0, 0xee, 0x18, 0x46, 0xde, 0x01, 0x23, 0x61, 0x0d,
+	0xac, 0xf8, 0xc8, 0x0c, 0xa0, 0x8b, 0xa9, 0x31, 0xdf, 0x37, 0x6e, 0x34, 0x1a, 0xe8, 0xe0, 0x4f,
+	0x60, 0x9b, 0x25, 0x4c, 0x31, 0xcc, 0x63, 0xcb, 0x8c, 0x7b, 0x2c, 0x69, 0x89, 0x5e, 0x2c, 0xd9,
+	0x2d, 0x0d, 0x1e, 0x18, 0xca, 0xc3, 0xa9, 0xba, 0x7c, 0xf5, 0x6d, 0xa2, 0x8e, 0x6a, 0xb6, 0x32,
+	0xdf, 0x77, 0xfe, 0x0b, 0x63, 0xff, 0xd1, 0xb8, 0x2f, 0xd8, 0x2d, 0x85, 0x18, 0xec, 0x78, 0xf4,
+	0xc8, 0x86, 0x1e, 0xc5, 0x6f, 0x15, 0xc0, 0x7f, 0xe0, 0x18, 0xc3, 0xcd, 0x3e, 0x7c, 0xc4, 0xf1,
+	0x83, 0x5f, 0xff, 0x29, 0x6f, 0x80, 0x85, 0x5c, 0xc2, 0x8a, 0xff, 0x4b, 0x28, 0xeb, 0x1b, 0x60,
+	0xdd, 0x37, 0x62, 0xd5, 0x4f, 0xe9, 0xfe, 0x6f, 0x25, 0x50, 0x9d, 0x4a, 0x57, 0x5d, 0x00, 0x1c,
+	0x37, 0x29, 0xd7, 0x27, 0x84, 0xce, 0x84, 0x4f, 0xe7, 0xc4, 0x31, 0x3a, 0x37, 0xea, 0xd3, 0x44,
+	0x65, 0xfd, 0xc8, 0x59, 0xb7, 0xbf, 0x00, 0xab, 0x23, 0xdd, 0x70, 0x13, 0x2c, 0x76, 0x69, 0xdf,
+	0x1c, 0x39, 0x95, 0x48, 0xdf, 0xc2, 0x2d, 0xb0, 0x74, 0xad, 0xdf, 0xc3, 0x9c, 0x1b, 0x95, 0xc8,
+	0x36, 0x8e, 0x17, 0x9e, 0x95, 0xea, 0xc7, 0xfa, 0xec, 0xf9, 0xe3, 0xef, 0x9d, 0xd2, 0xcf, 0x9f,
+	0x15, 0xfb, 0xef, 0x9b, 0x76, 0xdb, 0xee, 0x64, 0x6c, 0x2e, 0x9b, 0xa5, 0x3a, 0xfa, 0x3f, 0x00,
+	0x00, 0xff, 0xff, 0x61, 0xc1, 0x71, 0xcf, 0x36, 0x0b, 0x00, 0x00,
 }
 
 func (this *Upstream) Equal(that interface{}) bool {
@@ -676,6 +690,30 @@ func (this *Upstream_AwsEc2) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *Upstream_AwsEcs) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Upstream_AwsEcs)
+	if !ok {
+		that2, ok := that.(Upstream_AwsEcs)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.AwsEcs.Equal(that1.AwsEcs) {
+		return false
+	}
+	return true
+}
 func (this *DiscoveryMetadata) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -324,6 +324,22 @@ func (m *Upstream) Hash(hasher hash.Hash64) (uint64, error) {
 			}
 		}
 
+	case *Upstream_AwsEcs:
+
+		if h, ok := interface{}(m.GetAwsEcs()).(safe_hasher.SafeHasher); ok {
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if val, err := hashstructure.Hash(m.GetAwsEcs(), nil); err != nil {
+				return 0, err
+			} else {
+				if err := binary.Write(hasher, binary.LittleEndian, val); err != nil {
+					return 0, err
+				}
+			}
+		}
+
 	}
 
 	return hasher.Sum64(), nil
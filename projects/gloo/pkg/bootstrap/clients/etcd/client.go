@@ -0,0 +1,290 @@
+package etcd
+
+import (
+	"context"
+	"crypto/tls"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/solo-io/solo-kit/pkg/errors"
+	pb "go.etcd.io/etcd/etcdserver/etcdserverpb"
+	"go.etcd.io/etcd/mvcc/mvccpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/resolver/manual"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	DefaultEndpoint       = "127.0.0.1:2379"
+	DefaultRequestTimeout = 5 * time.Second
+
+	resolverScheme = "etcd"
+)
+
+type Options struct {
+	// the gRPC addresses of the etcd members, optionally prefixed with `http://` or `https://`
+	Endpoints []string
+	// if nil, TLS is only used for `https://` endpoints
+	TLSConfig *tls.Config
+	// authenticate as this etcd user if set
+	Username string
+	Password string
+	// bounds every request but watches
+	RequestTimeout time.Duration
+}
+
+// Client talks to the KV and Watch services of an etcd v3 cluster, failing over between its members.
+type Client struct {
+	conn  *grpc.ClientConn
+	kv    pb.KVClient
+	watch pb.WatchClient
+	auth  pb.AuthClient
+
+	username       string
+	password       string
+	token          *tokenCredentials
+	requestTimeout time.Duration
+}
+
+// NewClient connects to etcd until ctx is done.
+func NewClient(ctx context.Context, opts Options) (*Client, error) {
+	endpoints := opts.Endpoints
+	if len(endpoints) == 0 {
+		endpoints = []string{DefaultEndpoint}
+	}
+	tlsConfig := opts.TLSConfig
+	var addresses []resolver.Address
+	for _, endpoint := range endpoints {
+		switch {
+		case strings.HasPrefix(endpoint, "https://"):
+			if tlsConfig == nil {
+				tlsConfig = &tls.Config{}
+			}
+			endpoint = strings.TrimPrefix(endpoint, "https://")
+		case strings.HasPrefix(endpoint, "http://"):
+			if opts.TLSConfig != nil {
+				return nil, errors.Errorf("etcd endpoint %v cannot be used with TLS", endpoint)
+			}
+			endpoint = strings.TrimPrefix(endpoint, "http://")
+		}
+		addresses = append(addresses, resolver.Address{Addr: endpoint})
+	}
+
+	transportCreds := grpc.WithInsecure()
+	if tlsConfig != nil {
+		transportCreds = grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))
+	}
+	r := manual.NewBuilderWithScheme(resolverScheme)
+	r.InitialState(resolver.State{Addresses: addresses})
+	token := &tokenCredentials{}
+	conn, err := grpc.DialContext(ctx, resolverScheme+":///",
+		transportCreds,
+		grpc.WithResolvers(r),
+		grpc.WithPerRPCCredentials(token),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "connecting to etcd at %v", endpoints)
+	}
+	go func() {
+		<-ctx.Done()
+		conn.Close()
+	}()
+
+	requestTimeout := opts.RequestTimeout
+	if requestTimeout == 0 {
+		requestTimeout = DefaultRequestTimeout
+	}
+	return &Client{
+		conn:           conn,
+		kv:             pb.NewKVClient(conn),
+		watch:          pb.NewWatchClient(conn),
+		auth:           pb.NewAuthClient(conn),
+		username:       opts.Username,
+		password:       opts.Password,
+		token:          token,
+		requestTimeout: requestTimeout,
+	}, nil
+}
+
+// call authenticates if needed, and again if the token expired
+func (c *Client) call(ctx context.Context, timeout bool, f func(ctx context.Context) error) error {
+	if c.username != "" && c.token.get() == "" {
+		if err := c.authenticate(ctx); err != nil {
+			return err
+		}
+	}
+	err := c.callOnce(ctx, timeout, f)
+	if c.username != "" && status.Code(err) == codes.Unauthenticated {
+		if err := c.authenticate(ctx); err != nil {
+			return err
+		}
+		err = c.callOnce(ctx, timeout, f)
+	}
+	return err
+}
+
+func (c *Client) callOnce(ctx context.Context, timeout bool, f func(ctx context.Context) error) error {
+	if timeout {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.requestTimeout)
+		defer cancel()
+	}
+	return f(ctx)
+}
+
+func (c *Client) authenticate(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, c.requestTimeout)
+	defer cancel()
+	resp, err := c.auth.Authenticate(ctx, &pb.AuthenticateRequest{Name: c.username, Password: c.password})
+	if err != nil {
+		return errors.Wrapf(err, "authenticating to etcd as %v", c.username)
+	}
+	c.token.set(resp.GetToken())
+	return nil
+}
+
+func (c *Client) get(ctx context.Context, key string) (*mvccpb.KeyValue, error) {
+	var resp *pb.RangeResponse
+	err := c.call(ctx, true, func(ctx context.Context) error {
+		var err error
+		resp, err = c.kv.Range(ctx, &pb.RangeRequest{Key: []byte(key)})
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting %v from etcd", key)
+	}
+	if len(resp.GetKvs()) == 0 {
+		return nil, nil
+	}
+	return resp.GetKvs()[0], nil
+}
+
+// list returns the key-values under prefix, and the revision they were read at
+func (c *Client) list(ctx context.Context, prefix string) ([]*mvccpb.KeyValue, int64, error) {
+	var resp *pb.RangeResponse
+	err := c.call(ctx, true, func(ctx context.Context) error {
+		var err error
+		resp, err = c.kv.Range(ctx, &pb.RangeRequest{Key: []byte(prefix), RangeEnd: prefixEnd(prefix)})
+		return err
+	})
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "listing %v from etcd", prefix)
+	}
+	return resp.GetKvs(), resp.GetHeader().GetRevision(), nil
+}
+
+// put writes key if it was last modified at modRevision, or doesn't exist if modRevision is 0.
+// It returns the revision of the write, or 0 if the key was modified concurrently.
+func (c *Client) put(ctx context.Context, key string, value []byte, modRevision int64) (int64, error) {
+	compare := &pb.Compare{
+		Key:         []byte(key),
+		Target:      pb.Compare_MOD,
+		Result:      pb.Compare_EQUAL,
+		TargetUnion: &pb.Compare_ModRevision{ModRevision: modRevision},
+	}
+	if modRevision == 0 {
+		compare.Target = pb.Compare_CREATE
+		compare.TargetUnion = &pb.Compare_CreateRevision{CreateRevision: 0}
+	}
+	var resp *pb.TxnResponse
+	err := c.call(ctx, true, func(ctx context.Context) error {
+		var err error
+		resp, err = c.kv.Txn(ctx, &pb.TxnRequest{
+			Compare: []*pb.Compare{compare},
+			Success: []*pb.RequestOp{{Request: &pb.RequestOp_RequestPut{
+				RequestPut: &pb.PutRequest{Key: []byte(key), Value: value},
+			}}},
+		})
+		return err
+	})
+	if err != nil {
+		return 0, errors.Wrapf(err, "writing %v to etcd", key)
+	}
+	if !resp.GetSucceeded() {
+		return 0, nil
+	}
+	return resp.GetHeader().GetRevision(), nil
+}
+
+// del deletes key, returning whether it existed
+func (c *Client) del(ctx context.Context, key string) (bool, error) {
+	var resp *pb.DeleteRangeResponse
+	err := c.call(ctx, true, func(ctx context.Context) error {
+		var err error
+		resp, err = c.kv.DeleteRange(ctx, &pb.DeleteRangeRequest{Key: []byte(key)})
+		return err
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "deleting %v from etcd", key)
+	}
+	return resp.GetDeleted() > 0, nil
+}
+
+// watchPrefix streams the changes under prefix from revision, until ctx is done
+func (c *Client) watchPrefix(ctx context.Context, prefix string, revision int64) (pb.Watch_WatchClient, error) {
+	var stream pb.Watch_WatchClient
+	err := c.call(ctx, false, func(ctx context.Context) error {
+		var err error
+		stream, err = c.watch.Watch(ctx)
+		if err != nil {
+			return err
+		}
+		return stream.Send(&pb.WatchRequest{RequestUnion: &pb.WatchRequest_CreateRequest{
+			CreateRequest: &pb.WatchCreateRequest{
+				Key:           []byte(prefix),
+				RangeEnd:      prefixEnd(prefix),
+				StartRevision: revision,
+			},
+		}})
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "watching %v in etcd", prefix)
+	}
+	return stream, nil
+}
+
+// prefixEnd is the end of the range of keys starting with prefix
+func prefixEnd(prefix string) []byte {
+	end := []byte(prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	// every key is greater than the prefix
+	return []byte{0}
+}
+
+// tokenCredentials passes the token of the authenticated etcd user with each request
+type tokenCredentials struct {
+	lock  sync.RWMutex
+	token string
+}
+
+func (t *tokenCredentials) get() string {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.token
+}
+
+func (t *tokenCredentials) set(token string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.token = token
+}
+
+func (t *tokenCredentials) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	if token := t.get(); token != "" {
+		return map[string]string{"token": token}, nil
+	}
+	return nil, nil
+}
+
+func (t *tokenCredentials) RequireTransportSecurity() bool {
+	return false
+}
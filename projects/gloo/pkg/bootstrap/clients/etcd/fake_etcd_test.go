@@ -0,0 +1,176 @@
+package etcd_test
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/solo-io/solo-kit/pkg/errors"
+	pb "go.etcd.io/etcd/etcdserver/etcdserverpb"
+	"go.etcd.io/etcd/mvcc/mvccpb"
+)
+
+// fakeEtcd implements the subset of the etcd KV and Watch services used by the etcd resource client, in memory
+type fakeEtcd struct {
+	lock     sync.Mutex
+	revision int64
+	kvs      map[string]*mvccpb.KeyValue
+	events   []*mvccpb.Event
+	// closed and replaced on every change
+	changed chan struct{}
+}
+
+func newFakeEtcd() *fakeEtcd {
+	return &fakeEtcd{
+		revision: 1,
+		kvs:      map[string]*mvccpb.KeyValue{},
+		changed:  make(chan struct{}),
+	}
+}
+
+func inRange(key, start, end []byte) bool {
+	if len(end) == 0 {
+		return bytes.Equal(key, start)
+	}
+	if bytes.Compare(key, start) < 0 {
+		return false
+	}
+	return bytes.Equal(end, []byte{0}) || bytes.Compare(key, end) < 0
+}
+
+func (f *fakeEtcd) header() *pb.ResponseHeader {
+	return &pb.ResponseHeader{Revision: f.revision}
+}
+
+func (f *fakeEtcd) notify() {
+	close(f.changed)
+	f.changed = make(chan struct{})
+}
+
+func (f *fakeEtcd) put(key, value []byte) {
+	f.revision++
+	kv := &mvccpb.KeyValue{Key: key, Value: value, ModRevision: f.revision, CreateRevision: f.revision, Version: 1}
+	if existing, ok := f.kvs[string(key)]; ok {
+		kv.CreateRevision = existing.CreateRevision
+		kv.Version = existing.Version + 1
+	}
+	f.kvs[string(key)] = kv
+	f.events = append(f.events, &mvccpb.Event{Type: mvccpb.PUT, Kv: kv})
+	f.notify()
+}
+
+func (f *fakeEtcd) Range(_ context.Context, req *pb.RangeRequest) (*pb.RangeResponse, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	var kvs []*mvccpb.KeyValue
+	for _, kv := range f.kvs {
+		if inRange(kv.Key, req.Key, req.RangeEnd) {
+			kvs = append(kvs, kv)
+		}
+	}
+	sort.Slice(kvs, func(i, j int) bool { return bytes.Compare(kvs[i].Key, kvs[j].Key) < 0 })
+	return &pb.RangeResponse{Header: f.header(), Kvs: kvs, Count: int64(len(kvs))}, nil
+}
+
+func (f *fakeEtcd) Put(_ context.Context, req *pb.PutRequest) (*pb.PutResponse, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.put(req.Key, req.Value)
+	return &pb.PutResponse{Header: f.header()}, nil
+}
+
+func (f *fakeEtcd) DeleteRange(_ context.Context, req *pb.DeleteRangeRequest) (*pb.DeleteRangeResponse, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	var deleted int64
+	for key, kv := range f.kvs {
+		if inRange(kv.Key, req.Key, req.RangeEnd) {
+			if deleted == 0 {
+				f.revision++
+			}
+			deleted++
+			delete(f.kvs, key)
+			f.events = append(f.events, &mvccpb.Event{Type: mvccpb.DELETE, Kv: &mvccpb.KeyValue{Key: kv.Key, ModRevision: f.revision}})
+		}
+	}
+	if deleted > 0 {
+		f.notify()
+	}
+	return &pb.DeleteRangeResponse{Header: f.header(), Deleted: deleted}, nil
+}
+
+func (f *fakeEtcd) Txn(_ context.Context, req *pb.TxnRequest) (*pb.TxnResponse, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	succeeded := true
+	for _, compare := range req.Compare {
+		kv := f.kvs[string(compare.Key)]
+		switch compare.Target {
+		case pb.Compare_MOD:
+			succeeded = succeeded && kv != nil && kv.ModRevision == compare.GetModRevision()
+		case pb.Compare_CREATE:
+			succeeded = succeeded && (kv == nil && compare.GetCreateRevision() == 0 || kv != nil && kv.CreateRevision == compare.GetCreateRevision())
+		default:
+			return nil, errors.Errorf("unsupported compare target %v", compare.Target)
+		}
+	}
+	ops := req.Failure
+	if succeeded {
+		ops = req.Success
+	}
+	for _, op := range ops {
+		put := op.GetRequestPut()
+		if put == nil {
+			return nil, errors.Errorf("unsupported txn op %v", op)
+		}
+		f.put(put.Key, put.Value)
+	}
+	return &pb.TxnResponse{Header: f.header(), Succeeded: succeeded}, nil
+}
+
+func (f *fakeEtcd) Compact(context.Context, *pb.CompactionRequest) (*pb.CompactionResponse, error) {
+	return nil, errors.Errorf("compaction is not supported")
+}
+
+func (f *fakeEtcd) Watch(stream pb.Watch_WatchServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	create := req.GetCreateRequest()
+	if create == nil {
+		return errors.Errorf("expected a watch create request")
+	}
+	f.lock.Lock()
+	header := f.header()
+	f.lock.Unlock()
+	if err := stream.Send(&pb.WatchResponse{Header: header, Created: true}); err != nil {
+		return err
+	}
+
+	next := create.StartRevision
+	for {
+		f.lock.Lock()
+		var events []*mvccpb.Event
+		for _, event := range f.events {
+			if event.Kv.ModRevision >= next && inRange(event.Kv.Key, create.Key, create.RangeEnd) {
+				events = append(events, event)
+			}
+		}
+		next = f.revision + 1
+		header, changed := f.header(), f.changed
+		f.lock.Unlock()
+
+		if len(events) > 0 {
+			if err := stream.Send(&pb.WatchResponse{Header: header, Events: events}); err != nil {
+				return err
+			}
+		}
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-changed:
+		}
+	}
+}
@@ -0,0 +1,286 @@
+package etcd
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	"github.com/solo-io/solo-kit/pkg/utils/protoutils"
+	"go.etcd.io/etcd/mvcc/mvccpb"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// ResourceClientFactory builds resource clients storing resources as YAML in etcd, under
+// `<RootKey>/<group>/<version>/<kind>/<namespace>/<name>` like the solo-kit consul resource client.
+// Resource versions are the etcd revisions the resources were last modified at.
+type ResourceClientFactory struct {
+	Client  *Client
+	RootKey string
+}
+
+func (f *ResourceClientFactory) NewResourceClient(params factory.NewResourceClientParams) (clients.ResourceClient, error) {
+	versionedResource, ok := params.ResourceType.(resources.VersionedResource)
+	if !ok {
+		return nil, errors.Errorf("the etcd storage client can only be used for resources which implement the resources.VersionedResource interface resources, received type %v", resources.Kind(params.ResourceType))
+	}
+	return NewResourceClient(f.Client, f.RootKey, versionedResource), nil
+}
+
+type ResourceClient struct {
+	client       *Client
+	root         string
+	resourceType resources.VersionedResource
+}
+
+func NewResourceClient(client *Client, rootKey string, resourceType resources.VersionedResource) *ResourceClient {
+	return &ResourceClient{
+		client:       client,
+		root:         rootKey,
+		resourceType: resourceType,
+	}
+}
+
+var _ clients.ResourceClient = &ResourceClient{}
+
+func (rc *ResourceClient) Kind() string {
+	return resources.Kind(rc.resourceType)
+}
+
+func (rc *ResourceClient) NewResource() resources.Resource {
+	return resources.Clone(rc.resourceType)
+}
+
+func (rc *ResourceClient) Register() error {
+	return nil
+}
+
+func (rc *ResourceClient) Read(namespace, name string, opts clients.ReadOpts) (resources.Resource, error) {
+	if err := resources.ValidateName(name); err != nil {
+		return nil, errors.Wrapf(err, "validation error")
+	}
+	opts = opts.WithDefaults()
+
+	kv, err := rc.client.get(opts.Ctx, rc.resourceKey(namespace, name))
+	if err != nil {
+		return nil, err
+	}
+	if kv == nil {
+		return nil, errors.NewNotExistErr(namespace, name)
+	}
+	return rc.fromKeyValue(kv)
+}
+
+func (rc *ResourceClient) Write(resource resources.Resource, opts clients.WriteOpts) (resources.Resource, error) {
+	opts = opts.WithDefaults()
+	if err := resources.Validate(resource); err != nil {
+		return nil, errors.Wrapf(err, "validation error")
+	}
+	meta := resource.GetMetadata()
+	if meta.Namespace == "" {
+		return nil, errors.Errorf("namespace cannot be empty for etcd-backed resources")
+	}
+	key := rc.resourceKey(meta.Namespace, meta.Name)
+
+	original, err := rc.client.get(opts.Ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	var modRevision int64
+	if original != nil {
+		if !opts.OverwriteExisting {
+			return nil, errors.NewExistErr(meta)
+		}
+		if originalVersion := version(original); meta.ResourceVersion != originalVersion {
+			return nil, errors.NewResourceVersionErr(meta.Namespace, meta.Name, meta.ResourceVersion, originalVersion)
+		}
+		modRevision = original.ModRevision
+	}
+
+	// the resource version is the revision of the key, don't store it
+	clone := resources.Clone(resource)
+	resources.UpdateMetadata(clone, func(meta *core.Metadata) {
+		meta.ResourceVersion = ""
+	})
+	data, err := protoutils.MarshalYAML(clone)
+	if err != nil {
+		panic(errors.Wrapf(err, "internal err: failed to marshal resource"))
+	}
+
+	revision, err := rc.client.put(opts.Ctx, key, data, modRevision)
+	if err != nil {
+		return nil, err
+	}
+	if revision == 0 {
+		if original == nil {
+			return nil, errors.NewExistErr(meta)
+		}
+		return nil, errors.Errorf("writing %v %v.%v to etcd failed: it was modified concurrently", rc.Kind(), meta.Namespace, meta.Name)
+	}
+	resources.UpdateMetadata(clone, func(meta *core.Metadata) {
+		meta.ResourceVersion = strconv.FormatInt(revision, 10)
+	})
+	return clone, nil
+}
+
+func (rc *ResourceClient) Delete(namespace, name string, opts clients.DeleteOpts) error {
+	opts = opts.WithDefaults()
+	if namespace == "" {
+		return errors.Errorf("namespace cannot be empty for etcd-backed resources")
+	}
+	deleted, err := rc.client.del(opts.Ctx, rc.resourceKey(namespace, name))
+	if err != nil {
+		return errors.Wrapf(err, "deleting resource %v", name)
+	}
+	if !deleted && !opts.IgnoreNotExist {
+		return errors.NewNotExistErr(namespace, name)
+	}
+	return nil
+}
+
+func (rc *ResourceClient) List(namespace string, opts clients.ListOpts) (resources.ResourceList, error) {
+	opts = opts.WithDefaults()
+	list, _, err := rc.list(opts.Ctx, namespace, opts.Selector)
+	return list, err
+}
+
+func (rc *ResourceClient) list(ctx context.Context, namespace string, selector map[string]string) (resources.ResourceList, int64, error) {
+	kvs, revision, err := rc.client.list(ctx, rc.resourcePrefix(namespace))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var resourceList resources.ResourceList
+	for _, kv := range kvs {
+		resource, err := rc.fromKeyValue(kv)
+		if err != nil {
+			return nil, 0, err
+		}
+		if labels.SelectorFromSet(selector).Matches(labels.Set(resource.GetMetadata().Labels)) {
+			resourceList = append(resourceList, resource)
+		}
+	}
+
+	sort.SliceStable(resourceList, func(i, j int) bool {
+		return resourceList[i].GetMetadata().Name < resourceList[j].GetMetadata().Name
+	})
+
+	return resourceList, revision, nil
+}
+
+// Watch lists the resources, then lists them again each time etcd reports a change to them.
+// If the watch breaks, e.g. because etcd compacted the revisions it was watching from, the resources are listed and
+// watched again after the refresh rate.
+func (rc *ResourceClient) Watch(namespace string, opts clients.WatchOpts) (<-chan resources.ResourceList, <-chan error, error) {
+	opts = opts.WithDefaults()
+	ctx := opts.Ctx
+	resourcesChan := make(chan resources.ResourceList)
+	errs := make(chan error)
+
+	sendErr := func(err error) {
+		select {
+		case errs <- err:
+		case <-ctx.Done():
+		}
+	}
+	var revision int64
+	relist := func() bool {
+		list, listRevision, err := rc.list(ctx, namespace, opts.Selector)
+		if err != nil {
+			sendErr(err)
+			return false
+		}
+		revision = listRevision
+		select {
+		case resourcesChan <- list:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	go func() {
+		defer close(errs)
+		defer close(resourcesChan)
+		for {
+			if relist() {
+				if err := rc.watchChanges(ctx, namespace, revision+1, relist); err != nil {
+					sendErr(err)
+				}
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(opts.RefreshRate):
+			}
+		}
+	}()
+
+	return resourcesChan, errs, nil
+}
+
+func (rc *ResourceClient) watchChanges(ctx context.Context, namespace string, revision int64, onChange func() bool) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := rc.client.watchPrefix(ctx, rc.resourcePrefix(namespace), revision)
+	if err != nil {
+		return err
+	}
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return errors.Wrapf(err, "watching %v in etcd", rc.Kind())
+		}
+		if resp.GetCanceled() {
+			if compacted := resp.GetCompactRevision(); compacted > 0 {
+				return errors.Errorf("watch of %v canceled, etcd compacted revision %v", rc.Kind(), compacted)
+			}
+			return errors.Errorf("watch of %v canceled by etcd: %v", rc.Kind(), resp.GetCancelReason())
+		}
+		if len(resp.GetEvents()) > 0 && !onChange() {
+			return nil
+		}
+	}
+}
+
+func (rc *ResourceClient) fromKeyValue(kv *mvccpb.KeyValue) (resources.Resource, error) {
+	resource := rc.NewResource()
+	if err := protoutils.UnmarshalYAML(kv.Value, resource); err != nil {
+		return nil, errors.Wrapf(err, "reading KV into %v", rc.Kind())
+	}
+	resources.UpdateMetadata(resource, func(meta *core.Metadata) {
+		meta.ResourceVersion = version(kv)
+	})
+	return resource, nil
+}
+
+func version(kv *mvccpb.KeyValue) string {
+	return strconv.FormatInt(kv.ModRevision, 10)
+}
+
+// works with "" (NamespaceAll)
+func (rc *ResourceClient) resourcePrefix(namespace string) string {
+	parts := []string{
+		rc.root,
+		rc.resourceType.GroupVersionKind().Group,
+		rc.resourceType.GroupVersionKind().Version,
+		rc.resourceType.GroupVersionKind().Kind,
+	}
+	if namespace != "" {
+		parts = append(parts, namespace)
+	}
+	return strings.Join(parts, "/") + "/"
+}
+
+func (rc *ResourceClient) resourceKey(namespace, name string) string {
+	return rc.resourcePrefix(namespace) + name
+}
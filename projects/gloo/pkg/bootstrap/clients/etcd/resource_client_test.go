@@ -0,0 +1,137 @@
+package etcd_test
+
+import (
+	"context"
+	"net"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
+	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap/clients/etcd"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	pb "go.etcd.io/etcd/etcdserver/etcdserverpb"
+	"google.golang.org/grpc"
+)
+
+var _ = Describe("ResourceClient", func() {
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+		server *grpc.Server
+		client v1.UpstreamClient
+	)
+
+	upstream := func(namespace, name string) *v1.Upstream {
+		return &v1.Upstream{
+			Metadata: core.Metadata{Namespace: namespace, Name: name},
+			UpstreamType: &v1.Upstream_Static{Static: &static.UpstreamSpec{
+				Hosts: []*static.Host{{Addr: "127.0.0.1", Port: 8080}},
+			}},
+		}
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		fake := newFakeEtcd()
+		server = grpc.NewServer()
+		pb.RegisterKVServer(server, fake)
+		pb.RegisterWatchServer(server, fake)
+		go server.Serve(lis)
+
+		etcdClient, err := etcd.NewClient(ctx, etcd.Options{Endpoints: []string{"http://" + lis.Addr().String()}})
+		Expect(err).NotTo(HaveOccurred())
+		client, err = v1.NewUpstreamClient(&etcd.ResourceClientFactory{Client: etcdClient, RootKey: "gloo"})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		cancel()
+		server.Stop()
+	})
+
+	It("versions resources with etcd revisions", func() {
+		written, err := client.Write(upstream("gloo-system", "us"), clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(written.Metadata.ResourceVersion).NotTo(BeEmpty())
+
+		read, err := client.Read("gloo-system", "us", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(read).To(Equal(written))
+
+		_, err = client.Write(upstream("gloo-system", "us"), clients.WriteOpts{})
+		Expect(errors.IsExist(err)).To(BeTrue())
+
+		read.Status = core.Status{State: core.Status_Accepted, ReportedBy: "gloo"}
+		updated, err := client.Write(read, clients.WriteOpts{OverwriteExisting: true})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updated.Status.State).To(Equal(core.Status_Accepted))
+		Expect(updated.Metadata.ResourceVersion).NotTo(Equal(read.Metadata.ResourceVersion))
+
+		// the status write superseded the version that was read
+		_, err = client.Write(read, clients.WriteOpts{OverwriteExisting: true})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("resource version"))
+	})
+
+	It("lists and deletes resources by namespace", func() {
+		for _, us := range []*v1.Upstream{
+			upstream("gloo", "b"),
+			upstream("gloo", "a"),
+			upstream("gloo-system", "c"),
+		} {
+			_, err := client.Write(us, clients.WriteOpts{})
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		list, err := client.List("gloo", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list.Names()).To(Equal([]string{"a", "b"}))
+
+		list, err = client.List("", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list).To(HaveLen(3))
+
+		Expect(client.Delete("gloo", "a", clients.DeleteOpts{})).To(Succeed())
+		err = client.Delete("gloo", "a", clients.DeleteOpts{})
+		Expect(errors.IsNotExist(err)).To(BeTrue())
+		Expect(client.Delete("gloo", "a", clients.DeleteOpts{IgnoreNotExist: true})).To(Succeed())
+
+		list, err = client.List("gloo", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list.Names()).To(Equal([]string{"b"}))
+	})
+
+	It("watches for changes", func() {
+		_, err := client.Write(upstream("gloo-system", "a"), clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+
+		lists, errs, err := client.Watch("gloo-system", clients.WatchOpts{Ctx: ctx, RefreshRate: time.Second})
+		Expect(err).NotTo(HaveOccurred())
+		var list v1.UpstreamList
+		Eventually(lists).Should(Receive(&list))
+		Expect(list.Names()).To(Equal([]string{"a"}))
+
+		_, err = client.Write(upstream("gloo-system", "b"), clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(lists, 5*time.Second).Should(Receive(&list))
+		Expect(list.Names()).To(Equal([]string{"a", "b"}))
+
+		Expect(client.Delete("gloo-system", "a", clients.DeleteOpts{})).To(Succeed())
+		Eventually(lists, 5*time.Second).Should(Receive(&list))
+		Expect(list.Names()).To(Equal([]string{"b"}))
+
+		// changes in other namespaces are not watched
+		_, err = client.Write(upstream("other", "c"), clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Consistently(lists).ShouldNot(Receive())
+		Expect(errs).NotTo(Receive())
+	})
+})
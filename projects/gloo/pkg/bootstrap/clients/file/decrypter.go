@@ -0,0 +1,176 @@
+package file
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"io/ioutil"
+
+	"github.com/solo-io/solo-kit/pkg/errors"
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/armor"
+)
+
+// Decrypter decrypts resource files, so that they can be stored encrypted at rest.
+type Decrypter interface {
+	// Decrypt returns the plaintext of a file, which is the file itself if it is not encrypted.
+	Decrypt(data []byte) ([]byte, error)
+}
+
+// the first line of ASCII-armored OpenPGP messages and keys
+const armorPrefix = "-----BEGIN PGP "
+
+// NewPGPDecrypter decrypts files encrypted with OpenPGP, ASCII-armored or not. Files encrypted with a passphrase
+// (`gpg --symmetric`) are decrypted with the first line of passphraseFile, files encrypted to public keys
+// (`gpg --encrypt`) with the private keys in privateKeyFile, which may themselves be protected by the passphrase.
+// Either file may be empty.
+func NewPGPDecrypter(passphraseFile, privateKeyFile string) (Decrypter, error) {
+	d := &pgpDecrypter{}
+	if passphraseFile != "" {
+		data, err := ioutil.ReadFile(passphraseFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "reading passphrase file")
+		}
+		passphrase, err := bufio.NewReader(bytes.NewReader(data)).ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return nil, errors.Wrapf(err, "reading passphrase file")
+		}
+		d.passphrase = bytes.TrimRight(passphrase, "\r\n")
+	}
+	if privateKeyFile != "" {
+		data, err := ioutil.ReadFile(privateKeyFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "reading private key file")
+		}
+		read := openpgp.ReadKeyRing
+		if isArmored(data) {
+			read = openpgp.ReadArmoredKeyRing
+		}
+		d.keyRing, err = read(bytes.NewReader(data))
+		if err != nil {
+			return nil, errors.Wrapf(err, "reading private key file")
+		}
+		if err := d.decryptPrivateKeys(); err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+type pgpDecrypter struct {
+	passphrase []byte
+	keyRing    openpgp.EntityList
+}
+
+func (d *pgpDecrypter) decryptPrivateKeys() error {
+	for _, entity := range d.keyRing {
+		keys := []openpgp.Key{{Entity: entity, PrivateKey: entity.PrivateKey}}
+		for _, subkey := range entity.Subkeys {
+			keys = append(keys, openpgp.Key{Entity: entity, PrivateKey: subkey.PrivateKey})
+		}
+		for _, key := range keys {
+			if key.PrivateKey == nil || !key.PrivateKey.Encrypted {
+				continue
+			}
+			if d.passphrase == nil {
+				return errors.Errorf("private key %v is protected by a passphrase, but no passphrase file is configured", key.PrivateKey.KeyIdString())
+			}
+			if err := key.PrivateKey.Decrypt(d.passphrase); err != nil {
+				return errors.Wrapf(err, "decrypting private key %v", key.PrivateKey.KeyIdString())
+			}
+		}
+	}
+	return nil
+}
+
+func (d *pgpDecrypter) Decrypt(data []byte) ([]byte, error) {
+	var r io.Reader
+	switch {
+	case isArmored(data):
+		block, err := armor.Decode(bytes.NewReader(data))
+		if err != nil {
+			return nil, errors.Wrapf(err, "decoding armored file")
+		}
+		r = block.Body
+	case isBinaryMessage(data):
+		r = bytes.NewReader(data)
+	default:
+		return data, nil
+	}
+
+	prompted := false
+	prompt := func(keys []openpgp.Key, symmetric bool) ([]byte, error) {
+		// the prompt is called again if the passphrase is incorrect
+		if !symmetric || d.passphrase == nil || prompted {
+			return nil, errors.Errorf("no configured key can decrypt the file")
+		}
+		prompted = true
+		return d.passphrase, nil
+	}
+	md, err := openpgp.ReadMessage(r, d.keyRing, prompt, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "decrypting file")
+	}
+	// reading the whole body verifies its integrity
+	plaintext, err := ioutil.ReadAll(md.UnverifiedBody)
+	if err != nil {
+		return nil, errors.Wrapf(err, "decrypting file")
+	}
+	return plaintext, nil
+}
+
+func isArmored(data []byte) bool {
+	return bytes.HasPrefix(bytes.TrimSpace(data), []byte(armorPrefix))
+}
+
+// isBinaryMessage reports whether data starts like a binary OpenPGP message, with a session key encrypted to a
+// public key (RFC 4880 section 5.1) or with a passphrase (section 5.3). The version of the packet is checked as
+// well, as the header of a passphrase packet is also the first byte of some UTF-8 characters, e.g. "é".
+func isBinaryMessage(data []byte) bool {
+	if len(data) < 2 || data[0]&0x80 == 0 {
+		return false
+	}
+	var (
+		tag          byte
+		headerLength int
+	)
+	if data[0]&0x40 != 0 {
+		// new format header: the tag is in the low six bits, followed by a one, two or five byte length
+		tag = data[0] & 0x3f
+		switch {
+		case data[1] < 192:
+			headerLength = 2
+		case data[1] < 224:
+			headerLength = 3
+		case data[1] == 255:
+			headerLength = 6
+		default:
+			// partial lengths are not allowed for session key packets
+			return false
+		}
+	} else {
+		// old format header: the tag is in bits 5-2, the low two bits give the size of the length
+		tag = (data[0] >> 2) & 0x0f
+		switch data[0] & 0x03 {
+		case 0:
+			headerLength = 2
+		case 1:
+			headerLength = 3
+		case 2:
+			headerLength = 5
+		default:
+			return false
+		}
+	}
+	if len(data) <= headerLength {
+		return false
+	}
+	version := data[headerLength]
+	switch tag {
+	case 1:
+		return version == 3
+	case 3:
+		return version == 4
+	}
+	return false
+}
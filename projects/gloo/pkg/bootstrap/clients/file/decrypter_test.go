@@ -0,0 +1,169 @@
+package file_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap/clients/file"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/armor"
+	// the hash openpgp prefers for the keys it generates
+	_ "golang.org/x/crypto/ripemd160"
+)
+
+var _ = Describe("Decrypting secret files", func() {
+
+	const secretYaml = `metadata:
+  name: tls
+  namespace: gloo-system
+tls:
+  certChain: cert
+  privateKey: key
+`
+
+	var (
+		dir       string
+		secretDir string
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "decrypter")
+		Expect(err).NotTo(HaveOccurred())
+		secretDir = filepath.Join(dir, "secrets")
+		Expect(os.MkdirAll(filepath.Join(secretDir, "gloo-system"), 0755)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		_ = os.RemoveAll(dir)
+	})
+
+	writeFile := func(name string, data []byte) string {
+		path := filepath.Join(dir, name)
+		Expect(ioutil.WriteFile(path, data, 0644)).To(Succeed())
+		return path
+	}
+
+	writeSecret := func(data []byte) {
+		Expect(ioutil.WriteFile(filepath.Join(secretDir, "gloo-system", "tls.yaml"), data, 0644)).To(Succeed())
+	}
+
+	armored := func(data []byte) []byte {
+		buf := &bytes.Buffer{}
+		w, err := armor.Encode(buf, "PGP MESSAGE", nil)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = w.Write(data)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(w.Close()).To(Succeed())
+		return buf.Bytes()
+	}
+
+	encryptWithPassphrase := func(passphrase string) []byte {
+		buf := &bytes.Buffer{}
+		w, err := openpgp.SymmetricallyEncrypt(buf, []byte(passphrase), nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = w.Write([]byte(secretYaml))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(w.Close()).To(Succeed())
+		return buf.Bytes()
+	}
+
+	secretClient := func(decrypter file.Decrypter) v1.SecretClient {
+		client, err := v1.NewSecretClient(&file.ResourceClientFactory{RootDir: secretDir, Decrypter: decrypter})
+		Expect(err).NotTo(HaveOccurred())
+		return client
+	}
+
+	expectSecret := func(client v1.SecretClient) {
+		secret, err := client.Read("gloo-system", "tls", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(secret.GetTls().GetCertChain()).To(Equal("cert"))
+		Expect(secret.GetTls().GetPrivateKey()).To(Equal("key"))
+
+		list, err := client.List("gloo-system", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list).To(HaveLen(1))
+	}
+
+	It("decrypts files encrypted with a passphrase", func() {
+		decrypter, err := file.NewPGPDecrypter(writeFile("passphrase", []byte("secret passphrase\n")), "")
+		Expect(err).NotTo(HaveOccurred())
+
+		writeSecret(armored(encryptWithPassphrase("secret passphrase")))
+		expectSecret(secretClient(decrypter))
+
+		writeSecret(encryptWithPassphrase("secret passphrase"))
+		expectSecret(secretClient(decrypter))
+	})
+
+	It("decrypts files encrypted to a public key", func() {
+		entity, err := openpgp.NewEntity("gloo", "", "gloo@example.com", nil)
+		Expect(err).NotTo(HaveOccurred())
+		keyBuf := &bytes.Buffer{}
+		keyWriter, err := armor.Encode(keyBuf, openpgp.PrivateKeyType, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entity.SerializePrivate(keyWriter, nil)).To(Succeed())
+		Expect(keyWriter.Close()).To(Succeed())
+		decrypter, err := file.NewPGPDecrypter("", writeFile("key.asc", keyBuf.Bytes()))
+		Expect(err).NotTo(HaveOccurred())
+
+		buf := &bytes.Buffer{}
+		w, err := openpgp.Encrypt(buf, []*openpgp.Entity{entity}, nil, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = w.Write([]byte(secretYaml))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(w.Close()).To(Succeed())
+		writeSecret(buf.Bytes())
+
+		expectSecret(secretClient(decrypter))
+	})
+
+	It("reads plaintext files", func() {
+		decrypter, err := file.NewPGPDecrypter(writeFile("passphrase", []byte("secret passphrase")), "")
+		Expect(err).NotTo(HaveOccurred())
+		writeSecret([]byte(secretYaml))
+		expectSecret(secretClient(decrypter))
+	})
+
+	It("reads plaintext files starting with non-ASCII characters", func() {
+		decrypter, err := file.NewPGPDecrypter(writeFile("passphrase", []byte("secret passphrase")), "")
+		Expect(err).NotTo(HaveOccurred())
+		// a UTF-8 byte order mark, as written by some editors
+		writeSecret(append([]byte("\xef\xbb\xbf"), secretYaml...))
+		expectSecret(secretClient(decrypter))
+
+		// the first byte of "é" is also the header of a passphrase-encrypted session key packet
+		plaintext := []byte("é: accented key\n")
+		Expect(decrypter.Decrypt(plaintext)).To(Equal(plaintext))
+	})
+
+	It("rejects files which cannot be decrypted", func() {
+		decrypter, err := file.NewPGPDecrypter(writeFile("passphrase", []byte("wrong passphrase")), "")
+		Expect(err).NotTo(HaveOccurred())
+		writeSecret(armored(encryptWithPassphrase("secret passphrase")))
+		client := secretClient(decrypter)
+
+		_, err = client.Read("gloo-system", "tls", clients.ReadOpts{})
+		Expect(err).To(HaveOccurred())
+		list, err := client.List("gloo-system", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list).To(BeEmpty())
+	})
+
+	It("does not write secrets", func() {
+		decrypter, err := file.NewPGPDecrypter(writeFile("passphrase", []byte("secret passphrase")), "")
+		Expect(err).NotTo(HaveOccurred())
+		_, err = secretClient(decrypter).Write(&v1.Secret{
+			Metadata: core.Metadata{Namespace: "gloo-system", Name: "other"},
+		}, clients.WriteOpts{})
+		Expect(err).To(HaveOccurred())
+		Expect(filepath.Join(secretDir, "gloo-system", "other.yaml")).NotTo(BeAnExistingFile())
+	})
+})
@@ -0,0 +1,506 @@
+package file
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/ghodss/yaml"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/file"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	"github.com/solo-io/solo-kit/pkg/utils/fileutils"
+	"github.com/solo-io/solo-kit/pkg/utils/protoutils"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	statusFileSuffix = ".status"
+
+	// the reporter of the status of files which were rejected
+	reporter = "gloo"
+)
+
+// ResourceClientFactory builds resource clients storing resources in YAML files under RootDir, in the same layout as
+// the solo-kit file resource client (`<namespace>/<name>.yaml`).
+// Files which fail to parse or validate are rejected, keeping the last valid version of their resource.
+// If StatusFiles is set, statuses are written to `<name>.yaml.status` files instead of the resource files.
+// If Decrypter is set, files are decrypted when they are read, and resources cannot be written.
+type ResourceClientFactory struct {
+	RootDir     string
+	StatusFiles bool
+	Decrypter   Decrypter
+}
+
+func (f *ResourceClientFactory) NewResourceClient(params factory.NewResourceClientParams) (clients.ResourceClient, error) {
+	rc := NewResourceClient(f.RootDir, f.StatusFiles, params.ResourceType)
+	rc.decrypter = f.Decrypter
+	return rc, nil
+}
+
+type ResourceClient struct {
+	*file.ResourceClient
+	dir         string
+	statusFiles bool
+	decrypter   Decrypter
+
+	lock sync.Mutex
+	// the last valid resource read from each file
+	lastValid map[string]resources.Resource
+	// the last error of each rejected file, to only log and report it once
+	rejected map[string]string
+}
+
+func NewResourceClient(dir string, statusFiles bool, resourceType resources.Resource) *ResourceClient {
+	return &ResourceClient{
+		ResourceClient: file.NewResourceClient(dir, resourceType),
+		dir:            dir,
+		statusFiles:    statusFiles,
+		lastValid:      map[string]resources.Resource{},
+		rejected:       map[string]string{},
+	}
+}
+
+var _ clients.ResourceClient = &ResourceClient{}
+
+func (rc *ResourceClient) Read(namespace, name string, opts clients.ReadOpts) (resources.Resource, error) {
+	if rc.decrypter != nil {
+		return rc.readDecrypted(namespace, name)
+	}
+	resource, err := rc.ResourceClient.Read(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := rc.readStatus(rc.filename(namespace, name), resource); err != nil {
+		return nil, err
+	}
+	return resource, nil
+}
+
+func (rc *ResourceClient) Write(resource resources.Resource, opts clients.WriteOpts) (resources.Resource, error) {
+	if rc.decrypter != nil {
+		// never write plaintext next to the encrypted files
+		return nil, errors.Errorf("cannot write %v %v.%v, resources are read from encrypted files", rc.Kind(),
+			resource.GetMetadata().Namespace, resource.GetMetadata().Name)
+	}
+	inputResource, ok := resource.(resources.InputResource)
+	if !rc.statusFiles || !ok {
+		return rc.ResourceClient.Write(resource, opts)
+	}
+	opts = opts.WithDefaults()
+	if err := resources.Validate(resource); err != nil {
+		return nil, errors.Wrapf(err, "validation error")
+	}
+	meta := resource.GetMetadata()
+	path := rc.filename(meta.Namespace, meta.Name)
+
+	original, err := rc.ResourceClient.Read(meta.Namespace, meta.Name, clients.ReadOpts{Ctx: opts.Ctx})
+	if err != nil && !errors.IsNotExist(err) {
+		// never overwrite a file rejected as invalid, so that it can be fixed by its author
+		return nil, err
+	}
+	if original != nil && err == nil && sameSpec(original, resource) {
+		// only the status changed, leave the resource file untouched
+		if !opts.OverwriteExisting {
+			return nil, errors.NewExistErr(meta)
+		}
+		if meta.ResourceVersion != original.GetMetadata().ResourceVersion {
+			return nil, errors.NewResourceVersionErr(meta.Namespace, meta.Name, meta.ResourceVersion, original.GetMetadata().ResourceVersion)
+		}
+		if err := writeStatus(path, inputResource.GetStatus()); err != nil {
+			return nil, err
+		}
+		return resources.Clone(resource), nil
+	}
+
+	withoutStatus := resources.Clone(inputResource).(resources.InputResource)
+	withoutStatus.SetStatus(core.Status{})
+	written, err := rc.ResourceClient.Write(withoutStatus, opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := writeStatus(path, inputResource.GetStatus()); err != nil {
+		return nil, err
+	}
+	written.(resources.InputResource).SetStatus(inputResource.GetStatus())
+	return written, nil
+}
+
+func (rc *ResourceClient) Delete(namespace, name string, opts clients.DeleteOpts) error {
+	if err := rc.ResourceClient.Delete(namespace, name, opts); err != nil {
+		return err
+	}
+	if err := os.Remove(rc.filename(namespace, name) + statusFileSuffix); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "deleting status file of resource %v", name)
+	}
+	return nil
+}
+
+func (rc *ResourceClient) List(namespace string, opts clients.ListOpts) (resources.ResourceList, error) {
+	opts = opts.WithDefaults()
+	namespaces := []string{namespace}
+	if namespace == "" {
+		var err error
+		namespaces, err = subdirectories(rc.dir)
+		if os.IsNotExist(err) {
+			rc.forgetRemovedFiles(namespace, nil)
+			return nil, nil
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "reading namespace dir")
+		}
+	}
+
+	var resourceList resources.ResourceList
+	seen := map[string]bool{}
+	for _, ns := range namespaces {
+		nsResources, err := rc.listSingleNamespace(ns, opts, seen)
+		if err != nil {
+			return nil, err
+		}
+		resourceList = append(resourceList, nsResources...)
+	}
+	rc.forgetRemovedFiles(namespace, seen)
+	return resourceList.Sort(), nil
+}
+
+// forgetRemovedFiles drops the last valid resource and error of the files of the namespace (or of every namespace)
+// which were not seen when listing it, so that deleted files are not kept around.
+func (rc *ResourceClient) forgetRemovedFiles(namespace string, seen map[string]bool) {
+	namespaceDir := filepath.Join(rc.dir, namespace)
+	removed := func(path string) bool {
+		if seen[path] {
+			return false
+		}
+		if namespace == "" {
+			return true
+		}
+		return filepath.Dir(path) == namespaceDir
+	}
+
+	rc.lock.Lock()
+	defer rc.lock.Unlock()
+	for path := range rc.lastValid {
+		if removed(path) {
+			delete(rc.lastValid, path)
+		}
+	}
+	for path := range rc.rejected {
+		if removed(path) {
+			delete(rc.rejected, path)
+		}
+	}
+}
+
+func (rc *ResourceClient) listSingleNamespace(namespace string, opts clients.ListOpts, seen map[string]bool) (resources.ResourceList, error) {
+	namespaceDir := filepath.Join(rc.dir, namespace)
+	files, err := ioutil.ReadDir(namespaceDir)
+	if os.IsNotExist(err) {
+		// no resources have been written to the namespace yet
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading namespace dir")
+	}
+
+	var resourceList resources.ResourceList
+	for _, f := range files {
+		if f.IsDir() || !isResourceFile(f.Name()) {
+			continue
+		}
+		path := filepath.Join(namespaceDir, f.Name())
+		seen[path] = true
+		resource := rc.readValidFile(opts, namespace, path)
+		if resource == nil {
+			continue
+		}
+		if labels.SelectorFromSet(opts.Selector).Matches(labels.Set(resource.GetMetadata().Labels)) {
+			resourceList = append(resourceList, resource)
+		}
+	}
+	sort.SliceStable(resourceList, func(i, j int) bool {
+		return resourceList[i].GetMetadata().Name < resourceList[j].GetMetadata().Name
+	})
+	return resourceList, nil
+}
+
+// readValidFile returns the resource in a file, or the last valid resource read from it if the file is invalid.
+func (rc *ResourceClient) readValidFile(opts clients.ListOpts, namespace, path string) resources.Resource {
+	resource := rc.NewResource()
+	err := rc.readFile(path, resource)
+	if err == nil {
+		err = validateFile(namespace, path, resource)
+	}
+	if err == nil {
+		err = rc.readStatus(path, resource)
+	}
+
+	rc.lock.Lock()
+	defer rc.lock.Unlock()
+	if err == nil {
+		rc.lastValid[path] = resource
+		delete(rc.rejected, path)
+		return resources.Clone(resource)
+	}
+
+	if rc.rejected[path] != err.Error() {
+		rc.rejected[path] = err.Error()
+		contextutils.LoggerFrom(opts.Ctx).Warnw("rejected invalid resource file, keeping its last valid version",
+			zap.String("file", path), zap.Error(err))
+		if rc.statusFiles {
+			status := core.Status{State: core.Status_Rejected, Reason: err.Error(), ReportedBy: reporter}
+			if writeErr := writeStatus(path, status); writeErr != nil {
+				contextutils.LoggerFrom(opts.Ctx).Warnw("failed to write status file", zap.String("file", path), zap.Error(writeErr))
+			}
+		}
+	}
+	lastValid, ok := rc.lastValid[path]
+	if !ok {
+		return nil
+	}
+	return resources.Clone(lastValid)
+}
+
+// validateFile checks that a resource is valid and that its file is named after it.
+func validateFile(namespace, path string, resource resources.Resource) error {
+	if err := resources.Validate(resource); err != nil {
+		return err
+	}
+	meta := resource.GetMetadata()
+	if name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path)); meta.Name != name {
+		return errors.Errorf("resource name %v does not match its file name %v", meta.Name, name)
+	}
+	if meta.Namespace != namespace {
+		return errors.Errorf("resource namespace %v does not match its directory %v", meta.Namespace, namespace)
+	}
+	return nil
+}
+
+// Watch lists the resources whenever their files change, and every refresh rate.
+func (rc *ResourceClient) Watch(namespace string, opts clients.WatchOpts) (<-chan resources.ResourceList, <-chan error, error) {
+	opts = opts.WithDefaults()
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "starting watch on namespace dir")
+	}
+	dir := filepath.Join(rc.dir, namespace)
+	if err := watchRecursive(watcher, dir); err != nil {
+		watcher.Close()
+		return nil, nil, errors.Wrapf(err, "starting watch on namespace dir")
+	}
+	if namespace != "" && isDir(rc.dir) {
+		// the namespace dir may be created later
+		if err := watcher.Add(rc.dir); err != nil {
+			watcher.Close()
+			return nil, nil, errors.Wrapf(err, "starting watch on root dir")
+		}
+	}
+
+	resourcesChan := make(chan resources.ResourceList)
+	errs := make(chan error)
+	listOpts := clients.ListOpts{Ctx: opts.Ctx, Selector: opts.Selector}
+	var previous resources.ResourceList
+	updateResourceList := func() {
+		list, err := rc.List(namespace, listOpts)
+		if err != nil {
+			select {
+			case errs <- err:
+			case <-opts.Ctx.Done():
+			}
+			return
+		}
+		// writing status files triggers events, skip them
+		if previous != nil && list.Equal(previous) {
+			return
+		}
+		previous = list
+		select {
+		case resourcesChan <- list:
+		case <-opts.Ctx.Done():
+		}
+	}
+	go func() {
+		defer watcher.Close()
+		defer close(resourcesChan)
+		defer close(errs)
+		updateResourceList()
+		for {
+			select {
+			case <-time.After(opts.RefreshRate):
+				updateResourceList()
+			case event := <-watcher.Events:
+				if !isInDir(event.Name, dir) {
+					// events of other namespaces in the root dir
+					continue
+				}
+				if event.Op&fsnotify.Create != 0 {
+					// watch new namespace directories
+					_ = watchRecursive(watcher, event.Name)
+				}
+				if !isResourceFile(event.Name) && !isDir(event.Name) {
+					continue
+				}
+				updateResourceList()
+			case err := <-watcher.Errors:
+				select {
+				case errs <- errors.Wrapf(err, "file watcher error"):
+				case <-opts.Ctx.Done():
+				}
+			case <-opts.Ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return resourcesChan, errs, nil
+}
+
+func (rc *ResourceClient) readDecrypted(namespace, name string) (resources.Resource, error) {
+	if err := resources.ValidateName(name); err != nil {
+		return nil, errors.Wrapf(err, "validation error")
+	}
+	path := rc.filename(namespace, name)
+	if _, err := os.Stat(path); err != nil && os.IsNotExist(err) {
+		return nil, errors.NewNotExistErr(namespace, name, err)
+	}
+	resource := rc.NewResource()
+	if err := rc.readFile(path, resource); err != nil {
+		return nil, errors.Wrapf(err, "reading file into %v", rc.Kind())
+	}
+	if err := rc.readStatus(path, resource); err != nil {
+		return nil, err
+	}
+	return resource, nil
+}
+
+// readFile reads a resource from a file, decrypting it if needed
+func (rc *ResourceClient) readFile(path string, resource resources.Resource) error {
+	if rc.decrypter == nil {
+		return fileutils.ReadFileInto(path, resource)
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return errors.Errorf("error reading file: %v", err)
+	}
+	plaintext, err := rc.decrypter.Decrypt(data)
+	if err != nil {
+		return err
+	}
+	jsn, err := yaml.YAMLToJSON(plaintext)
+	if err != nil {
+		return err
+	}
+	return protoutils.UnmarshalBytes(jsn, resource)
+}
+
+func (rc *ResourceClient) filename(namespace, name string) string {
+	return filepath.Join(rc.dir, namespace, name) + ".yaml"
+}
+
+// readStatus sets the status of an input resource from its status file, if statuses are written to status files.
+func (rc *ResourceClient) readStatus(path string, resource resources.Resource) error {
+	inputResource, ok := resource.(resources.InputResource)
+	if !rc.statusFiles || !ok {
+		return nil
+	}
+	data, err := ioutil.ReadFile(path + statusFileSuffix)
+	if os.IsNotExist(err) {
+		inputResource.SetStatus(core.Status{})
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "reading status file")
+	}
+	jsn, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return errors.Wrapf(err, "parsing status file")
+	}
+	var status core.Status
+	if err := jsonpb.Unmarshal(bytes.NewReader(jsn), &status); err != nil {
+		return errors.Wrapf(err, "parsing status file")
+	}
+	inputResource.SetStatus(status)
+	return nil
+}
+
+func writeStatus(path string, status core.Status) error {
+	jsn, err := (&jsonpb.Marshaler{}).MarshalToString(&status)
+	if err != nil {
+		return err
+	}
+	data, err := yaml.JSONToYAML([]byte(jsn))
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path+statusFileSuffix, data, 0644)
+}
+
+// sameSpec returns whether two resources only differ by their status and resource version.
+func sameSpec(a, b resources.Resource) bool {
+	strip := func(resource resources.Resource) proto.Message {
+		clone := resources.Clone(resource)
+		if inputResource, ok := clone.(resources.InputResource); ok {
+			inputResource.SetStatus(core.Status{})
+		}
+		resources.UpdateMetadata(clone, func(meta *core.Metadata) {
+			meta.ResourceVersion = ""
+		})
+		return clone.(proto.Message)
+	}
+	return proto.Equal(strip(a), strip(b))
+}
+
+func isResourceFile(name string) bool {
+	return strings.HasSuffix(name, ".yaml") || strings.HasSuffix(name, ".yml")
+}
+
+func isDir(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
+
+func isInDir(path, dir string) bool {
+	return path == dir || strings.HasPrefix(path, dir+string(filepath.Separator))
+}
+
+func subdirectories(dir string) ([]string, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var dirs []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			dirs = append(dirs, entry.Name())
+		}
+	}
+	return dirs, nil
+}
+
+func watchRecursive(watcher *fsnotify.Watcher, dir string) error {
+	if !isDir(dir) {
+		return nil
+	}
+	return filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return watcher.Add(path)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,148 @@
+package file_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
+	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap/clients/file"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("ResourceClient", func() {
+
+	var (
+		dir    string
+		client v1.UpstreamClient
+	)
+
+	upstream := func(name string) *v1.Upstream {
+		return &v1.Upstream{
+			Metadata: core.Metadata{Namespace: "gloo-system", Name: name},
+			UpstreamType: &v1.Upstream_Static{Static: &static.UpstreamSpec{
+				Hosts: []*static.Host{{Addr: "127.0.0.1", Port: 8080}},
+			}},
+		}
+	}
+
+	filename := func(name string) string {
+		return filepath.Join(dir, "gloo-system", name+".yaml")
+	}
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "file-client")
+		Expect(err).NotTo(HaveOccurred())
+		client, err = v1.NewUpstreamClient(&file.ResourceClientFactory{RootDir: dir, StatusFiles: true})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		_ = os.RemoveAll(dir)
+	})
+
+	It("writes statuses to status files without touching the resource files", func() {
+		written, err := client.Write(upstream("us"), clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		before, err := ioutil.ReadFile(filename("us"))
+		Expect(err).NotTo(HaveOccurred())
+
+		written.Status = core.Status{State: core.Status_Accepted, ReportedBy: "gloo"}
+		_, err = client.Write(written, clients.WriteOpts{OverwriteExisting: true})
+		Expect(err).NotTo(HaveOccurred())
+
+		after, err := ioutil.ReadFile(filename("us"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(after).To(Equal(before))
+		Expect(filename("us") + ".status").To(BeAnExistingFile())
+
+		read, err := client.Read("gloo-system", "us", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(read.Status).To(Equal(written.Status))
+
+		Expect(client.Delete("gloo-system", "us", clients.DeleteOpts{})).NotTo(HaveOccurred())
+		Expect(filename("us") + ".status").NotTo(BeAnExistingFile())
+	})
+
+	It("rejects invalid files, keeping their last valid resource", func() {
+		_, err := client.Write(upstream("us"), clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		list, err := client.List("gloo-system", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list).To(HaveLen(1))
+
+		Expect(ioutil.WriteFile(filename("us"), []byte("static: [broken"), 0644)).NotTo(HaveOccurred())
+		Expect(ioutil.WriteFile(filename("new"), []byte("metadata: {name: other, namespace: gloo-system}"), 0644)).NotTo(HaveOccurred())
+
+		list, err = client.List("", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list.Names()).To(ConsistOf("us"))
+
+		status, err := ioutil.ReadFile(filename("us") + ".status")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(status)).To(ContainSubstring("state: Rejected"))
+		status, err = ioutil.ReadFile(filename("new") + ".status")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(status)).To(ContainSubstring("does not match its file name"))
+
+		// statuses are not written over invalid files
+		list[0].Status = core.Status{State: core.Status_Accepted}
+		_, err = client.Write(list[0], clients.WriteOpts{OverwriteExisting: true})
+		Expect(err).To(HaveOccurred())
+		Expect(ioutil.ReadFile(filename("us"))).To(Equal([]byte("static: [broken")))
+	})
+
+	It("forgets the last valid resource of deleted files", func() {
+		_, err := client.Write(upstream("us"), clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = client.List("gloo-system", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ioutil.WriteFile(filename("us"), []byte("static: [broken"), 0644)).NotTo(HaveOccurred())
+		list, err := client.List("gloo-system", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list.Names()).To(ConsistOf("us"))
+
+		Expect(os.Remove(filename("us"))).NotTo(HaveOccurred())
+		list, err = client.List("gloo-system", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list).To(BeEmpty())
+
+		// an invalid file created with the same name doesn't bring the deleted resource back
+		Expect(ioutil.WriteFile(filename("us"), []byte("static: [broken"), 0644)).NotTo(HaveOccurred())
+		list, err = client.List("", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list).To(BeEmpty())
+	})
+
+	It("lists the resources when their files change", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		Expect(os.MkdirAll(filepath.Join(dir, "gloo-system"), 0755)).NotTo(HaveOccurred())
+		watch, _, err := client.Watch("gloo-system", clients.WatchOpts{Ctx: ctx, RefreshRate: time.Hour})
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(watch).Should(Receive(BeEmpty()))
+
+		_, err = client.Write(upstream("us"), clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(watch, "5s").Should(Receive(HaveLen(1)))
+	})
+
+	It("watches namespace dirs created after the watch started", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		watch, _, err := client.Watch("gloo-system", clients.WatchOpts{Ctx: ctx, RefreshRate: time.Hour})
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(watch).Should(Receive(BeEmpty()))
+
+		_, err = client.Write(upstream("us"), clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(watch, "5s").Should(Receive(HaveLen(1)))
+	})
+})
@@ -0,0 +1,382 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/vault/api"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	"github.com/solo-io/solo-kit/pkg/utils/protoutils"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	// DefaultPathPrefix is the default mount path of the KV version 2 secrets engine
+	DefaultPathPrefix = "secret"
+
+	dataKey        = "data"
+	optionsKey     = "options"
+	checkAndSetKey = "cas"
+
+	directoryTypeData     = "data"
+	directoryTypeMetadata = "metadata"
+)
+
+// ResourceClientFactory builds resource clients storing resources in a KV version 2 secrets engine
+// mounted at PathPrefix. Resources are stored in the same layout as the solo-kit vault resource client, so
+// secrets written by either client can be read by the other.
+type ResourceClientFactory struct {
+	Vault      *api.Client
+	RootKey    string
+	PathPrefix string
+	// if set, overrides the refresh rate of watches
+	RefreshRate time.Duration
+}
+
+func (f *ResourceClientFactory) NewResourceClient(params factory.NewResourceClientParams) (clients.ResourceClient, error) {
+	versionedResource, ok := params.ResourceType.(resources.VersionedResource)
+	if !ok {
+		return nil, errors.Errorf("the vault storage client can only be used for resources which implement the resources.VersionedResource interface resource type. received type %v", resources.Kind(params.ResourceType))
+	}
+	return NewResourceClient(f.Vault, f.PathPrefix, f.RootKey, f.RefreshRate, versionedResource), nil
+}
+
+// ResourceClient stores resources as versioned KV version 2 secrets. The resource version of a resource is the
+// version of its secret, and writes use check-and-set on it.
+type ResourceClient struct {
+	vault        *api.Client
+	pathPrefix   string
+	root         string
+	refreshRate  time.Duration
+	resourceType resources.VersionedResource
+}
+
+func NewResourceClient(client *api.Client, pathPrefix, rootKey string, refreshRate time.Duration, resourceType resources.VersionedResource) *ResourceClient {
+	if pathPrefix == "" {
+		pathPrefix = DefaultPathPrefix
+	}
+	return &ResourceClient{
+		vault:        client,
+		pathPrefix:   strings.Trim(pathPrefix, "/"),
+		root:         rootKey,
+		refreshRate:  refreshRate,
+		resourceType: resourceType,
+	}
+}
+
+var _ clients.ResourceClient = &ResourceClient{}
+
+func (rc *ResourceClient) Kind() string {
+	return resources.Kind(rc.resourceType)
+}
+
+func (rc *ResourceClient) NewResource() resources.Resource {
+	return resources.Clone(rc.resourceType)
+}
+
+func (rc *ResourceClient) Register() error {
+	return nil
+}
+
+func (rc *ResourceClient) fromVaultSecret(secret *api.Secret) (resources.Resource, bool, error) {
+	if secret.Data == nil {
+		return nil, false, errors.Errorf("secret data cannot be nil")
+	}
+	metadata, ok := secret.Data["metadata"].(map[string]interface{})
+	if !ok {
+		return nil, false, errors.Errorf("secret metadata is missing, is the secrets engine KV version 2?")
+	}
+	// the latest version of the secret was deleted or destroyed
+	deletionTime, _ := metadata["deletion_time"].(string)
+	destroyed, _ := metadata["destroyed"].(bool)
+	if deletionTime != "" || destroyed {
+		return nil, true, nil
+	}
+	version, err := parseVersion(metadata["version"])
+	if err != nil {
+		return nil, false, err
+	}
+	data, ok := secret.Data[dataKey].(map[string]interface{})
+	if !ok {
+		return nil, false, errors.Errorf("secret data is missing")
+	}
+
+	resource := rc.NewResource()
+	if err := protoutils.UnmarshalMap(data, resource); err != nil {
+		return nil, false, err
+	}
+	resources.UpdateMetadata(resource, func(meta *core.Metadata) {
+		meta.ResourceVersion = strconv.Itoa(version)
+	})
+	return resource, false, nil
+}
+
+// the vault client decodes numbers as json.Number
+func parseVersion(version interface{}) (int, error) {
+	switch v := version.(type) {
+	case json.Number:
+		i, err := v.Int64()
+		return int(i), err
+	case float64:
+		return int(v), nil
+	case int:
+		return v, nil
+	}
+	return 0, errors.Errorf("invalid secret version %v", version)
+}
+
+func (rc *ResourceClient) toVaultSecret(resource resources.Resource) (map[string]interface{}, error) {
+	var version int
+	if rv := resource.GetMetadata().ResourceVersion; rv != "" {
+		var err error
+		version, err = strconv.Atoi(rv)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid resource version: %v (must be int)", rv)
+		}
+	}
+
+	data, err := protoutils.MarshalMap(resource)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		dataKey: data,
+		optionsKey: map[string]interface{}{
+			checkAndSetKey: version,
+		},
+	}, nil
+}
+
+func (rc *ResourceClient) Read(namespace, name string, opts clients.ReadOpts) (resources.Resource, error) {
+	if err := resources.ValidateName(name); err != nil {
+		return nil, errors.Wrapf(err, "validation error")
+	}
+	opts = opts.WithDefaults()
+
+	secret, err := rc.vault.Logical().Read(rc.resourceKey(namespace, name, directoryTypeData))
+	if err != nil {
+		return nil, errors.Wrapf(err, "performing vault KV get")
+	}
+	if secret == nil {
+		return nil, errors.NewNotExistErr(namespace, name)
+	}
+
+	resource, deleted, err := rc.fromVaultSecret(secret)
+	if err != nil {
+		return nil, err
+	}
+	if deleted {
+		return nil, errors.NewNotExistErr(namespace, name)
+	}
+	return resource, nil
+}
+
+func (rc *ResourceClient) Write(resource resources.Resource, opts clients.WriteOpts) (resources.Resource, error) {
+	opts = opts.WithDefaults()
+	if err := resources.ValidateName(resource.GetMetadata().Name); err != nil {
+		return nil, errors.Wrapf(err, "validation error")
+	}
+	meta := resource.GetMetadata()
+	if meta.Namespace == "" {
+		return nil, errors.Errorf("namespace cannot be empty for vault-backed resources")
+	}
+
+	original, err := rc.Read(meta.Namespace, meta.Name, clients.ReadOpts{Ctx: opts.Ctx})
+	if original != nil && err == nil {
+		if !opts.OverwriteExisting {
+			return nil, errors.NewExistErr(meta)
+		}
+		if meta.ResourceVersion != original.GetMetadata().ResourceVersion {
+			return nil, errors.NewResourceVersionErr(meta.Namespace, meta.Name, meta.ResourceVersion, original.GetMetadata().ResourceVersion)
+		}
+	}
+
+	// mutate and return clone
+	clone := resources.Clone(resource)
+	clone.SetMetadata(meta)
+
+	secret, err := rc.toVaultSecret(clone)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := rc.vault.Logical().Write(rc.resourceKey(meta.Namespace, meta.Name, directoryTypeData), secret); err != nil {
+		return nil, errors.Wrapf(err, "writing to KV")
+	}
+	// return a read object to update the resource version
+	return rc.Read(meta.Namespace, meta.Name, clients.ReadOpts{Ctx: opts.Ctx})
+}
+
+func (rc *ResourceClient) Delete(namespace, name string, opts clients.DeleteOpts) error {
+	opts = opts.WithDefaults()
+	if namespace == "" {
+		return errors.Errorf("namespace cannot be empty for vault-backed resources")
+	}
+
+	if !opts.IgnoreNotExist {
+		if _, err := rc.Read(namespace, name, clients.ReadOpts{Ctx: opts.Ctx}); err != nil {
+			return err
+		}
+	}
+	// deleting the metadata deletes every version of the secret
+	if _, err := rc.vault.Logical().Delete(rc.resourceKey(namespace, name, directoryTypeMetadata)); err != nil {
+		return errors.Wrapf(err, "deleting resource %v", name)
+	}
+	return nil
+}
+
+func (rc *ResourceClient) List(namespace string, opts clients.ListOpts) (resources.ResourceList, error) {
+	opts = opts.WithDefaults()
+	if namespace != "" {
+		return rc.listSingleNamespace(namespace, opts)
+	}
+
+	namespaces, err := rc.listKeys(rc.resourceDirectory("", directoryTypeMetadata))
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading namespace root")
+	}
+	var resourceList resources.ResourceList
+	for _, ns := range namespaces {
+		nsResources, err := rc.listSingleNamespace(strings.TrimSuffix(ns, "/"), opts)
+		if err != nil {
+			return nil, err
+		}
+		resourceList = append(resourceList, nsResources...)
+	}
+	return resourceList.Sort(), nil
+}
+
+func (rc *ResourceClient) listSingleNamespace(namespace string, opts clients.ListOpts) (resources.ResourceList, error) {
+	keys, err := rc.listKeys(rc.resourceDirectory(namespace, directoryTypeMetadata))
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading resource namespace directory")
+	}
+
+	var resourceList resources.ResourceList
+	for _, key := range keys {
+		secret, err := rc.vault.Logical().Read(rc.resourceKey(namespace, key, directoryTypeData))
+		if err != nil {
+			return nil, errors.Wrapf(err, "getting secret %s", key)
+		}
+		if secret == nil {
+			// deleted between the list and the read
+			continue
+		}
+		resource, deleted, err := rc.fromVaultSecret(secret)
+		if err != nil {
+			return nil, err
+		}
+		if !deleted && labels.SelectorFromSet(opts.Selector).Matches(labels.Set(resource.GetMetadata().Labels)) {
+			resourceList = append(resourceList, resource)
+		}
+	}
+	return resourceList.Sort(), nil
+}
+
+func (rc *ResourceClient) listKeys(directory string) ([]string, error) {
+	keyList, err := rc.vault.Logical().List(directory)
+	if err != nil {
+		return nil, errors.Wrapf(err, "listing directory %v", directory)
+	}
+	if keyList == nil {
+		return nil, nil
+	}
+	val, ok := keyList.Data["keys"]
+	if !ok {
+		return nil, errors.Errorf("vault secret list at root %s did not contain key \"keys\"", directory)
+	}
+	keys, ok := val.([]interface{})
+	if !ok {
+		return nil, errors.Errorf("expected secret list of type []interface{} but got %v", reflect.TypeOf(val))
+	}
+
+	var keysAsStrings []string
+	for _, keyAsInterface := range keys {
+		key, ok := keyAsInterface.(string)
+		if !ok {
+			return nil, errors.Errorf("expected key of type string but got %v", reflect.TypeOf(keyAsInterface))
+		}
+		keysAsStrings = append(keysAsStrings, key)
+	}
+	return keysAsStrings, nil
+}
+
+// Watch polls Vault for the resources every refresh rate, as the KV secrets engine has no watch API.
+func (rc *ResourceClient) Watch(namespace string, opts clients.WatchOpts) (<-chan resources.ResourceList, <-chan error, error) {
+	opts = opts.WithDefaults()
+	refreshRate := opts.RefreshRate
+	if rc.refreshRate > 0 {
+		refreshRate = rc.refreshRate
+	}
+	listOpts := clients.ListOpts{
+		Ctx:      opts.Ctx,
+		Selector: opts.Selector,
+	}
+
+	resourcesChan := make(chan resources.ResourceList)
+	errs := make(chan error)
+	go func() {
+		defer close(resourcesChan)
+		defer close(errs)
+		// watch should open up with an initial read
+		timer := time.NewTimer(0)
+		defer timer.Stop()
+		for {
+			select {
+			case <-timer.C:
+				list, err := rc.List(namespace, listOpts)
+				if err != nil {
+					if !sendErr(opts.Ctx, errs, err) {
+						return
+					}
+				} else {
+					select {
+					case resourcesChan <- list:
+					case <-opts.Ctx.Done():
+						return
+					}
+				}
+				timer.Reset(refreshRate)
+			case <-opts.Ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return resourcesChan, errs, nil
+}
+
+func sendErr(ctx context.Context, errs chan<- error, err error) bool {
+	select {
+	case errs <- err:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func (rc *ResourceClient) resourceDirectory(namespace, directoryType string) string {
+	return strings.Join([]string{
+		rc.pathPrefix,
+		directoryType,
+		rc.root,
+		rc.resourceType.GroupVersionKind().Group,
+		rc.resourceType.GroupVersionKind().Version,
+		rc.resourceType.GroupVersionKind().Kind,
+		namespace,
+	}, "/")
+}
+
+func (rc *ResourceClient) resourceKey(namespace, name, directoryType string) string {
+	return strings.Join([]string{
+		rc.resourceDirectory(namespace, directoryType),
+		name,
+	}, "/")
+}
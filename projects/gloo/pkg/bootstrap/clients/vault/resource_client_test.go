@@ -0,0 +1,164 @@
+package vault_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/vault/api"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap/clients/vault"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+// fakeKv serves the subset of the KV version 2 API used by the resource client, for the engine mounted at mount
+type fakeKv struct {
+	mount    string
+	lock     sync.Mutex
+	versions map[string][]interface{}
+}
+
+func (kv *fakeKv) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	kv.lock.Lock()
+	defer kv.lock.Unlock()
+	path := strings.TrimPrefix(r.URL.Path, "/v1/"+kv.mount+"/")
+	switch {
+	case strings.HasPrefix(path, "data/") && r.Method == http.MethodGet:
+		versions := kv.versions[strings.TrimPrefix(path, "data/")]
+		if len(versions) == 0 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		writeJson(w, map[string]interface{}{"data": map[string]interface{}{
+			"data":     versions[len(versions)-1],
+			"metadata": map[string]interface{}{"version": len(versions), "deletion_time": "", "destroyed": false},
+		}})
+	case strings.HasPrefix(path, "data/"):
+		var body struct {
+			Data    interface{}
+			Options struct{ Cas int }
+		}
+		Expect(json.NewDecoder(r.Body).Decode(&body)).NotTo(HaveOccurred())
+		key := strings.TrimPrefix(path, "data/")
+		if body.Options.Cas != len(kv.versions[key]) {
+			w.WriteHeader(http.StatusBadRequest)
+			writeJson(w, map[string]interface{}{"errors": []string{"check-and-set parameter did not match the current version"}})
+			return
+		}
+		kv.versions[key] = append(kv.versions[key], body.Data)
+		writeJson(w, map[string]interface{}{"data": map[string]interface{}{"version": len(kv.versions[key])}})
+	case strings.HasPrefix(path, "metadata/") && r.Method == http.MethodDelete:
+		delete(kv.versions, strings.TrimPrefix(path, "metadata/"))
+		w.WriteHeader(http.StatusNoContent)
+	case strings.HasPrefix(path, "metadata/"):
+		dir := strings.TrimSuffix(strings.TrimPrefix(path, "metadata/"), "/") + "/"
+		keys := map[string]bool{}
+		for key := range kv.versions {
+			if strings.HasPrefix(key, dir) {
+				child := strings.SplitAfter(strings.TrimPrefix(key, dir), "/")[0]
+				keys[child] = true
+			}
+		}
+		if len(keys) == 0 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var list []string
+		for key := range keys {
+			list = append(list, key)
+		}
+		writeJson(w, map[string]interface{}{"data": map[string]interface{}{"keys": list}})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func writeJson(w http.ResponseWriter, body interface{}) {
+	Expect(json.NewEncoder(w).Encode(body)).NotTo(HaveOccurred())
+}
+
+var _ = Describe("ResourceClient", func() {
+
+	var (
+		server *httptest.Server
+		client v1.SecretClient
+	)
+
+	secret := func(namespace, name string) *v1.Secret {
+		return &v1.Secret{
+			Metadata: core.Metadata{Namespace: namespace, Name: name},
+			Kind:     &v1.Secret_Tls{Tls: &v1.TlsSecret{CertChain: "cert", PrivateKey: "key"}},
+		}
+	}
+
+	BeforeEach(func() {
+		server = httptest.NewServer(&fakeKv{mount: "kv", versions: map[string][]interface{}{}})
+		vaultClient, err := api.NewClient(&api.Config{Address: server.URL})
+		Expect(err).NotTo(HaveOccurred())
+		vaultClient.SetToken("root")
+
+		client, err = v1.NewSecretClient(&vault.ResourceClientFactory{
+			Vault:       vaultClient,
+			RootKey:     "gloo",
+			PathPrefix:  "kv",
+			RefreshRate: 10 * time.Millisecond,
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("versions secrets written to the mount path", func() {
+		written, err := client.Write(secret("gloo-system", "tls"), clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(written.GetMetadata().ResourceVersion).To(Equal("1"))
+
+		read, err := client.Read("gloo-system", "tls", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(read).To(Equal(written))
+
+		// writes must be based on the latest version
+		_, err = client.Write(secret("gloo-system", "tls"), clients.WriteOpts{OverwriteExisting: true})
+		Expect(errors.IsResourceVersion(err)).To(BeTrue())
+		written, err = client.Write(read, clients.WriteOpts{OverwriteExisting: true})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(written.GetMetadata().ResourceVersion).To(Equal("2"))
+
+		Expect(client.Delete("gloo-system", "tls", clients.DeleteOpts{})).NotTo(HaveOccurred())
+		_, err = client.Read("gloo-system", "tls", clients.ReadOpts{})
+		Expect(errors.IsNotExist(err)).To(BeTrue())
+	})
+
+	It("lists secrets in every namespace", func() {
+		for _, ns := range []string{"a", "b"} {
+			_, err := client.Write(secret(ns, "tls"), clients.WriteOpts{})
+			Expect(err).NotTo(HaveOccurred())
+		}
+		list, err := client.List("", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list).To(HaveLen(2))
+	})
+
+	It("polls for changes at the refresh rate", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		watch, errs, err := client.Watch("gloo-system", clients.WatchOpts{Ctx: ctx, RefreshRate: time.Hour})
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(watch).Should(Receive(BeEmpty()))
+
+		_, err = client.Write(secret("gloo-system", "tls"), clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(watch).Should(Receive(HaveLen(1)))
+		Consistently(errs).ShouldNot(Receive())
+	})
+})
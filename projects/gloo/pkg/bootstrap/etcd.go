@@ -0,0 +1,68 @@
+package bootstrap
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+
+	"github.com/gogo/protobuf/types"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap/clients/etcd"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+// EtcdClientForSettings connects to etcd until ctx is done, if it is the config or artifact source.
+// Otherwise it returns nil.
+func EtcdClientForSettings(ctx context.Context, settings *v1.Settings) (*etcd.Client, error) {
+	if settings.GetEtcdKvSource() == nil && settings.GetEtcdKvArtifactSource() == nil {
+		return nil, nil
+	}
+	etcdSettings := settings.GetEtcd()
+	opts := etcd.Options{
+		Endpoints: etcdSettings.GetEndpoints(),
+		Username:  etcdSettings.GetUsername(),
+		Password:  etcdSettings.GetPassword(),
+	}
+	if requestTimeout := etcdSettings.GetRequestTimeout(); requestTimeout != nil {
+		duration, err := types.DurationFromProto(requestTimeout)
+		if err != nil {
+			return nil, err
+		}
+		opts.RequestTimeout = duration
+	}
+	tlsConfig, err := etcdTLSConfig(etcdSettings)
+	if err != nil {
+		return nil, err
+	}
+	opts.TLSConfig = tlsConfig
+	return etcd.NewClient(ctx, opts)
+}
+
+// returns nil unless TLS is configured
+func etcdTLSConfig(etcdSettings *v1.Settings_EtcdConfiguration) (*tls.Config, error) {
+	caFile, certFile, keyFile := etcdSettings.GetCaFile(), etcdSettings.GetCertFile(), etcdSettings.GetKeyFile()
+	insecureSkipVerify := etcdSettings.GetInsecureSkipVerify().GetValue()
+	if caFile == "" && certFile == "" && keyFile == "" && !insecureSkipVerify {
+		return nil, nil
+	}
+	tlsConfig := &tls.Config{InsecureSkipVerify: insecureSkipVerify}
+	if caFile != "" {
+		caCert, err := ioutil.ReadFile(caFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "reading etcd CA certificate")
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(caCert) {
+			return nil, errors.Errorf("no certificates found in etcd CA file %v", caFile)
+		}
+	}
+	if certFile != "" || keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "loading etcd client certificate")
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
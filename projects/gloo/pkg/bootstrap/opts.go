@@ -5,8 +5,10 @@ import (
 	"net"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/solo-io/gloo/projects/gloo/pkg/validation"
 
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/external"
 	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
 
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
@@ -33,12 +35,15 @@ type Opts struct {
 	RateLimitConfigs  factory.ResourceClientFactory
 	KubeClient        kubernetes.Interface
 	Consul            Consul
+	ExternalPlugins   []*external.Plugin
 	WatchOpts         clients.WatchOpts
 	DevMode           bool
 	ControlPlane      ControlPlane
 	ValidationServer  ValidationServer
 	Settings          *v1.Settings
 	KubeCoreCache     corecache.KubeCoreCache
+	// components that can apply Settings changes without being restarted register here
+	SettingsReloaders *SettingsReloaders
 }
 
 type Consul struct {
@@ -64,3 +69,32 @@ type GrpcService struct {
 	GrpcServer      *grpc.Server
 	StartGrpcServer bool
 }
+
+// SettingsReloaders applies new Settings to running components, so that changes which only affect translation
+// don't require restarting the control plane.
+type SettingsReloaders struct {
+	reloaders []func(settings *v1.Settings) error
+}
+
+// Add registers a func applying new Settings. Adding to nil SettingsReloaders is a no-op, as Settings are not
+// reloaded in that case.
+func (r *SettingsReloaders) Add(reload func(settings *v1.Settings) error) {
+	if r == nil {
+		return
+	}
+	r.reloaders = append(r.reloaders, reload)
+}
+
+// Reload applies settings to every registered component.
+func (r *SettingsReloaders) Reload(settings *v1.Settings) error {
+	if r == nil {
+		return nil
+	}
+	var multiErr *multierror.Error
+	for _, reload := range r.reloaders {
+		if err := reload(settings); err != nil {
+			multiErr = multierror.Append(multiErr, err)
+		}
+	}
+	return multiErr.ErrorOrNil()
+}
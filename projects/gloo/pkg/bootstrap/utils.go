@@ -13,6 +13,9 @@ import (
 	"github.com/solo-io/gloo/pkg/utils/settingsutil"
 	kubeconverters "github.com/solo-io/gloo/projects/gloo/pkg/api/converters/kube"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap/clients/etcd"
+	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap/clients/file"
+	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap/clients/vault"
 	"github.com/solo-io/go-utils/kubeutils"
 	"github.com/solo-io/solo-kit/pkg/api/external/kubernetes/service"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
@@ -25,7 +28,7 @@ import (
 	"k8s.io/client-go/rest"
 )
 
-// used for vault and consul key-value storage
+// used for vault, consul and etcd key-value storage
 const DefaultRootKey = "gloo"
 
 type ConfigFactoryParams struct {
@@ -33,13 +36,15 @@ type ConfigFactoryParams struct {
 	memory   configFactoryParamsMemory
 	kube     configFactoryParamsKube
 	consul   configFactoryParamsConsul
+	etcd     configFactoryParamsEtcd
 }
 
 func NewConfigFactoryParams(settings *v1.Settings,
 	sharedCache memory.InMemoryResourceCache,
 	cache kube.SharedCache,
 	cfg **rest.Config,
-	consulClient *consulapi.Client) ConfigFactoryParams {
+	consulClient *consulapi.Client,
+	etcdClient *etcd.Client) ConfigFactoryParams {
 	return ConfigFactoryParams{
 		settings: settings,
 		memory: configFactoryParamsMemory{
@@ -52,6 +57,9 @@ func NewConfigFactoryParams(settings *v1.Settings,
 		consul: configFactoryParamsConsul{
 			consulClient: consulClient,
 		},
+		etcd: configFactoryParamsEtcd{
+			etcdClient: etcdClient,
+		},
 	}
 }
 
@@ -68,7 +76,11 @@ type configFactoryParamsConsul struct {
 	consulClient *consulapi.Client
 }
 
-// sharedCache, resourceCrd+cfg OR consulClient must be non-nil
+type configFactoryParamsEtcd struct {
+	etcdClient *etcd.Client
+}
+
+// sharedCache, resourceCrd+cfg, consulClient OR etcdClient must be non-nil
 func ConfigFactoryForSettings(params ConfigFactoryParams, resourceCrd crd.Crd) (factory.ResourceClientFactory, error) {
 	settings := params.settings
 
@@ -120,9 +132,23 @@ func ConfigFactoryForSettings(params ConfigFactoryParams, resourceCrd crd.Crd) (
 			Consul:  consulClient,
 			RootKey: rootKey,
 		}, nil
+	case *v1.Settings_EtcdKvSource:
+		etcdClient := params.etcd.etcdClient
+		if etcdClient == nil {
+			return nil, errors.Errorf("internal error: etcd client cannot be nil")
+		}
+		rootKey := source.EtcdKvSource.GetRootKey()
+		if rootKey == "" {
+			rootKey = DefaultRootKey
+		}
+		return &etcd.ResourceClientFactory{
+			Client:  etcdClient,
+			RootKey: rootKey,
+		}, nil
 	case *v1.Settings_DirectoryConfigSource:
-		return &factory.FileResourceClientFactory{
-			RootDir: filepath.Join(source.DirectoryConfigSource.Directory, resourceCrd.Plural),
+		return &file.ResourceClientFactory{
+			RootDir:     filepath.Join(source.DirectoryConfigSource.GetDirectory(), resourceCrd.Plural),
+			StatusFiles: source.DirectoryConfigSource.GetStatusFiles(),
 		}, nil
 	}
 	return nil, errors.Errorf("invalid config source type")
@@ -191,13 +217,33 @@ func SecretFactoryForSettings(ctx context.Context,
 		if rootKey == "" {
 			rootKey = DefaultRootKey
 		}
-		return &factory.VaultSecretClientFactory{
-			Vault:   vaultClient,
-			RootKey: rootKey,
+		var refreshRate time.Duration
+		if rate := source.VaultSecretSource.GetRefreshRate(); rate != nil {
+			var err error
+			refreshRate, err = types.DurationFromProto(rate)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid vault refreshRate")
+			}
+		}
+		return &vault.ResourceClientFactory{
+			Vault:       vaultClient,
+			RootKey:     rootKey,
+			PathPrefix:  source.VaultSecretSource.GetPathPrefix(),
+			RefreshRate: refreshRate,
 		}, nil
 	case *v1.Settings_DirectorySecretSource:
-		return &factory.FileResourceClientFactory{
-			RootDir: filepath.Join(source.DirectorySecretSource.Directory, pluralName),
+		var decrypter file.Decrypter
+		if decryption := source.DirectorySecretSource.GetDecryption(); decryption != nil {
+			var err error
+			decrypter, err = file.NewPGPDecrypter(decryption.GetPassphraseFile(), decryption.GetPrivateKeyFile())
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid secret decryption")
+			}
+		}
+		return &file.ResourceClientFactory{
+			RootDir:     filepath.Join(source.DirectorySecretSource.GetDirectory(), pluralName),
+			StatusFiles: source.DirectorySecretSource.GetStatusFiles(),
+			Decrypter:   decrypter,
 		}, nil
 	}
 	return nil, errors.Errorf("invalid config source type")
@@ -211,6 +257,7 @@ func ArtifactFactoryForSettings(ctx context.Context,
 	clientset *kubernetes.Interface,
 	kubeCoreCache *cache.KubeCoreCache,
 	consulClient *consulapi.Client,
+	etcdClient *etcd.Client,
 	pluralName string) (factory.ResourceClientFactory, error) {
 	if settings.ArtifactSource == nil {
 		if sharedCache == nil {
@@ -232,8 +279,9 @@ func ArtifactFactoryForSettings(ctx context.Context,
 			CustomConverter: kubeconverters.NewArtifactConverter(),
 		}, nil
 	case *v1.Settings_DirectoryArtifactSource:
-		return &factory.FileResourceClientFactory{
-			RootDir: filepath.Join(source.DirectoryArtifactSource.Directory, pluralName),
+		return &file.ResourceClientFactory{
+			RootDir:     filepath.Join(source.DirectoryArtifactSource.GetDirectory(), pluralName),
+			StatusFiles: source.DirectoryArtifactSource.GetStatusFiles(),
 		}, nil
 	case *v1.Settings_ConsulKvArtifactSource:
 		rootKey := source.ConsulKvArtifactSource.GetRootKey()
@@ -244,6 +292,18 @@ func ArtifactFactoryForSettings(ctx context.Context,
 			Consul:  consulClient,
 			RootKey: rootKey,
 		}, nil
+	case *v1.Settings_EtcdKvArtifactSource:
+		if etcdClient == nil {
+			return nil, errors.Errorf("internal error: etcd client cannot be nil")
+		}
+		rootKey := source.EtcdKvArtifactSource.GetRootKey()
+		if rootKey == "" {
+			rootKey = DefaultRootKey
+		}
+		return &etcd.ResourceClientFactory{
+			Client:  etcdClient,
+			RootKey: rootKey,
+		}, nil
 	}
 	return nil, errors.Errorf("invalid config source type")
 }
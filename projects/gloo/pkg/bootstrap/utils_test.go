@@ -49,6 +49,7 @@ var _ = Describe("Utils", func() {
 			nil,
 			&cfg,
 			nil,
+			nil,
 		)
 
 		kubefactory, err := ConfigFactoryForSettings(params, v1.UpstreamCrd)
@@ -128,6 +129,7 @@ var _ = Describe("Utils", func() {
 					&kube,
 					&kubeCoreCache,
 					&api.Client{},
+					nil,
 					"artifacts")
 				Expect(err).NotTo(HaveOccurred())
 				artifactClient, err = v1.NewArtifactClient(factory)
@@ -221,6 +223,7 @@ var _ = Describe("Utils", func() {
 					nil,
 					nil,
 					client,
+					nil,
 					"artifacts")
 				Expect(err).NotTo(HaveOccurred())
 				artifactClient, err = v1.NewArtifactClient(factory)
@@ -1,12 +1,34 @@
 package bootstrap
 
 import (
+	"context"
+	"io/ioutil"
+	"path"
+	"strings"
+	"time"
+
 	"github.com/hashicorp/vault/api"
 	errors "github.com/rotisserie/eris"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/contextutils"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultKubernetesAuthMountPath = "kubernetes"
+	defaultServiceAccountTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	defaultAppRoleAuthMountPath    = "approle"
+
+	maxVaultLoginBackoff = 30 * time.Second
 )
 
-func VaultClientForSettings(vaultSettings *v1.Settings_VaultSecrets) (*api.Client, error) {
+// vaultLogin logs in to Vault, returning the secret holding the token
+type vaultLogin func(client *api.Client) (*api.Secret, error)
+
+// VaultClientForSettings returns a Vault client authenticated with the token or auth method of the settings.
+// Renewable tokens are renewed until ctx is done, and tokens obtained from an auth method are replaced by logging
+// in again once they can no longer be renewed.
+func VaultClientForSettings(ctx context.Context, vaultSettings *v1.Settings_VaultSecrets) (*api.Client, error) {
 	cfg := api.DefaultConfig()
 
 	var tlsCfg *api.TLSConfig
@@ -58,11 +80,203 @@ func VaultClientForSettings(vaultSettings *v1.Settings_VaultSecrets) (*api.Clien
 			return nil, err
 		}
 	}
-	token := vaultSettings.GetToken()
-	if token == "" {
-		return nil, errors.Errorf("token is required for connecting to vault")
+	login, canLogin, err := vaultLoginForSettings(vaultSettings)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := login(client)
+	if err != nil {
+		return nil, err
+	}
+	client.SetToken(secret.Auth.ClientToken)
+	if secret.Auth.Renewable || canLogin {
+		go renewVaultToken(ctx, client, login, canLogin, secret)
 	}
-	client.SetToken(token)
 
 	return client, nil
 }
+
+// vaultLoginForSettings returns how to log in to Vault, and whether logging in again yields a new token.
+func vaultLoginForSettings(vaultSettings *v1.Settings_VaultSecrets) (vaultLogin, bool, error) {
+	token := vaultSettings.GetToken()
+	kubernetesAuth := vaultSettings.GetKubernetesAuth()
+	appRoleAuth := vaultSettings.GetAppRoleAuth()
+
+	var methods int
+	for _, set := range []bool{token != "", kubernetesAuth != nil, appRoleAuth != nil} {
+		if set {
+			methods++
+		}
+	}
+	switch {
+	case methods == 0:
+		return nil, false, errors.Errorf("token, kubernetesAuth or appRoleAuth is required for connecting to vault")
+	case methods > 1:
+		return nil, false, errors.Errorf("only one of token, kubernetesAuth and appRoleAuth may be set for connecting to vault")
+	}
+
+	switch {
+	case kubernetesAuth != nil:
+		return kubernetesVaultLogin(kubernetesAuth), true, nil
+	case appRoleAuth != nil:
+		return appRoleVaultLogin(appRoleAuth), true, nil
+	}
+	return tokenVaultLogin(token), false, nil
+}
+
+func tokenVaultLogin(token string) vaultLogin {
+	return func(client *api.Client) (*api.Secret, error) {
+		client.SetToken(token)
+		secret := &api.Secret{Auth: &api.SecretAuth{ClientToken: token}}
+		// the token may not be allowed to look itself up, in which case it is not renewed
+		self, err := client.Auth().Token().LookupSelf()
+		if err != nil {
+			return secret, nil
+		}
+		renewable, _ := self.TokenIsRenewable()
+		ttl, _ := self.TokenTTL()
+		// tokens without a TTL, such as root tokens, never expire
+		secret.Auth.Renewable = renewable && ttl > 0
+		secret.Auth.LeaseDuration = int(ttl.Seconds())
+		return secret, nil
+	}
+}
+
+func kubernetesVaultLogin(kubernetesAuth *v1.Settings_VaultSecrets_KubernetesAuth) vaultLogin {
+	mountPath := kubernetesAuth.GetMountPath()
+	if mountPath == "" {
+		mountPath = defaultKubernetesAuthMountPath
+	}
+	tokenPath := kubernetesAuth.GetServiceAccountTokenPath()
+	if tokenPath == "" {
+		tokenPath = defaultServiceAccountTokenPath
+	}
+	return func(client *api.Client) (*api.Secret, error) {
+		// the service account token may be rotated, read it on every login
+		jwt, err := ioutil.ReadFile(tokenPath)
+		if err != nil {
+			return nil, errors.Wrapf(err, "reading service account token")
+		}
+		return writeVaultLogin(client, mountPath, map[string]interface{}{
+			"role": kubernetesAuth.GetRole(),
+			"jwt":  strings.TrimSpace(string(jwt)),
+		})
+	}
+}
+
+func appRoleVaultLogin(appRoleAuth *v1.Settings_VaultSecrets_AppRoleAuth) vaultLogin {
+	mountPath := appRoleAuth.GetMountPath()
+	if mountPath == "" {
+		mountPath = defaultAppRoleAuthMountPath
+	}
+	return func(client *api.Client) (*api.Secret, error) {
+		secretId := appRoleAuth.GetSecretId()
+		if secretIdPath := appRoleAuth.GetSecretIdPath(); secretIdPath != "" {
+			raw, err := ioutil.ReadFile(secretIdPath)
+			if err != nil {
+				return nil, errors.Wrapf(err, "reading AppRole SecretID")
+			}
+			secretId = strings.TrimSpace(string(raw))
+		}
+		return writeVaultLogin(client, mountPath, map[string]interface{}{
+			"role_id":   appRoleAuth.GetRoleId(),
+			"secret_id": secretId,
+		})
+	}
+}
+
+func writeVaultLogin(client *api.Client, mountPath string, data map[string]interface{}) (*api.Secret, error) {
+	// log in without the current (possibly expired) token, which the client keeps using until it is replaced
+	loginClient, err := client.Clone()
+	if err != nil {
+		return nil, err
+	}
+	loginClient.ClearToken()
+	secret, err := loginClient.Logical().Write(path.Join("auth", mountPath, "login"), data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "logging in to vault with auth method %v", mountPath)
+	}
+	if secret == nil || secret.Auth == nil || secret.Auth.ClientToken == "" {
+		return nil, errors.Errorf("logging in to vault with auth method %v returned no token", mountPath)
+	}
+	return secret, nil
+}
+
+// renewVaultToken renews the token of the secret until it reaches its max TTL (or fails to renew), then logs in
+// again for a new token if possible.
+func renewVaultToken(ctx context.Context, client *api.Client, login vaultLogin, canLogin bool, secret *api.Secret) {
+	logger := contextutils.LoggerFrom(ctx)
+	for {
+		if !secret.Auth.Renewable && secret.Auth.LeaseDuration <= 0 {
+			// the token never expires
+			return
+		}
+		if secret.Auth.Renewable {
+			watcher, err := client.NewLifetimeWatcher(&api.LifetimeWatcherInput{Secret: secret})
+			if err != nil {
+				logger.Errorw("failed to renew vault token", zap.Error(err))
+				return
+			}
+			go watcher.Start()
+			err = waitForVaultTokenExpiry(ctx, watcher)
+			watcher.Stop()
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				logger.Warnw("failed to renew vault token", zap.Error(err))
+			}
+		} else {
+			// wait until the token is about to expire
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(time.Duration(secret.Auth.LeaseDuration) * time.Second * 2 / 3):
+			}
+		}
+		if !canLogin {
+			logger.Warnf("vault token can no longer be renewed, gloo will not be able to read secrets from vault once it expires")
+			return
+		}
+
+		secret = loginToVaultWithRetry(ctx, client, login)
+		if secret == nil {
+			return
+		}
+		client.SetToken(secret.Auth.ClientToken)
+		logger.Debugf("logged in to vault again")
+	}
+}
+
+func waitForVaultTokenExpiry(ctx context.Context, watcher *api.LifetimeWatcher) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-watcher.DoneCh():
+			return err
+		case renewal := <-watcher.RenewCh():
+			contextutils.LoggerFrom(ctx).Debugf("renewed vault token at %v", renewal.RenewedAt)
+		}
+	}
+}
+
+// loginToVaultWithRetry logs in to vault, retrying with backoff until it succeeds or ctx is done.
+func loginToVaultWithRetry(ctx context.Context, client *api.Client, login vaultLogin) *api.Secret {
+	backoff := time.Second
+	for {
+		secret, err := login(client)
+		if err == nil {
+			return secret
+		}
+		contextutils.LoggerFrom(ctx).Warnw("failed to log in to vault, retrying", zap.Error(err), zap.Duration("backoff", backoff))
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(backoff):
+		}
+		if backoff *= 2; backoff > maxVaultLoginBackoff {
+			backoff = maxVaultLoginBackoff
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package bootstrap_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/bootstrap"
+)
+
+var _ = Describe("VaultClientForSettings", func() {
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+		vault  *httptest.Server
+
+		lock   sync.Mutex
+		logins []map[string]interface{}
+		// the lease duration of the tokens returned on login, in seconds
+		leaseDuration int
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		logins = nil
+		leaseDuration = 3600
+		vault = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			lock.Lock()
+			defer lock.Unlock()
+			switch r.URL.Path {
+			case "/v1/auth/approle/login", "/v1/auth/kubernetes/login":
+				var body map[string]interface{}
+				Expect(json.NewDecoder(r.Body).Decode(&body)).NotTo(HaveOccurred())
+				logins = append(logins, body)
+				fmt.Fprintf(w, `{"auth": {"client_token": "token-%d", "renewable": false, "lease_duration": %d}}`, len(logins), leaseDuration)
+			case "/v1/auth/token/lookup-self":
+				fmt.Fprint(w, `{"data": {"renewable": false, "ttl": 0}}`)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+	})
+
+	AfterEach(func() {
+		cancel()
+		vault.Close()
+	})
+
+	loginCount := func() int {
+		lock.Lock()
+		defer lock.Unlock()
+		return len(logins)
+	}
+
+	It("requires exactly one auth method", func() {
+		_, err := VaultClientForSettings(ctx, &v1.Settings_VaultSecrets{Address: vault.URL})
+		Expect(err).To(MatchError(ContainSubstring("is required for connecting to vault")))
+
+		_, err = VaultClientForSettings(ctx, &v1.Settings_VaultSecrets{
+			Address:     vault.URL,
+			Token:       "root",
+			AppRoleAuth: &v1.Settings_VaultSecrets_AppRoleAuth{RoleId: "role"},
+		})
+		Expect(err).To(MatchError(ContainSubstring("only one of")))
+	})
+
+	It("uses a static token", func() {
+		client, err := VaultClientForSettings(ctx, &v1.Settings_VaultSecrets{Address: vault.URL, Token: "root"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(client.Token()).To(Equal("root"))
+	})
+
+	It("logs in with an AppRole", func() {
+		secretIdFile, err := ioutil.TempFile("", "secret-id")
+		Expect(err).NotTo(HaveOccurred())
+		defer os.Remove(secretIdFile.Name())
+		_, err = secretIdFile.WriteString("secret-id\n")
+		Expect(err).NotTo(HaveOccurred())
+
+		client, err := VaultClientForSettings(ctx, &v1.Settings_VaultSecrets{
+			Address: vault.URL,
+			AppRoleAuth: &v1.Settings_VaultSecrets_AppRoleAuth{
+				RoleId:       "role-id",
+				SecretIdPath: secretIdFile.Name(),
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(client.Token()).To(Equal("token-1"))
+		Expect(logins).To(Equal([]map[string]interface{}{{"role_id": "role-id", "secret_id": "secret-id"}}))
+	})
+
+	It("logs in with a kubernetes service account and logs in again before the token expires", func() {
+		jwtFile, err := ioutil.TempFile("", "jwt")
+		Expect(err).NotTo(HaveOccurred())
+		defer os.Remove(jwtFile.Name())
+		_, err = jwtFile.WriteString("jwt")
+		Expect(err).NotTo(HaveOccurred())
+		leaseDuration = 1
+
+		client, err := VaultClientForSettings(ctx, &v1.Settings_VaultSecrets{
+			Address: vault.URL,
+			KubernetesAuth: &v1.Settings_VaultSecrets_KubernetesAuth{
+				Role:                    "gloo",
+				ServiceAccountTokenPath: jwtFile.Name(),
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(client.Token()).To(Equal("token-1"))
+		Expect(logins[0]).To(Equal(map[string]interface{}{"role": "gloo", "jwt": "jwt"}))
+
+		Eventually(loginCount, "5s").Should(BeNumerically(">=", 2))
+		Eventually(client.Token).ShouldNot(Equal("token-1"))
+	})
+})
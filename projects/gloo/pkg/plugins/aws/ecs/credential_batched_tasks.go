@@ -0,0 +1,194 @@
+package ecs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"go.uber.org/zap"
+)
+
+const (
+	TaskArnAnnotationKey   = "taskArn"
+	ContainerAnnotationKey = "container"
+)
+
+const maxPort = 65535
+
+// ECS sets the group of the tasks started by a service to "service:<service name>"
+const serviceGroupPrefix = "service:"
+
+// In order to minimize calls to the AWS API, we group calls by credentials and cluster, and select the tasks of each
+// upstream locally.
+// This function groups upstreams by credentials, calls the AWS API, maps the tasks to upstreams, and returns the
+// endpoints associated with the provided upstream list
+// NOTE: MUST filter the upstreamList to ONLY ECS upstreams before calling this function
+func getLatestEndpoints(ctx context.Context, lister EcsTaskLister, secrets v1.SecretList, writeNamespace string, upstreamList v1.UpstreamList) (v1.EndpointList, error) {
+	credGroups := getCredGroupsFromUpstreams(upstreamList)
+	// list the tasks once for each set of credentials and apply the output to the credential groups
+	if err := getTasksForCredentialGroups(ctx, lister, secrets, credGroups); err != nil {
+		return nil, err
+	}
+	// produce the endpoints list
+	var allEndpoints v1.EndpointList
+	for _, credGroup := range credGroups {
+		for _, upstream := range credGroup.upstreams {
+			for _, task := range filterTasksForUpstream(upstream, credGroup.tasks.Tasks) {
+				if endpoint := upstreamTaskToEndpoint(ctx, writeNamespace, upstream, task, credGroup.tasks.ContainerInstanceIps); endpoint != nil {
+					allEndpoints = append(allEndpoints, endpoint)
+				}
+			}
+		}
+	}
+	return allEndpoints, nil
+}
+
+// credentialGroup exists to support batched calls to the AWS API
+// one credentialGroup should be made for each unique credentialSpec
+type credentialGroup struct {
+	// a unique credential spec
+	credentialSpec *CredentialSpec
+	// all the upstreams that share the CredentialSpec
+	upstreams v1.UpstreamList
+	// all the running tasks in the cluster
+	tasks *ClusterTasks
+}
+
+// Initializes the credentialGroups
+// Credential groups are returned as a map to enforce the "one credentialGroup per unique credential" property
+// NOTE: assumes that upstreams are ECS upstreams
+func getCredGroupsFromUpstreams(upstreams v1.UpstreamList) map[CredentialKey]*credentialGroup {
+	credGroups := make(map[CredentialKey]*credentialGroup)
+	for _, upstream := range upstreams {
+		cred := NewCredentialSpecFromEcsUpstreamSpec(upstream.GetAwsEcs())
+		key := cred.GetKey()
+		if _, ok := credGroups[key]; ok {
+			credGroups[key].upstreams = append(credGroups[key].upstreams, upstream)
+		} else {
+			credGroups[key] = &credentialGroup{
+				upstreams:      v1.UpstreamList{upstream},
+				credentialSpec: cred,
+			}
+		}
+	}
+	return credGroups
+}
+
+func getTasksForCredentialGroups(ctx context.Context, lister EcsTaskLister, secrets v1.SecretList, credGroups map[CredentialKey]*credentialGroup) error {
+	for _, credGroup := range credGroups {
+		tasks, err := lister.ListForCredentials(ctx, credGroup.credentialSpec, secrets)
+		if err != nil {
+			return err
+		}
+		credGroup.tasks = tasks
+	}
+	return nil
+}
+
+// NOTE: assumes that upstreams are ECS upstreams
+func filterTasksForUpstream(upstream *v1.Upstream, tasks []*ecs.Task) []*ecs.Task {
+	serviceName := upstream.GetAwsEcs().GetServiceName()
+	if serviceName == "" {
+		return tasks
+	}
+	var result []*ecs.Task
+	for _, task := range tasks {
+		if aws.StringValue(task.Group) == serviceGroupPrefix+serviceName {
+			result = append(result, task)
+		}
+	}
+	return result
+}
+
+// NOTE: assumes that upstreams are ECS upstreams
+func upstreamTaskToEndpoint(ctx context.Context, writeNamespace string, upstream *v1.Upstream, task *ecs.Task, containerInstanceIps map[string]string) *v1.Endpoint {
+	logger := contextutils.LoggerFrom(ctx)
+	spec := upstream.GetAwsEcs()
+	if aws.StringValue(task.HealthStatus) == ecs.HealthStatusUnhealthy {
+		logger.Debugw("task for upstream is unhealthy, skipping",
+			zap.Any("upstreamRef", upstream.GetMetadata().Ref()),
+			zap.Any("taskArn", aws.StringValue(task.TaskArn)))
+		return nil
+	}
+	container := findContainer(task, spec.GetContainerName())
+	if container == nil {
+		logger.Warnw("no container found for config",
+			zap.Any("upstreamRef", upstream.GetMetadata().Ref()),
+			zap.Any("taskArn", aws.StringValue(task.TaskArn)),
+			zap.Any("upstream.containerName", spec.GetContainerName()))
+		return nil
+	}
+	containerPort := spec.GetContainerPort()
+	if containerPort == 0 {
+		containerPort = DefaultPort
+	}
+	address, port := containerAddress(task, container, containerPort, containerInstanceIps)
+	if address == "" {
+		logger.Warnw("no address found for config",
+			zap.Any("upstreamRef", upstream.GetMetadata().Ref()),
+			zap.Any("taskArn", aws.StringValue(task.TaskArn)),
+			zap.Any("upstream.containerPort", containerPort))
+		return nil
+	}
+	ref := upstream.Metadata.Ref()
+	// for easier debugging, add the task arn and container to the xds output
+	taskInfo := map[string]string{
+		TaskArnAnnotationKey:   aws.StringValue(task.TaskArn),
+		ContainerAnnotationKey: aws.StringValue(container.Name),
+	}
+	endpoint := v1.Endpoint{
+		Upstreams: []*core.ResourceRef{&ref},
+		Address:   address,
+		Port:      port,
+		Metadata: core.Metadata{
+			Name:        generateName(ref, address, port),
+			Namespace:   writeNamespace,
+			Annotations: taskInfo,
+		},
+	}
+	logger.Debugw("task from upstream",
+		zap.Any("upstream", upstream),
+		zap.Any("task", task),
+		zap.Any("endpoint", endpoint))
+	return &endpoint
+}
+
+func findContainer(task *ecs.Task, name string) *ecs.Container {
+	for _, container := range task.Containers {
+		if name == "" || aws.StringValue(container.Name) == name {
+			return container
+		}
+	}
+	return nil
+}
+
+// tasks using the awsvpc network mode are reached on the container port at the task's private IP.
+// otherwise the container port is mapped to a port on the container instance running the task.
+func containerAddress(task *ecs.Task, container *ecs.Container, containerPort uint32, containerInstanceIps map[string]string) (string, uint32) {
+	for _, eni := range container.NetworkInterfaces {
+		if ip := aws.StringValue(eni.PrivateIpv4Address); ip != "" {
+			return ip, containerPort
+		}
+	}
+	hostIp := containerInstanceIps[aws.StringValue(task.ContainerInstanceArn)]
+	if hostIp == "" {
+		return "", 0
+	}
+	for _, binding := range container.NetworkBindings {
+		if aws.Int64Value(binding.ContainerPort) != int64(containerPort) || binding.HostPort == nil {
+			continue
+		}
+		if protocol := aws.StringValue(binding.Protocol); protocol != "" && protocol != ecs.TransportProtocolTcp {
+			continue
+		}
+		hostPort := aws.Int64Value(binding.HostPort)
+		if hostPort <= 0 || hostPort > maxPort {
+			continue
+		}
+		return hostIp, uint32(hostPort)
+	}
+	return "", 0
+}
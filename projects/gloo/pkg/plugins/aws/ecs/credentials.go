@@ -0,0 +1,62 @@
+package ecs
+
+import (
+	glooecs "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws/ecs"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// a credential spec represents an AWS client's view into an ECS cluster
+// we expect multiple upstreams (e.g. one per service) to share the same view, so we batch the queries and select the
+// tasks of each upstream locally
+type CredentialSpec struct {
+	// secretRef identifies the AWS secret that should be used to authenticate the client
+	secretRef *core.ResourceRef
+	// region is the AWS region where the cluster lives
+	region string
+	// roleArn is an AWS Role (specified by its Amazon Resource Number (ARN)) which should be assumed when
+	// querying for tasks
+	roleArn string
+	// cluster is the name or ARN of the ECS cluster
+	cluster string
+}
+
+func (cs *CredentialSpec) GetKey() CredentialKey {
+	return CredentialKey{
+		secretRef: cs.SecretRef().String(),
+		region:    cs.Region(),
+		roleArn:   cs.Arn(),
+		cluster:   cs.Cluster(),
+	}
+}
+
+func (cs *CredentialSpec) Region() string {
+	return cs.region
+}
+
+func (cs *CredentialSpec) SecretRef() *core.ResourceRef {
+	return cs.secretRef
+}
+
+func (cs *CredentialSpec) Arn() string {
+	return cs.roleArn
+}
+
+func (cs *CredentialSpec) Cluster() string {
+	return cs.cluster
+}
+
+func NewCredentialSpecFromEcsUpstreamSpec(spec *glooecs.UpstreamSpec) *CredentialSpec {
+	return &CredentialSpec{
+		secretRef: spec.GetSecretRef(),
+		region:    spec.GetRegion(),
+		roleArn:   spec.GetRoleArn(),
+		cluster:   spec.GetCluster(),
+	}
+}
+
+type CredentialKey struct {
+	secretRef string
+	region    string
+	roleArn   string
+	cluster   string
+}
@@ -0,0 +1,13 @@
+package ecs
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/solo-io/go-utils/testutils"
+)
+
+func TestEcs(t *testing.T) {
+	testutils.RegisterCommonFailHandlers()
+	RunSpecs(t, "ECS Suite")
+}
@@ -0,0 +1,42 @@
+package ecs
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	aws2 "github.com/solo-io/gloo/projects/gloo/pkg/utils/aws"
+)
+
+// GetClients returns the ECS client used to list tasks, and the EC2 client used to resolve the addresses of the
+// container instances running tasks that do not use the awsvpc network mode
+func GetClients(cred *CredentialSpec, secrets v1.SecretList) (ecsiface.ECSAPI, ec2iface.EC2API, error) {
+	regionConfig := &aws.Config{Region: aws.String(cred.Region())}
+	secretRef := cred.SecretRef()
+	sess, err := aws2.GetAwsSession(secretRef, secrets, regionConfig)
+	if err != nil {
+		if secretRef == nil {
+			return nil, nil, CreateSessionFromEnvError(err)
+		}
+		return nil, nil, CreateSessionFromSecretError(err)
+	}
+	if cred.Arn() != "" {
+		config := &aws.Config{Credentials: stscreds.NewCredentials(sess, cred.Arn())}
+		return ecs.New(sess, config), ec2.New(sess, config), nil
+	}
+	return ecs.New(sess), ec2.New(sess), nil
+}
+
+var (
+	CreateSessionFromEnvError = func(err error) error {
+		return eris.Wrapf(err, "unable to create a session with credentials taken from env")
+	}
+
+	CreateSessionFromSecretError = func(err error) error {
+		return eris.Wrapf(err, "unable to create a session with credentials taken from secret ref")
+	}
+)
@@ -0,0 +1,176 @@
+package ecs
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	. "github.com/onsi/gomega"
+)
+
+// fakeAws serves the subset of the ECS (json) and EC2 (query) APIs used by the task lister
+type fakeAws struct {
+	server *httptest.Server
+	// tasks per cluster, in the json format of the ECS API
+	tasks map[string][]*ecs.Task
+	// ec2 instance id per container instance arn
+	containerInstances map[string]string
+	// private ip per ec2 instance id
+	instanceIps map[string]string
+	// the number of tasks returned per ListTasks page
+	pageSize int
+
+	describeTasksCalls int
+}
+
+func newFakeAws() *fakeAws {
+	f := &fakeAws{
+		tasks:              make(map[string][]*ecs.Task),
+		containerInstances: make(map[string]string),
+		instanceIps:        make(map[string]string),
+		pageSize:           2,
+	}
+	f.server = httptest.NewServer(http.HandlerFunc(f.serveHTTP))
+	return f
+}
+
+func (f *fakeAws) Close() {
+	f.server.Close()
+}
+
+func (f *fakeAws) clients() (*ecs.ECS, *ec2.EC2) {
+	sess, err := session.NewSession(&aws.Config{
+		Endpoint:    aws.String(f.server.URL),
+		Region:      aws.String("us-east-1"),
+		Credentials: credentials.NewStaticCredentials("access", "secret", ""),
+		MaxRetries:  aws.Int(0),
+	})
+	Expect(err).NotTo(HaveOccurred())
+	return ecs.New(sess), ec2.New(sess)
+}
+
+func (f *fakeAws) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := ioutil.ReadAll(r.Body)
+	if target := r.Header.Get("X-Amz-Target"); target != "" {
+		f.serveEcs(w, target[strings.LastIndex(target, ".")+1:], body)
+		return
+	}
+	values, _ := url.ParseQuery(string(body))
+	if values.Get("Action") != "DescribeInstances" {
+		http.Error(w, "unsupported action", http.StatusBadRequest)
+		return
+	}
+	f.serveDescribeInstances(w, values)
+}
+
+func (f *fakeAws) serveEcs(w http.ResponseWriter, operation string, body []byte) {
+	var input struct {
+		Cluster            string
+		NextToken          string
+		Tasks              []string
+		ContainerInstances []string
+	}
+	_ = json.Unmarshal(body, &input)
+	clusterTasks := f.tasks[input.Cluster]
+
+	var out interface{}
+	switch operation {
+	case "ListTasks":
+		start := 0
+		if input.NextToken != "" {
+			start = len(input.NextToken)
+		}
+		end := start + f.pageSize
+		if end > len(clusterTasks) {
+			end = len(clusterTasks)
+		}
+		var arns []*string
+		for _, task := range clusterTasks[start:end] {
+			arns = append(arns, task.TaskArn)
+		}
+		page := &ecs.ListTasksOutput{TaskArns: arns}
+		if end < len(clusterTasks) {
+			// the token encodes the offset of the next page
+			page.NextToken = aws.String(strings.Repeat("x", end))
+		}
+		out = page
+	case "DescribeTasks":
+		f.describeTasksCalls++
+		described := &ecs.DescribeTasksOutput{}
+		for _, arn := range input.Tasks {
+			for _, task := range clusterTasks {
+				if aws.StringValue(task.TaskArn) == arn {
+					described.Tasks = append(described.Tasks, task)
+				}
+			}
+		}
+		out = described
+	case "DescribeContainerInstances":
+		described := &ecs.DescribeContainerInstancesOutput{}
+		for _, arn := range input.ContainerInstances {
+			described.ContainerInstances = append(described.ContainerInstances, &ecs.ContainerInstance{
+				ContainerInstanceArn: aws.String(arn),
+				Ec2InstanceId:        aws.String(f.containerInstances[arn]),
+			})
+		}
+		out = described
+	default:
+		http.Error(w, "unsupported operation", http.StatusBadRequest)
+		return
+	}
+	// the sdk types are serialized with their api field names
+	resp, err := jsonutil.BuildJSON(out)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+	_, _ = w.Write(resp)
+}
+
+type describeInstancesResponse struct {
+	XMLName        xml.Name `xml:"DescribeInstancesResponse"`
+	ReservationSet struct {
+		Items []struct {
+			InstancesSet struct {
+				Items []ec2Instance `xml:"item"`
+			} `xml:"instancesSet"`
+		} `xml:"item"`
+	} `xml:"reservationSet"`
+}
+
+type ec2Instance struct {
+	InstanceId       string `xml:"instanceId"`
+	PrivateIpAddress string `xml:"privateIpAddress"`
+}
+
+func (f *fakeAws) serveDescribeInstances(w http.ResponseWriter, values url.Values) {
+	var instances []ec2Instance
+	for key, ids := range values {
+		if !strings.HasPrefix(key, "InstanceId.") {
+			continue
+		}
+		for _, id := range ids {
+			instances = append(instances, ec2Instance{InstanceId: id, PrivateIpAddress: f.instanceIps[id]})
+		}
+	}
+	var resp describeInstancesResponse
+	resp.ReservationSet.Items = make([]struct {
+		InstancesSet struct {
+			Items []ec2Instance `xml:"item"`
+		} `xml:"instancesSet"`
+	}, 1)
+	resp.ReservationSet.Items[0].InstancesSet.Items = instances
+	w.Header().Set("Content-Type", "text/xml")
+	_ = xml.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,152 @@
+package ecs
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	glooecs "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws/ecs"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("polling", func() {
+
+	var (
+		ctx          context.Context
+		cancel       context.CancelFunc
+		fake         *fakeAws
+		lister       *fakeAwsTaskLister
+		secretClient v1.SecretClient
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		fake = newFakeAws()
+		primeFakeAws(fake)
+		lister = &fakeAwsTaskLister{fake: fake}
+		var err error
+		secretClient, err = v1.NewSecretClient(&factory.MemoryResourceClientFactory{Cache: memory.NewInMemoryResourceCache()})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		cancel()
+		fake.Close()
+	})
+
+	ecsUpstream := func(name string, spec *glooecs.UpstreamSpec) *v1.Upstream {
+		spec.Region = "us-east-1"
+		spec.Cluster = testCluster
+		return &v1.Upstream{
+			Metadata:     core.Metadata{Name: name, Namespace: "default"},
+			UpstreamType: &v1.Upstream_AwsEcs{AwsEcs: spec},
+		}
+	}
+
+	pollOnce := func(upstreams v1.UpstreamList) v1.EndpointList {
+		epw := &edsWatcher{
+			upstreams:      upstreams,
+			watchContext:   ctx,
+			secretClient:   secretClient,
+			refreshRate:    time.Minute,
+			writeNamespace: "gloo-system",
+			ecsTaskLister:  lister,
+		}
+		endpointsChan, errs, err := epw.poll()
+		Expect(err).NotTo(HaveOccurred())
+		select {
+		case err := <-errs:
+			Fail(err.Error())
+		case endpoints := <-endpointsChan:
+			return endpoints
+		case <-time.After(5 * time.Second):
+			Fail("timed out waiting for endpoints")
+		}
+		return nil
+	}
+
+	It("should create endpoints for the tasks of a service", func() {
+		us := ecsUpstream("web", &glooecs.UpstreamSpec{
+			ServiceName:   "web",
+			ContainerName: "web",
+			ContainerPort: 8080,
+		})
+		ref := us.Metadata.Ref()
+
+		endpoints := pollOnce(v1.UpstreamList{us})
+		Expect(endpoints).To(ConsistOf(
+			&v1.Endpoint{
+				Upstreams: []*core.ResourceRef{&ref},
+				Address:   testTaskIp1,
+				Port:      8080,
+				Metadata: core.Metadata{
+					Name:      "ecs-name-web-namespace-default--10-0-1-1-8080",
+					Namespace: "gloo-system",
+					Annotations: map[string]string{
+						TaskArnAnnotationKey:   "task-web-awsvpc",
+						ContainerAnnotationKey: "web",
+					},
+				},
+			},
+			&v1.Endpoint{
+				Upstreams: []*core.ResourceRef{&ref},
+				Address:   testHostIp,
+				Port:      testHostPort,
+				Metadata: core.Metadata{
+					Name:      "ecs-name-web-namespace-default--10-0-0-5-32768",
+					Namespace: "gloo-system",
+					Annotations: map[string]string{
+						TaskArnAnnotationKey:   "task-web-bridge",
+						ContainerAnnotationKey: "web",
+					},
+				},
+			},
+		))
+	})
+
+	It("should batch the calls for upstreams sharing credentials and cluster", func() {
+		web := ecsUpstream("web", &glooecs.UpstreamSpec{ServiceName: "web", ContainerName: "web", ContainerPort: 8080})
+		worker := ecsUpstream("worker", &glooecs.UpstreamSpec{ServiceName: "worker"})
+
+		endpoints := pollOnce(v1.UpstreamList{web, worker})
+		Expect(endpoints).To(HaveLen(3))
+		Expect(lister.calls).To(Equal(1))
+
+		var workerEndpoints v1.EndpointList
+		for _, ep := range endpoints {
+			if ep.Upstreams[0].Name == "worker" {
+				workerEndpoints = append(workerEndpoints, ep)
+			}
+		}
+		Expect(workerEndpoints).To(HaveLen(1))
+		Expect(workerEndpoints[0].Address).To(Equal(testTaskIp2))
+		Expect(workerEndpoints[0].Port).To(BeEquivalentTo(DefaultPort))
+	})
+
+	It("should skip tasks without a matching container or port mapping", func() {
+		us := ecsUpstream("web", &glooecs.UpstreamSpec{ServiceName: "web", ContainerName: "web", ContainerPort: 9000})
+
+		endpoints := pollOnce(v1.UpstreamList{us})
+		// only the awsvpc task is reachable on any container port
+		Expect(endpoints).To(HaveLen(1))
+		Expect(endpoints[0].Address).To(Equal(testTaskIp1))
+
+		us = ecsUpstream("web", &glooecs.UpstreamSpec{ServiceName: "web", ContainerName: "missing"})
+		Expect(pollOnce(v1.UpstreamList{us})).To(BeEmpty())
+	})
+})
+
+type fakeAwsTaskLister struct {
+	fake  *fakeAws
+	calls int
+}
+
+func (l *fakeAwsTaskLister) ListForCredentials(ctx context.Context, cred *CredentialSpec, secrets v1.SecretList) (*ClusterTasks, error) {
+	l.calls++
+	ecsClient, ec2Client := l.fake.clients()
+	return NewEcsTaskLister().ListWithClients(ctx, cred.Cluster(), ecsClient, ec2Client)
+}
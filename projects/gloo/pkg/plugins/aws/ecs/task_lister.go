@@ -0,0 +1,190 @@
+package ecs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/contextutils"
+	"go.uber.org/zap"
+)
+
+// the maximum number of tasks or container instances that can be described in a single call
+const describeBatchSize = 100
+
+// ClusterTasks are the running tasks of an ECS cluster
+type ClusterTasks struct {
+	Tasks []*ecs.Task
+	// the private IP addresses of the EC2 instances running the tasks, keyed by container instance ARN.
+	// only populated for tasks that are not reachable at their own address (i.e. do not use the awsvpc network mode)
+	ContainerInstanceIps map[string]string
+}
+
+// EcsTaskLister is a simple interface for calling the AWS API.
+// This allows us to easily mock the API in our tests.
+type EcsTaskLister interface {
+	ListForCredentials(ctx context.Context, cred *CredentialSpec, secrets v1.SecretList) (*ClusterTasks, error)
+}
+
+type ecsTaskLister struct {
+}
+
+func NewEcsTaskLister() *ecsTaskLister {
+	return &ecsTaskLister{}
+}
+
+var _ EcsTaskLister = &ecsTaskLister{}
+
+func (c *ecsTaskLister) ListForCredentials(ctx context.Context, cred *CredentialSpec, secrets v1.SecretList) (*ClusterTasks, error) {
+	ecsSvc, ec2Svc, err := GetClients(cred, secrets)
+	if err != nil {
+		return nil, GetClientError(err)
+	}
+	return c.ListWithClients(ctx, cred.Cluster(), ecsSvc, ec2Svc)
+}
+
+func (c *ecsTaskLister) ListWithClients(ctx context.Context, cluster string, ecsSvc ecsiface.ECSAPI, ec2Svc ec2iface.EC2API) (*ClusterTasks, error) {
+	var taskArns []*string
+	input := &ecs.ListTasksInput{
+		Cluster:       aws.String(cluster),
+		DesiredStatus: aws.String(ecs.DesiredStatusRunning),
+	}
+	err := ecsSvc.ListTasksPagesWithContext(ctx, input, func(r *ecs.ListTasksOutput, more bool) bool {
+		taskArns = append(taskArns, r.TaskArns...)
+		return true
+	})
+	if err != nil {
+		return nil, ListTasksError(err, cluster)
+	}
+
+	result := &ClusterTasks{ContainerInstanceIps: make(map[string]string)}
+	for _, batch := range batches(taskArns) {
+		out, err := ecsSvc.DescribeTasksWithContext(ctx, &ecs.DescribeTasksInput{
+			Cluster: aws.String(cluster),
+			Tasks:   batch,
+		})
+		if err != nil {
+			return nil, DescribeTasksError(err, cluster)
+		}
+		for _, task := range out.Tasks {
+			// tasks that are still starting or already stopping are listed with a desired status of running
+			if aws.StringValue(task.LastStatus) == ecs.DesiredStatusRunning {
+				result.Tasks = append(result.Tasks, task)
+			}
+		}
+	}
+
+	if err := c.resolveContainerInstanceIps(ctx, cluster, ecsSvc, ec2Svc, result); err != nil {
+		return nil, err
+	}
+
+	contextutils.LoggerFrom(ctx).Debugw("ecsUpstream result", zap.Any("value", result))
+	return result, nil
+}
+
+// tasks that do not use the awsvpc network mode are reached through a port on the EC2 instance running them,
+// so we need to look up the address of that instance.
+func (c *ecsTaskLister) resolveContainerInstanceIps(ctx context.Context, cluster string, ecsSvc ecsiface.ECSAPI, ec2Svc ec2iface.EC2API, result *ClusterTasks) error {
+	var containerInstanceArns []*string
+	seen := make(map[string]bool)
+	for _, task := range result.Tasks {
+		arn := aws.StringValue(task.ContainerInstanceArn)
+		if arn == "" || seen[arn] || usesAwsvpc(task) {
+			continue
+		}
+		seen[arn] = true
+		containerInstanceArns = append(containerInstanceArns, task.ContainerInstanceArn)
+	}
+	if len(containerInstanceArns) == 0 {
+		return nil
+	}
+
+	instanceIdToArn := make(map[string]string)
+	var instanceIds []*string
+	for _, batch := range batches(containerInstanceArns) {
+		out, err := ecsSvc.DescribeContainerInstancesWithContext(ctx, &ecs.DescribeContainerInstancesInput{
+			Cluster:            aws.String(cluster),
+			ContainerInstances: batch,
+		})
+		if err != nil {
+			return DescribeContainerInstancesError(err, cluster)
+		}
+		for _, ci := range out.ContainerInstances {
+			if ci.Ec2InstanceId == nil {
+				continue
+			}
+			instanceIdToArn[aws.StringValue(ci.Ec2InstanceId)] = aws.StringValue(ci.ContainerInstanceArn)
+			instanceIds = append(instanceIds, ci.Ec2InstanceId)
+		}
+	}
+	if len(instanceIds) == 0 {
+		return nil
+	}
+
+	err := ec2Svc.DescribeInstancesPagesWithContext(ctx, &ec2.DescribeInstancesInput{InstanceIds: instanceIds}, func(r *ec2.DescribeInstancesOutput, more bool) bool {
+		for _, reservation := range r.Reservations {
+			for _, instance := range reservation.Instances {
+				if instance.PrivateIpAddress == nil {
+					continue
+				}
+				if arn, ok := instanceIdToArn[aws.StringValue(instance.InstanceId)]; ok {
+					result.ContainerInstanceIps[arn] = aws.StringValue(instance.PrivateIpAddress)
+				}
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return DescribeInstancesError(err)
+	}
+	return nil
+}
+
+// a task using the awsvpc network mode has its own network interface, which is reported on its containers
+func usesAwsvpc(task *ecs.Task) bool {
+	for _, container := range task.Containers {
+		if len(container.NetworkInterfaces) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func batches(items []*string) [][]*string {
+	var result [][]*string
+	for len(items) > describeBatchSize {
+		result = append(result, items[:describeBatchSize])
+		items = items[describeBatchSize:]
+	}
+	if len(items) > 0 {
+		result = append(result, items)
+	}
+	return result
+}
+
+var (
+	GetClientError = func(err error) error {
+		return eris.Wrapf(err, "unable to get aws client")
+	}
+
+	ListTasksError = func(err error, cluster string) error {
+		return eris.Wrapf(err, "unable to list tasks in cluster %v", cluster)
+	}
+
+	DescribeTasksError = func(err error, cluster string) error {
+		return eris.Wrapf(err, "unable to describe tasks in cluster %v", cluster)
+	}
+
+	DescribeContainerInstancesError = func(err error, cluster string) error {
+		return eris.Wrapf(err, "unable to describe container instances in cluster %v", cluster)
+	}
+
+	DescribeInstancesError = func(err error) error {
+		return eris.Wrapf(err, "unable to describe instances")
+	}
+)
@@ -0,0 +1,124 @@
+package ecs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("task lister", func() {
+
+	var (
+		ctx  context.Context
+		fake *fakeAws
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		fake = newFakeAws()
+		primeFakeAws(fake)
+	})
+
+	AfterEach(func() {
+		fake.Close()
+	})
+
+	It("should list the running tasks of a cluster across pages", func() {
+		ecsClient, ec2Client := fake.clients()
+		result, err := NewEcsTaskLister().ListWithClients(ctx, testCluster, ecsClient, ec2Client)
+		Expect(err).NotTo(HaveOccurred())
+
+		var arns []string
+		for _, task := range result.Tasks {
+			arns = append(arns, aws.StringValue(task.TaskArn))
+		}
+		// the pending task is excluded
+		Expect(arns).To(ConsistOf("task-web-awsvpc", "task-web-bridge", "task-worker"))
+		Expect(fake.describeTasksCalls).To(Equal(1))
+	})
+
+	It("should resolve the addresses of container instances for tasks without awsvpc networking", func() {
+		ecsClient, ec2Client := fake.clients()
+		result, err := NewEcsTaskLister().ListWithClients(ctx, testCluster, ecsClient, ec2Client)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.ContainerInstanceIps).To(Equal(map[string]string{"container-instance-1": testHostIp}))
+	})
+
+	It("should return an empty list for an empty cluster", func() {
+		ecsClient, ec2Client := fake.clients()
+		result, err := NewEcsTaskLister().ListWithClients(ctx, "empty", ecsClient, ec2Client)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Tasks).To(BeEmpty())
+	})
+})
+
+const (
+	testCluster  = "prod"
+	testHostIp   = "10.0.0.5"
+	testTaskIp1  = "10.0.1.1"
+	testTaskIp2  = "10.0.1.2"
+	testHostPort = 32768
+)
+
+func primeFakeAws(fake *fakeAws) {
+	fake.tasks[testCluster] = []*ecs.Task{
+		{
+			TaskArn:    aws.String("task-web-awsvpc"),
+			Group:      aws.String("service:web"),
+			LastStatus: aws.String(ecs.DesiredStatusRunning),
+			Containers: []*ecs.Container{{
+				Name: aws.String("web"),
+				NetworkInterfaces: []*ecs.NetworkInterface{{
+					PrivateIpv4Address: aws.String(testTaskIp1),
+				}},
+			}},
+		},
+		{
+			TaskArn:              aws.String("task-web-bridge"),
+			Group:                aws.String("service:web"),
+			LastStatus:           aws.String(ecs.DesiredStatusRunning),
+			ContainerInstanceArn: aws.String("container-instance-1"),
+			Containers: []*ecs.Container{
+				{
+					Name: aws.String("sidecar"),
+					NetworkBindings: []*ecs.NetworkBinding{{
+						ContainerPort: aws.Int64(9901),
+						HostPort:      aws.Int64(32769),
+					}},
+				},
+				{
+					Name: aws.String("web"),
+					NetworkBindings: []*ecs.NetworkBinding{{
+						ContainerPort: aws.Int64(8080),
+						HostPort:      aws.Int64(testHostPort),
+						Protocol:      aws.String(ecs.TransportProtocolTcp),
+					}},
+				},
+			},
+		},
+		{
+			TaskArn:    aws.String("task-worker"),
+			Group:      aws.String("service:worker"),
+			LastStatus: aws.String(ecs.DesiredStatusRunning),
+			Containers: []*ecs.Container{{
+				Name: aws.String("worker"),
+				NetworkInterfaces: []*ecs.NetworkInterface{{
+					PrivateIpv4Address: aws.String(testTaskIp2),
+				}},
+			}},
+		},
+		{
+			TaskArn:    aws.String("task-web-pending"),
+			Group:      aws.String("service:web"),
+			LastStatus: aws.String("PENDING"),
+			Containers: []*ecs.Container{{
+				Name: aws.String("web"),
+			}},
+		},
+	}
+	fake.containerInstances["container-instance-1"] = "i-1"
+	fake.instanceIps["i-1"] = testHostIp
+}
@@ -0,0 +1,13 @@
+package external_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestExternal(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "External Plugin Suite")
+}
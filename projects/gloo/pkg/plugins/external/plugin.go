@@ -0,0 +1,204 @@
+package external
+
+import (
+	"context"
+	"time"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/gogo/protobuf/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/rotisserie/eris"
+	pluginapi "github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/plugin"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/wasm"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultTimeout is the timeout of a single call to an external plugin that doesn't configure one.
+const DefaultTimeout = time.Second
+
+var _ plugins.Plugin = new(Plugin)
+var _ plugins.UpstreamPlugin = new(Plugin)
+var _ plugins.VirtualHostPlugin = new(Plugin)
+var _ plugins.RoutePlugin = new(Plugin)
+var _ plugins.HttpFilterPlugin = new(Plugin)
+
+// Plugin calls an out-of-process plugin implementing the ExternalPluginService. Errors returned by the
+// plugin, including timeouts, are returned with the name of the plugin, so that the translator reports them
+// on the resource being translated.
+type Plugin struct {
+	name    string
+	timeout time.Duration
+	client  pluginapi.ExternalPluginServiceClient
+}
+
+func NewPlugin(name string, timeout time.Duration, client pluginapi.ExternalPluginServiceClient) *Plugin {
+	return &Plugin{
+		name:    name,
+		timeout: timeout,
+		client:  client,
+	}
+}
+
+// NewPluginsForSettings connects to the external plugins configured in the settings. The connections are
+// closed when ctx is done.
+func NewPluginsForSettings(ctx context.Context, settings *v1.Settings) ([]*Plugin, error) {
+	var result []*Plugin
+	for _, config := range settings.GetGloo().GetExternalPlugins() {
+		if config.GetAddress() == "" {
+			return nil, eris.Errorf("external plugin %v has no address", config.GetName())
+		}
+		name := config.GetName()
+		if name == "" {
+			name = config.GetAddress()
+		}
+		timeout := DefaultTimeout
+		if config.GetTimeout() != nil {
+			var err error
+			timeout, err = types.DurationFromProto(config.GetTimeout())
+			if err != nil {
+				return nil, eris.Wrapf(err, "invalid timeout for external plugin %v", name)
+			}
+		}
+		// the dial doesn't block, the connection is established on the first call
+		conn, err := grpc.DialContext(ctx, config.GetAddress(), grpc.WithInsecure())
+		if err != nil {
+			return nil, eris.Wrapf(err, "connecting to external plugin %v", name)
+		}
+		go func() {
+			<-ctx.Done()
+			conn.Close()
+		}()
+		result = append(result, NewPlugin(name, timeout, pluginapi.NewExternalPluginServiceClient(conn)))
+	}
+	return result, nil
+}
+
+func (p *Plugin) Init(params plugins.InitParams) error {
+	return nil
+}
+
+func (p *Plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoyapi.Cluster) error {
+	cluster, err := marshalAny(out)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(params.Ctx, p.timeout)
+	defer cancel()
+	resp, err := p.client.ProcessUpstream(ctx, &pluginapi.ProcessUpstreamRequest{
+		Upstream: in,
+		Cluster:  cluster,
+	})
+	if err != nil {
+		return p.callError(err)
+	}
+	return p.replace(resp.GetCluster(), out)
+}
+
+func (p *Plugin) ProcessVirtualHost(params plugins.VirtualHostParams, in *v1.VirtualHost, out *envoyroute.VirtualHost) error {
+	virtualHost, err := marshalAny(out)
+	if err != nil {
+		return err
+	}
+	proxyRef := params.Proxy.GetMetadata().Ref()
+	ctx, cancel := context.WithTimeout(params.Ctx, p.timeout)
+	defer cancel()
+	resp, err := p.client.ProcessVirtualHost(ctx, &pluginapi.ProcessVirtualHostRequest{
+		Proxy:            &proxyRef,
+		Listener:         params.Listener.GetName(),
+		VirtualHost:      in,
+		EnvoyVirtualHost: virtualHost,
+	})
+	if err != nil {
+		return p.callError(err)
+	}
+	return p.replace(resp.GetEnvoyVirtualHost(), out)
+}
+
+func (p *Plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoyroute.Route) error {
+	route, err := marshalAny(out)
+	if err != nil {
+		return err
+	}
+	proxyRef := params.Proxy.GetMetadata().Ref()
+	ctx, cancel := context.WithTimeout(params.Ctx, p.timeout)
+	defer cancel()
+	resp, err := p.client.ProcessRoute(ctx, &pluginapi.ProcessRouteRequest{
+		Proxy:       &proxyRef,
+		Listener:    params.Listener.GetName(),
+		VirtualHost: params.VirtualHost.GetName(),
+		Route:       in,
+		EnvoyRoute:  route,
+	})
+	if err != nil {
+		return p.callError(err)
+	}
+	return p.replace(resp.GetEnvoyRoute(), out)
+}
+
+func (p *Plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	ctx, cancel := context.WithTimeout(params.Ctx, p.timeout)
+	defer cancel()
+	resp, err := p.client.HttpFilters(ctx, &pluginapi.HttpFiltersRequest{
+		HttpListener: listener,
+	})
+	if err != nil {
+		return nil, p.callError(err)
+	}
+
+	var filters []plugins.StagedHttpFilter
+	for _, filter := range resp.GetFilters() {
+		if filter.GetName() == "" {
+			return nil, eris.Errorf("external plugin %v returned an http filter without a name", p.name)
+		}
+		httpFilter := &envoyhttp.HttpFilter{Name: filter.GetName()}
+		if typedConfig := filter.GetTypedConfig(); typedConfig != nil {
+			httpFilter.ConfigType = &envoyhttp.HttpFilter_TypedConfig{
+				TypedConfig: &any.Any{TypeUrl: typedConfig.GetTypeUrl(), Value: typedConfig.GetValue()},
+			}
+		}
+		filters = append(filters, plugins.StagedHttpFilter{
+			HttpFilter: httpFilter,
+			Stage:      wasm.TransformWasmFilterStage(filter.GetStage()),
+		})
+	}
+	return filters, nil
+}
+
+// plugins need not implement every method
+func (p *Plugin) callError(err error) error {
+	if status.Code(err) == codes.Unimplemented {
+		return nil
+	}
+	return eris.Wrapf(err, "external plugin %v", p.name)
+}
+
+// replaces out with the message returned by the plugin, if any
+func (p *Plugin) replace(in *types.Any, out proto.Message) error {
+	if in == nil {
+		return nil
+	}
+	replacement := proto.Clone(out)
+	if err := ptypes.UnmarshalAny(&any.Any{TypeUrl: in.GetTypeUrl(), Value: in.GetValue()}, replacement); err != nil {
+		return eris.Wrapf(err, "external plugin %v returned an invalid %T", p.name, out)
+	}
+	out.Reset()
+	proto.Merge(out, replacement)
+	return nil
+}
+
+// the envoy protos are not gogo protos, unlike the gloo protos sent to the plugins
+func marshalAny(msg proto.Message) (*types.Any, error) {
+	envoyAny, err := ptypes.MarshalAny(msg)
+	if err != nil {
+		return nil, err
+	}
+	return &types.Any{TypeUrl: envoyAny.GetTypeUrl(), Value: envoyAny.GetValue()}, nil
+}
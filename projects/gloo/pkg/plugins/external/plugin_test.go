@@ -0,0 +1,191 @@
+package external_test
+
+import (
+	"context"
+	"net"
+	"time"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	"github.com/gogo/protobuf/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	pluginapi "github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/plugin"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/wasm"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/external"
+	"github.com/solo-io/gloo/test/matchers"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"google.golang.org/grpc"
+)
+
+// sets the lb policy of clusters and the prefix rewrite of routes, and adds an http filter
+type testPluginServer struct {
+	pluginapi.UnimplementedExternalPluginServiceServer
+	delay        time.Duration
+	routeRequest *pluginapi.ProcessRouteRequest
+}
+
+func (s *testPluginServer) ProcessUpstream(ctx context.Context, req *pluginapi.ProcessUpstreamRequest) (*pluginapi.ProcessUpstreamResponse, error) {
+	time.Sleep(s.delay)
+	var cluster envoyapi.Cluster
+	if err := unmarshalAny(req.GetCluster(), &cluster); err != nil {
+		return nil, err
+	}
+	cluster.LbPolicy = envoyapi.Cluster_RANDOM
+	return &pluginapi.ProcessUpstreamResponse{Cluster: marshalAny(&cluster)}, nil
+}
+
+func (s *testPluginServer) ProcessRoute(ctx context.Context, req *pluginapi.ProcessRouteRequest) (*pluginapi.ProcessRouteResponse, error) {
+	s.routeRequest = req
+	if req.GetRoute().GetName() == "invalid" {
+		return &pluginapi.ProcessRouteResponse{EnvoyRoute: marshalAny(&envoyapi.Cluster{})}, nil
+	}
+	var route envoyroute.Route
+	if err := unmarshalAny(req.GetEnvoyRoute(), &route); err != nil {
+		return nil, err
+	}
+	route.GetRoute().PrefixRewrite = "/rewritten"
+	return &pluginapi.ProcessRouteResponse{EnvoyRoute: marshalAny(&route)}, nil
+}
+
+func (s *testPluginServer) HttpFilters(ctx context.Context, req *pluginapi.HttpFiltersRequest) (*pluginapi.HttpFiltersResponse, error) {
+	return &pluginapi.HttpFiltersResponse{
+		Filters: []*pluginapi.HttpFiltersResponse_HttpFilter{
+			{
+				Name:        "test.filter",
+				TypedConfig: &types.Any{TypeUrl: "type.googleapis.com/test.Config", Value: []byte("config")},
+				Stage:       &wasm.FilterStage{Stage: wasm.FilterStage_AuthZStage, Predicate: wasm.FilterStage_After},
+			},
+			{
+				Name: "test.default",
+			},
+		},
+	}, nil
+}
+
+// the envoy protos are not gogo protos
+func marshalAny(msg proto.Message) *types.Any {
+	envoyAny, err := ptypes.MarshalAny(msg)
+	Expect(err).NotTo(HaveOccurred())
+	return &types.Any{TypeUrl: envoyAny.GetTypeUrl(), Value: envoyAny.GetValue()}
+}
+
+func unmarshalAny(in *types.Any, out proto.Message) error {
+	return ptypes.UnmarshalAny(&any.Any{TypeUrl: in.GetTypeUrl(), Value: in.GetValue()}, out)
+}
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		ctx        context.Context
+		cancel     context.CancelFunc
+		grpcServer *grpc.Server
+		server     *testPluginServer
+		plugin     *external.Plugin
+		params     plugins.Params
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		params = plugins.Params{Ctx: ctx}
+
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		grpcServer = grpc.NewServer()
+		server = &testPluginServer{}
+		pluginapi.RegisterExternalPluginServiceServer(grpcServer, server)
+		go grpcServer.Serve(lis)
+
+		externalPlugins, err := external.NewPluginsForSettings(ctx, &v1.Settings{
+			Gloo: &v1.GlooOptions{
+				ExternalPlugins: []*v1.GlooOptions_ExternalPlugin{{
+					Name:    "test",
+					Address: lis.Addr().String(),
+					Timeout: types.DurationProto(500 * time.Millisecond),
+				}},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(externalPlugins).To(HaveLen(1))
+		plugin = externalPlugins[0]
+		Expect(plugin.Init(plugins.InitParams{Ctx: ctx})).To(Succeed())
+	})
+
+	AfterEach(func() {
+		cancel()
+		grpcServer.Stop()
+	})
+
+	It("replaces the cluster of an upstream", func() {
+		out := &envoyapi.Cluster{Name: "us"}
+		Expect(plugin.ProcessUpstream(params, &v1.Upstream{}, out)).To(Succeed())
+		Expect(out).To(matchers.MatchProto(&envoyapi.Cluster{Name: "us", LbPolicy: envoyapi.Cluster_RANDOM}))
+	})
+
+	It("reports timeouts with the name of the plugin", func() {
+		server.delay = time.Second
+		out := &envoyapi.Cluster{Name: "us"}
+		err := plugin.ProcessUpstream(params, &v1.Upstream{}, out)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("external plugin test"))
+		Expect(err.Error()).To(ContainSubstring("DeadlineExceeded"))
+		Expect(out).To(matchers.MatchProto(&envoyapi.Cluster{Name: "us"}))
+	})
+
+	It("passes the proxy, listener and virtual host of routes", func() {
+		routeParams := plugins.RouteParams{
+			VirtualHostParams: plugins.VirtualHostParams{
+				Params:   params,
+				Proxy:    &v1.Proxy{Metadata: core.Metadata{Namespace: "gloo-system", Name: "proxy"}},
+				Listener: &v1.Listener{Name: "listener"},
+			},
+			VirtualHost: &v1.VirtualHost{Name: "vhost"},
+		}
+		out := &envoyroute.Route{Name: "route", Action: &envoyroute.Route_Route{Route: &envoyroute.RouteAction{}}}
+		Expect(plugin.ProcessRoute(routeParams, &v1.Route{Name: "route"}, out)).To(Succeed())
+		Expect(out.GetRoute().GetPrefixRewrite()).To(Equal("/rewritten"))
+		Expect(server.routeRequest.GetProxy()).To(Equal(&core.ResourceRef{Namespace: "gloo-system", Name: "proxy"}))
+		Expect(server.routeRequest.GetListener()).To(Equal("listener"))
+		Expect(server.routeRequest.GetVirtualHost()).To(Equal("vhost"))
+
+		out = &envoyroute.Route{Name: "invalid"}
+		err := plugin.ProcessRoute(routeParams, &v1.Route{Name: "invalid"}, out)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("external plugin test returned an invalid"))
+		Expect(err.Error()).To(ContainSubstring("mismatched message type"))
+		Expect(out).To(matchers.MatchProto(&envoyroute.Route{Name: "invalid"}))
+	})
+
+	It("skips unimplemented methods", func() {
+		out := &envoyroute.VirtualHost{Name: "vhost"}
+		Expect(plugin.ProcessVirtualHost(plugins.VirtualHostParams{Params: params}, &v1.VirtualHost{}, out)).To(Succeed())
+		Expect(out).To(matchers.MatchProto(&envoyroute.VirtualHost{Name: "vhost"}))
+	})
+
+	It("adds staged http filters", func() {
+		filters, err := plugin.HttpFilters(params, &v1.HttpListener{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(HaveLen(2))
+		Expect(filters[0].HttpFilter.GetName()).To(Equal("test.filter"))
+		Expect(filters[0].HttpFilter.GetTypedConfig().GetTypeUrl()).To(Equal("type.googleapis.com/test.Config"))
+		Expect(filters[0].HttpFilter.GetTypedConfig().GetValue()).To(Equal([]byte("config")))
+		Expect(filters[0].Stage).To(Equal(plugins.AfterStage(plugins.AuthZStage)))
+		Expect(filters[1].HttpFilter.GetName()).To(Equal("test.default"))
+		Expect(filters[1].HttpFilter.GetConfigType()).To(BeNil())
+		Expect(filters[1].Stage).To(Equal(plugins.BeforeStage(plugins.AcceptedStage)))
+	})
+
+	It("requires an address", func() {
+		_, err := external.NewPluginsForSettings(ctx, &v1.Settings{
+			Gloo: &v1.GlooOptions{
+				ExternalPlugins: []*v1.GlooOptions_ExternalPlugin{{Name: "test"}},
+			},
+		})
+		Expect(err).To(MatchError("external plugin test has no address"))
+	})
+})
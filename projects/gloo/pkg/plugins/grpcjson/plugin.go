@@ -1,10 +1,16 @@
 package grpcjson
 
 import (
+	"encoding/base64"
+
 	envoy_extensions_filters_http_grpc_json_transcoder_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/grpc_json_transcoder/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
 	"github.com/rotisserie/eris"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc_json"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 )
@@ -37,6 +43,19 @@ func (p *Plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) (
 		return nil, err
 	}
 
+	if envoyGrpcJsonConf.DescriptorSet == nil {
+		// no descriptors were provided, use the ones discovered (or configured) on the grpc upstreams for these services
+		var upstreams v1.UpstreamList
+		if params.Snapshot != nil {
+			upstreams = params.Snapshot.Upstreams
+		}
+		descriptorBin, err := descriptorSetForServices(upstreams, grpcJsonConf.GetServices())
+		if err != nil {
+			return nil, eris.Wrapf(err, "finding proto descriptors for grpc json transcoder")
+		}
+		envoyGrpcJsonConf.DescriptorSet = &envoy_extensions_filters_http_grpc_json_transcoder_v3.GrpcJsonTranscoder_ProtoDescriptorBin{ProtoDescriptorBin: descriptorBin}
+	}
+
 	grpcJsonFilter, err := plugins.NewStagedFilterWithConfig(wellknown.GRPCJSONTranscoder, envoyGrpcJsonConf, pluginStage)
 	if err != nil {
 		return nil, eris.Wrapf(err, "generating filter config")
@@ -68,6 +87,75 @@ func translateGlooToEnvoyGrpcJson(grpcJsonConf *grpc_json.GrpcJsonTranscoder) (*
 	return envoyGrpcJsonConf, nil
 }
 
+// descriptorSetForServices merges the descriptors of every grpc upstream that provides one of the given services
+// into a single serialized FileDescriptorSet
+func descriptorSetForServices(upstreams v1.UpstreamList, services []string) ([]byte, error) {
+	if len(services) == 0 {
+		return nil, eris.New("no services or descriptor set provided")
+	}
+	wanted := make(map[string]bool)
+	for _, svc := range services {
+		wanted[svc] = false
+	}
+
+	merged := &descriptor.FileDescriptorSet{}
+	seenFiles := make(map[string]bool)
+	for _, us := range upstreams {
+		grpcSpec := getGrpcSpec(us)
+		if grpcSpec == nil || len(grpcSpec.GetDescriptors()) == 0 {
+			continue
+		}
+		var provides bool
+		for _, svc := range grpcSpec.GetGrpcServices() {
+			name := svc.GetServiceName()
+			if svc.GetPackageName() != "" {
+				name = svc.GetPackageName() + "." + name
+			}
+			if _, ok := wanted[name]; ok {
+				wanted[name] = true
+				provides = true
+			}
+		}
+		if !provides {
+			continue
+		}
+		rawDescriptors, err := base64.StdEncoding.DecodeString(string(grpcSpec.GetDescriptors()))
+		if err != nil {
+			return nil, eris.Wrapf(err, "decoding descriptors for upstream %v", us.GetMetadata().Ref())
+		}
+		var descriptors descriptor.FileDescriptorSet
+		if err := proto.Unmarshal(rawDescriptors, &descriptors); err != nil {
+			return nil, eris.Wrapf(err, "parsing descriptors for upstream %v", us.GetMetadata().Ref())
+		}
+		for _, file := range descriptors.GetFile() {
+			if seenFiles[file.GetName()] {
+				continue
+			}
+			seenFiles[file.GetName()] = true
+			merged.File = append(merged.File, file)
+		}
+	}
+
+	for _, svc := range services {
+		if !wanted[svc] {
+			return nil, eris.Errorf("no grpc upstream with descriptors provides service %v", svc)
+		}
+	}
+	return proto.Marshal(merged)
+}
+
+func getGrpcSpec(us *v1.Upstream) *grpc.ServiceSpec {
+	specGetter, ok := us.GetUpstreamType().(v1.ServiceSpecGetter)
+	if !ok {
+		return nil
+	}
+	grpcWrapper, ok := specGetter.GetServiceSpec().GetPluginType().(*options.ServiceSpec_Grpc)
+	if !ok {
+		return nil
+	}
+	return grpcWrapper.Grpc
+}
+
 func translateGlooToEnvoyPrintOptions(options *grpc_json.GrpcJsonTranscoder_PrintOptions) *envoy_extensions_filters_http_grpc_json_transcoder_v3.GrpcJsonTranscoder_PrintOptions {
 	if options == nil {
 		return nil
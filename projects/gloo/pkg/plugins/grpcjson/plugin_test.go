@@ -1,17 +1,26 @@
 package grpcjson_test
 
 import (
+	"encoding/base64"
+
 	envoy_extensions_filters_http_grpc_json_transcoder_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/grpc_json_transcoder/v3"
 	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"github.com/golang/protobuf/ptypes"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc_json"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/grpcjson"
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/gloo/test/matchers"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
 
 var _ = Describe("GrpcJson", func() {
@@ -62,4 +71,72 @@ var _ = Describe("GrpcJson", func() {
 		Expect(f).To(matchers.BeEquivalentToDiff(expectedFilter))
 	})
 
+	It("should use the descriptors of grpc upstreams when no descriptor set is provided", func() {
+		fileDescriptors := &descriptor.FileDescriptorSet{
+			File: []*descriptor.FileDescriptorProto{{
+				Name:    proto.String("bookstore.proto"),
+				Package: proto.String("main"),
+				Service: []*descriptor.ServiceDescriptorProto{{
+					Name:   proto.String("Bookstore"),
+					Method: []*descriptor.MethodDescriptorProto{{Name: proto.String("GetShelf")}},
+				}},
+			}},
+		}
+		rawDescriptors, err := proto.Marshal(fileDescriptors)
+		Expect(err).NotTo(HaveOccurred())
+
+		us := &v1.Upstream{
+			Metadata: core.Metadata{Name: "bookstore", Namespace: "default"},
+			UpstreamType: &v1.Upstream_Static{
+				Static: &static.UpstreamSpec{
+					ServiceSpec: &options.ServiceSpec{
+						PluginType: &options.ServiceSpec_Grpc{
+							Grpc: &grpc.ServiceSpec{
+								Descriptors: []byte(base64.StdEncoding.EncodeToString(rawDescriptors)),
+								GrpcServices: []*grpc.ServiceSpec_GrpcService{{
+									PackageName:   "main",
+									ServiceName:   "Bookstore",
+									FunctionNames: []string{"GetShelf"},
+								}},
+							},
+						},
+					},
+				},
+			},
+		}
+		hl := &v1.HttpListener{
+			Options: &v1.HttpListenerOptions{
+				GrpcJsonTranscoder: &grpc_json.GrpcJsonTranscoder{
+					Services: []string{"main.Bookstore"},
+				},
+			},
+		}
+
+		p := grpcjson.NewPlugin()
+		p.Init(initParams)
+		f, err := p.HttpFilters(plugins.Params{Snapshot: &v1.ApiSnapshot{Upstreams: v1.UpstreamList{us}}}, hl)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(f).To(HaveLen(1))
+
+		var envoyConf envoy_extensions_filters_http_grpc_json_transcoder_v3.GrpcJsonTranscoder
+		err = ptypes.UnmarshalAny(f[0].HttpFilter.GetTypedConfig(), &envoyConf)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(envoyConf.GetProtoDescriptorBin()).To(Equal(rawDescriptors))
+	})
+
+	It("should error when no upstream provides the descriptors for a service", func() {
+		hl := &v1.HttpListener{
+			Options: &v1.HttpListenerOptions{
+				GrpcJsonTranscoder: &grpc_json.GrpcJsonTranscoder{
+					Services: []string{"main.Bookstore"},
+				},
+			},
+		}
+
+		p := grpcjson.NewPlugin()
+		p.Init(initParams)
+		_, err := p.HttpFilters(plugins.Params{Snapshot: &v1.ApiSnapshot{}}, hl)
+		Expect(err).To(HaveOccurred())
+	})
+
 })
@@ -6,6 +6,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/als"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/aws"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/aws/ec2"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/aws/ecs"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/azure"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/basicroute"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/buffer"
@@ -60,7 +61,7 @@ var globalRegistry = func(opts bootstrap.Opts, pluginExtensions ...func() plugin
 		hcmPlugin,
 		als.NewPlugin(),
 		pipe.NewPlugin(),
-		tcp.NewPlugin(utils.NewSslConfigTranslator()),
+		tcp.NewPlugin(utils.NewSslConfigTranslatorForSettings(opts.Settings)),
 		static.NewPlugin(),
 		transformationPlugin,
 		grpcweb.NewPlugin(),
@@ -71,6 +72,7 @@ var globalRegistry = func(opts bootstrap.Opts, pluginExtensions ...func() plugin
 		linkerd.NewPlugin(),
 		stats.NewPlugin(),
 		ec2.NewPlugin(opts.Secrets),
+		ecs.NewPlugin(opts.Secrets),
 		tracing.NewPlugin(),
 		shadowing.NewPlugin(),
 		headers.NewPlugin(),
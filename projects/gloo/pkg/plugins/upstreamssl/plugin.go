@@ -9,13 +9,16 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 )
 
-type Plugin struct{}
+type Plugin struct {
+	sslConfigTranslator utils.SslConfigTranslator
+}
 
 func NewPlugin() *Plugin {
-	return &Plugin{}
+	return &Plugin{sslConfigTranslator: utils.NewSslConfigTranslator()}
 }
 
 func (p *Plugin) Init(params plugins.InitParams) error {
+	p.sslConfigTranslator = utils.NewSslConfigTranslatorForSettings(params.Settings)
 	return nil
 }
 
@@ -26,7 +29,7 @@ func (p *Plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 		return nil
 	}
 
-	cfg, err := utils.NewSslConfigTranslator().ResolveUpstreamSslConfig(params.Snapshot.Secrets, sslConfig)
+	cfg, err := p.sslConfigTranslator.ResolveUpstreamSslConfig(params.Snapshot.Secrets, sslConfig)
 	if err != nil {
 		return err
 	}
@@ -34,10 +34,12 @@ func StartGlooInTest(customCtx context.Context) error {
 }
 
 func startSetupLoop(ctx context.Context, usageReporter client.UsagePayloadReader) error {
+	setupFunc, reloadFunc := syncer.NewReloadableSetupFunc()
 	return setuputils.Main(setuputils.SetupOpts{
 		LoggerName:    "gloo",
 		Version:       version.Version,
-		SetupFunc:     syncer.NewSetupFunc(),
+		SetupFunc:     setupFunc,
+		ReloadFunc:    reloadFunc,
 		ExitOnError:   true,
 		CustomCtx:     ctx,
 		UsageReporter: usageReporter,
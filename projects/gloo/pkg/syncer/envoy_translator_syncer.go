@@ -9,15 +9,19 @@ import (
 	"github.com/solo-io/go-utils/hashutils"
 
 	"github.com/gorilla/mux"
+	"github.com/hashicorp/go-multierror"
+	"github.com/mitchellh/hashstructure"
 	"github.com/rotisserie/eris"
 	"github.com/solo-io/gloo/pkg/utils/syncutil"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
 	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/go-utils/log"
 	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
 	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
 	"go.opencensus.io/stats"
 	"go.opencensus.io/stats/view"
@@ -88,8 +92,10 @@ func (s *translatorSyncer) syncEnvoy(ctx context.Context, snap *v1.ApiSnapshot)
 	allReports.Accept(snap.Upstreams.AsInputResources()...)
 	allReports.Accept(snap.UpstreamGroups.AsInputResources()...)
 	allReports.Accept(snap.Proxies.AsInputResources()...)
+	// per-route statuses are written as subresource statuses on the proxies
+	routeStatuses := make(map[*v1.Proxy]map[string]*core.Status)
 
-	if !s.settings.GetGloo().GetDisableProxyGarbageCollection().GetValue() {
+	if !s.getSettings().GetGloo().GetDisableProxyGarbageCollection().GetValue() {
 		allKeys := map[string]bool{
 			xds.FallbackNodeKey: true,
 		}
@@ -122,7 +128,7 @@ func (s *translatorSyncer) syncEnvoy(ctx context.Context, snap *v1.ApiSnapshot)
 			Snapshot: snap,
 		}
 
-		xdsSnapshot, reports, _, err := s.translator.Translate(params, proxy)
+		xdsSnapshot, reports, proxyReport, err := s.translator.Translate(params, proxy)
 		if err != nil {
 			err := eris.Wrapf(err, "translation loop failed")
 			logger.DPanicw("", zap.Error(err))
@@ -134,6 +140,9 @@ func (s *translatorSyncer) syncEnvoy(ctx context.Context, snap *v1.ApiSnapshot)
 		}
 
 		allReports.Merge(reports)
+		if statuses := validation.GetRouteStatuses(proxy, proxyReport); statuses != nil {
+			routeStatuses[proxy] = statuses
+		}
 
 		key := xds.SnapshotKey(proxy)
 
@@ -181,11 +190,21 @@ func (s *translatorSyncer) syncEnvoy(ctx context.Context, snap *v1.ApiSnapshot)
 
 	logger.Debugf("gloo reports to be written: %v", allReports)
 
+	var multiErr *multierror.Error
+	for proxy, statuses := range routeStatuses {
+		proxyReports := reporter.ResourceReports{proxy: allReports[proxy]}
+		delete(allReports, proxy)
+		if err := s.reporter.WriteReports(ctx, proxyReports, statuses); err != nil {
+			logger.Debugf("Failed writing report for proxy %v: %v", proxy.Metadata.Ref(), err)
+			multiErr = multierror.Append(multiErr, eris.Wrapf(err, "writing reports"))
+		}
+	}
+
 	if err := s.reporter.WriteReports(ctx, allReports, nil); err != nil {
 		logger.Debugf("Failed writing report for proxies: %v", err)
-		return eris.Wrapf(err, "writing reports")
+		multiErr = multierror.Append(multiErr, eris.Wrapf(err, "writing reports"))
 	}
-	return nil
+	return multiErr.ErrorOrNil()
 }
 
 // TODO(ilackarms): move this somewhere else, make it part of dev-mode
@@ -212,13 +231,15 @@ func (s *translatorSyncer) updateEndpointsOnly(snapshotKey string, current envoy
 		return nil, err
 	}
 
-	newSnapshot := xds.NewSnapshotFromResources(
+	newSnapshot := xds.NewSnapshotFromResourcesWithSecrets(
 		// Set endpoints and clusters calculated during this sync
 		current.GetResources(xds.EndpointType),
 		current.GetResources(xds.ClusterType),
 		// Keep other resources from previous snapshot
 		previous.GetResources(xds.RouteType),
 		previous.GetResources(xds.ListenerType),
+		// Serve the secrets referenced by both, so that certificates are still rotated
+		mergeSecrets(previous.GetResources(xds.SecretType), current.GetResources(xds.SecretType)),
 	)
 
 	if err := newSnapshot.Consistent(); err != nil {
@@ -227,3 +248,22 @@ func (s *translatorSyncer) updateEndpointsOnly(snapshotKey string, current envoy
 
 	return newSnapshot, nil
 }
+
+// mergeSecrets returns the current secrets, and the previous secrets which are no longer in the current snapshot.
+func mergeSecrets(previous, current envoycache.Resources) envoycache.Resources {
+	if len(previous.Items) == 0 {
+		return current
+	}
+	items := make(map[string]envoycache.Resource, len(previous.Items)+len(current.Items))
+	for name, secret := range previous.Items {
+		items[name] = secret
+	}
+	for name, secret := range current.Items {
+		items[name] = secret
+	}
+	version, err := hashstructure.Hash(items, nil)
+	if err != nil {
+		return current
+	}
+	return envoycache.Resources{Version: fmt.Sprintf("%v", version), Items: items}
+}
@@ -3,6 +3,7 @@ package sanitizer
 import (
 	"context"
 	"sort"
+	"sync"
 
 	endpoint "github.com/envoyproxy/go-control-plane/envoy/api/v2/endpoint"
 	listener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
@@ -45,26 +46,37 @@ var (
 )
 
 type RouteReplacingSanitizer struct {
+	lock             sync.RWMutex
 	enabled          bool
 	fallbackListener *envoyapi.Listener
 	fallbackCluster  *envoyapi.Cluster
 }
 
 func NewRouteReplacingSanitizer(cfg *v1.GlooOptions_InvalidConfigPolicy) (*RouteReplacingSanitizer, error) {
+	s := &RouteReplacingSanitizer{}
+	if err := s.UpdateInvalidConfigPolicy(cfg); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// UpdateInvalidConfigPolicy applies cfg to the snapshots sanitized from now on.
+func (s *RouteReplacingSanitizer) UpdateInvalidConfigPolicy(cfg *v1.GlooOptions_InvalidConfigPolicy) error {
 
 	responseCode := cfg.GetInvalidRouteResponseCode()
 	responseBody := cfg.GetInvalidRouteResponseBody()
 
 	listener, cluster, err := makeFallbackListenerAndCluster(responseCode, responseBody)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	return &RouteReplacingSanitizer{
-		enabled:          cfg.GetReplaceInvalidRoutes(),
-		fallbackListener: listener,
-		fallbackCluster:  cluster,
-	}, nil
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.enabled = cfg.GetReplaceInvalidRoutes()
+	s.fallbackListener = listener
+	s.fallbackCluster = cluster
+	return nil
 }
 
 func makeFallbackListenerAndCluster(responseCode uint32, responseBody string) (*envoyapi.Listener, *envoyapi.Cluster, error) {
@@ -153,6 +165,8 @@ func makeFallbackListenerAndCluster(responseCode uint32, responseBody string) (*
 }
 
 func (s *RouteReplacingSanitizer) SanitizeSnapshot(ctx context.Context, glooSnapshot *v1.ApiSnapshot, xdsSnapshot envoycache.Snapshot, reports reporter.ResourceReports) (envoycache.Snapshot, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
 	if !s.enabled {
 		// if if the route sanitizer is not enabled, enforce strict validation of routes (warnings are treated as errors)
 		// this is necessary because the translator only uses Validate() which ignores warnings
@@ -181,11 +195,12 @@ func (s *RouteReplacingSanitizer) SanitizeSnapshot(ctx context.Context, glooSnap
 		s.insertFallbackCluster(&clusters)
 	}
 
-	xdsSnapshot = xds.NewSnapshotFromResources(
+	xdsSnapshot = xds.NewSnapshotFromResourcesWithSecrets(
 		xdsSnapshot.GetResources(xds.EndpointType),
 		clusters,
 		translator.MakeRdsResources(replacedRouteConfigs),
 		listeners,
+		xdsSnapshot.GetResources(xds.SecretType),
 	)
 
 	// If the snapshot is not consistent, error
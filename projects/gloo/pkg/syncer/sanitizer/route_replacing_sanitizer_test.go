@@ -227,4 +227,46 @@ var _ = Describe("RouteReplacingSanitizer", func() {
 		Expect(listenersWithFallback.ResourceProto()).To(Equal(sanitizer.fallbackListener))
 		Expect(clustersWithFallback.ResourceProto()).To(Equal(sanitizer.fallbackCluster))
 	})
+	It("applies an updated invalid config policy", func() {
+		routeCfg := &envoyapi.RouteConfiguration{
+			Name: routeCfgName,
+			VirtualHosts: []*route.VirtualHost{{
+				Routes: []*route.Route{missingRouteSingle},
+			}},
+		}
+		xdsSnapshot := xds.NewSnapshotFromResources(
+			envoycache.NewResources("", nil),
+			envoycache.NewResources("", nil),
+			envoycache.NewResources("routes", []envoycache.Resource{
+				xds.NewEnvoyResource(routeCfg),
+			}),
+			envoycache.NewResources("listeners", []envoycache.Resource{
+				xds.NewEnvoyResource(listener),
+			}),
+		)
+		reports := reporter.ResourceReports{
+			&v1.Proxy{}: {
+				Warnings: []string{"route with missing upstream"},
+			},
+		}
+		glooSnapshot := &v1.ApiSnapshot{
+			Upstreams: v1.UpstreamList{us},
+		}
+
+		sanitizer, err := NewRouteReplacingSanitizer(&v1.GlooOptions_InvalidConfigPolicy{})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = sanitizer.SanitizeSnapshot(context.TODO(), glooSnapshot, xdsSnapshot, reports)
+		Expect(err).To(HaveOccurred())
+
+		err = sanitizer.UpdateInvalidConfigPolicy(invalidCfgPolicy)
+		Expect(err).NotTo(HaveOccurred())
+		snap, err := sanitizer.SanitizeSnapshot(context.TODO(), glooSnapshot, xdsSnapshot, reports)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(snap.GetResources(xds.RouteType).Items[routeCfg.GetName()].ResourceProto()).To(Equal(&envoyapi.RouteConfiguration{
+			Name: routeCfgName,
+			VirtualHosts: []*route.VirtualHost{{
+				Routes: []*route.Route{fixedRouteSingle},
+			}},
+		}))
+	})
 })
@@ -59,11 +59,12 @@ func (s *UpstreamRemovingSanitizer) SanitizeSnapshot(ctx context.Context, glooSn
 	// TODO(marco): the function accepts and return a Snapshot interface, but then swaps in its own implementation.
 	//  This breaks the abstraction and mocking the snapshot becomes impossible. We should have a generic way of
 	//  creating snapshots.
-	xdsSnapshot = xds.NewSnapshotFromResources(
+	xdsSnapshot = xds.NewSnapshotFromResourcesWithSecrets(
 		endpoints,
 		clusters,
 		xdsSnapshot.GetResources(xds.RouteType),
 		xdsSnapshot.GetResources(xds.ListenerType),
+		xdsSnapshot.GetResources(xds.SecretType),
 	)
 
 	// If the snapshot is not consistent,
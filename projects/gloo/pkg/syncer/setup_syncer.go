@@ -10,6 +10,7 @@ import (
 	"time"
 
 	envoyv2 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v2"
+	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_zap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
@@ -18,16 +19,19 @@ import (
 	vaultapi "github.com/hashicorp/vault/api"
 	"github.com/solo-io/gloo/pkg/utils"
 	"github.com/solo-io/gloo/pkg/utils/channelutils"
+	"github.com/solo-io/gloo/pkg/utils/leaderelector"
 	"github.com/solo-io/gloo/pkg/utils/setuputils"
 	rlv1alpha1 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/solo/ratelimit"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	extauth "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extauth/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/ratelimit"
 	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap"
+	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap/clients/etcd"
 	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
 	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	consulplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/consul"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/external"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/registry"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/wasm"
 	"github.com/solo-io/gloo/projects/gloo/pkg/syncer/sanitizer"
@@ -41,6 +45,7 @@ import (
 	"github.com/solo-io/gloo/projects/metrics/pkg/runner"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/go-utils/errutils"
+	"github.com/solo-io/go-utils/kubeutils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube"
@@ -49,6 +54,7 @@ import (
 	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
 	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/server"
 	xdsserver "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/server"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
 	"github.com/solo-io/solo-kit/pkg/errors"
 	"go.uber.org/zap"
@@ -78,11 +84,22 @@ func NewSetupFuncWithRun(runFunc RunFunc) setuputils.SetupFunc {
 	return NewSetupFuncWithRunAndExtensions(runFunc, nil)
 }
 
+// NewReloadableSetupFunc returns the SetupFunc of NewSetupFunc, and a ReloadFunc applying settings changes which only
+// affect translation to the running translator and plugins.
+func NewReloadableSetupFunc() (setuputils.SetupFunc, setuputils.ReloadFunc) {
+	s := newSetupSyncer(RunGloo, nil)
+	return s.Setup, s.Reload
+}
+
 func NewSetupFuncWithRunAndExtensions(runFunc RunFunc, extensions *Extensions) setuputils.SetupFunc {
-	s := &setupSyncer{
+	return newSetupSyncer(runFunc, extensions).Setup
+}
+
+func newSetupSyncer(runFunc RunFunc, extensions *Extensions) *setupSyncer {
+	return &setupSyncer{
 		extensions: extensions,
-		makeGrpcServer: func(ctx context.Context) *grpc.Server {
-			return grpc.NewServer(grpc.StreamInterceptor(
+		makeGrpcServer: func(ctx context.Context, options ...grpc.ServerOption) *grpc.Server {
+			return grpc.NewServer(append([]grpc.ServerOption{grpc.StreamInterceptor(
 				grpc_middleware.ChainStreamServer(
 					grpc_ctxtags.StreamServerInterceptor(),
 					grpc_zap.StreamServerInterceptor(zap.NewNop()),
@@ -91,11 +108,10 @@ func NewSetupFuncWithRunAndExtensions(runFunc RunFunc, extensions *Extensions) s
 						return handler(srv, ss)
 					},
 				)),
-			)
+			}, options...)...)
 		},
 		runFunc: runFunc,
 	}
-	return s.Setup
 }
 
 type grpcServer struct {
@@ -106,12 +122,15 @@ type grpcServer struct {
 type setupSyncer struct {
 	extensions               *Extensions
 	runFunc                  RunFunc
-	makeGrpcServer           func(ctx context.Context) *grpc.Server
+	makeGrpcServer           func(ctx context.Context, options ...grpc.ServerOption) *grpc.Server
 	previousXdsServer        grpcServer
+	previousXdsAuth          *v1.GlooOptions_XdsAuthOptions
 	previousValidationServer grpcServer
 	controlPlane             bootstrap.ControlPlane
 	validationServer         bootstrap.ValidationServer
 	callbacks                xdsserver.Callbacks
+	// the components started by the last run which apply settings changes live
+	settingsReloaders *bootstrap.SettingsReloaders
 }
 
 func NewControlPlane(ctx context.Context, grpcServer *grpc.Server, bindAddr net.Addr, callbacks xdsserver.Callbacks, start bool) bootstrap.ControlPlane {
@@ -133,6 +152,34 @@ func NewControlPlane(ctx context.Context, grpcServer *grpc.Server, bindAddr net.
 	}
 }
 
+// xdsAuthServerOptions returns the options of the xDS gRPC server that authenticate and authorize its clients.
+func xdsAuthServerOptions(xdsAuth *v1.GlooOptions_XdsAuthOptions) ([]grpc.ServerOption, error) {
+	if xdsAuth == nil {
+		return nil, nil
+	}
+	options, err := xds.XdsServerOptions(xdsAuth)
+	if err != nil {
+		return nil, err
+	}
+	var reviewer xds.TokenReviewer
+	if xdsAuth.GetServiceAccountTokens() {
+		cfg, err := kubeutils.GetConfig("", "")
+		if err != nil {
+			return nil, errors.Wrapf(err, "getting kube config to review service account tokens")
+		}
+		kube, err := kubernetes.NewForConfig(cfg)
+		if err != nil {
+			return nil, errors.Wrapf(err, "creating kube client to review service account tokens")
+		}
+		reviewer = xds.NewKubeTokenReviewer(kube)
+	}
+	authorizer := xds.NewXdsAuthorizer(xdsAuth, reviewer)
+	return append(options,
+		grpc.ChainStreamInterceptor(authorizer.StreamInterceptor()),
+		grpc.ChainUnaryInterceptor(authorizer.UnaryInterceptor()),
+	), nil
+}
+
 func NewValidationServer(ctx context.Context, grpcServer *grpc.Server, bindAddr net.Addr, start bool) bootstrap.ValidationServer {
 	return bootstrap.ValidationServer{
 		GrpcService: &bootstrap.GrpcService{
@@ -202,7 +249,8 @@ func (s *setupSyncer) Setup(ctx context.Context, kubeCache kube.SharedCache, mem
 	emptyControlPlane := bootstrap.ControlPlane{}
 	emptyValidationServer := bootstrap.ValidationServer{}
 
-	if xdsAddr != s.previousXdsServer.addr {
+	xdsAuth := settings.GetGloo().GetXdsAuth()
+	if xdsAddr != s.previousXdsServer.addr || !xdsAuth.Equal(s.previousXdsAuth) {
 		if s.previousXdsServer.cancel != nil {
 			s.previousXdsServer.cancel()
 			s.previousXdsServer.cancel = nil
@@ -221,14 +269,19 @@ func (s *setupSyncer) Setup(ctx context.Context, kubeCache kube.SharedCache, mem
 	// initialize the control plane context in this block either on the first loop, or if bind addr changed
 	if s.controlPlane == emptyControlPlane {
 		// create new context as the grpc server might survive multiple iterations of this loop.
+		xdsServerOptions, err := xdsAuthServerOptions(xdsAuth)
+		if err != nil {
+			return errors.Wrapf(err, "configuring xds auth")
+		}
 		ctx, cancel := context.WithCancel(context.Background())
 		var callbacks xdsserver.Callbacks
 		if s.extensions != nil {
 			callbacks = s.extensions.XdsCallbacks
 		}
-		s.controlPlane = NewControlPlane(ctx, s.makeGrpcServer(ctx), xdsTcpAddress, callbacks, true)
+		s.controlPlane = NewControlPlane(ctx, s.makeGrpcServer(ctx, xdsServerOptions...), xdsTcpAddress, callbacks, true)
 		s.previousXdsServer.cancel = cancel
 		s.previousXdsServer.addr = xdsAddr
+		s.previousXdsAuth = xdsAuth
 	}
 
 	// initialize the validation server context in this block either on the first loop, or if bind addr changed
@@ -245,9 +298,14 @@ func (s *setupSyncer) Setup(ctx context.Context, kubeCache kube.SharedCache, mem
 		return err
 	}
 
+	etcdClient, err := bootstrap.EtcdClientForSettings(ctx, settings)
+	if err != nil {
+		return err
+	}
+
 	var vaultClient *vaultapi.Client
 	if vaultSettings := settings.GetVaultSecretSource(); vaultSettings != nil {
-		vaultClient, err = bootstrap.VaultClientForSettings(vaultSettings)
+		vaultClient, err = bootstrap.VaultClientForSettings(ctx, vaultSettings)
 		if err != nil {
 			return err
 		}
@@ -258,6 +316,7 @@ func (s *setupSyncer) Setup(ctx context.Context, kubeCache kube.SharedCache, mem
 		&clientset,
 		kubeCache,
 		consulClient,
+		etcdClient,
 		vaultClient,
 		memCache,
 		settings,
@@ -300,6 +359,14 @@ func (s *setupSyncer) Setup(ctx context.Context, kubeCache kube.SharedCache, mem
 		opts.Consul.ConsulWatcher = consulClientWrapper
 	}
 
+	opts.ExternalPlugins, err = external.NewPluginsForSettings(ctx, settings)
+	if err != nil {
+		return err
+	}
+
+	s.settingsReloaders = &bootstrap.SettingsReloaders{}
+	opts.SettingsReloaders = s.settingsReloaders
+
 	err = s.runFunc(opts)
 
 	s.validationServer.StartGrpcServer = opts.ValidationServer.StartGrpcServer
@@ -308,6 +375,34 @@ func (s *setupSyncer) Setup(ctx context.Context, kubeCache kube.SharedCache, mem
 	return err
 }
 
+// Reload applies settings to the components started by the last Setup, if they differ from previous only in
+// settings applied on translation.
+func (s *setupSyncer) Reload(ctx context.Context, previous, settings *v1.Settings) (bool, error) {
+	if s.settingsReloaders == nil || !withoutTranslationSettings(previous).Equal(withoutTranslationSettings(settings)) {
+		return false, nil
+	}
+	return true, s.settingsReloaders.Reload(settings)
+}
+
+// withoutTranslationSettings clears the settings that can change without restarting gloo, as they are only
+// read when translating (or not read by gloo at all).
+func withoutTranslationSettings(settings *v1.Settings) *v1.Settings {
+	settings = proto.Clone(settings).(*v1.Settings)
+	settings.Metadata = core.Metadata{}
+	settings.Status = core.Status{}
+	settings.Gateway = nil
+	settings.RatelimitServer = nil
+	settings.Extauth = nil
+	if gloo := settings.GetGloo(); gloo != nil {
+		gloo.CircuitBreakers = nil
+		gloo.InvalidConfigPolicy = nil
+		gloo.DisableGrpcWeb = nil
+		gloo.DisableProxyGarbageCollection = nil
+		gloo.RegexMaxProgramSize = nil
+	}
+	return settings
+}
+
 type Extensions struct {
 	// Deprecated. Use PluginExtensionsFuncs instead.
 	PluginExtensions      []plugins.Plugin
@@ -330,6 +425,10 @@ func GetPluginsWithExtensionsAndRegistry(opts bootstrap.Opts, registryPlugins fu
 		for _, pluginExtension := range pluginfuncs {
 			plugins = append(plugins, pluginExtension())
 		}
+		// external plugins are called last, so that they can modify the output of every compiled-in plugin
+		for _, externalPlugin := range opts.ExternalPlugins {
+			plugins = append(plugins, externalPlugin)
+		}
 		return plugins
 	}
 }
@@ -462,7 +561,10 @@ func RunGlooWithExtensions(opts bootstrap.Opts, extensions Extensions) error {
 
 	go errutils.AggregateErrs(watchOpts.Ctx, errs, edsErrs, "eds.gloo")
 
-	apiCache := v1.NewApiEmitter(
+	// forces a sync, e.g. after settings are reloaded
+	resync := make(chan struct{}, 1)
+
+	apiCache := v1.NewApiEmitterWithEmit(
 		artifactClient,
 		endpointClient,
 		proxyClient,
@@ -471,40 +573,37 @@ func RunGlooWithExtensions(opts bootstrap.Opts, extensions Extensions) error {
 		hybridUsClient,
 		authConfigClient,
 		rlClient,
+		resync,
 	)
 
 	if os.Getenv(wasm.WasmEnabled) == "true" {
-		// If wasm, use constructor with emit channel,
-		// Start goroutine that triggers it every couple seconds.
-		wasmAutoNotifier := make(chan struct{}, 1)
-
-		// Start update loop every 5 seconds
-		go reTriggerSync(opts.WatchOpts.Ctx, wasmAutoNotifier, 5*time.Second)
-
-		apiCache = v1.NewApiEmitterWithEmit(
-			artifactClient,
-			endpointClient,
-			proxyClient,
-			upstreamGroupClient,
-			secretClient,
-			hybridUsClient,
-			authConfigClient,
-			rlClient,
-			wasmAutoNotifier,
-		)
-	}
-
-	rpt := reporter.NewReporter("gloo",
+		// If wasm, start goroutine that triggers a sync every couple seconds.
+		go reTriggerSync(opts.WatchOpts.Ctx, resync, 5*time.Second)
+	}
+
+	identity, err := leaderelector.ForSettings(watchOpts.Ctx, opts.Settings, "gloo")
+	if err != nil {
+		return err
+	}
+	// every replica serves xds, but only the leader writes statuses
+	rpt := leaderelector.NewStatusReporter(reporter.NewReporter("gloo",
 		hybridUsClient.BaseClient(),
 		proxyClient.BaseClient(),
 		upstreamGroupClient.BaseClient(),
 		authConfigClient.BaseClient(),
 		rlReporterClient,
-	)
+	), identity)
+	leaderelector.OnElected(watchOpts.Ctx, identity, func() {
+		select {
+		case resync <- struct{}{}:
+		default:
+			// a sync is already pending
+		}
+	})
 
-	t := translator.NewTranslator(sslutils.NewSslConfigTranslator(), opts.Settings, getPlugins)
+	t := translator.NewTranslator(sslutils.NewSslConfigTranslatorForSettings(opts.Settings), opts.Settings, getPlugins)
 
-	validator := validation.NewValidator(watchOpts.Ctx, t)
+	validator := validation.NewValidator(watchOpts.Ctx, t, validation.NewXdsValidators(opts.Settings.GetGloo().GetXdsValidation()))
 	if opts.ValidationServer.Server != nil {
 		opts.ValidationServer.Server.SetValidator(validator)
 	}
@@ -538,6 +637,20 @@ func RunGlooWithExtensions(opts bootstrap.Opts, extensions Extensions) error {
 
 	translationSync := NewTranslatorSyncer(t, opts.ControlPlane.SnapshotCache, xdsHasher, xdsSanitizer, rpt, opts.DevMode, syncerExtensions, opts.Settings)
 
+	opts.SettingsReloaders.Add(func(settings *v1.Settings) error {
+		if err := routeReplacingSanitizer.UpdateInvalidConfigPolicy(settings.GetGloo().GetInvalidConfigPolicy()); err != nil {
+			return err
+		}
+		t.(translator.SettingsUpdater).UpdateSettings(settings)
+		translationSync.(translator.SettingsUpdater).UpdateSettings(settings)
+		select {
+		case resync <- struct{}{}:
+		default:
+			// a sync is already pending
+		}
+		return nil
+	})
+
 	syncers := v1.ApiSyncers{
 		translationSync,
 		validator,
@@ -638,7 +751,7 @@ func RunGlooWithExtensions(opts bootstrap.Opts, extensions Extensions) error {
 	return nil
 }
 
-func constructOpts(ctx context.Context, clientset *kubernetes.Interface, kubeCache kube.SharedCache, consulClient *consulapi.Client, vaultClient *vaultapi.Client, memCache memory.InMemoryResourceCache, settings *v1.Settings) (bootstrap.Opts, error) {
+func constructOpts(ctx context.Context, clientset *kubernetes.Interface, kubeCache kube.SharedCache, consulClient *consulapi.Client, etcdClient *etcd.Client, vaultClient *vaultapi.Client, memCache memory.InMemoryResourceCache, settings *v1.Settings) (bootstrap.Opts, error) {
 
 	var (
 		cfg           *rest.Config
@@ -651,6 +764,7 @@ func constructOpts(ctx context.Context, clientset *kubernetes.Interface, kubeCac
 		kubeCache,
 		&cfg,
 		consulClient,
+		etcdClient,
 	)
 
 	upstreamFactory, err := bootstrap.ConfigFactoryForSettings(params, v1.UpstreamCrd)
@@ -702,6 +816,7 @@ func constructOpts(ctx context.Context, clientset *kubernetes.Interface, kubeCac
 		clientset,
 		&kubeCoreCache,
 		consulClient,
+		etcdClient,
 		v1.ArtifactCrd.Plural,
 	)
 	if err != nil {
@@ -6,6 +6,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube"
 	"google.golang.org/grpc"
@@ -101,6 +102,31 @@ var _ = Describe("SetupSyncer", func() {
 				err = testFunc()
 				Expect(err).NotTo(HaveOccurred())
 			})
+
+			It("reloads settings which only affect translation", func() {
+				setup, reload := NewReloadableSetupFunc()
+
+				updated := proto.Clone(settings).(*v1.Settings)
+				updated.Gloo.InvalidConfigPolicy = &v1.GlooOptions_InvalidConfigPolicy{ReplaceInvalidRoutes: true}
+				reloaded, err := reload(ctx, settings, updated)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(reloaded).To(BeFalse(), "nothing to reload before setup")
+
+				err = setup(ctx, nil, memcache, settings)
+				Expect(err).NotTo(HaveOccurred())
+
+				updated.Metadata.ResourceVersion = "2"
+				updated.Gloo.RegexMaxProgramSize = &types.UInt32Value{Value: 200}
+				updated.Gloo.CircuitBreakers = &v1.CircuitBreakerConfig{MaxConnections: &types.UInt32Value{Value: 10}}
+				reloaded, err = reload(ctx, settings, updated)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(reloaded).To(BeTrue())
+
+				updated.WatchNamespaces = []string{"another-namespace"}
+				reloaded, err = reload(ctx, settings, updated)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(reloaded).To(BeFalse())
+			})
 		})
 		Context("Extensions tests", func() {
 			var (
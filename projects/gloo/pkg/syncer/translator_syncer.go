@@ -2,6 +2,7 @@ package syncer
 
 import (
 	"context"
+	"sync"
 
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/ratelimit"
 	"github.com/solo-io/gloo/projects/gloo/pkg/syncer/sanitizer"
@@ -25,6 +26,7 @@ type translatorSyncer struct {
 	extensions []TranslatorSyncerExtension
 	// used to track which envoy node IDs exist without belonging to a proxy
 	extensionKeys map[string]struct{}
+	settingsLock  sync.RWMutex
 	settings      *v1.Settings
 }
 
@@ -58,6 +60,19 @@ func NewTranslatorSyncer(translator translator.Translator, xdsCache envoycache.S
 	return s
 }
 
+// UpdateSettings applies settings on the next sync, without restarting the control plane.
+func (s *translatorSyncer) UpdateSettings(settings *v1.Settings) {
+	s.settingsLock.Lock()
+	defer s.settingsLock.Unlock()
+	s.settings = settings
+}
+
+func (s *translatorSyncer) getSettings() *v1.Settings {
+	s.settingsLock.RLock()
+	defer s.settingsLock.RUnlock()
+	return s.settings
+}
+
 func (s *translatorSyncer) Sync(ctx context.Context, snap *v1.ApiSnapshot) error {
 	var multiErr *multierror.Error
 	err := s.syncEnvoy(ctx, snap)
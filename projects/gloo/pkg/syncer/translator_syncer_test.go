@@ -4,6 +4,7 @@ import (
 	v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	validationutils "github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
 	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
 
 	"context"
@@ -60,7 +61,7 @@ var _ = Describe("Translate Proxy", func() {
 		rep := reporter.NewReporter(ref, proxyClient.BaseClient(), upstreamClient)
 
 		xdsHasher := &xds.ProxyKeyHasher{}
-		syncer = NewTranslatorSyncer(&mockTranslator{true}, xdsCache, xdsHasher, sanitizer, rep, false, nil, settings)
+		syncer = NewTranslatorSyncer(&mockTranslator{reportErrs: true}, xdsCache, xdsHasher, sanitizer, rep, false, nil, settings)
 		snap = &v1.ApiSnapshot{
 			Proxies: v1.ProxyList{
 				proxy,
@@ -89,7 +90,7 @@ var _ = Describe("Translate Proxy", func() {
 		Expect(err).NotTo(HaveOccurred())
 		snap.Proxies[0] = p1
 
-		syncer = NewTranslatorSyncer(&mockTranslator{false}, xdsCache, xdsHasher, sanitizer, rep, false, nil, settings)
+		syncer = NewTranslatorSyncer(&mockTranslator{}, xdsCache, xdsHasher, sanitizer, rep, false, nil, settings)
 
 		err = syncer.Sync(context.Background(), snap)
 		Expect(err).NotTo(HaveOccurred())
@@ -148,13 +149,89 @@ var _ = Describe("Translate Proxy", func() {
 
 		Expect(oldRoutes).To(Equal(newRoutes))
 	})
+
+	It("writes the statuses of routes with warnings as subresource statuses on the proxy", func() {
+		snap.Proxies[0].Listeners = []*v1.Listener{{
+			Name: "listener",
+			ListenerType: &v1.Listener_HttpListener{HttpListener: &v1.HttpListener{
+				VirtualHosts: []*v1.VirtualHost{{Name: "vh", Routes: []*v1.Route{{}}}},
+			}},
+		}}
+		syncer = NewTranslatorSyncer(&mockTranslator{reportRouteWarnings: true}, xdsCache, &xds.ProxyKeyHasher{}, sanitizer,
+			reporter.NewReporter(ref, proxyClient.BaseClient()), false, nil, settings)
+		err := syncer.Sync(context.Background(), snap)
+		Expect(err).NotTo(HaveOccurred())
+
+		proxy, err := proxyClient.Read(ns, proxyName, clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(proxy.Status).To(Equal(core.Status{
+			State:      core.Status_Accepted,
+			ReportedBy: ref,
+			SubresourceStatuses: map[string]*core.Status{
+				"*v1.Route.listener/vh/routes[0]": {
+					State:  core.Status_Warning,
+					Reason: "Route Warning: InvalidDestinationWarning. Reason: no upstream",
+				},
+			},
+		}))
+	})
+
+	It("writes the reports of the other proxies when writing the route statuses of a proxy fails", func() {
+		snap.Proxies[0].Listeners = []*v1.Listener{{
+			Name: "listener",
+			ListenerType: &v1.Listener_HttpListener{HttpListener: &v1.HttpListener{
+				VirtualHosts: []*v1.VirtualHost{{Name: "vh", Routes: []*v1.Route{{}}}},
+			}},
+		}}
+		other, err := proxyClient.Write(&v1.Proxy{Metadata: core.Metadata{Namespace: ns, Name: "other-proxy"}}, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		snap.Proxies = append(snap.Proxies, other)
+
+		rep := &failingSubresourceReporter{Reporter: reporter.NewReporter(ref, proxyClient.BaseClient())}
+		syncer = NewTranslatorSyncer(&mockTranslator{reportRouteWarnings: true}, xdsCache, &xds.ProxyKeyHasher{}, sanitizer,
+			rep, false, nil, settings)
+		err = syncer.Sync(context.Background(), snap)
+		Expect(err).To(MatchError(ContainSubstring("the status service is on a break")))
+
+		other, err = proxyClient.Read(ns, "other-proxy", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(other.Status).To(Equal(core.Status{
+			State:      core.Status_Accepted,
+			ReportedBy: ref,
+		}))
+	})
 })
 
+// fails to write reports with subresource statuses
+type failingSubresourceReporter struct {
+	reporter.Reporter
+}
+
+func (r *failingSubresourceReporter) WriteReports(ctx context.Context, reports reporter.ResourceReports, subresourceStatuses map[string]*core.Status) error {
+	if subresourceStatuses != nil {
+		return errors.Errorf("the status service is on a break")
+	}
+	return r.Reporter.WriteReports(ctx, reports, subresourceStatuses)
+}
+
 type mockTranslator struct {
 	reportErrs bool
+	// report a warning on every route
+	reportRouteWarnings bool
 }
 
 func (t *mockTranslator) Translate(params plugins.Params, proxy *v1.Proxy) (envoycache.Snapshot, reporter.ResourceReports, *validation.ProxyReport, error) {
+	if t.reportRouteWarnings {
+		proxyReport := validationutils.MakeReport(proxy)
+		for _, listenerReport := range proxyReport.GetListenerReports() {
+			for _, vhReport := range listenerReport.GetHttpListenerReport().GetVirtualHostReports() {
+				for _, routeReport := range vhReport.GetRouteReports() {
+					validationutils.AppendRouteWarning(routeReport, validation.RouteReport_Warning_InvalidDestinationWarning, "no upstream")
+				}
+			}
+		}
+		return envoycache.NilSnapshot{}, nil, proxyReport, nil
+	}
 	if t.reportErrs {
 		rpts := reporter.ResourceReports{}
 		rpts.AddError(proxy, errors.Errorf("hi, how ya doin'?"))
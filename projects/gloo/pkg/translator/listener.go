@@ -134,7 +134,7 @@ func (t *translatorInstance) computeListenerFilters(params plugins.Params, liste
 	}
 
 	// add the http connection manager filter after all the InAuth Listener Filters
-	rdsName := routeConfigName(listener)
+	rdsName := RouteConfigName(listener)
 	httpConnMgr := t.computeHttpConnectionManagerFilter(params, httpListener.HttpListener, rdsName, httpListenerReport)
 	listenerFilters = append(listenerFilters, plugins.StagedListenerFilter{
 		ListenerFilter: httpConnMgr,
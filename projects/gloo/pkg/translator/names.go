@@ -2,6 +2,7 @@ package translator
 
 import v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 
-func routeConfigName(listener *v1.Listener) string {
+// RouteConfigName is the name of the RDS resource generated for an http listener
+func RouteConfigName(listener *v1.Listener) string {
 	return listener.Name + "-routes"
 }
@@ -0,0 +1,100 @@
+package translator
+
+import (
+	"sort"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoyauthv2 "github.com/envoyproxy/go-control-plane/envoy/api/v2/auth"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/ptypes"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+)
+
+// computeSdsSecrets returns the secrets gloo serves over ADS for the TLS Secrets that the clusters and listeners
+// reference by name (see settings.gloo.enableSdsSecrets). Secrets which are not referenced are not served, so that
+// an Envoy can only fetch the certificates of its own configuration.
+func computeSdsSecrets(secrets v1.SecretList, clusters []*envoyapi.Cluster, listeners []*envoyapi.Listener) []*envoyauthv2.Secret {
+	referenced := map[string]bool{}
+	for _, cluster := range clusters {
+		addAdsSecretNames(referenced, cluster.GetTransportSocket())
+		for _, match := range cluster.GetTransportSocketMatches() {
+			addAdsSecretNames(referenced, match.GetTransportSocket())
+		}
+	}
+	for _, listener := range listeners {
+		for _, chain := range listener.GetFilterChains() {
+			addAdsSecretNames(referenced, chain.GetTransportSocket())
+		}
+	}
+	if len(referenced) == 0 {
+		return nil
+	}
+
+	var sdsSecrets []*envoyauthv2.Secret
+	for _, secret := range secrets {
+		tlsSecret := secret.GetTls()
+		if tlsSecret == nil {
+			continue
+		}
+		ref := secret.GetMetadata().Ref()
+		if name := utils.SdsCertificateSecretName(ref); referenced[name] && tlsSecret.GetCertChain() != "" {
+			sdsSecrets = append(sdsSecrets, &envoyauthv2.Secret{
+				Name: name,
+				Type: &envoyauthv2.Secret_TlsCertificate{
+					TlsCertificate: &envoyauthv2.TlsCertificate{
+						CertificateChain: DataSourceFromString(tlsSecret.GetCertChain()),
+						PrivateKey:       DataSourceFromString(tlsSecret.GetPrivateKey()),
+					},
+				},
+			})
+		}
+		if name := utils.SdsValidationContextSecretName(ref); referenced[name] && tlsSecret.GetRootCa() != "" {
+			sdsSecrets = append(sdsSecrets, &envoyauthv2.Secret{
+				Name: name,
+				Type: &envoyauthv2.Secret_ValidationContext{
+					ValidationContext: &envoyauthv2.CertificateValidationContext{
+						TrustedCa: DataSourceFromString(tlsSecret.GetRootCa()),
+					},
+				},
+			})
+		}
+	}
+	sort.SliceStable(sdsSecrets, func(i, j int) bool {
+		return sdsSecrets[i].GetName() < sdsSecrets[j].GetName()
+	})
+	return sdsSecrets
+}
+
+// addAdsSecretNames adds the names of the secrets that a TLS transport socket fetches over ADS.
+func addAdsSecretNames(names map[string]bool, socket *envoycore.TransportSocket) {
+	if socket.GetName() != wellknown.TransportSocketTls || socket.GetTypedConfig() == nil {
+		return
+	}
+	var common *envoyauth.CommonTlsContext
+	downstream := &envoyauth.DownstreamTlsContext{}
+	upstream := &envoyauth.UpstreamTlsContext{}
+	if ptypes.Is(socket.GetTypedConfig(), downstream) {
+		if ptypes.UnmarshalAny(socket.GetTypedConfig(), downstream) != nil {
+			return
+		}
+		common = downstream.GetCommonTlsContext()
+	} else if ptypes.Is(socket.GetTypedConfig(), upstream) {
+		if ptypes.UnmarshalAny(socket.GetTypedConfig(), upstream) != nil {
+			return
+		}
+		common = upstream.GetCommonTlsContext()
+	}
+
+	sdsConfigs := append([]*envoyauth.SdsSecretConfig{}, common.GetTlsCertificateSdsSecretConfigs()...)
+	sdsConfigs = append(sdsConfigs,
+		common.GetValidationContextSdsSecretConfig(),
+		common.GetCombinedValidationContext().GetValidationContextSdsSecretConfig())
+	for _, sdsConfig := range sdsConfigs {
+		if sdsConfig.GetSdsConfig().GetAds() != nil {
+			names[sdsConfig.GetName()] = true
+		}
+	}
+}
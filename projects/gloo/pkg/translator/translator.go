@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"math/rand"
 	"os"
+	"sync"
 
+	"github.com/solo-io/gloo/pkg/utils/settingsutil"
 	validationapi "github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
@@ -18,6 +20,7 @@ import (
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
 
 	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoyauthv2 "github.com/envoyproxy/go-control-plane/envoy/api/v2/auth"
 	envoy_api_v2_core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
 	structpb "github.com/golang/protobuf/ptypes/struct"
 	"github.com/mitchellh/hashstructure"
@@ -29,6 +32,12 @@ type Translator interface {
 	Translate(params plugins.Params, proxy *v1.Proxy) (envoycache.Snapshot, reporter.ResourceReports, *validationapi.ProxyReport, error)
 }
 
+// SettingsUpdater is implemented by the translators returned by NewTranslator. Updated settings are passed to the
+// plugins on the next translation, so they can be changed without restarting the control plane.
+type SettingsUpdater interface {
+	UpdateSettings(settings *v1.Settings)
+}
+
 func NewTranslator(sslConfigTranslator utils.SslConfigTranslator, settings *v1.Settings, getPlugins func() []plugins.Plugin) Translator {
 	return &translatorFactory{
 		getPlugins:          getPlugins,
@@ -39,14 +48,31 @@ func NewTranslator(sslConfigTranslator utils.SslConfigTranslator, settings *v1.S
 
 type translatorFactory struct {
 	getPlugins          func() []plugins.Plugin
-	settings            *v1.Settings
 	sslConfigTranslator utils.SslConfigTranslator
+	settingsLock        sync.RWMutex
+	settings            *v1.Settings
+	// set once settings were updated after the control plane started
+	settingsUpdated bool
+}
+
+func (t *translatorFactory) UpdateSettings(settings *v1.Settings) {
+	t.settingsLock.Lock()
+	defer t.settingsLock.Unlock()
+	t.settings = settings
+	t.settingsUpdated = true
 }
 
 func (t *translatorFactory) Translate(params plugins.Params, proxy *v1.Proxy) (envoycache.Snapshot, reporter.ResourceReports, *validationapi.ProxyReport, error) {
+	t.settingsLock.RLock()
+	settings, settingsUpdated := t.settings, t.settingsUpdated
+	t.settingsLock.RUnlock()
+	if settingsUpdated {
+		// the settings on the context are the ones the control plane was started with
+		params.Ctx = settingsutil.WithSettings(params.Ctx, settings)
+	}
 	instance := &translatorInstance{
 		plugins:             t.getPlugins(),
-		settings:            t.settings,
+		settings:            settings,
 		sslConfigTranslator: t.sslConfigTranslator,
 	}
 	return instance.Translate(params, proxy)
@@ -157,7 +183,9 @@ ClusterLoop:
 		clusters = append(clusters, generated...)
 	}
 
-	xdsSnapshot := generateXDSSnapshot(clusters, endpoints, routeConfigs, listeners)
+	secrets := computeSdsSecrets(params.Snapshot.Secrets, clusters, listeners)
+
+	xdsSnapshot := generateXDSSnapshot(clusters, endpoints, routeConfigs, listeners, secrets)
 
 	if err := validation.GetProxyError(proxyRpt); err != nil {
 		reports.AddError(proxy, err)
@@ -185,7 +213,7 @@ func (t *translatorInstance) computeListenerResources(params plugins.Params, pro
 	params.Ctx = ctx
 	defer span.End()
 
-	rdsName := routeConfigName(listener)
+	rdsName := RouteConfigName(listener)
 
 	// Calculate routes before listeners, so that HttpFilters is called after ProcessVirtualHost\ProcessRoute
 	routeConfig := t.computeRouteConfig(params, proxy, listener, rdsName, listenerReport)
@@ -204,9 +232,10 @@ func (t *translatorInstance) computeListenerResources(params plugins.Params, pro
 func generateXDSSnapshot(clusters []*envoyapi.Cluster,
 	endpoints []*envoyapi.ClusterLoadAssignment,
 	routeConfigs []*envoyapi.RouteConfiguration,
-	listeners []*envoyapi.Listener) envoycache.Snapshot {
+	listeners []*envoyapi.Listener,
+	secrets []*envoyauthv2.Secret) envoycache.Snapshot {
 
-	var endpointsProto, clustersProto, listenersProto []envoycache.Resource
+	var endpointsProto, clustersProto, listenersProto, secretsProto []envoycache.Resource
 
 	for _, ep := range endpoints {
 		endpointsProto = append(endpointsProto, xds.NewEnvoyResource(ep))
@@ -221,6 +250,9 @@ func generateXDSSnapshot(clusters []*envoyapi.Cluster,
 		}
 		listenersProto = append(listenersProto, xds.NewEnvoyResource(listener))
 	}
+	for _, secret := range secrets {
+		secretsProto = append(secretsProto, xds.NewEnvoyResource(secret))
+	}
 	// construct version
 	// TODO: investigate whether we need a more sophisticated versioning algorithm
 	endpointsVersion, err := hashstructure.Hash(endpointsProto, nil)
@@ -238,6 +270,11 @@ func generateXDSSnapshot(clusters []*envoyapi.Cluster,
 		panic(errors.Wrap(err, "constructing version hash for listeners envoy snapshot components"))
 	}
 
+	secretsVersion, err := hashstructure.Hash(secretsProto, nil)
+	if err != nil {
+		panic(errors.Wrap(err, "constructing version hash for secrets envoy snapshot components"))
+	}
+
 	lv := fmt.Sprintf("%v", listenersVersion)
 	if os.Getenv(wasm.WasmEnabled) == "true" {
 		// Add random # to artificially change version number & proto hash so envoy tries to update
@@ -268,11 +305,12 @@ func generateXDSSnapshot(clusters []*envoyapi.Cluster,
 
 	// if clusters are updated, provider a new version of the endpoints,
 	// so the clusters are warm
-	return xds.NewSnapshotFromResources(
+	return xds.NewSnapshotFromResourcesWithSecrets(
 		envoycache.NewResources(fmt.Sprintf("%v-%v", clustersVersion, endpointsVersion), endpointsProto),
 		envoycache.NewResources(fmt.Sprintf("%v", clustersVersion), clustersProto),
 		MakeRdsResources(routeConfigs),
-		envoycache.NewResources(lv, listenersProto))
+		envoycache.NewResources(lv, listenersProto),
+		envoycache.NewResources(fmt.Sprintf("%v", secretsVersion), secretsProto))
 
 }
 
@@ -6,6 +6,7 @@ import (
 
 	envoycore_sk "github.com/solo-io/solo-kit/pkg/api/external/envoy/api/v2/core"
 
+	envoyauthv2 "github.com/envoyproxy/go-control-plane/envoy/api/v2/auth"
 	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
 	envoy_api_v2_endpoint "github.com/envoyproxy/go-control-plane/envoy/api/v2/endpoint"
 	envoylistener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
@@ -170,7 +171,7 @@ var _ = Describe("Translator", func() {
 		getPlugins := func() []plugins.Plugin {
 			return registeredPlugins
 		}
-		translator = NewTranslator(glooutils.NewSslConfigTranslator(), settings, getPlugins)
+		translator = NewTranslator(glooutils.NewSslConfigTranslatorForSettings(settings), settings, getPlugins)
 		httpListener := &v1.Listener{
 			Name:        "http-listener",
 			BindAddress: "127.0.0.1",
@@ -2063,6 +2064,85 @@ var _ = Describe("Translator", func() {
 				Expect(fc.FilterChainMatch.ServerNames).To(Equal([]string{"c.com"}))
 			})
 		})
+		Context("secret refs served over sds", func() {
+			var tlsSecret *v1.TlsSecret
+
+			BeforeEach(func() {
+				settings.Gloo = &v1.GlooOptions{EnableSdsSecrets: true}
+				tlsSecret = &v1.TlsSecret{
+					CertChain:  "chain",
+					PrivateKey: "key",
+					RootCa:     "rootca",
+				}
+				params.Snapshot.Secrets = append(params.Snapshot.Secrets, &v1.Secret{
+					Metadata: core.Metadata{
+						Name:      "solo",
+						Namespace: "solo.io",
+					},
+					Kind: &v1.Secret_Tls{Tls: tlsSecret},
+				}, &v1.Secret{
+					Metadata: core.Metadata{
+						Name:      "unreferenced",
+						Namespace: "solo.io",
+					},
+					Kind: &v1.Secret_Tls{
+						Tls: &v1.TlsSecret{
+							CertChain:  "chain2",
+							PrivateKey: "key2",
+						},
+					},
+				})
+			})
+
+			It("should reference the secret by name and serve only referenced secrets", func() {
+				prep([]*v1.SslConfig{
+					{
+						SslSecrets: &v1.SslConfig_SecretRef{
+							SecretRef: &core.ResourceRef{
+								Name:      "solo",
+								Namespace: "solo.io",
+							},
+						},
+						SniDomains: []string{"a.com"},
+					},
+				})
+
+				Expect(listener.GetFilterChains()).To(HaveLen(1))
+				common := tlsContext(listener.GetFilterChains()[0]).GetCommonTlsContext()
+				Expect(common.GetTlsCertificates()).To(BeEmpty())
+				Expect(common.GetTlsCertificateSdsSecretConfigs()[0].GetName()).To(Equal("solo.io.solo"))
+				Expect(common.GetValidationContextSdsSecretConfig().GetName()).To(Equal("solo.io.solo~ca"))
+
+				secrets := snapshot.GetResources(xds.SecretType).Items
+				Expect(secrets).To(HaveLen(2))
+				cert := secrets["solo.io.solo"].ResourceProto().(*envoyauthv2.Secret).GetTlsCertificate()
+				Expect(cert.GetCertificateChain().GetInlineString()).To(Equal("chain"))
+				Expect(cert.GetPrivateKey().GetInlineString()).To(Equal("key"))
+				ca := secrets["solo.io.solo~ca"].ResourceProto().(*envoyauthv2.Secret).GetValidationContext()
+				Expect(ca.GetTrustedCa().GetInlineString()).To(Equal("rootca"))
+			})
+
+			It("should change only the secrets version when a certificate is rotated", func() {
+				prep([]*v1.SslConfig{
+					{
+						SslSecrets: &v1.SslConfig_SecretRef{
+							SecretRef: &core.ResourceRef{
+								Name:      "solo",
+								Namespace: "solo.io",
+							},
+						},
+					},
+				})
+				previous := snapshot
+
+				tlsSecret.CertChain = "rotated"
+				translate()
+
+				Expect(snapshot.GetResources(xds.ListenerType).Version).To(Equal(previous.GetResources(xds.ListenerType).Version))
+				Expect(snapshot.GetResources(xds.ClusterType).Version).To(Equal(previous.GetResources(xds.ClusterType).Version))
+				Expect(snapshot.GetResources(xds.SecretType).Version).NotTo(Equal(previous.GetResources(xds.SecretType).Version))
+			})
+		})
 	})
 
 	It("Should report an error for virtual services with empty domains", func() {
@@ -47,12 +47,30 @@ type SslConfigTranslator interface {
 }
 
 type sslConfigTranslator struct {
+	// reference the certificates of secretRefs by name, to be served over ADS, instead of inlining them
+	sdsSecrets bool
 }
 
 func NewSslConfigTranslator() *sslConfigTranslator {
 	return &sslConfigTranslator{}
 }
 
+// NewSslConfigTranslatorForSettings returns a translator that references the certificates of secretRefs over SDS
+// if settings.gloo.enableSdsSecrets is set.
+func NewSslConfigTranslatorForSettings(settings *v1.Settings) *sslConfigTranslator {
+	return &sslConfigTranslator{sdsSecrets: settings.GetGloo().GetEnableSdsSecrets()}
+}
+
+// SdsCertificateSecretName is the name of the SDS secret serving the certificate chain and private key of a TLS Secret.
+func SdsCertificateSecretName(ref core.ResourceRef) string {
+	return ref.Key()
+}
+
+// SdsValidationContextSecretName is the name of the SDS secret serving the root CA of a TLS Secret.
+func SdsValidationContextSecretName(ref core.ResourceRef) string {
+	return ref.Key() + "~ca"
+}
+
 func (s *sslConfigTranslator) ResolveUpstreamSslConfig(secrets v1.SecretList, uc *v1.UpstreamSslConfig) (*envoyauth.UpstreamTlsContext, error) {
 	common, err := s.ResolveCommonSslConfig(uc, secrets, false)
 	if err != nil {
@@ -141,6 +159,18 @@ func buildSds(name string, sslSecrets *v1.SDSConfig) *envoyauth.SdsSecretConfig
 	}
 }
 
+// buildAdsSds references a secret served by gloo on the ADS connection.
+func buildAdsSds(name string) *envoyauth.SdsSecretConfig {
+	return &envoyauth.SdsSecretConfig{
+		Name: name,
+		SdsConfig: &envoycore.ConfigSource{
+			ConfigSourceSpecifier: &envoycore.ConfigSource_Ads{
+				Ads: &envoycore.AggregatedConfigSource{},
+			},
+		},
+	}
+}
+
 func buildDeprecatedSDS(name string, sslSecrets *v1.SDSConfig) *envoyauth.SdsSecretConfig {
 	config := &envoygrpccredential.FileBasedMetadataConfig{
 		SecretData: &envoycore.DataSource{
@@ -241,6 +271,9 @@ func (s *sslConfigTranslator) ResolveCommonSslConfig(cs CertSource, secrets v1.S
 		if err != nil {
 			return nil, err
 		}
+		if s.sdsSecrets {
+			return s.handleSecretRefOverSds(cs, *ref, certChain, privateKey, rootCa, mustHaveCert)
+		}
 	} else if sslSecrets := cs.GetSslFiles(); sslSecrets != nil {
 		certChain, privateKey, rootCa = sslSecrets.TlsCert, sslSecrets.TlsKey, sslSecrets.RootCa
 	} else if sslSecrets := cs.GetSds(); sslSecrets != nil {
@@ -312,6 +345,47 @@ func (s *sslConfigTranslator) ResolveCommonSslConfig(cs CertSource, secrets v1.S
 	return tlsContext, err
 }
 
+// handleSecretRefOverSds references the certificate chain, private key and root CA of a TLS Secret by name,
+// so that they are served to Envoy over ADS.
+func (s *sslConfigTranslator) handleSecretRefOverSds(cs CertSource, ref core.ResourceRef, certChain, privateKey, rootCa string, mustHaveCert bool) (*envoyauth.CommonTlsContext, error) {
+	if mustHaveCert && (certChain == "" || privateKey == "") {
+		return nil, NoCertificateFoundError
+	}
+	if (certChain == "") != (privateKey == "") {
+		return nil, eris.Errorf("both or none of cert chain and private key must be provided")
+	}
+
+	tlsContext := &envoyauth.CommonTlsContext{}
+	if certChain != "" {
+		tlsContext.TlsCertificateSdsSecretConfigs = []*envoyauth.SdsSecretConfig{buildAdsSds(SdsCertificateSecretName(ref))}
+	}
+
+	sanList := verifySanListToMatchSanList(cs.GetVerifySubjectAltName())
+	if rootCa != "" {
+		validationSds := buildAdsSds(SdsValidationContextSecretName(ref))
+		if len(sanList) == 0 {
+			tlsContext.ValidationContextType = &envoyauth.CommonTlsContext_ValidationContextSdsSecretConfig{
+				ValidationContextSdsSecretConfig: validationSds,
+			}
+		} else {
+			tlsContext.ValidationContextType = &envoyauth.CommonTlsContext_CombinedValidationContext{
+				CombinedValidationContext: &envoyauth.CommonTlsContext_CombinedCertificateValidationContext{
+					DefaultValidationContext:         &envoyauth.CertificateValidationContext{MatchSubjectAltNames: sanList},
+					ValidationContextSdsSecretConfig: validationSds,
+				},
+			}
+		}
+	} else if len(sanList) != 0 {
+		return nil, RootCaMustBeProvidedError
+	}
+
+	var err error
+	tlsContext.TlsParams, err = convertTlsParams(cs)
+
+	tlsContext.AlpnProtocols = cs.GetAlpnProtocols()
+	return tlsContext, err
+}
+
 func getSslSecrets(ref core.ResourceRef, secrets v1.SecretList) (string, string, string, error) {
 	secret, err := secrets.Find(ref.Strings())
 	if err != nil {
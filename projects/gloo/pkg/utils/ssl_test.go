@@ -270,6 +270,50 @@ var _ = Describe("Ssl", func() {
 			})
 		})
 
+		Context("served over sds", func() {
+			BeforeEach(func() {
+				configTranslator = NewSslConfigTranslatorForSettings(&v1.Settings{
+					Gloo: &v1.GlooOptions{EnableSdsSecrets: true},
+				})
+			})
+
+			It("should reference the certificate and root ca over ads", func() {
+				c, err := resolveCommonSslConfig(downstreamCfg, secrets)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(c.TlsCertificates).To(BeEmpty())
+				Expect(c.TlsCertificateSdsSecretConfigs).To(HaveLen(1))
+				Expect(c.TlsCertificateSdsSecretConfigs[0].Name).To(Equal("secret.secret"))
+				Expect(c.TlsCertificateSdsSecretConfigs[0].SdsConfig.GetAds()).NotTo(BeNil())
+
+				vctx := c.GetValidationContextSdsSecretConfig()
+				Expect(vctx.Name).To(Equal("secret.secret~ca"))
+				Expect(vctx.SdsConfig.GetAds()).NotTo(BeNil())
+			})
+
+			It("should not reference a root ca if the secret has none", func() {
+				tlsSecret.RootCa = ""
+				c, err := resolveCommonSslConfig(upstreamCfg, secrets)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(c.TlsCertificateSdsSecretConfigs).To(HaveLen(1))
+				Expect(c.ValidationContextType).To(BeNil())
+			})
+
+			It("should add SAN verification when provided", func() {
+				upstreamCfg.VerifySubjectAltName = []string{"test"}
+				c, err := resolveCommonSslConfig(upstreamCfg, secrets)
+				Expect(err).NotTo(HaveOccurred())
+				combined := c.GetCombinedValidationContext()
+				Expect(combined.ValidationContextSdsSecretConfig.Name).To(Equal("secret.secret~ca"))
+				Expect(combined.DefaultValidationContext.MatchSubjectAltNames[0].GetExact()).To(Equal("test"))
+			})
+
+			It("should require cert and key for downstream config", func() {
+				tlsSecret.PrivateKey = ""
+				_, err := configTranslator.ResolveDownstreamSslConfig(secrets, downstreamCfg)
+				Expect(err).To(Equal(NoCertificateFoundError))
+			})
+		})
+
 	})
 
 	Context("sds", func() {
@@ -2,10 +2,12 @@ package validation
 
 import (
 	"fmt"
+	"strings"
 
 	errors "github.com/rotisserie/eris"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"go.uber.org/multierr"
 )
 
@@ -185,6 +187,68 @@ func GetProxyWarning(proxyRpt *validation.ProxyReport) []string {
 	return warnings
 }
 
+var routeStatusKeyPrefix = fmt.Sprintf("%T.", (*v1.Route)(nil))
+
+// the key of a route's status in the subresource statuses of its proxy
+func RouteStatusKey(listener *v1.Listener, virtualHost *v1.VirtualHost, routeIndex int) string {
+	return fmt.Sprintf("%v%v/%v/routes[%d]", routeStatusKeyPrefix, listener.GetName(), virtualHost.GetName(), routeIndex)
+}
+
+// true if the subresource status with this key is the status of a route, rather than a resource.
+// the routes of gateway resources are keyed with the same prefix.
+func IsRouteStatusKey(key string) bool {
+	return strings.HasPrefix(key, routeStatusKeyPrefix)
+}
+
+// returns the status of each route in the proxy which has errors or warnings, keyed by RouteStatusKey.
+// routes without errors or warnings are omitted. returns nil if there are none.
+func GetRouteStatuses(proxy *v1.Proxy, proxyRpt *validation.ProxyReport) map[string]*core.Status {
+	var statuses map[string]*core.Status
+	listeners := proxy.GetListeners()
+	for i, listenerReport := range proxyRpt.GetListenerReports() {
+		if i >= len(listeners) {
+			break
+		}
+		virtualHosts := listeners[i].GetHttpListener().GetVirtualHosts()
+		for j, vhReport := range listenerReport.GetHttpListenerReport().GetVirtualHostReports() {
+			if j >= len(virtualHosts) {
+				break
+			}
+			for k, routeReport := range vhReport.GetRouteReports() {
+				status := getRouteStatus(routeReport)
+				if status == nil {
+					continue
+				}
+				if statuses == nil {
+					statuses = map[string]*core.Status{}
+				}
+				statuses[RouteStatusKey(listeners[i], virtualHosts[j], k)] = status
+			}
+		}
+	}
+	return statuses
+}
+
+func getRouteStatus(routeReport *validation.RouteReport) *core.Status {
+	var problems []string
+	for _, err := range GetRouteErr(routeReport) {
+		problems = append(problems, err.Error())
+	}
+	problems = append(problems, GetRouteWarning(routeReport)...)
+	if len(problems) == 0 {
+		return nil
+	}
+
+	state := core.Status_Warning
+	if len(routeReport.GetErrors()) > 0 {
+		state = core.Status_Rejected
+	}
+	return &core.Status{
+		State:  state,
+		Reason: strings.Join(problems, "\n"),
+	}
+}
+
 func AppendListenerError(listenerReport *validation.ListenerReport, errType validation.ListenerReport_Error_Type, reason string) {
 	listenerReport.Errors = append(listenerReport.Errors, &validation.ListenerReport_Error{
 		Type:   errType,
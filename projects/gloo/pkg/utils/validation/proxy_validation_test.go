@@ -6,6 +6,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	. "github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
 
 var _ = Describe("validation utils", func() {
@@ -120,4 +121,34 @@ var _ = Describe("validation utils", func() {
 			Expect(err.Error()).To(ContainSubstring("VirtualHost Error: DomainsNotUniqueError. Reason: domains not unique; Listener Error: BindPortNotUniqueError. Reason: bind port not unique; HttpListener Error: ProcessingError. Reason: bad http plugin"))
 		})
 	})
+
+	var _ = Describe("GetRouteStatuses", func() {
+		It("reports the routes with errors or warnings", func() {
+			proxy := makeProxy(true)
+			proxy.Listeners[1].Name = "listener"
+			proxy.Listeners[1].GetHttpListener().VirtualHosts[3].Name = "vh"
+			rpt := MakeReport(proxy)
+
+			vhReport := rpt.ListenerReports[1].GetHttpListenerReport().VirtualHostReports[3]
+			AppendRouteWarning(vhReport.RouteReports[2], validation.RouteReport_Warning_InvalidDestinationWarning, "bad destination")
+			AppendRouteError(vhReport.RouteReports[4], validation.RouteReport_Error_InvalidMatcherError, "bad matcher")
+			AppendRouteWarning(vhReport.RouteReports[4], validation.RouteReport_Warning_InvalidDestinationWarning, "bad destination")
+
+			Expect(GetRouteStatuses(proxy, rpt)).To(Equal(map[string]*core.Status{
+				"*v1.Route.listener/vh/routes[2]": {
+					State:  core.Status_Warning,
+					Reason: "Route Warning: InvalidDestinationWarning. Reason: bad destination",
+				},
+				"*v1.Route.listener/vh/routes[4]": {
+					State:  core.Status_Rejected,
+					Reason: "Route Error: InvalidMatcherError. Reason: bad matcher\nRoute Warning: InvalidDestinationWarning. Reason: bad destination",
+				},
+			}))
+		})
+
+		It("returns nil when every route is accepted", func() {
+			proxy := makeProxy(true)
+			Expect(GetRouteStatuses(proxy, MakeReport(proxy))).To(BeNil())
+		})
+	})
 })
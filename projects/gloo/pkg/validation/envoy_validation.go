@@ -0,0 +1,233 @@
+package validation
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"time"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoyendpoint "github.com/envoyproxy/go-control-plane/envoy/api/v2/endpoint"
+	envoylistener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
+	envoybootstrap "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v2"
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyhcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+)
+
+const (
+	defaultEnvoyValidationTimeout = 10 * time.Second
+
+	// a static cluster stands in for the xds server in the validation bootstrap,
+	// so config sources pointing at ADS (e.g. for SDS) remain valid
+	validationXdsClusterName = "gloo_validation_xds_cluster"
+	validationNodeName       = "gloo-validation"
+)
+
+type envoyValidator struct {
+	envoyPath string
+	timeout   time.Duration
+}
+
+// NewEnvoyValidator returns an XdsValidator which renders the xds snapshot into a static bootstrap
+// and validates it with the envoy binary at envoyPath.
+func NewEnvoyValidator(envoyPath string, timeout time.Duration) *envoyValidator {
+	return &envoyValidator{envoyPath: envoyPath, timeout: timeout}
+}
+
+func (v *envoyValidator) ValidateSnapshot(ctx context.Context, proxy *v1.Proxy, xdsSnapshot envoycache.Snapshot, report *validation.ProxyReport) error {
+	bootstrapConfig, err := BuildValidationBootstrap(xdsSnapshot)
+	if err != nil {
+		return err
+	}
+	bootstrapBytes, err := proto.Marshal(bootstrapConfig)
+	if err != nil {
+		return eris.Wrapf(err, "marshalling validation bootstrap")
+	}
+
+	// envoy parses files with the .pb extension as binary protos
+	f, err := ioutil.TempFile("", "gloo-validation-*.pb")
+	if err != nil {
+		return eris.Wrapf(err, "creating validation bootstrap file")
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(bootstrapBytes); err != nil {
+		f.Close()
+		return eris.Wrapf(err, "writing validation bootstrap file")
+	}
+	if err := f.Close(); err != nil {
+		return eris.Wrapf(err, "writing validation bootstrap file")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, v.timeout)
+	defer cancel()
+	validateCmd := exec.CommandContext(ctx, v.envoyPath, "--mode", "validate", "-c", f.Name(), "-l", "critical", "--log-format", "%v")
+	output, err := validateCmd.CombinedOutput()
+	if err == nil {
+		return nil
+	}
+	// unlike the filter validation in the bootstrap package, a missing binary is an error here,
+	// as the path was explicitly configured
+	if ctx.Err() != nil {
+		return eris.Wrapf(ctx.Err(), "envoy validation did not complete")
+	}
+	if _, ok := err.(*exec.ExitError); !ok {
+		return eris.Wrapf(err, "running envoy at %v", v.envoyPath)
+	}
+	validationErr := eris.Errorf("envoy validation mode output: %v, error: %v", string(output), err)
+	newXdsReportIndex(proxy, report).appendListenerErrorByText(string(output), validationErr)
+	return nil
+}
+
+// BuildValidationBootstrap renders the resources in an xds snapshot as the static resources of an
+// envoy bootstrap: route configurations are inlined into the http connection managers referencing
+// them and EDS clusters are replaced by static clusters with the snapshot's endpoints.
+func BuildValidationBootstrap(xdsSnapshot envoycache.Snapshot) (*envoybootstrap.Bootstrap, error) {
+	routeConfigs := map[string]*envoyapi.RouteConfiguration{}
+	for _, res := range xdsSnapshot.GetResources(xds.RouteType).Items {
+		if routeConfig, ok := res.ResourceProto().(*envoyapi.RouteConfiguration); ok {
+			routeConfigs[routeConfig.GetName()] = routeConfig
+		}
+	}
+	endpoints := map[string]*envoyapi.ClusterLoadAssignment{}
+	for _, res := range xdsSnapshot.GetResources(xds.EndpointType).Items {
+		if loadAssignment, ok := res.ResourceProto().(*envoyapi.ClusterLoadAssignment); ok {
+			endpoints[loadAssignment.GetClusterName()] = loadAssignment
+		}
+	}
+
+	staticResources := &envoybootstrap.Bootstrap_StaticResources{
+		Clusters: []*envoyapi.Cluster{validationXdsCluster()},
+	}
+
+	for _, res := range xdsSnapshot.GetResources(xds.ListenerType).Items {
+		listener, ok := res.ResourceProto().(*envoyapi.Listener)
+		if !ok {
+			continue
+		}
+		listener, err := inlineRouteConfigs(listener, routeConfigs)
+		if err != nil {
+			return nil, err
+		}
+		staticResources.Listeners = append(staticResources.Listeners, listener)
+	}
+
+	for _, res := range xdsSnapshot.GetResources(xds.ClusterType).Items {
+		cluster, ok := res.ResourceProto().(*envoyapi.Cluster)
+		if !ok {
+			continue
+		}
+		staticResources.Clusters = append(staticResources.Clusters, staticCluster(cluster, endpoints))
+	}
+
+	return &envoybootstrap.Bootstrap{
+		Node: &envoycore.Node{
+			Id:      validationNodeName,
+			Cluster: validationNodeName,
+		},
+		StaticResources: staticResources,
+		DynamicResources: &envoybootstrap.Bootstrap_DynamicResources{
+			AdsConfig: &envoycore.ApiConfigSource{
+				ApiType: envoycore.ApiConfigSource_GRPC,
+				GrpcServices: []*envoycore.GrpcService{{
+					TargetSpecifier: &envoycore.GrpcService_EnvoyGrpc_{
+						EnvoyGrpc: &envoycore.GrpcService_EnvoyGrpc{ClusterName: validationXdsClusterName},
+					},
+				}},
+			},
+		},
+	}, nil
+}
+
+func inlineRouteConfigs(listener *envoyapi.Listener, routeConfigs map[string]*envoyapi.RouteConfiguration) (*envoyapi.Listener, error) {
+	listener = proto.Clone(listener).(*envoyapi.Listener)
+	for _, filterChain := range listener.GetFilterChains() {
+		for _, filter := range filterChain.GetFilters() {
+			hcm, err := unpackHcm(filter.GetTypedConfig())
+			if err != nil {
+				return nil, eris.Wrapf(err, "invalid http connection manager on listener %v", listener.GetName())
+			}
+			rds := hcm.GetRds()
+			if rds == nil {
+				continue
+			}
+			routeConfig, ok := routeConfigs[rds.GetRouteConfigName()]
+			if !ok {
+				continue
+			}
+			// the route configurations are served as v2 resources, while the http connection
+			// manager is v3. the two are wire compatible.
+			routeConfigBytes, err := proto.Marshal(routeConfig)
+			if err != nil {
+				return nil, eris.Wrapf(err, "marshalling route configuration %v", routeConfig.GetName())
+			}
+			inlineRouteConfig := &envoyroutev3.RouteConfiguration{}
+			if err := proto.Unmarshal(routeConfigBytes, inlineRouteConfig); err != nil {
+				return nil, eris.Wrapf(err, "converting route configuration %v", routeConfig.GetName())
+			}
+			hcm.RouteSpecifier = &envoyhcm.HttpConnectionManager_RouteConfig{RouteConfig: inlineRouteConfig}
+			typedConfig, err := ptypes.MarshalAny(hcm)
+			if err != nil {
+				return nil, eris.Wrapf(err, "marshalling http connection manager on listener %v", listener.GetName())
+			}
+			filter.ConfigType = &envoylistener.Filter_TypedConfig{TypedConfig: typedConfig}
+		}
+	}
+	return listener, nil
+}
+
+func staticCluster(cluster *envoyapi.Cluster, endpoints map[string]*envoyapi.ClusterLoadAssignment) *envoyapi.Cluster {
+	if cluster.GetType() != envoyapi.Cluster_EDS {
+		return cluster
+	}
+	serviceName := cluster.GetEdsClusterConfig().GetServiceName()
+	if serviceName == "" {
+		serviceName = cluster.GetName()
+	}
+	cluster = proto.Clone(cluster).(*envoyapi.Cluster)
+	cluster.ClusterDiscoveryType = &envoyapi.Cluster_Type{Type: envoyapi.Cluster_STATIC}
+	cluster.EdsClusterConfig = nil
+	loadAssignment := &envoyapi.ClusterLoadAssignment{ClusterName: cluster.GetName()}
+	if clusterEndpoints, ok := endpoints[serviceName]; ok {
+		loadAssignment.Endpoints = clusterEndpoints.GetEndpoints()
+		loadAssignment.NamedEndpoints = clusterEndpoints.GetNamedEndpoints()
+		loadAssignment.Policy = clusterEndpoints.GetPolicy()
+	}
+	cluster.LoadAssignment = loadAssignment
+	return cluster
+}
+
+func validationXdsCluster() *envoyapi.Cluster {
+	return &envoyapi.Cluster{
+		Name:                 validationXdsClusterName,
+		ClusterDiscoveryType: &envoyapi.Cluster_Type{Type: envoyapi.Cluster_STATIC},
+		Http2ProtocolOptions: &envoycore.Http2ProtocolOptions{},
+		LoadAssignment: &envoyapi.ClusterLoadAssignment{
+			ClusterName: validationXdsClusterName,
+			Endpoints: []*envoyendpoint.LocalityLbEndpoints{{
+				LbEndpoints: []*envoyendpoint.LbEndpoint{{
+					HostIdentifier: &envoyendpoint.LbEndpoint_Endpoint{
+						Endpoint: &envoyendpoint.Endpoint{
+							Address: &envoycore.Address{
+								Address: &envoycore.Address_SocketAddress{
+									SocketAddress: &envoycore.SocketAddress{
+										Address:       "127.0.0.1",
+										PortSpecifier: &envoycore.SocketAddress_PortValue{PortValue: 9977},
+									},
+								},
+							},
+						},
+					},
+				}},
+			}},
+		},
+	}
+}
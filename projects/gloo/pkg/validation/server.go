@@ -2,8 +2,13 @@ package validation
 
 import (
 	"context"
+	"sort"
 	"sync"
 
+	"github.com/golang/protobuf/proto"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+
 	"github.com/solo-io/gloo/pkg/utils/syncutil"
 	"go.uber.org/zap/zapcore"
 
@@ -13,10 +18,16 @@ import (
 	"github.com/solo-io/go-utils/contextutils"
 	"go.uber.org/zap"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	validationutils "github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+	"go.uber.org/multierr"
 	"google.golang.org/grpc"
 )
 
@@ -31,10 +42,12 @@ type validator struct {
 	translator     translator.Translator
 	notifyResync   map[*validation.NotifyOnResyncRequest]chan struct{}
 	ctx            context.Context
+	xdsValidator   XdsValidator
 }
 
-func NewValidator(ctx context.Context, translator translator.Translator) *validator {
-	return &validator{translator: translator, notifyResync: make(map[*validation.NotifyOnResyncRequest]chan struct{}, 1), ctx: ctx}
+// xdsValidator may be nil, in which case only the translation of the proxy is validated
+func NewValidator(ctx context.Context, translator translator.Translator, xdsValidator XdsValidator) *validator {
+	return &validator{translator: translator, notifyResync: make(map[*validation.NotifyOnResyncRequest]chan struct{}, 1), ctx: ctx, xdsValidator: xdsValidator}
 }
 
 // only call within a lock
@@ -152,23 +165,17 @@ func (s *validator) NotifyOnResync(req *validation.NotifyOnResyncRequest, stream
 }
 
 func (s *validator) ValidateProxy(ctx context.Context, req *validation.ProxyValidationServiceRequest) (*validation.ProxyValidationServiceResponse, error) {
-	s.lock.RLock()
-	// we may receive a ValidateProxy call before a Sync has occurred
-	if s.latestSnapshot == nil {
-		s.lock.RUnlock()
-		return nil, eris.New("proxy validation called before the validation server received its first sync of resources")
+	snapCopy, err := s.snapshotCopy()
+	if err != nil {
+		return nil, err
 	}
-	snapCopy := s.latestSnapshot.Clone()
-	s.lock.RUnlock()
 
 	ctx = contextutils.WithLogger(ctx, "proxy-validator")
 
-	params := plugins.Params{Ctx: ctx, Snapshot: &snapCopy}
-
 	logger := contextutils.LoggerFrom(ctx)
 
 	logger.Infof("received proxy validation request")
-	_, _, report, err := s.translator.Translate(params, req.GetProxy())
+	report, _, err := s.validateProxy(ctx, &snapCopy, req.GetProxy())
 	if err != nil {
 		logger.Errorw("failed to validate proxy", zap.Error(err))
 		return nil, err
@@ -177,6 +184,262 @@ func (s *validator) ValidateProxy(ctx context.Context, req *validation.ProxyVali
 	return &validation.ProxyValidationServiceResponse{ProxyReport: report}, nil
 }
 
+func (s *validator) ValidateResource(ctx context.Context, req *validation.ResourceValidationServiceRequest) (*validation.ResourceValidationServiceResponse, error) {
+	snapCopy, err := s.snapshotCopy()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = contextutils.WithLogger(ctx, "resource-validator")
+
+	logger := contextutils.LoggerFrom(ctx)
+
+	resource, err := resourceFromRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	logger.Infof("received validation request for %v %v (delete: %v)", resources.Kind(resource), resource.GetMetadata().Ref(), req.GetDelete())
+
+	modifiedSnap := snapCopy.Clone()
+	applyResourceChange(&modifiedSnap, resource, req.GetDelete())
+
+	response := &validation.ResourceValidationServiceResponse{}
+	var resourceReports reporter.ResourceReports
+
+	for _, proxy := range snapCopy.Proxies {
+		after, reports, err := s.validateProxy(ctx, &modifiedSnap, proxy)
+		if err != nil {
+			return nil, err
+		}
+		if resourceReports == nil {
+			resourceReports = reports
+		}
+		if !proxyReportHasProblems(after) {
+			continue
+		}
+		// proxies which were already invalid don't block the change, unless it makes things worse
+		before, _, err := s.validateProxy(ctx, &snapCopy, proxy)
+		if err != nil {
+			return nil, err
+		}
+		if newProxyReportProblems(before, after) {
+			response.ProxyResults = append(response.ProxyResults, &validation.ResourceValidationServiceResponse_ProxyResult{
+				Proxy:       proxy,
+				ProxyReport: after,
+			})
+		}
+	}
+
+	if !req.GetDelete() {
+		after, err := s.resourceErrors(ctx, &modifiedSnap, resourceReports, resource)
+		if err != nil {
+			return nil, err
+		}
+		if len(after) > 0 {
+			// resources which were already invalid don't block the change, unless it adds errors
+			before, err := s.resourceErrors(ctx, &snapCopy, nil, resource)
+			if err != nil {
+				return nil, err
+			}
+			existing := map[string]bool{}
+			for _, resourceErr := range before {
+				existing[resourceErr] = true
+			}
+			for _, resourceErr := range after {
+				if !existing[resourceErr] {
+					response.ResourceErrors = append(response.ResourceErrors, resourceErr)
+				}
+			}
+		}
+	}
+
+	logger.Infof("resource validation result: %v", response.String())
+	return response, nil
+}
+
+// returns the errors reported on the resource when translating the snapshot. reports may be the reports of an
+// earlier translation of the snapshot, otherwise an empty proxy is translated so the resource is still processed.
+func (s *validator) resourceErrors(ctx context.Context, snap *v1.ApiSnapshot, reports reporter.ResourceReports, resource resources.Resource) ([]string, error) {
+	if reports == nil {
+		emptyProxy := &v1.Proxy{Metadata: core.Metadata{Name: "resource-validation", Namespace: resource.GetMetadata().Namespace}}
+		var err error
+		if _, reports, err = s.validateProxy(ctx, snap, emptyProxy); err != nil {
+			return nil, err
+		}
+	}
+	_, rpt := reports.Find(resources.Kind(resource), resource.GetMetadata().Ref())
+	if rpt.Errors == nil {
+		return nil, nil
+	}
+	resourceErrs := []error{rpt.Errors}
+	if merr, ok := rpt.Errors.(*multierror.Error); ok {
+		resourceErrs = merr.WrappedErrors()
+	}
+	var result []string
+	for _, err := range resourceErrs {
+		result = append(result, err.Error())
+	}
+	return result, nil
+}
+
+func (s *validator) TranslateProxy(ctx context.Context, req *validation.ProxyTranslationServiceRequest) (*validation.ProxyTranslationServiceResponse, error) {
+	snapCopy, err := s.snapshotCopy()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = contextutils.WithLogger(ctx, "proxy-translator")
+
+	logger := contextutils.LoggerFrom(ctx)
+
+	logger.Infof("received proxy translation request")
+	params := plugins.Params{Ctx: ctx, Snapshot: &snapCopy}
+	xdsSnapshot, _, report, err := s.translator.Translate(params, req.GetProxy())
+	if err != nil {
+		logger.Errorw("failed to translate proxy", zap.Error(err))
+		return nil, err
+	}
+	xdsResources, err := xdsResourcesFromSnapshot(xdsSnapshot)
+	if err != nil {
+		return nil, err
+	}
+	return &validation.ProxyTranslationServiceResponse{XdsResources: xdsResources, ProxyReport: report}, nil
+}
+
+func (s *validator) snapshotCopy() (v1.ApiSnapshot, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	// we may receive a validation call before a Sync has occurred
+	if s.latestSnapshot == nil {
+		return v1.ApiSnapshot{}, eris.New("proxy validation called before the validation server received its first sync of resources")
+	}
+	return s.latestSnapshot.Clone(), nil
+}
+
+// translates the proxy against the given snapshot, and validates the resulting xds config
+func (s *validator) validateProxy(ctx context.Context, snap *v1.ApiSnapshot, proxy *v1.Proxy) (*validation.ProxyReport, reporter.ResourceReports, error) {
+	params := plugins.Params{Ctx: ctx, Snapshot: snap}
+	xdsSnapshot, reports, report, err := s.translator.Translate(params, proxy)
+	if err != nil {
+		return nil, nil, err
+	}
+	if s.xdsValidator != nil {
+		if err := s.xdsValidator.ValidateSnapshot(ctx, proxy, xdsSnapshot, report); err != nil {
+			return nil, nil, eris.Wrapf(err, "failed to validate generated xds config")
+		}
+	}
+	return report, reports, nil
+}
+
+// hashes the resources in the xds snapshot, sorted by type url and name so responses can be compared
+func xdsResourcesFromSnapshot(xdsSnapshot envoycache.Snapshot) ([]*validation.ProxyTranslationServiceResponse_XdsResource, error) {
+	var xdsResources []*validation.ProxyTranslationServiceResponse_XdsResource
+	for _, typeUrl := range xds.ResponseTypes {
+		for name, res := range xdsSnapshot.GetResources(typeUrl).Items {
+			// deterministic marshalling sorts map keys, so equal resources have equal hashes. the binary encoding
+			// leaves the typed configs packed, some of which are only registered with gogo
+			buf := proto.NewBuffer(nil)
+			buf.SetDeterministic(true)
+			if err := buf.Marshal(res.ResourceProto()); err != nil {
+				return nil, eris.Wrapf(err, "marshalling %v %v", typeUrl, name)
+			}
+			xdsResources = append(xdsResources, &validation.ProxyTranslationServiceResponse_XdsResource{
+				TypeUrl: typeUrl,
+				Name:    name,
+				Hash:    hashutils.HashAll(buf.Bytes()),
+			})
+		}
+	}
+	sort.SliceStable(xdsResources, func(i, j int) bool {
+		if xdsResources[i].TypeUrl != xdsResources[j].TypeUrl {
+			return xdsResources[i].TypeUrl < xdsResources[j].TypeUrl
+		}
+		return xdsResources[i].Name < xdsResources[j].Name
+	})
+	return xdsResources, nil
+}
+
+func resourceFromRequest(req *validation.ResourceValidationServiceRequest) (resources.Resource, error) {
+	switch resource := req.GetResource().(type) {
+	case *validation.ResourceValidationServiceRequest_Upstream:
+		return resource.Upstream, nil
+	case *validation.ResourceValidationServiceRequest_UpstreamGroup:
+		return resource.UpstreamGroup, nil
+	case *validation.ResourceValidationServiceRequest_Secret:
+		return resource.Secret, nil
+	}
+	return nil, eris.Errorf("unsupported resource type %T", req.GetResource())
+}
+
+// writes or removes the resource in the snapshot
+func applyResourceChange(snap *v1.ApiSnapshot, resource resources.Resource, isDelete bool) {
+	ref := resource.GetMetadata().Ref()
+	switch typed := resource.(type) {
+	case *v1.Upstream:
+		var upstreams v1.UpstreamList
+		for _, us := range snap.Upstreams {
+			if us.GetMetadata().Ref() != ref {
+				upstreams = append(upstreams, us)
+			}
+		}
+		if !isDelete {
+			upstreams = append(upstreams, typed)
+			upstreams.Sort()
+		}
+		snap.Upstreams = upstreams
+	case *v1.UpstreamGroup:
+		var upstreamGroups v1.UpstreamGroupList
+		for _, ug := range snap.UpstreamGroups {
+			if ug.GetMetadata().Ref() != ref {
+				upstreamGroups = append(upstreamGroups, ug)
+			}
+		}
+		if !isDelete {
+			upstreamGroups = append(upstreamGroups, typed)
+			upstreamGroups.Sort()
+		}
+		snap.UpstreamGroups = upstreamGroups
+	case *v1.Secret:
+		var secrets v1.SecretList
+		for _, secret := range snap.Secrets {
+			if secret.GetMetadata().Ref() != ref {
+				secrets = append(secrets, secret)
+			}
+		}
+		if !isDelete {
+			secrets = append(secrets, typed)
+			secrets.Sort()
+		}
+		snap.Secrets = secrets
+	}
+}
+
+func proxyReportHasProblems(report *validation.ProxyReport) bool {
+	return validationutils.GetProxyError(report) != nil || len(validationutils.GetProxyWarning(report)) > 0
+}
+
+// true if the after report contains an error or warning which is not present in the before report
+func newProxyReportProblems(before, after *validation.ProxyReport) bool {
+	existing := map[string]bool{}
+	for _, err := range multierr.Errors(validationutils.GetProxyError(before)) {
+		existing[err.Error()] = true
+	}
+	for _, warning := range validationutils.GetProxyWarning(before) {
+		existing[warning] = true
+	}
+	for _, err := range multierr.Errors(validationutils.GetProxyError(after)) {
+		if !existing[err.Error()] {
+			return true
+		}
+	}
+	for _, warning := range validationutils.GetProxyWarning(after) {
+		if !existing[warning] {
+			return true
+		}
+	}
+	return false
+}
+
 type ValidationServer interface {
 	validation.ProxyValidationServiceServer
 	SetValidator(v Validator)
@@ -217,3 +480,19 @@ func (s *validationServer) ValidateProxy(ctx context.Context, req *validation.Pr
 
 	return validator.ValidateProxy(ctx, req)
 }
+
+func (s *validationServer) ValidateResource(ctx context.Context, req *validation.ResourceValidationServiceRequest) (*validation.ResourceValidationServiceResponse, error) {
+	s.lock.RLock()
+	validator := s.validator
+	s.lock.RUnlock()
+
+	return validator.ValidateResource(ctx, req)
+}
+
+func (s *validationServer) TranslateProxy(ctx context.Context, req *validation.ProxyTranslationServiceRequest) (*validation.ProxyTranslationServiceResponse, error) {
+	s.lock.RLock()
+	validator := s.validator
+	s.lock.RUnlock()
+
+	return validator.TranslateProxy(ctx, req)
+}
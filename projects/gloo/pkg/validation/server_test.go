@@ -3,6 +3,7 @@ package validation_test
 import (
 	"context"
 	"net"
+	"sort"
 	"sync"
 	"time"
 
@@ -20,14 +21,19 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	. "github.com/solo-io/gloo/projects/gloo/pkg/validation"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/golang/mock/gomock"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 
 	sslutils "github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 
 	. "github.com/solo-io/gloo/projects/gloo/pkg/translator"
 
@@ -78,12 +84,142 @@ var _ = Describe("Validation Server", func() {
 	Context("proxy validation", func() {
 		It("validates the requested proxy", func() {
 			proxy := params.Snapshot.Proxies[0]
-			s := NewValidator(context.TODO(), translator)
+			s := NewValidator(context.TODO(), translator, nil)
 			_ = s.Sync(context.TODO(), params.Snapshot)
 			rpt, err := s.ValidateProxy(context.TODO(), &validationgrpc.ProxyValidationServiceRequest{Proxy: proxy})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(rpt).To(Equal(&validationgrpc.ProxyValidationServiceResponse{ProxyReport: validation.MakeReport(proxy)}))
 		})
+
+		It("reports invalid generated xds config on the proxy report", func() {
+			proxy := proto.Clone(params.Snapshot.Proxies[0]).(*v1.Proxy)
+			route := proxy.GetListeners()[0].GetHttpListener().GetVirtualHosts()[0].GetRoutes()[0]
+			route.Matchers = []*matchers.Matcher{{
+				PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/"},
+				Headers:       []*matchers.HeaderMatcher{{Name: "bad\nheader"}},
+			}}
+			s := NewValidator(context.TODO(), translator, NewApiValidator())
+			_ = s.Sync(context.TODO(), params.Snapshot)
+			rpt, err := s.ValidateProxy(context.TODO(), &validationgrpc.ProxyValidationServiceRequest{Proxy: proxy})
+			Expect(err).NotTo(HaveOccurred())
+			routeReport := rpt.GetProxyReport().GetListenerReports()[0].GetHttpListenerReport().GetVirtualHostReports()[0].GetRouteReports()[0]
+			Expect(routeReport.GetErrors()).To(HaveLen(1))
+			Expect(routeReport.GetErrors()[0].GetReason()).To(ContainSubstring("invalid route"))
+		})
+	})
+
+	Context("resource validation", func() {
+		var s Validator
+
+		JustBeforeEach(func() {
+			s = NewValidator(context.TODO(), translator, nil)
+			_ = s.Sync(context.TODO(), params.Snapshot)
+		})
+
+		upstreamRequest := func(us *v1.Upstream, isDelete bool) *validationgrpc.ResourceValidationServiceRequest {
+			return &validationgrpc.ResourceValidationServiceRequest{
+				Resource: &validationgrpc.ResourceValidationServiceRequest_Upstream{Upstream: us},
+				Delete:   isDelete,
+			}
+		}
+
+		It("accepts changes which don't affect any proxy", func() {
+			us := samples.SimpleUpstream()
+			us.Metadata.Labels = map[string]string{"updated": "true"}
+			rsp, err := s.ValidateResource(context.TODO(), upstreamRequest(us, false))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rsp.GetProxyResults()).To(BeEmpty())
+			Expect(rsp.GetResourceErrors()).To(BeEmpty())
+		})
+
+		It("reports the proxies broken by deleting a referenced upstream", func() {
+			us := samples.SimpleUpstream()
+			rsp, err := s.ValidateResource(context.TODO(), upstreamRequest(us, true))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rsp.GetProxyResults()).To(HaveLen(1))
+			result := rsp.GetProxyResults()[0]
+			Expect(result.GetProxy().GetMetadata().Ref()).To(Equal(params.Snapshot.Proxies[0].GetMetadata().Ref()))
+			Expect(validation.GetProxyWarning(result.GetProxyReport())).To(ContainElement(ContainSubstring("InvalidDestinationWarning")))
+		})
+
+		It("accepts deleting an unreferenced upstream", func() {
+			us := samples.SimpleUpstream()
+			us.Metadata.Name = "unreferenced"
+			rsp, err := s.ValidateResource(context.TODO(), upstreamRequest(us, true))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rsp.GetProxyResults()).To(BeEmpty())
+		})
+
+		It("reports errors on the resource itself", func() {
+			us := samples.SimpleUpstream()
+			us.Metadata.Name = "invalid"
+			us.GetStatic().Hosts = nil
+			rsp, err := s.ValidateResource(context.TODO(), upstreamRequest(us, false))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rsp.GetProxyResults()).To(BeEmpty())
+			Expect(rsp.GetResourceErrors()).To(ContainElement(ContainSubstring("cluster type STATIC specified but LoadAssignment was empty")))
+		})
+
+		It("doesn't report the errors the resource already had", func() {
+			invalid := samples.SimpleUpstream()
+			invalid.Metadata.Name = "invalid"
+			invalid.GetStatic().Hosts = nil
+			params.Snapshot.Upstreams = append(params.Snapshot.Upstreams, invalid)
+			_ = s.Sync(context.TODO(), params.Snapshot)
+
+			updated := proto.Clone(invalid).(*v1.Upstream)
+			updated.Metadata.Labels = map[string]string{"updated": "true"}
+			rsp, err := s.ValidateResource(context.TODO(), upstreamRequest(updated, false))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rsp.GetResourceErrors()).To(BeEmpty())
+		})
+	})
+
+	Context("proxy translation", func() {
+		It("returns the xds resources generated for the proxy, sorted by type and name", func() {
+			proxy := params.Snapshot.Proxies[0]
+			s := NewValidator(context.TODO(), translator, nil)
+			_ = s.Sync(context.TODO(), params.Snapshot)
+			rsp, err := s.TranslateProxy(context.TODO(), &validationgrpc.ProxyTranslationServiceRequest{Proxy: proxy})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rsp.GetProxyReport()).To(Equal(validation.MakeReport(proxy)))
+
+			var typeUrls []string
+			for _, res := range rsp.GetXdsResources() {
+				typeUrls = append(typeUrls, res.GetTypeUrl())
+				Expect(res.GetName()).NotTo(BeEmpty())
+				Expect(res.GetHash()).NotTo(BeZero())
+			}
+			Expect(typeUrls).To(ContainElement(xds.ListenerType))
+			Expect(typeUrls).To(ContainElement(xds.ClusterType))
+			Expect(sort.StringsAreSorted(typeUrls)).To(BeTrue())
+		})
+
+		It("hashes the clusters of upstreams configured by gloo's envoy extensions", func() {
+			params.Snapshot.Secrets = append(params.Snapshot.Secrets, &v1.Secret{
+				Metadata: core.Metadata{Name: "aws-creds", Namespace: "default"},
+				Kind:     &v1.Secret_Aws{Aws: &v1.AwsSecret{AccessKey: "access", SecretKey: "secret"}},
+			})
+			params.Snapshot.Upstreams = append(params.Snapshot.Upstreams, &v1.Upstream{
+				Metadata: core.Metadata{Name: "lambda", Namespace: "default"},
+				UpstreamType: &v1.Upstream_Aws{Aws: &aws.UpstreamSpec{
+					Region:    "us-east-1",
+					SecretRef: &core.ResourceRef{Name: "aws-creds", Namespace: "default"},
+				}},
+			})
+			s := NewValidator(context.TODO(), translator, nil)
+			_ = s.Sync(context.TODO(), params.Snapshot)
+			rsp, err := s.TranslateProxy(context.TODO(), &validationgrpc.ProxyTranslationServiceRequest{Proxy: params.Snapshot.Proxies[0]})
+			Expect(err).NotTo(HaveOccurred())
+
+			var clusters []string
+			for _, res := range rsp.GetXdsResources() {
+				if res.GetTypeUrl() == xds.ClusterType {
+					clusters = append(clusters, res.GetName())
+				}
+			}
+			Expect(clusters).To(ContainElement("lambda_default"))
+		})
 	})
 
 	Context("Watch Sync Notifications", func() {
@@ -98,7 +234,7 @@ var _ = Describe("Validation Server", func() {
 
 			srv = grpc.NewServer()
 
-			v = NewValidator(context.TODO(), nil)
+			v = NewValidator(context.TODO(), nil, nil)
 
 			server := NewValidationServer()
 			server.SetValidator(v)
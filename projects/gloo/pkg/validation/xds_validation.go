@@ -0,0 +1,429 @@
+package validation
+
+import (
+	"context"
+	"strings"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	envoyhcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/gogo/protobuf/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	validationutils "github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	"github.com/solo-io/go-utils/contextutils"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+)
+
+// an XdsValidator inspects the xds snapshot generated for a proxy during validation.
+// problems with the generated config are appended to the proxy report, so they are
+// returned to the caller the same way as translation errors.
+// a returned error means the validator itself could not run.
+type XdsValidator interface {
+	ValidateSnapshot(ctx context.Context, proxy *v1.Proxy, xdsSnapshot envoycache.Snapshot, report *validation.ProxyReport) error
+}
+
+type XdsValidators []XdsValidator
+
+func (v XdsValidators) ValidateSnapshot(ctx context.Context, proxy *v1.Proxy, xdsSnapshot envoycache.Snapshot, report *validation.ProxyReport) error {
+	for _, validator := range v {
+		if err := validator.ValidateSnapshot(ctx, proxy, xdsSnapshot, report); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// builds the xds validators enabled in the given settings
+func NewXdsValidators(opts *v1.GlooOptions_XdsValidationOptions) XdsValidators {
+	var validators XdsValidators
+	if opts.GetEnableApiValidation() {
+		validators = append(validators, NewApiValidator())
+	}
+	if opts.GetEnvoyBinaryPath() != "" {
+		timeout := defaultEnvoyValidationTimeout
+		if configured, err := types.DurationFromProto(opts.GetEnvoyValidationTimeout()); err == nil && configured > 0 {
+			timeout = configured
+		}
+		validators = append(validators, NewEnvoyValidator(opts.GetEnvoyBinaryPath(), timeout))
+	}
+	return validators
+}
+
+// all envoy api types generated by protoc-gen-validate implement this
+type validatable interface {
+	Validate() error
+}
+
+type apiValidator struct{}
+
+// NewApiValidator returns an XdsValidator which checks each xds resource, and every typed filter
+// config it contains, against the constraints declared in the envoy api.
+func NewApiValidator() *apiValidator {
+	return &apiValidator{}
+}
+
+func (v *apiValidator) ValidateSnapshot(ctx context.Context, proxy *v1.Proxy, xdsSnapshot envoycache.Snapshot, report *validation.ProxyReport) error {
+	reports := newXdsReportIndex(proxy, report)
+	logger := contextutils.LoggerFrom(ctx)
+
+	for _, res := range xdsSnapshot.GetResources(xds.ListenerType).Items {
+		listener, ok := res.ResourceProto().(*envoyapi.Listener)
+		if !ok {
+			continue
+		}
+		if err := validateListener(listener); err != nil {
+			reports.appendListenerError(listener.GetName(), err)
+		}
+	}
+
+	for _, res := range xdsSnapshot.GetResources(xds.RouteType).Items {
+		routeConfig, ok := res.ResourceProto().(*envoyapi.RouteConfiguration)
+		if !ok {
+			continue
+		}
+		validateRouteConfig(routeConfig, reports)
+	}
+
+	for _, res := range xdsSnapshot.GetResources(xds.ClusterType).Items {
+		cluster, ok := res.ResourceProto().(*envoyapi.Cluster)
+		if !ok {
+			continue
+		}
+		if err := validateCluster(cluster); err != nil {
+			if !reports.appendClusterError(cluster.GetName(), err) {
+				logger.Warnf("generated cluster %v is invalid: %v", cluster.GetName(), err)
+			}
+		}
+	}
+
+	for _, res := range xdsSnapshot.GetResources(xds.EndpointType).Items {
+		endpoints, ok := res.ResourceProto().(*envoyapi.ClusterLoadAssignment)
+		if !ok {
+			continue
+		}
+		if err := endpoints.Validate(); err != nil {
+			err = eris.Wrapf(err, "invalid endpoints for cluster %v", endpoints.GetClusterName())
+			if !reports.appendClusterError(endpoints.GetClusterName(), err) {
+				logger.Warnf("generated endpoints are invalid: %v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateListener(listener *envoyapi.Listener) error {
+	if err := listener.Validate(); err != nil {
+		return eris.Wrapf(err, "invalid listener %v", listener.GetName())
+	}
+	for _, listenerFilter := range listener.GetListenerFilters() {
+		if err := validateTypedConfig(listenerFilter.GetName(), listenerFilter.GetTypedConfig()); err != nil {
+			return err
+		}
+	}
+	for _, filterChain := range listener.GetFilterChains() {
+		if err := validateTypedConfig("transport socket", filterChain.GetTransportSocket().GetTypedConfig()); err != nil {
+			return err
+		}
+		for _, filter := range filterChain.GetFilters() {
+			if err := validateTypedConfig(filter.GetName(), filter.GetTypedConfig()); err != nil {
+				return err
+			}
+			hcm, err := unpackHcm(filter.GetTypedConfig())
+			if err != nil || hcm == nil {
+				continue
+			}
+			for _, httpFilter := range hcm.GetHttpFilters() {
+				if err := validateTypedConfig(httpFilter.GetName(), httpFilter.GetTypedConfig()); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func validateRouteConfig(routeConfig *envoyapi.RouteConfiguration, reports *xdsReportIndex) {
+	// validate each level of the route config separately, so that errors are attributed
+	// to the most specific report available
+	routeConfigOnly := *routeConfig
+	routeConfigOnly.VirtualHosts = nil
+	if err := routeConfigOnly.Validate(); err != nil {
+		reports.appendRouteConfigError(routeConfig.GetName(), eris.Wrapf(err, "invalid route configuration %v", routeConfig.GetName()))
+	}
+
+	for i, virtualHost := range routeConfig.GetVirtualHosts() {
+		vhostReport, routeReports := reports.virtualHostReports(routeConfig.GetName(), i, len(virtualHost.GetRoutes()))
+		appendVhostError := func(err error) {
+			if vhostReport == nil {
+				reports.appendRouteConfigError(routeConfig.GetName(), err)
+				return
+			}
+			validationutils.AppendVirtualHostError(vhostReport, validation.VirtualHostReport_Error_ProcessingError, err.Error())
+		}
+
+		vhostOnly := *virtualHost
+		vhostOnly.Routes = nil
+		if err := vhostOnly.Validate(); err != nil {
+			appendVhostError(eris.Wrapf(err, "invalid virtual host %v", virtualHost.GetName()))
+		}
+		for name, cfg := range virtualHost.GetTypedPerFilterConfig() {
+			if err := validateTypedConfig(name, cfg); err != nil {
+				appendVhostError(err)
+			}
+		}
+
+		for j, route := range virtualHost.GetRoutes() {
+			var routeReport *validation.RouteReport
+			if routeReports != nil {
+				routeReport = routeReports[j]
+			}
+			for _, clusterName := range routeClusters(route) {
+				reports.addClusterReference(clusterName, routeReport, vhostReport)
+			}
+			if err := validateRoute(route); err != nil {
+				if routeReport == nil {
+					appendVhostError(err)
+					continue
+				}
+				validationutils.AppendRouteError(routeReport, validation.RouteReport_Error_ProcessingError, err.Error())
+			}
+		}
+	}
+}
+
+func validateRoute(route *envoyroute.Route) error {
+	if err := route.Validate(); err != nil {
+		return eris.Wrapf(err, "invalid route %v", route.GetName())
+	}
+	for name, cfg := range route.GetTypedPerFilterConfig() {
+		if err := validateTypedConfig(name, cfg); err != nil {
+			return err
+		}
+	}
+	for _, weightedCluster := range route.GetRoute().GetWeightedClusters().GetClusters() {
+		for name, cfg := range weightedCluster.GetTypedPerFilterConfig() {
+			if err := validateTypedConfig(name, cfg); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func validateCluster(cluster *envoyapi.Cluster) error {
+	if err := cluster.Validate(); err != nil {
+		return eris.Wrapf(err, "invalid cluster %v", cluster.GetName())
+	}
+	if err := validateTypedConfig("transport socket", cluster.GetTransportSocket().GetTypedConfig()); err != nil {
+		return err
+	}
+	for name, cfg := range cluster.GetTypedExtensionProtocolOptions() {
+		if err := validateTypedConfig(name, cfg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func routeClusters(route *envoyroute.Route) []string {
+	if cluster := route.GetRoute().GetCluster(); cluster != "" {
+		return []string{cluster}
+	}
+	var clusters []string
+	for _, weightedCluster := range route.GetRoute().GetWeightedClusters().GetClusters() {
+		clusters = append(clusters, weightedCluster.GetName())
+	}
+	return clusters
+}
+
+// validates the message packed in a typed config, if its type is known to gloo.
+// unknown types are left for envoy to validate.
+func validateTypedConfig(name string, cfg *any.Any) error {
+	if cfg == nil {
+		return nil
+	}
+	typeName, err := ptypes.AnyMessageName(cfg)
+	if err != nil {
+		return eris.Wrapf(err, "invalid typed config for %v", name)
+	}
+	if proto.MessageType(typeName) == nil {
+		return nil
+	}
+	var msg ptypes.DynamicAny
+	if err := ptypes.UnmarshalAny(cfg, &msg); err != nil {
+		return eris.Wrapf(err, "invalid typed config for %v", name)
+	}
+	if v, ok := msg.Message.(validatable); ok {
+		if err := v.Validate(); err != nil {
+			return eris.Wrapf(err, "invalid typed config for %v", name)
+		}
+	}
+	return nil
+}
+
+// returns nil if the config is not an http connection manager
+func unpackHcm(cfg *any.Any) (*envoyhcm.HttpConnectionManager, error) {
+	if cfg == nil || !ptypes.Is(cfg, &envoyhcm.HttpConnectionManager{}) {
+		return nil, nil
+	}
+	hcm := &envoyhcm.HttpConnectionManager{}
+	if err := ptypes.UnmarshalAny(cfg, hcm); err != nil {
+		return nil, err
+	}
+	return hcm, nil
+}
+
+// maps the names of generated xds resources back to the proxy report entries of the
+// gloo resources they were translated from
+type xdsReportIndex struct {
+	listeners    map[string]*validation.ListenerReport
+	routeConfigs map[string]*routeConfigReports
+	// the route reports (or, when they can't be determined, the virtual host reports)
+	// of the routes referencing each cluster
+	clusterRouteReports map[string][]*validation.RouteReport
+	clusterVhostReports map[string][]*validation.VirtualHostReport
+}
+
+type routeConfigReports struct {
+	listener       *v1.HttpListener
+	listenerReport *validation.ListenerReport
+}
+
+func newXdsReportIndex(proxy *v1.Proxy, report *validation.ProxyReport) *xdsReportIndex {
+	idx := &xdsReportIndex{
+		listeners:           map[string]*validation.ListenerReport{},
+		routeConfigs:        map[string]*routeConfigReports{},
+		clusterRouteReports: map[string][]*validation.RouteReport{},
+		clusterVhostReports: map[string][]*validation.VirtualHostReport{},
+	}
+	for i, listener := range proxy.GetListeners() {
+		if i >= len(report.GetListenerReports()) {
+			break
+		}
+		listenerReport := report.GetListenerReports()[i]
+		idx.listeners[listener.GetName()] = listenerReport
+		if httpListener := listener.GetHttpListener(); httpListener != nil {
+			idx.routeConfigs[translator.RouteConfigName(listener)] = &routeConfigReports{
+				listener:       httpListener,
+				listenerReport: listenerReport,
+			}
+		}
+	}
+	return idx
+}
+
+func (idx *xdsReportIndex) appendListenerError(listenerName string, err error) bool {
+	listenerReport, ok := idx.listeners[listenerName]
+	if !ok {
+		return false
+	}
+	validationutils.AppendListenerError(listenerReport, validation.ListenerReport_Error_ProcessingError, err.Error())
+	return true
+}
+
+// appends the error to every listener named in the given text, or to all listeners if none are
+func (idx *xdsReportIndex) appendListenerErrorByText(text string, err error) {
+	var found bool
+	for name, listenerReport := range idx.listeners {
+		if strings.Contains(text, name) {
+			validationutils.AppendListenerError(listenerReport, validation.ListenerReport_Error_ProcessingError, err.Error())
+			found = true
+		}
+	}
+	if found {
+		return
+	}
+	for _, listenerReport := range idx.listeners {
+		validationutils.AppendListenerError(listenerReport, validation.ListenerReport_Error_ProcessingError, err.Error())
+	}
+}
+
+func (idx *xdsReportIndex) appendRouteConfigError(routeConfigName string, err error) {
+	reports, ok := idx.routeConfigs[routeConfigName]
+	if !ok {
+		return
+	}
+	httpListenerReport := reports.listenerReport.GetHttpListenerReport()
+	if httpListenerReport == nil {
+		validationutils.AppendListenerError(reports.listenerReport, validation.ListenerReport_Error_ProcessingError, err.Error())
+		return
+	}
+	validationutils.AppendHTTPListenerError(httpListenerReport, validation.HttpListenerReport_Error_ProcessingError, err.Error())
+}
+
+// returns the report for the virtual host at the given index of the route config, along with
+// the report of the gloo route each of its numEnvoyRoutes envoy routes was generated from.
+// the route reports are nil if the envoy routes don't line up with the gloo routes.
+func (idx *xdsReportIndex) virtualHostReports(routeConfigName string, vhostIndex, numEnvoyRoutes int) (*validation.VirtualHostReport, []*validation.RouteReport) {
+	reports, ok := idx.routeConfigs[routeConfigName]
+	if !ok {
+		return nil, nil
+	}
+	virtualHosts := reports.listener.GetVirtualHosts()
+	vhostReports := reports.listenerReport.GetHttpListenerReport().GetVirtualHostReports()
+	if vhostIndex >= len(virtualHosts) || vhostIndex >= len(vhostReports) {
+		return nil, nil
+	}
+	vhostReport := vhostReports[vhostIndex]
+
+	// the translator generates one envoy route per matcher (or a single route if there are none)
+	var routeReports []*validation.RouteReport
+	for i, route := range virtualHosts[vhostIndex].GetRoutes() {
+		if i >= len(vhostReport.GetRouteReports()) {
+			return vhostReport, nil
+		}
+		numMatchers := len(route.GetMatchers())
+		if numMatchers == 0 {
+			numMatchers = 1
+		}
+		for j := 0; j < numMatchers; j++ {
+			routeReports = append(routeReports, vhostReport.GetRouteReports()[i])
+		}
+	}
+	if len(routeReports) != numEnvoyRoutes {
+		return vhostReport, nil
+	}
+	return vhostReport, routeReports
+}
+
+func (idx *xdsReportIndex) addClusterReference(clusterName string, routeReport *validation.RouteReport, vhostReport *validation.VirtualHostReport) {
+	// routes with several matchers reference the same cluster through several envoy routes
+	if routeReport != nil {
+		for _, existing := range idx.clusterRouteReports[clusterName] {
+			if existing == routeReport {
+				return
+			}
+		}
+		idx.clusterRouteReports[clusterName] = append(idx.clusterRouteReports[clusterName], routeReport)
+		return
+	}
+	if vhostReport != nil {
+		for _, existing := range idx.clusterVhostReports[clusterName] {
+			if existing == vhostReport {
+				return
+			}
+		}
+		idx.clusterVhostReports[clusterName] = append(idx.clusterVhostReports[clusterName], vhostReport)
+	}
+}
+
+// appends the error to the reports of the routes referencing the cluster.
+// returns false if the cluster is not referenced by any route of the proxy.
+func (idx *xdsReportIndex) appendClusterError(clusterName string, err error) bool {
+	routeReports, vhostReports := idx.clusterRouteReports[clusterName], idx.clusterVhostReports[clusterName]
+	for _, routeReport := range routeReports {
+		validationutils.AppendRouteError(routeReport, validation.RouteReport_Error_ProcessingError, err.Error())
+	}
+	for _, vhostReport := range vhostReports {
+		validationutils.AppendVirtualHostError(vhostReport, validation.VirtualHostReport_Error_ProcessingError, err.Error())
+	}
+	return len(routeReports)+len(vhostReports) > 0
+}
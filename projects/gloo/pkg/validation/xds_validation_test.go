@@ -0,0 +1,247 @@
+package validation_test
+
+import (
+	"context"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoyendpoint "github.com/envoyproxy/go-control-plane/envoy/api/v2/endpoint"
+	envoylistener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyhcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	validationgrpc "github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/validation"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Xds Validation", func() {
+
+	const (
+		listenerName    = "listener-::-8080"
+		routeConfigName = listenerName + "-routes"
+		clusterName     = "us_default"
+	)
+
+	var (
+		proxy  *v1.Proxy
+		report *validationgrpc.ProxyReport
+	)
+
+	BeforeEach(func() {
+		// the second route has two matchers, so it is translated to the second and third envoy routes
+		proxy = &v1.Proxy{
+			Metadata: core.Metadata{Name: "proxy", Namespace: "default"},
+			Listeners: []*v1.Listener{{
+				Name:     listenerName,
+				BindPort: 8080,
+				ListenerType: &v1.Listener_HttpListener{
+					HttpListener: &v1.HttpListener{
+						VirtualHosts: []*v1.VirtualHost{{
+							Name:    "vhost",
+							Domains: []string{"*"},
+							Routes: []*v1.Route{
+								{Matchers: []*matchers.Matcher{{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/a"}}}},
+								{Matchers: []*matchers.Matcher{
+									{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/b"}},
+									{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/c"}},
+								}},
+							},
+						}},
+					},
+				},
+			}},
+		}
+		report = validation.MakeReport(proxy)
+	})
+
+	envoyRoute := func(prefix, cluster string) *envoyroute.Route {
+		return &envoyroute.Route{
+			Match: &envoyroute.RouteMatch{PathSpecifier: &envoyroute.RouteMatch_Prefix{Prefix: prefix}},
+			Action: &envoyroute.Route_Route{Route: &envoyroute.RouteAction{
+				ClusterSpecifier: &envoyroute.RouteAction_Cluster{Cluster: cluster},
+			}},
+		}
+	}
+
+	makeSnapshot := func(routes []*envoyroute.Route, cluster *envoyapi.Cluster, endpoints *envoyapi.ClusterLoadAssignment) envoycache.Snapshot {
+		hcm, err := ptypes.MarshalAny(&envoyhcm.HttpConnectionManager{
+			StatPrefix: "http",
+			RouteSpecifier: &envoyhcm.HttpConnectionManager_Rds{Rds: &envoyhcm.Rds{
+				RouteConfigName: routeConfigName,
+				ConfigSource: &envoycorev3.ConfigSource{
+					ConfigSourceSpecifier: &envoycorev3.ConfigSource_Ads{Ads: &envoycorev3.AggregatedConfigSource{}},
+				},
+			}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		listener := &envoyapi.Listener{
+			Name: listenerName,
+			Address: &envoycore.Address{Address: &envoycore.Address_SocketAddress{SocketAddress: &envoycore.SocketAddress{
+				Address:       "::",
+				PortSpecifier: &envoycore.SocketAddress_PortValue{PortValue: 8080},
+			}}},
+			FilterChains: []*envoylistener.FilterChain{{
+				Filters: []*envoylistener.Filter{{
+					Name:       wellknown.HTTPConnectionManager,
+					ConfigType: &envoylistener.Filter_TypedConfig{TypedConfig: hcm},
+				}},
+			}},
+		}
+		routeConfig := &envoyapi.RouteConfiguration{
+			Name: routeConfigName,
+			VirtualHosts: []*envoyroute.VirtualHost{{
+				Name:    "vhost",
+				Domains: []string{"*"},
+				Routes:  routes,
+			}},
+		}
+		return xds.NewSnapshot("1",
+			[]envoycache.Resource{xds.NewEnvoyResource(endpoints)},
+			[]envoycache.Resource{xds.NewEnvoyResource(cluster)},
+			[]envoycache.Resource{xds.NewEnvoyResource(routeConfig)},
+			[]envoycache.Resource{xds.NewEnvoyResource(listener)},
+		)
+	}
+
+	makeCluster := func() *envoyapi.Cluster {
+		return &envoyapi.Cluster{
+			Name:                 clusterName,
+			ConnectTimeout:       ptypes.DurationProto(5e9),
+			ClusterDiscoveryType: &envoyapi.Cluster_Type{Type: envoyapi.Cluster_EDS},
+			EdsClusterConfig: &envoyapi.Cluster_EdsClusterConfig{
+				EdsConfig: &envoycore.ConfigSource{
+					ConfigSourceSpecifier: &envoycore.ConfigSource_Ads{Ads: &envoycore.AggregatedConfigSource{}},
+				},
+			},
+		}
+	}
+
+	makeEndpoints := func() *envoyapi.ClusterLoadAssignment {
+		return &envoyapi.ClusterLoadAssignment{
+			ClusterName: clusterName,
+			Endpoints: []*envoyendpoint.LocalityLbEndpoints{{
+				LbEndpoints: []*envoyendpoint.LbEndpoint{{
+					HostIdentifier: &envoyendpoint.LbEndpoint_Endpoint{Endpoint: &envoyendpoint.Endpoint{
+						Address: &envoycore.Address{Address: &envoycore.Address_SocketAddress{SocketAddress: &envoycore.SocketAddress{
+							Address:       "10.0.0.1",
+							PortSpecifier: &envoycore.SocketAddress_PortValue{PortValue: 80},
+						}}},
+					}},
+				}},
+			}},
+		}
+	}
+
+	validRoutes := func() []*envoyroute.Route {
+		return []*envoyroute.Route{
+			envoyRoute("/a", clusterName),
+			envoyRoute("/b", clusterName),
+			envoyRoute("/c", "other"),
+		}
+	}
+
+	Context("api validation", func() {
+
+		validate := func(snap envoycache.Snapshot) {
+			err := NewApiValidator().ValidateSnapshot(context.TODO(), proxy, snap, report)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		It("accepts valid config", func() {
+			validate(makeSnapshot(validRoutes(), makeCluster(), makeEndpoints()))
+			Expect(validation.GetProxyError(report)).NotTo(HaveOccurred())
+		})
+
+		It("attributes invalid envoy routes to the gloo route they were generated from", func() {
+			routes := validRoutes()
+			routes[2].Match.Headers = []*envoyroute.HeaderMatcher{{
+				Name:                 "bad\nheader",
+				HeaderMatchSpecifier: &envoyroute.HeaderMatcher_PresentMatch{PresentMatch: true},
+			}}
+			validate(makeSnapshot(routes, makeCluster(), makeEndpoints()))
+
+			routeReports := report.GetListenerReports()[0].GetHttpListenerReport().GetVirtualHostReports()[0].GetRouteReports()
+			Expect(routeReports[0].GetErrors()).To(BeEmpty())
+			Expect(routeReports[1].GetErrors()).To(HaveLen(1))
+			Expect(routeReports[1].GetErrors()[0].GetReason()).To(ContainSubstring("invalid route"))
+			Expect(routeReports[1].GetErrors()[0].GetReason()).To(ContainSubstring("Name"))
+		})
+
+		It("attributes invalid clusters to the routes referencing them", func() {
+			cluster := makeCluster()
+			cluster.LbPolicy = envoyapi.Cluster_LbPolicy(100)
+			validate(makeSnapshot(validRoutes(), cluster, makeEndpoints()))
+
+			routeReports := report.GetListenerReports()[0].GetHttpListenerReport().GetVirtualHostReports()[0].GetRouteReports()
+			Expect(routeReports[0].GetErrors()).To(HaveLen(1))
+			Expect(routeReports[0].GetErrors()[0].GetReason()).To(ContainSubstring("invalid cluster " + clusterName))
+			// the route only references the cluster through one of its matchers
+			Expect(routeReports[1].GetErrors()).To(HaveLen(1))
+		})
+
+		It("validates typed filter configs", func() {
+			routes := validRoutes()
+			badHcm, err := ptypes.MarshalAny(&envoyhcm.HttpConnectionManager{})
+			Expect(err).NotTo(HaveOccurred())
+			routes[0].TypedPerFilterConfig = map[string]*any.Any{"bad": badHcm}
+			validate(makeSnapshot(routes, makeCluster(), makeEndpoints()))
+
+			routeReports := report.GetListenerReports()[0].GetHttpListenerReport().GetVirtualHostReports()[0].GetRouteReports()
+			Expect(routeReports[0].GetErrors()).To(HaveLen(1))
+			Expect(routeReports[0].GetErrors()[0].GetReason()).To(ContainSubstring("invalid typed config for bad"))
+		})
+
+		It("reports errors on the virtual host when routes can't be mapped to gloo routes", func() {
+			routes := validRoutes()[:2]
+			routes[0].Match.Headers = []*envoyroute.HeaderMatcher{{
+				Name:                 "bad\nheader",
+				HeaderMatchSpecifier: &envoyroute.HeaderMatcher_PresentMatch{PresentMatch: true},
+			}}
+			validate(makeSnapshot(routes, makeCluster(), makeEndpoints()))
+
+			vhostReport := report.GetListenerReports()[0].GetHttpListenerReport().GetVirtualHostReports()[0]
+			Expect(vhostReport.GetErrors()).To(HaveLen(1))
+			Expect(vhostReport.GetRouteReports()[0].GetErrors()).To(BeEmpty())
+		})
+	})
+
+	Context("envoy validation bootstrap", func() {
+
+		It("inlines route configs and converts eds clusters to static clusters", func() {
+			bootstrap, err := BuildValidationBootstrap(makeSnapshot(validRoutes(), makeCluster(), makeEndpoints()))
+			Expect(err).NotTo(HaveOccurred())
+
+			listeners := bootstrap.GetStaticResources().GetListeners()
+			Expect(listeners).To(HaveLen(1))
+			hcm := &envoyhcm.HttpConnectionManager{}
+			err = ptypes.UnmarshalAny(listeners[0].GetFilterChains()[0].GetFilters()[0].GetTypedConfig(), hcm)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hcm.GetRds()).To(BeNil())
+			Expect(hcm.GetRouteConfig().GetName()).To(Equal(routeConfigName))
+			Expect(hcm.GetRouteConfig().GetVirtualHosts()[0].GetRoutes()).To(HaveLen(3))
+
+			var cluster *envoyapi.Cluster
+			for _, c := range bootstrap.GetStaticResources().GetClusters() {
+				if c.GetName() == clusterName {
+					cluster = c
+				}
+			}
+			Expect(cluster).NotTo(BeNil())
+			Expect(cluster.GetType()).To(Equal(envoyapi.Cluster_STATIC))
+			Expect(cluster.GetEdsClusterConfig()).To(BeNil())
+			Expect(cluster.GetLoadAssignment().GetEndpoints()).To(Equal(makeEndpoints().GetEndpoints()))
+			Expect(bootstrap.Validate()).NotTo(HaveOccurred())
+		})
+	})
+})
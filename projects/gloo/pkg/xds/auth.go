@@ -0,0 +1,338 @@
+package xds
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/contextutils"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	authv1 "k8s.io/api/authentication/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+var (
+	mXdsAuthDenied     = stats.Int64("gloo.solo.io/xds/auth_denied", "The number of xDS requests rejected by the xDS authorizer", "1")
+	deniedReasonKey, _ = tag.NewKey("reason")
+	deniedRoleKey, _   = tag.NewKey("role")
+
+	xdsAuthDeniedView = &view.View{
+		Name:        "gloo.solo.io/xds/auth_denied",
+		Measure:     mXdsAuthDenied,
+		Description: "The number of xDS requests rejected because the client is not authenticated or not bound to the requested role",
+		Aggregation: view.Count(),
+		TagKeys:     []tag.Key{deniedReasonKey, deniedRoleKey},
+	}
+)
+
+func init() {
+	_ = view.Register(xdsAuthDeniedView)
+}
+
+const (
+	deniedUnauthenticated = "unauthenticated"
+	deniedUnauthorized    = "unauthorized"
+
+	// how long the identity of a reviewed service account token is remembered
+	tokenReviewTtl = time.Minute
+)
+
+var errUnauthenticated = status.Error(codes.Unauthenticated, "xDS clients must authenticate with a client certificate or a service account token")
+
+// TokenReviewer authenticates a Kubernetes service account token, and returns the name of the user it identifies.
+type TokenReviewer func(ctx context.Context, token string) (string, error)
+
+// NewKubeTokenReviewer reviews tokens with the TokenReview API.
+func NewKubeTokenReviewer(kube kubernetes.Interface) TokenReviewer {
+	return func(ctx context.Context, token string) (string, error) {
+		review, err := kube.AuthenticationV1().TokenReviews().Create(&authv1.TokenReview{
+			Spec: authv1.TokenReviewSpec{Token: token},
+		})
+		if err != nil {
+			return "", eris.Wrapf(err, "reviewing service account token")
+		}
+		if !review.Status.Authenticated {
+			return "", eris.Errorf("service account token was not authenticated: %v", review.Status.Error)
+		}
+		return review.Status.User.Username, nil
+	}
+}
+
+// XdsServerOptions returns the options that serve xDS over TLS, verifying client certificates if a client CA is set.
+// It returns no options if no server certificate is set.
+func XdsServerOptions(opts *v1.GlooOptions_XdsAuthOptions) ([]grpc.ServerOption, error) {
+	if opts.GetServerCertFile() == "" && opts.GetServerKeyFile() == "" {
+		if opts.GetClientCaFile() != "" {
+			return nil, eris.New("xdsAuth.clientCaFile requires xdsAuth.serverCertFile and xdsAuth.serverKeyFile")
+		}
+		return nil, nil
+	}
+	cert, err := tls.LoadX509KeyPair(opts.GetServerCertFile(), opts.GetServerKeyFile())
+	if err != nil {
+		return nil, eris.Wrapf(err, "loading xDS server certificate")
+	}
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if opts.GetClientCaFile() != "" {
+		caPem, err := ioutil.ReadFile(opts.GetClientCaFile())
+		if err != nil {
+			return nil, eris.Wrapf(err, "reading xDS client CA")
+		}
+		tlsConfig.ClientCAs = x509.NewCertPool()
+		if !tlsConfig.ClientCAs.AppendCertsFromPEM(caPem) {
+			return nil, eris.Errorf("no PEM certificates found in xDS client CA file %v", opts.GetClientCaFile())
+		}
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+		if opts.GetServiceAccountTokens() {
+			// clients may authenticate with a token instead
+			tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+		}
+	}
+	return []grpc.ServerOption{grpc.Creds(credentials.NewTLS(tlsConfig))}, nil
+}
+
+// XdsAuthorizer rejects the xDS requests of clients that are not authenticated, or that request a role
+// (node.metadata.role) which is not bound to their identity.
+type XdsAuthorizer struct {
+	bindings     []*v1.GlooOptions_XdsAuthOptions_RoleBinding
+	reviewTokens TokenReviewer
+	hasher       *ProxyKeyHasher
+	now          func() time.Time
+
+	tokensLock sync.Mutex
+	tokens     map[string]reviewedToken
+}
+
+type reviewedToken struct {
+	identity string
+	expiry   time.Time
+}
+
+// NewXdsAuthorizer returns an authorizer for the given options. Service account tokens are only accepted if
+// opts.ServiceAccountTokens is set, in which case reviewer must not be nil.
+func NewXdsAuthorizer(opts *v1.GlooOptions_XdsAuthOptions, reviewer TokenReviewer) *XdsAuthorizer {
+	a := &XdsAuthorizer{
+		bindings: opts.GetRoleBindings(),
+		hasher:   NewNodeHasher(),
+		now:      time.Now,
+		tokens:   map[string]reviewedToken{},
+	}
+	if opts.GetServiceAccountTokens() {
+		a.reviewTokens = reviewer
+	}
+	return a
+}
+
+// Identities returns the identities the client of the call authenticated with: the URI and DNS SANs of its verified
+// certificate, and the user of its service account token.
+func (a *XdsAuthorizer) Identities(ctx context.Context) ([]string, error) {
+	var identities []string
+	if p, ok := peer.FromContext(ctx); ok {
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			identities = append(identities, CertificateIdentities(tlsInfo.State)...)
+		}
+	}
+	if a.reviewTokens == nil {
+		return identities, nil
+	}
+	token := bearerToken(ctx)
+	if token == "" {
+		return identities, nil
+	}
+	identity, err := a.reviewToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	return append(identities, identity), nil
+}
+
+// CertificateIdentities returns the URI and DNS SANs of the verified client certificate of a TLS connection.
+func CertificateIdentities(state tls.ConnectionState) []string {
+	if len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return nil
+	}
+	leaf := state.VerifiedChains[0][0]
+	var identities []string
+	for _, uri := range leaf.URIs {
+		identities = append(identities, uri.String())
+	}
+	return append(identities, leaf.DNSNames...)
+}
+
+func bearerToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	for _, value := range md.Get("authorization") {
+		if len(value) > len("bearer ") && strings.EqualFold(value[:len("bearer ")], "bearer ") {
+			return value[len("bearer "):]
+		}
+	}
+	return ""
+}
+
+func (a *XdsAuthorizer) reviewToken(ctx context.Context, token string) (string, error) {
+	a.tokensLock.Lock()
+	reviewed, ok := a.tokens[token]
+	a.tokensLock.Unlock()
+	if ok && a.now().Before(reviewed.expiry) {
+		return reviewed.identity, nil
+	}
+
+	identity, err := a.reviewTokens(ctx, token)
+	if err != nil {
+		return "", err
+	}
+	a.tokensLock.Lock()
+	defer a.tokensLock.Unlock()
+	for cached, reviewed := range a.tokens {
+		if !a.now().Before(reviewed.expiry) {
+			delete(a.tokens, cached)
+		}
+	}
+	a.tokens[token] = reviewedToken{identity: identity, expiry: a.now().Add(tokenReviewTtl)}
+	return identity, nil
+}
+
+// Authorize returns a gRPC status error unless one of the identities is bound to the role.
+func (a *XdsAuthorizer) Authorize(identities []string, role string) error {
+	if len(identities) == 0 {
+		return errUnauthenticated
+	}
+	for _, binding := range a.bindings {
+		if matchesAny(binding.GetIdentities(), identities) && matchesAny(binding.GetRoles(), []string{role}) {
+			return nil
+		}
+	}
+	return status.Errorf(codes.PermissionDenied, "%v may not request the xDS resources of role %q", strings.Join(identities, ", "), role)
+}
+
+func matchesAny(patterns, values []string) bool {
+	for _, pattern := range patterns {
+		for _, value := range values {
+			if matched, err := path.Match(pattern, value); err == nil && matched {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// authorizeRequest authenticates the client of the call unless identities were already resolved, and authorizes the
+// role of the request.
+// Requests without a node are rejected, as the role can't be determined.
+func (a *XdsAuthorizer) authorizeRequest(ctx context.Context, identities *[]string, req *v2.DiscoveryRequest) error {
+	if *identities == nil {
+		resolved, err := a.Identities(ctx)
+		if err != nil {
+			a.deny(ctx, deniedUnauthenticated, "", nil, err)
+			return status.Error(codes.Unauthenticated, err.Error())
+		}
+		*identities = resolved
+	}
+	if len(*identities) == 0 {
+		err := errUnauthenticated
+		a.deny(ctx, deniedUnauthenticated, "", nil, err)
+		return err
+	}
+	if req.GetNode() == nil {
+		return status.Error(codes.InvalidArgument, "xDS requests must contain a node")
+	}
+	role := a.hasher.ID(req.GetNode())
+	if err := a.Authorize(*identities, role); err != nil {
+		reason := deniedUnauthorized
+		if status.Code(err) == codes.Unauthenticated {
+			reason = deniedUnauthenticated
+		}
+		a.deny(ctx, reason, role, *identities, err)
+		return err
+	}
+	return nil
+}
+
+func (a *XdsAuthorizer) deny(ctx context.Context, reason, role string, identities []string, err error) {
+	var addr string
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		addr = p.Addr.String()
+	}
+	contextutils.LoggerFrom(ctx).Warnw("rejected xDS request",
+		"reason", reason, "role", role, "identities", identities, "peer", addr, "error", err.Error())
+	if ctxWithTags, tagErr := tag.New(ctx, tag.Insert(deniedReasonKey, reason), tag.Insert(deniedRoleKey, role)); tagErr == nil {
+		stats.Record(ctxWithTags, mXdsAuthDenied.M(1))
+	}
+}
+
+// StreamInterceptor authorizes every discovery request received on an xDS stream. The stream ends with the
+// authorization error if a request is rejected.
+func (a *XdsAuthorizer) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		stream := &authorizedStream{ServerStream: ss, authorizer: a}
+		err := handler(srv, stream)
+		// the xDS server ends the stream without an error when a receive fails
+		if stream.err != nil {
+			return stream.err
+		}
+		return err
+	}
+}
+
+// UnaryInterceptor authorizes the discovery requests of xDS fetches.
+func (a *XdsAuthorizer) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if discoveryRequest, ok := req.(*v2.DiscoveryRequest); ok {
+			var identities []string
+			if err := a.authorizeRequest(ctx, &identities, discoveryRequest); err != nil {
+				return nil, err
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+type authorizedStream struct {
+	grpc.ServerStream
+	authorizer *XdsAuthorizer
+
+	identities []string
+	// the role authorized on the stream. Envoy may only send its node on the first request of a stream.
+	role string
+	err  error
+}
+
+func (s *authorizedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	req, ok := m.(*v2.DiscoveryRequest)
+	if !ok || (req.GetNode() == nil && s.identities != nil) {
+		return nil
+	}
+	if s.identities != nil && s.authorizer.hasher.ID(req.GetNode()) == s.role {
+		return nil
+	}
+	if err := s.authorizer.authorizeRequest(s.Context(), &s.identities, req); err != nil {
+		s.err = err
+		return err
+	}
+	s.role = s.authorizer.hasher.ID(req.GetNode())
+	return nil
+}
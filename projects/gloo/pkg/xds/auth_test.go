@@ -0,0 +1,324 @@
+package xds_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoyv2 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v2"
+	"github.com/golang/protobuf/ptypes"
+	structpb "github.com/golang/protobuf/ptypes/struct"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	"github.com/solo-io/gloo/test/helpers"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/server"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("XdsAuthorizer", func() {
+
+	var (
+		authOptions *v1.GlooOptions_XdsAuthOptions
+		tokens      map[string]string
+		reviews     int
+	)
+
+	reviewer := func(_ context.Context, token string) (string, error) {
+		reviews++
+		if identity, ok := tokens[token]; ok {
+			return identity, nil
+		}
+		return "", eris.New("invalid token")
+	}
+
+	BeforeEach(func() {
+		authOptions = &v1.GlooOptions_XdsAuthOptions{
+			ServiceAccountTokens: true,
+			RoleBindings: []*v1.GlooOptions_XdsAuthOptions_RoleBinding{
+				{
+					Identities: []string{"system:serviceaccount:team-a:*", "spiffe://cluster.local/ns/team-a/sa/*"},
+					Roles:      []string{"team-a~*"},
+				},
+				{
+					Identities: []string{"system:serviceaccount:gloo-system:extauth"},
+					Roles:      []string{"extauth"},
+				},
+			},
+		}
+		tokens = map[string]string{
+			"token-a":       "system:serviceaccount:team-a:gateway-proxy",
+			"token-extauth": "system:serviceaccount:gloo-system:extauth",
+		}
+		reviews = 0
+	})
+
+	Context("Authorize", func() {
+
+		It("allows the roles bound to an identity", func() {
+			authorizer := xds.NewXdsAuthorizer(authOptions, reviewer)
+			Expect(authorizer.Authorize([]string{"system:serviceaccount:team-a:gateway-proxy"}, "team-a~gateway-proxy")).To(Succeed())
+			Expect(authorizer.Authorize([]string{"spiffe://cluster.local/ns/team-a/sa/gateway-proxy"}, "team-a~public")).To(Succeed())
+			Expect(authorizer.Authorize([]string{"system:serviceaccount:gloo-system:extauth"}, "extauth")).To(Succeed())
+		})
+
+		It("rejects the roles of other identities", func() {
+			authorizer := xds.NewXdsAuthorizer(authOptions, reviewer)
+			err := authorizer.Authorize([]string{"system:serviceaccount:team-b:gateway-proxy"}, "team-a~gateway-proxy")
+			Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+			err = authorizer.Authorize([]string{"system:serviceaccount:gloo-system:extauth"}, "team-a~gateway-proxy")
+			Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+			// wildcards do not match across '/'
+			err = authorizer.Authorize([]string{"spiffe://cluster.local/ns/team-a/sa/x/y"}, "team-a~gateway-proxy")
+			Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+		})
+
+		It("rejects unauthenticated clients", func() {
+			authorizer := xds.NewXdsAuthorizer(authOptions, reviewer)
+			err := authorizer.Authorize(nil, "team-a~gateway-proxy")
+			Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+		})
+	})
+
+	Context("Identities", func() {
+
+		It("reviews bearer tokens, and remembers them", func() {
+			authorizer := xds.NewXdsAuthorizer(authOptions, reviewer)
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer token-a"))
+			for i := 0; i < 2; i++ {
+				identities, err := authorizer.Identities(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(identities).To(Equal([]string{"system:serviceaccount:team-a:gateway-proxy"}))
+			}
+			Expect(reviews).To(Equal(1))
+		})
+
+		It("ignores tokens unless service account tokens are enabled", func() {
+			authOptions.ServiceAccountTokens = false
+			authorizer := xds.NewXdsAuthorizer(authOptions, reviewer)
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer token-a"))
+			identities, err := authorizer.Identities(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(identities).To(BeEmpty())
+			Expect(reviews).To(BeZero())
+		})
+
+		It("fails for invalid tokens", func() {
+			authorizer := xds.NewXdsAuthorizer(authOptions, reviewer)
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer bogus"))
+			_, err := authorizer.Identities(ctx)
+			Expect(err).To(MatchError(ContainSubstring("invalid token")))
+		})
+	})
+
+	Context("xDS server", func() {
+
+		var (
+			ctx      context.Context
+			cancel   context.CancelFunc
+			tmpDir   string
+			listener net.Listener
+		)
+
+		BeforeEach(func() {
+			ctx, cancel = context.WithCancel(context.Background())
+			var err error
+			tmpDir, err = ioutil.TempDir("", "xds-auth")
+			Expect(err).NotTo(HaveOccurred())
+			listener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			cancel()
+			os.RemoveAll(tmpDir)
+		})
+
+		serve := func() {
+			options, err := xds.XdsServerOptions(authOptions)
+			Expect(err).NotTo(HaveOccurred())
+			authorizer := xds.NewXdsAuthorizer(authOptions, reviewer)
+			grpcServer := grpc.NewServer(append(options,
+				grpc.ChainStreamInterceptor(authorizer.StreamInterceptor()),
+				grpc.ChainUnaryInterceptor(authorizer.UnaryInterceptor()),
+			)...)
+
+			snapshotCache := cache.NewSnapshotCache(true, xds.NewNodeHasher(), contextutils.LoggerFrom(ctx))
+			for _, role := range []string{"team-a~gateway-proxy", "team-b~gateway-proxy"} {
+				err := snapshotCache.SetSnapshot(role, xds.NewSnapshot("1", nil,
+					[]cache.Resource{xds.NewEnvoyResource(&v2.Cluster{Name: role})}, nil, nil))
+				Expect(err).NotTo(HaveOccurred())
+			}
+			xdsServer := server.NewServer(snapshotCache, nil)
+			envoyv2.RegisterAggregatedDiscoveryServiceServer(grpcServer, xdsServer)
+			xds.SetupEnvoyXds(grpcServer, xdsServer, snapshotCache)
+			go grpcServer.Serve(listener)
+			go func() {
+				<-ctx.Done()
+				grpcServer.Stop()
+			}()
+		}
+
+		// returns the clusters received for the role, or the status of the failed stream
+		fetchClusters := func(dialOption grpc.DialOption, token, role string) ([]string, error) {
+			conn, err := grpc.DialContext(ctx, listener.Addr().String(), dialOption)
+			Expect(err).NotTo(HaveOccurred())
+			defer conn.Close()
+
+			streamCtx, streamCancel := context.WithTimeout(ctx, 5*time.Second)
+			defer streamCancel()
+			if token != "" {
+				streamCtx = metadata.AppendToOutgoingContext(streamCtx, "authorization", "Bearer "+token)
+			}
+			stream, err := envoyv2.NewAggregatedDiscoveryServiceClient(conn).StreamAggregatedResources(streamCtx)
+			if err != nil {
+				return nil, err
+			}
+			err = stream.Send(&v2.DiscoveryRequest{
+				TypeUrl: xds.ClusterType,
+				Node: &core.Node{
+					Id: "envoy",
+					Metadata: &structpb.Struct{Fields: map[string]*structpb.Value{
+						"role": {Kind: &structpb.Value_StringValue{StringValue: role}},
+					}},
+				},
+			})
+			if err != nil {
+				return nil, err
+			}
+			resp, err := stream.Recv()
+			if err != nil {
+				return nil, err
+			}
+			var clusters []string
+			for _, resource := range resp.GetResources() {
+				var cluster v2.Cluster
+				Expect(ptypes.UnmarshalAny(resource, &cluster)).To(Succeed())
+				clusters = append(clusters, cluster.GetName())
+			}
+			return clusters, nil
+		}
+
+		It("serves the roles bound to the service account of the client", func() {
+			serve()
+
+			clusters, err := fetchClusters(grpc.WithInsecure(), "token-a", "team-a~gateway-proxy")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(clusters).To(Equal([]string{"team-a~gateway-proxy"}))
+
+			_, err = fetchClusters(grpc.WithInsecure(), "token-a", "team-b~gateway-proxy")
+			Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+
+			_, err = fetchClusters(grpc.WithInsecure(), "", "team-a~gateway-proxy")
+			Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+		})
+
+		It("rejects requests without a node", func() {
+			serve()
+			conn, err := grpc.DialContext(ctx, listener.Addr().String(), grpc.WithInsecure())
+			Expect(err).NotTo(HaveOccurred())
+			defer conn.Close()
+			withToken := metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer token-a")
+
+			// unauthenticated clients are rejected before the request is read
+			_, err = v2.NewClusterDiscoveryServiceClient(conn).FetchClusters(ctx, &v2.DiscoveryRequest{TypeUrl: xds.ClusterType})
+			Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+			_, err = v2.NewClusterDiscoveryServiceClient(conn).FetchClusters(withToken, &v2.DiscoveryRequest{TypeUrl: xds.ClusterType})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+			for _, streamCtx := range []context.Context{ctx, withToken} {
+				stream, err := envoyv2.NewAggregatedDiscoveryServiceClient(conn).StreamAggregatedResources(streamCtx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(stream.Send(&v2.DiscoveryRequest{TypeUrl: xds.ClusterType})).To(Succeed())
+				_, err = stream.Recv()
+				if streamCtx == ctx {
+					Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+				} else {
+					Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+				}
+			}
+		})
+
+		It("serves the roles bound to the client certificate", func() {
+			authOptions.ServiceAccountTokens = false
+			serverCert, serverKey := helpers.GetCerts(helpers.Params{Hosts: "127.0.0.1", IsCA: true})
+			clientCert, clientKey := clientCertificate("spiffe://cluster.local/ns/team-a/sa/gateway-proxy")
+			authOptions.ServerCertFile = writeFile(tmpDir, "server.crt", serverCert)
+			authOptions.ServerKeyFile = writeFile(tmpDir, "server.key", serverKey)
+			authOptions.ClientCaFile = writeFile(tmpDir, "ca.crt", clientCert)
+			serve()
+
+			serverCAs := x509.NewCertPool()
+			Expect(serverCAs.AppendCertsFromPEM([]byte(serverCert))).To(BeTrue())
+			keyPair, err := tls.X509KeyPair([]byte(clientCert), []byte(clientKey))
+			Expect(err).NotTo(HaveOccurred())
+			withClientCert := grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
+				RootCAs:      serverCAs,
+				Certificates: []tls.Certificate{keyPair},
+			}))
+
+			clusters, err := fetchClusters(withClientCert, "", "team-a~gateway-proxy")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(clusters).To(Equal([]string{"team-a~gateway-proxy"}))
+
+			_, err = fetchClusters(withClientCert, "", "team-b~gateway-proxy")
+			Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+
+			// the server requires a client certificate
+			withoutClientCert := grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{RootCAs: serverCAs}))
+			_, err = fetchClusters(withoutClientCert, "", "team-a~gateway-proxy")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
+
+func writeFile(dir, name, content string) string {
+	path := filepath.Join(dir, name)
+	Expect(ioutil.WriteFile(path, []byte(content), 0600)).To(Succeed())
+	return path
+}
+
+// clientCertificate returns a self-signed client certificate with the given URI SAN, and its key.
+func clientCertificate(uri string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).NotTo(HaveOccurred())
+	san, err := url.Parse(uri)
+	Expect(err).NotTo(HaveOccurred())
+	template := x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		URIs:                  []*url.URL{san},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	Expect(err).NotTo(HaveOccurred())
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	Expect(err).NotTo(HaveOccurred())
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}))
+}
@@ -23,8 +23,8 @@ func NewNodeHasher() *ProxyKeyHasher {
 }
 
 func (h *ProxyKeyHasher) ID(node *core.Node) string {
-	if node.Metadata != nil {
-		roleValue := node.Metadata.Fields["role"]
+	if node.GetMetadata() != nil {
+		roleValue := node.GetMetadata().Fields["role"]
 		if roleValue != nil {
 			return roleValue.GetStringValue()
 		}
@@ -16,6 +16,7 @@ package xds
 
 import (
 	v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	auth "github.com/envoyproxy/go-control-plane/envoy/api/v2/auth"
 	listener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
 	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/conversion"
@@ -41,6 +42,7 @@ const (
 	ClusterType  = typePrefix + "Cluster"
 	RouteType    = typePrefix + "RouteConfiguration"
 	ListenerType = typePrefix + "Listener"
+	SecretType   = typePrefix + "auth.Secret"
 )
 
 var (
@@ -50,6 +52,7 @@ var (
 		ClusterType,
 		RouteType,
 		ListenerType,
+		SecretType,
 	}
 )
 
@@ -71,6 +74,8 @@ func (e *EnvoyResource) Name() string {
 		return v.GetName()
 	case *v2.Listener:
 		return v.GetName()
+	case *auth.Secret:
+		return v.GetName()
 	default:
 		return ""
 	}
@@ -90,6 +95,8 @@ func (e *EnvoyResource) Type() string {
 		return RouteType
 	case *v2.Listener:
 		return ListenerType
+	case *auth.Secret:
+		return SecretType
 	default:
 		return ""
 	}
@@ -229,6 +236,8 @@ func GetResourceName(res cache.ResourceProto) string {
 		return v.GetName()
 	case *v2.Listener:
 		return v.GetName()
+	case *auth.Secret:
+		return v.GetName()
 	default:
 		return ""
 	}
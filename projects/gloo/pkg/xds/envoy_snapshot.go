@@ -37,6 +37,9 @@ type EnvoySnapshot struct {
 
 	// Listeners are items in the LDS response payload.
 	Listeners cache.Resources
+
+	// Secrets are items in the SDS response payload.
+	Secrets cache.Resources
 }
 
 var _ cache.Snapshot = &EnvoySnapshot{}
@@ -52,6 +55,7 @@ func NewSnapshot(version string,
 		Clusters:  cache.NewResources(version, clusters),
 		Routes:    cache.NewResources(version, routes),
 		Listeners: cache.NewResources(version, listeners),
+		Secrets:   cache.NewResources(version, nil),
 	}
 }
 
@@ -59,11 +63,21 @@ func NewSnapshotFromResources(endpoints cache.Resources,
 	clusters cache.Resources,
 	routes cache.Resources,
 	listeners cache.Resources) cache.Snapshot {
+	return NewSnapshotFromResourcesWithSecrets(endpoints, clusters, routes, listeners, cache.Resources{})
+}
+
+// NewSnapshotFromResourcesWithSecrets creates a snapshot which also serves the given secrets over SDS.
+func NewSnapshotFromResourcesWithSecrets(endpoints cache.Resources,
+	clusters cache.Resources,
+	routes cache.Resources,
+	listeners cache.Resources,
+	secrets cache.Resources) cache.Snapshot {
 	return &EnvoySnapshot{
 		Endpoints: endpoints,
 		Clusters:  clusters,
 		Routes:    routes,
 		Listeners: listeners,
+		Secrets:   secrets,
 	}
 }
 
@@ -108,6 +122,8 @@ func (s *EnvoySnapshot) GetResources(typ string) cache.Resources {
 		return s.Routes
 	case ListenerType:
 		return s.Listeners
+	case SecretType:
+		return s.Secrets
 	}
 	return cache.Resources{}
 }
@@ -135,6 +151,11 @@ func (s *EnvoySnapshot) Clone() cache.Snapshot {
 		Items:   cloneItems(s.Listeners.Items),
 	}
 
+	snapshotClone.Secrets = cache.Resources{
+		Version: s.Secrets.Version,
+		Items:   cloneItems(s.Secrets.Items),
+	}
+
 	return snapshotClone
 }
 
@@ -8,12 +8,20 @@ import (
 	uds "github.com/solo-io/gloo/projects/discovery/pkg/uds/setup"
 	gatewaysetup "github.com/solo-io/gloo/projects/gateway/pkg/setup"
 	gloosetup "github.com/solo-io/gloo/projects/gloo/pkg/setup"
+	"github.com/solo-io/gloo/projects/hypergloo/pkg/standalone"
 	ingresssetup "github.com/solo-io/gloo/projects/ingress/pkg/setup"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/go-utils/log"
 	"github.com/solo-io/go-utils/stats"
 )
 
+var standaloneDir string
+
+func init() {
+	flag.StringVar(&standaloneDir, "standalone", "",
+		"run gloo, gateway and discovery outside of kubernetes, with the settings, config, secrets and artifacts in this directory")
+}
+
 func main() {
 	stats.ConditionallyStartStatsServer()
 	if err := run(); err != nil {
@@ -24,21 +32,42 @@ func main() {
 func run() error {
 	contextutils.LoggerFrom(context.TODO()).Infof("hypergloo!")
 	flag.Parse()
+	mains := []func(context.Context) error{
+		gloosetup.Main,
+		gatewaysetup.Main,
+		ingresssetup.Main,
+		uds.Main,
+		fdssetup.Main,
+	}
+	if standaloneDir != "" {
+		if err := initStandalone(); err != nil {
+			return err
+		}
+		// ingress requires kubernetes
+		mains = []func(context.Context) error{
+			gloosetup.Main,
+			gatewaysetup.Main,
+			uds.Main,
+			fdssetup.Main,
+		}
+	}
+
 	errs := make(chan error)
-	go func() {
-		errs <- gloosetup.Main(nil)
-	}()
-	go func() {
-		errs <- gatewaysetup.Main(nil)
-	}()
-	go func() {
-		errs <- ingresssetup.Main(nil)
-	}()
-	go func() {
-		errs <- uds.Main(nil)
-	}()
-	go func() {
-		errs <- fdssetup.Main(nil)
-	}()
+	for _, start := range mains {
+		start := start
+		go func() {
+			errs <- start(nil)
+		}()
+	}
 	return <-errs
 }
+
+// initStandalone initializes the standalone directory, and reads the settings from it
+func initStandalone() error {
+	namespace := flag.Lookup("namespace").Value.String()
+	name := flag.Lookup("name").Value.String()
+	if err := standalone.Init(standaloneDir, namespace, name); err != nil {
+		return err
+	}
+	return flag.Set("dir", standaloneDir)
+}
@@ -0,0 +1,85 @@
+package standalone
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	gatewaydefaults "github.com/solo-io/gloo/projects/gateway/pkg/defaults"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+const (
+	configDir   = "config"
+	secretDir   = "secret"
+	artifactDir = "artifact"
+
+	defaultXdsBindAddr = "0.0.0.0:9977"
+	defaultRefreshRate = time.Minute
+)
+
+// DefaultSettings returns the Settings of standalone mode: config, secrets and artifacts are read from the `config`,
+// `secret` and `artifact` subdirectories of dir, and statuses are written to status files next to the config files.
+func DefaultSettings(dir, namespace, name string) *v1.Settings {
+	directory := func(subdir string) *v1.Settings_Directory {
+		return &v1.Settings_Directory{
+			Directory:   filepath.Join(dir, subdir),
+			StatusFiles: true,
+		}
+	}
+	return &v1.Settings{
+		Metadata:           core.Metadata{Namespace: namespace, Name: name},
+		DiscoveryNamespace: namespace,
+		DevMode:            true,
+		RefreshRate:        types.DurationProto(defaultRefreshRate),
+		Gloo:               &v1.GlooOptions{XdsBindAddr: defaultXdsBindAddr},
+		ConfigSource:       &v1.Settings_DirectoryConfigSource{DirectoryConfigSource: directory(configDir)},
+		SecretSource:       &v1.Settings_DirectorySecretSource{DirectorySecretSource: directory(secretDir)},
+		ArtifactSource:     &v1.Settings_DirectoryArtifactSource{DirectoryArtifactSource: directory(artifactDir)},
+	}
+}
+
+// Init prepares dir for standalone mode: unless they already exist, it writes the default Settings to
+// `<dir>/<namespace>/<name>.yaml` (where setup reads them from) and the default gateways to the config directory.
+func Init(dir, namespace, name string) error {
+	settingsClient, err := v1.NewSettingsClient(&factory.FileResourceClientFactory{RootDir: dir})
+	if err != nil {
+		return err
+	}
+	settings, err := settingsClient.Read(namespace, name, clients.ReadOpts{})
+	if errors.IsNotExist(err) {
+		settings, err = settingsClient.Write(DefaultSettings(dir, namespace, name), clients.WriteOpts{})
+	}
+	if err != nil {
+		return errors.Wrapf(err, "initializing standalone settings")
+	}
+
+	configSource := settings.GetDirectoryConfigSource()
+	if configSource == nil {
+		// config is not read from a directory, leave it alone
+		return nil
+	}
+	gatewaysDir := filepath.Join(configSource.GetDirectory(), gatewayv1.GatewayCrd.Plural)
+	if _, err := os.Stat(gatewaysDir); !os.IsNotExist(err) {
+		return err
+	}
+	gatewayClient, err := gatewayv1.NewGatewayClient(&factory.FileResourceClientFactory{RootDir: gatewaysDir})
+	if err != nil {
+		return err
+	}
+	for _, gateway := range []*gatewayv1.Gateway{
+		gatewaydefaults.DefaultGateway(namespace),
+		gatewaydefaults.DefaultSslGateway(namespace),
+	} {
+		if _, err := gatewayClient.Write(gateway, clients.WriteOpts{}); err != nil {
+			return errors.Wrapf(err, "writing default gateway %v", gateway.GetMetadata().Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package standalone_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/hypergloo/pkg/standalone"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+)
+
+var _ = Describe("Init", func() {
+
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "standalone")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		_ = os.RemoveAll(dir)
+	})
+
+	It("writes the default settings and gateways", func() {
+		Expect(standalone.Init(dir, "gloo-system", "default")).NotTo(HaveOccurred())
+
+		settingsClient, err := v1.NewSettingsClient(&factory.FileResourceClientFactory{RootDir: dir})
+		Expect(err).NotTo(HaveOccurred())
+		settings, err := settingsClient.Read("gloo-system", "default", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(settings.GetDirectoryConfigSource().GetDirectory()).To(Equal(filepath.Join(dir, "config")))
+		Expect(settings.GetDirectoryConfigSource().GetStatusFiles()).To(BeTrue())
+
+		Expect(filepath.Join(dir, "config", "gateways", "gloo-system", "gateway-proxy.yaml")).To(BeAnExistingFile())
+		Expect(filepath.Join(dir, "config", "gateways", "gloo-system", "gateway-proxy-ssl.yaml")).To(BeAnExistingFile())
+	})
+
+	It("keeps existing settings and gateways", func() {
+		Expect(standalone.Init(dir, "gloo-system", "default")).NotTo(HaveOccurred())
+		gateway := filepath.Join(dir, "config", "gateways", "gloo-system", "gateway-proxy.yaml")
+		Expect(os.Remove(gateway)).NotTo(HaveOccurred())
+
+		Expect(standalone.Init(dir, "gloo-system", "default")).NotTo(HaveOccurred())
+		Expect(gateway).NotTo(BeAnExistingFile())
+	})
+})
@@ -61,6 +61,7 @@ func Setup(ctx context.Context, kubeCache kube.SharedCache, inMemoryCache memory
 		kubeCache,
 		&cfg,
 		nil, // no consul client for ingress controller
+		nil, // no etcd client for ingress controller
 	)
 
 	proxyFactory, err := bootstrap.ConfigFactoryForSettings(params, gloov1.ProxyCrd)
@@ -8,12 +8,16 @@ import (
 	"github.com/solo-io/gloo/pkg/version"
 	"github.com/solo-io/gloo/projects/sds/pkg/run"
 	"github.com/solo-io/gloo/projects/sds/pkg/server"
+	"github.com/solo-io/gloo/projects/sds/pkg/sources"
 	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/go-utils/kubeutils"
+	"github.com/solo-io/go-utils/stats"
 
 	"github.com/avast/retry-go"
 	"github.com/kelseyhightower/envconfig"
 	"go.uber.org/zap"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
 )
 
 var (
@@ -37,9 +41,23 @@ type Config struct {
 	IstioCertDir           string `split_words:"true" default:"/etc/istio-certs/"`
 	IstioServerCert        string `split_words:"true" default:"istio_server_cert"`
 	IstioValidationContext string `split_words:"true" default:"istio_validation_context"`
+
+	// Serve each subdirectory of SecretDir as a secret, named after the subdirectory
+	SecretDir string `split_words:"true"`
+
+	// Serve the kubernetes.io/tls secrets matching KubeSecretsLabelSelector in KubeSecretsNamespace (all namespaces if
+	// empty), named <namespace>.<name>
+	KubeSecretsEnabled       bool   `split_words:"true"`
+	KubeSecretsNamespace     string `split_words:"true"`
+	KubeSecretsLabelSelector string `split_words:"true"`
+
+	// YAML file selecting the secrets served to each node ID. If not set, every node is served every secret.
+	NodeSecretsFile string `split_words:"true"`
 }
 
 func main() {
+	stats.ConditionallyStartStatsServer()
+
 	ctx := contextutils.WithLogger(context.Background(), "sds_server")
 	ctx = contextutils.WithLoggerValues(ctx, "version", version.Version)
 
@@ -87,9 +105,45 @@ func main() {
 
 	contextutils.LoggerFrom(ctx).Info("secrets confirmed present, proceeding to start SDS server")
 
-	if err := run.Run(ctx, secrets, c.SdsClient, c.SdsServerAddress); err != nil {
+	opts, err := serverOptions(c)
+	if err != nil {
 		contextutils.LoggerFrom(ctx).Fatal(err)
 	}
+
+	if err := run.Run(ctx, secrets, c.SdsClient, c.SdsServerAddress, opts); err != nil {
+		contextutils.LoggerFrom(ctx).Fatal(err)
+	}
+}
+
+// serverOptions configures the dynamic secret sources and the per-node secrets.
+func serverOptions(c Config) (server.Options, error) {
+	var opts server.Options
+	if c.SecretDir != "" {
+		opts.Sources = append(opts.Sources, sources.NewDirectorySource(c.SecretDir))
+	}
+	if c.KubeSecretsEnabled {
+		cfg, err := kubeutils.GetConfig("", "")
+		if err != nil {
+			return opts, err
+		}
+		kube, err := kubernetes.NewForConfig(cfg)
+		if err != nil {
+			return opts, err
+		}
+		kubeSource, err := sources.NewKubeSource(kube, c.KubeSecretsNamespace, c.KubeSecretsLabelSelector)
+		if err != nil {
+			return opts, err
+		}
+		opts.Sources = append(opts.Sources, kubeSource)
+	}
+	if c.NodeSecretsFile != "" {
+		nodeSecrets, err := server.LoadNodeSecrets(c.NodeSecretsFile)
+		if err != nil {
+			return opts, err
+		}
+		opts.NodeSecrets = nodeSecrets
+	}
+	return opts, nil
 }
 
 func setup(ctx context.Context) Config {
@@ -105,8 +159,9 @@ func setup(ctx context.Context) Config {
 	}
 
 	// At least one must be enabled, otherwise we have nothing to do.
-	if !c.GlooMtlsSdsEnabled && !c.IstioMtlsSdsEnabled {
-		err := fmt.Errorf("at least one of Istio Cert rotation or Gloo Cert rotation must be enabled, using env vars GLOO_MTLS_SDS_ENABLED or ISTIO_MTLS_SDS_ENABLED")
+	if !c.GlooMtlsSdsEnabled && !c.IstioMtlsSdsEnabled && c.SecretDir == "" && !c.KubeSecretsEnabled {
+		err := fmt.Errorf("at least one of Istio Cert rotation, Gloo Cert rotation, a secret directory or Kubernetes secrets must be enabled, " +
+			"using env vars GLOO_MTLS_SDS_ENABLED, ISTIO_MTLS_SDS_ENABLED, SECRET_DIR or KUBE_SECRETS_ENABLED")
 		contextutils.LoggerFrom(ctx).Fatal(err)
 	}
 	return c
@@ -14,11 +14,11 @@ import (
 	"github.com/solo-io/go-utils/contextutils"
 )
 
-func Run(ctx context.Context, secrets []server.Secret, sdsClient, sdsServerAddress string) error {
+func Run(ctx context.Context, secrets []server.Secret, sdsClient, sdsServerAddress string, opts server.Options) error {
 	ctx, cancel := context.WithCancel(ctx)
 
 	// Set up the gRPC server
-	sdsServer := server.SetupEnvoySDS(secrets, sdsClient, sdsServerAddress)
+	sdsServer := server.SetupEnvoySDS(secrets, sdsClient, sdsServerAddress, opts)
 	// Run the gRPC Server
 	serverStopped, err := sdsServer.Run(ctx) // runs the grpc server in internal goroutines
 	if err != nil {
@@ -33,6 +33,18 @@ func Run(ctx context.Context, secrets []server.Secret, sdsClient, sdsServerAddre
 		return err
 	}
 
+	// Watch the dynamic secret sources
+	for _, source := range opts.Sources {
+		if err := source.Watch(ctx, func() {
+			if err := sdsServer.UpdateSDSConfig(ctx); err != nil {
+				contextutils.LoggerFrom(ctx).Warnw("failed to update SDS config", zap.Error(err))
+			}
+		}); err != nil {
+			cancel()
+			return err
+		}
+	}
+
 	// create a new file watcher
 	watcher, err := fsnotify.NewWatcher()
 	if err != nil {
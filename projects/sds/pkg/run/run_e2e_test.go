@@ -73,7 +73,7 @@ var _ = Describe("SDS Server E2E Test", func() {
 	It("runs and stops correctly", func() {
 		ctx, cancel := context.WithCancel(context.Background())
 		go func() {
-			if err := run.Run(ctx, []server.Secret{secret}, sdsClient, testServerAddress); err != nil {
+			if err := run.Run(ctx, []server.Secret{secret}, sdsClient, testServerAddress, server.Options{}); err != nil {
 				Expect(err).To(BeNil())
 			}
 		}()
@@ -104,7 +104,7 @@ var _ = Describe("SDS Server E2E Test", func() {
 
 	It("correctly picks up multiple cert rotations", func() {
 
-		go run.Run(context.Background(), []server.Secret{secret}, sdsClient, testServerAddress)
+		go run.Run(context.Background(), []server.Secret{secret}, sdsClient, testServerAddress, server.Options{})
 
 		// Give it a second to spin up + read the files
 		time.Sleep(1 * time.Second)
@@ -0,0 +1,74 @@
+package server
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+
+	auth "github.com/envoyproxy/go-control-plane/envoy/api/v2/auth"
+	cache_types "github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+)
+
+var (
+	mCertificateExpiry = stats.Int64("sds.gloo.solo.io/certificate_expiry", "The expiry time of a certificate served by the SDS server, in seconds since the epoch", "s")
+	secretNameKey, _   = tag.NewKey("secret")
+	subjectKey, _      = tag.NewKey("subject")
+
+	certificateExpiryView = &view.View{
+		Name:        "sds.gloo.solo.io/certificate_expiry",
+		Measure:     mCertificateExpiry,
+		Description: "The expiry time of each certificate (including CA certificates) served by the SDS server, in seconds since the epoch",
+		Aggregation: view.LastValue(),
+		TagKeys:     []tag.Key{secretNameKey, subjectKey},
+	}
+)
+
+func init() {
+	_ = view.Register(certificateExpiryView)
+}
+
+// recordCertificateExpiry records the expiry of every certificate in the secrets.
+func recordCertificateExpiry(ctx context.Context, items []cache_types.Resource) {
+	for _, item := range items {
+		secret, ok := item.(*auth.Secret)
+		if !ok {
+			continue
+		}
+		var pemBytes []byte
+		switch typ := secret.GetType().(type) {
+		case *auth.Secret_TlsCertificate:
+			pemBytes = typ.TlsCertificate.GetCertificateChain().GetInlineBytes()
+		case *auth.Secret_ValidationContext:
+			pemBytes = typ.ValidationContext.GetTrustedCa().GetInlineBytes()
+		}
+		for _, cert := range parseCertificates(pemBytes) {
+			ctxWithTags, err := tag.New(ctx,
+				tag.Insert(secretNameKey, secret.GetName()),
+				tag.Insert(subjectKey, cert.Subject.CommonName))
+			if err != nil {
+				continue
+			}
+			stats.Record(ctxWithTags, mCertificateExpiry.M(cert.NotAfter.Unix()))
+		}
+	}
+}
+
+func parseCertificates(pemBytes []byte) []*x509.Certificate {
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, pemBytes = pem.Decode(pemBytes)
+		if block == nil {
+			return certs
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		if cert, err := x509.ParseCertificate(block.Bytes); err == nil {
+			certs = append(certs, cert)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package server
+
+import (
+	"io/ioutil"
+	"path"
+
+	"github.com/ghodss/yaml"
+	"github.com/rotisserie/eris"
+)
+
+// NodeSecrets selects the secrets served to the nodes whose ID matches Node.
+// Node and Secrets are path.Match patterns, e.g. `gateway-proxy-*.gloo-system` and `gloo-system.*`.
+type NodeSecrets struct {
+	Node    string   `json:"node"`
+	Secrets []string `json:"secrets"`
+}
+
+// LoadNodeSecrets reads a YAML list of NodeSecrets from a file.
+func LoadNodeSecrets(file string) ([]NodeSecrets, error) {
+	raw, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, eris.Wrapf(err, "reading node secrets file %v", file)
+	}
+	var nodeSecrets []NodeSecrets
+	if err := yaml.Unmarshal(raw, &nodeSecrets); err != nil {
+		return nil, eris.Wrapf(err, "parsing node secrets file %v", file)
+	}
+	for _, rule := range nodeSecrets {
+		for _, pattern := range append([]string{rule.Node}, rule.Secrets...) {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return nil, eris.Wrapf(err, "invalid pattern %q in node secrets file %v", pattern, file)
+			}
+		}
+	}
+	return nodeSecrets, nil
+}
+
+// selectSecrets returns the names of the secrets served to a node: the secrets matched by the first rule whose
+// node pattern matches the node ID, or none if no rule matches.
+// The validation context of a secret is selected along with it.
+func selectSecrets(rules []NodeSecrets, nodeID string, names []string) map[string]bool {
+	selected := map[string]bool{}
+	for _, rule := range rules {
+		if matched, _ := path.Match(rule.Node, nodeID); !matched {
+			continue
+		}
+		for _, name := range names {
+			for _, pattern := range rule.Secrets {
+				if matchesSecret(pattern, name) {
+					selected[name] = true
+					break
+				}
+			}
+		}
+		return selected
+	}
+	return selected
+}
+
+func matchesSecret(pattern, name string) bool {
+	if matched, _ := path.Match(pattern, name); matched {
+		return true
+	}
+	matched, _ := path.Match(ValidationContextName(pattern), name)
+	return matched
+}
@@ -0,0 +1,55 @@
+package server
+
+import (
+	"context"
+
+	envoy_api_v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Node secrets", func() {
+
+	var (
+		srv       *Server
+		callbacks *nodeCallbacks
+	)
+
+	BeforeEach(func() {
+		srv = SetupEnvoySDS(nil, "", "127.0.0.1:0", Options{
+			NodeSecrets: []NodeSecrets{{Node: "*", Secrets: []string{"*"}}},
+		})
+		callbacks = &nodeCallbacks{server: srv}
+	})
+
+	request := func(node string) *envoy_api_v2.DiscoveryRequest {
+		return &envoy_api_v2.DiscoveryRequest{Node: &core.Node{Id: node}}
+	}
+
+	It("forgets a node once its last stream is closed", func() {
+		Expect(callbacks.OnStreamRequest(1, request("gateway-proxy-1"))).NotTo(HaveOccurred())
+		Expect(callbacks.OnStreamRequest(1, request("gateway-proxy-1"))).NotTo(HaveOccurred())
+		Expect(callbacks.OnStreamRequest(2, request("gateway-proxy-1"))).NotTo(HaveOccurred())
+		Expect(srv.nodes).To(Equal(map[string]int{"gateway-proxy-1": 2}))
+
+		callbacks.OnStreamClosed(1)
+		Expect(srv.nodes).To(Equal(map[string]int{"gateway-proxy-1": 1}))
+		_, err := srv.snapshotCache.GetSnapshot("gateway-proxy-1")
+		Expect(err).NotTo(HaveOccurred())
+
+		callbacks.OnStreamClosed(2)
+		Expect(srv.nodes).To(BeEmpty())
+		Expect(srv.streamNodes).To(BeEmpty())
+		_, err = srv.snapshotCache.GetSnapshot("gateway-proxy-1")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("doesn't keep the nodes of fetch requests", func() {
+		Expect(callbacks.OnFetchRequest(context.TODO(), request("gateway-proxy-1"))).NotTo(HaveOccurred())
+		Expect(srv.nodes).To(BeEmpty())
+		_, err := srv.snapshotCache.GetSnapshot("gateway-proxy-1")
+		Expect(err).NotTo(HaveOccurred())
+	})
+})
@@ -7,6 +7,8 @@ import (
 	"hash/fnv"
 	"io/ioutil"
 	"net"
+	"sort"
+	"sync"
 
 	"github.com/avast/retry-go"
 	"github.com/solo-io/go-utils/contextutils"
@@ -15,6 +17,7 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 
+	envoy_api_v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
 	auth "github.com/envoyproxy/go-control-plane/envoy/api/v2/auth"
 	core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
 	sds "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v2"
@@ -36,33 +39,62 @@ type Secret struct {
 	ValidationContext string // name of the validation_context_sds_secret_config
 }
 
+// Options configure how the server discovers secrets and which secrets it serves to each node, in addition to
+// its static secrets.
+type Options struct {
+	// Sources discover secrets dynamically.
+	Sources []SecretSource
+	// NodeSecrets restrict the secrets served to each node, keyed by node ID. If empty, every node is served every
+	// secret.
+	NodeSecrets []NodeSecrets
+}
+
 // Server is the SDS server. Holds config & secrets.
 type Server struct {
 	secrets       []Secret
+	sources       []SecretSource
+	nodeSecrets   []NodeSecrets
 	sdsClient     string
 	grpcServer    *grpc.Server
 	address       string
 	snapshotCache cache.SnapshotCache
+
+	// serializes updates, so that an older update can't overwrite a newer one
+	updateLock sync.Mutex
+	lock       sync.Mutex
+	// all secrets, as of the last update
+	items []cache_types.Resource
+	// the number of open streams of each node, if secrets are served per node
+	nodes map[string]int
+	// the node of each open stream
+	streamNodes map[int64]string
 }
 
 // ID needed for snapshotCache
-func (s *Server) ID(_ *core.Node) string {
-	return s.sdsClient
+func (s *Server) ID(node *core.Node) string {
+	if len(s.nodeSecrets) == 0 {
+		return s.sdsClient
+	}
+	return node.GetId()
 }
 
 // SetupEnvoySDS creates a new SDSServer. The returned server can be started with Run()
-func SetupEnvoySDS(secrets []Secret, sdsClient, serverAddress string) *Server {
+func SetupEnvoySDS(secrets []Secret, sdsClient, serverAddress string, opts Options) *Server {
 	grpcServer := grpc.NewServer(grpcOptions...)
 	sdsServer := &Server{
-		secrets:    secrets,
-		grpcServer: grpcServer,
-		sdsClient:  sdsClient,
-		address:    serverAddress,
+		secrets:     secrets,
+		sources:     opts.Sources,
+		nodeSecrets: opts.NodeSecrets,
+		grpcServer:  grpcServer,
+		sdsClient:   sdsClient,
+		address:     serverAddress,
+		nodes:       map[string]int{},
+		streamNodes: map[int64]string{},
 	}
 	snapshotCache := cache.NewSnapshotCache(false, sdsServer, nil)
 	sdsServer.snapshotCache = snapshotCache
 
-	svr := server.NewServer(context.Background(), snapshotCache, nil)
+	svr := server.NewServer(context.Background(), snapshotCache, &nodeCallbacks{server: sdsServer})
 
 	// register services
 	sds.RegisterSecretDiscoveryServiceServer(grpcServer, svr)
@@ -93,6 +125,9 @@ func (s *Server) Run(ctx context.Context) (<-chan struct{}, error) {
 
 // UpdateSDSConfig updates with the current certs
 func (s *Server) UpdateSDSConfig(ctx context.Context) error {
+	s.updateLock.Lock()
+	defer s.updateLock.Unlock()
+
 	var certs [][]byte
 	var items []cache_types.Resource
 	for _, sec := range s.secrets {
@@ -114,17 +149,141 @@ func (s *Server) UpdateSDSConfig(ctx context.Context) error {
 		items = append(items, serverCertSecret(key, certChain, sec.ServerCert))
 		items = append(items, validationContextSecret(ca, sec.ValidationContext))
 	}
+	var sourceItems []cache_types.Resource
+	for _, source := range s.sources {
+		sourceSecrets, err := source.Secrets(ctx)
+		if err != nil {
+			return err
+		}
+		for _, sec := range sourceSecrets {
+			sourceItems = append(sourceItems, sec.resources()...)
+		}
+	}
+	sort.SliceStable(sourceItems, func(i, j int) bool {
+		return secretName(sourceItems[i]) < secretName(sourceItems[j])
+	})
+	for _, item := range sourceItems {
+		certs = append(certs, secretBytes(item)...)
+	}
+	items = append(items, sourceItems...)
+	recordCertificateExpiry(ctx, items)
 
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.items = items
+	if len(s.nodeSecrets) == 0 {
+		return s.setSnapshot(ctx, s.sdsClient, items, certs)
+	}
+	for node := range s.nodes {
+		if err := s.setNodeSnapshot(ctx, node); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setNodeSnapshot serves the secrets selected for the node. Must be called with the lock held.
+func (s *Server) setNodeSnapshot(ctx context.Context, node string) error {
+	names := make([]string, 0, len(s.items))
+	for _, item := range s.items {
+		names = append(names, secretName(item))
+	}
+	selected := selectSecrets(s.nodeSecrets, node, names)
+	var certs [][]byte
+	var items []cache_types.Resource
+	for _, item := range s.items {
+		if selected[secretName(item)] {
+			certs = append(certs, secretBytes(item)...)
+			items = append(items, item)
+		}
+	}
+	return s.setSnapshot(ctx, node, items, certs)
+}
+
+func (s *Server) setSnapshot(ctx context.Context, node string, items []cache_types.Resource, certs [][]byte) error {
 	snapshotVersion, err := GetSnapshotVersion(certs)
 	if err != nil {
 		contextutils.LoggerFrom(ctx).Info("Error getting snapshot version", zap.Error(err))
 		return err
 	}
-	contextutils.LoggerFrom(ctx).Infof("Updating SDS config. sdsClient is %s. Snapshot version is %s", s.sdsClient, snapshotVersion)
+	contextutils.LoggerFrom(ctx).Infof("Updating SDS config. sdsClient is %s. Snapshot version is %s", node, snapshotVersion)
 
 	secretSnapshot := cache.Snapshot{}
 	secretSnapshot.Resources[cache_types.Secret] = cache.NewResources(snapshotVersion, items)
-	return s.snapshotCache.SetSnapshot(s.sdsClient, secretSnapshot)
+	return s.snapshotCache.SetSnapshot(node, secretSnapshot)
+}
+
+// nodeCallbacks serves the secrets of a node once it first requests them, if secrets are served per node.
+// The secrets of a node are updated until its last stream is closed.
+type nodeCallbacks struct {
+	server *Server
+}
+
+func (c *nodeCallbacks) OnStreamOpen(context.Context, int64, string) error {
+	return nil
+}
+
+func (c *nodeCallbacks) OnStreamClosed(streamID int64) {
+	c.removeStream(streamID)
+}
+
+func (c *nodeCallbacks) OnStreamRequest(streamID int64, req *envoy_api_v2.DiscoveryRequest) error {
+	return c.addStream(context.Background(), streamID, req.GetNode())
+}
+
+func (c *nodeCallbacks) OnStreamResponse(int64, *envoy_api_v2.DiscoveryRequest, *envoy_api_v2.DiscoveryResponse) {
+}
+
+func (c *nodeCallbacks) OnFetchRequest(ctx context.Context, req *envoy_api_v2.DiscoveryRequest) error {
+	s := c.server
+	if len(s.nodeSecrets) == 0 {
+		return nil
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.nodes[req.GetNode().GetId()] > 0 {
+		return nil
+	}
+	// fetches have no stream to tell us when the node is gone, so their node isn't kept up to date
+	return s.setNodeSnapshot(ctx, req.GetNode().GetId())
+}
+
+func (c *nodeCallbacks) OnFetchResponse(*envoy_api_v2.DiscoveryRequest, *envoy_api_v2.DiscoveryResponse) {
+}
+
+func (c *nodeCallbacks) addStream(ctx context.Context, streamID int64, node *core.Node) error {
+	s := c.server
+	if len(s.nodeSecrets) == 0 {
+		return nil
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, ok := s.streamNodes[streamID]; ok {
+		return nil
+	}
+	s.streamNodes[streamID] = node.GetId()
+	s.nodes[node.GetId()]++
+	if s.nodes[node.GetId()] > 1 {
+		return nil
+	}
+	return s.setNodeSnapshot(ctx, node.GetId())
+}
+
+func (c *nodeCallbacks) removeStream(streamID int64) {
+	s := c.server
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	node, ok := s.streamNodes[streamID]
+	if !ok {
+		return
+	}
+	delete(s.streamNodes, streamID)
+	s.nodes[node]--
+	if s.nodes[node] > 0 {
+		return
+	}
+	delete(s.nodes, node)
+	s.snapshotCache.ClearSnapshot(node)
 }
 
 // GetSnapshotVersion generates a version string by hashing the certs
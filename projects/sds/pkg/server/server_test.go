@@ -54,7 +54,7 @@ var _ = Describe("SDS Server", func() {
 				ValidationContext: "test-validation",
 			},
 		}
-		srv = server.SetupEnvoySDS(secrets, sdsClient, serverAddr)
+		srv = server.SetupEnvoySDS(secrets, sdsClient, serverAddr, server.Options{})
 	})
 
 	AfterEach(func() {
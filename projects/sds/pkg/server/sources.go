@@ -0,0 +1,69 @@
+package server
+
+import (
+	"context"
+
+	auth "github.com/envoyproxy/go-control-plane/envoy/api/v2/auth"
+	core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	cache_types "github.com/envoyproxy/go-control-plane/pkg/cache/types"
+)
+
+// SourceSecret is a TLS secret discovered by a SecretSource.
+// It is served as a tls_certificate secret named Name if it has a certificate chain and a private key,
+// and as a validation_context secret named ValidationContextName(Name) if it has a root CA.
+type SourceSecret struct {
+	Name       string
+	CertChain  []byte
+	PrivateKey []byte
+	RootCa     []byte
+}
+
+// SecretSource discovers secrets dynamically, e.g. from a directory tree or from Kubernetes.
+type SecretSource interface {
+	// Secrets returns the secrets currently available from the source. Secrets which can't be read are
+	// skipped (and logged) rather than failing the whole source.
+	Secrets(ctx context.Context) ([]SourceSecret, error)
+	// Watch calls notify whenever the secrets of the source may have changed, until ctx is done.
+	Watch(ctx context.Context, notify func()) error
+}
+
+// ValidationContextName is the name of the validation_context secret serving the root CA of a SourceSecret.
+func ValidationContextName(name string) string {
+	return name + "~ca"
+}
+
+func (s SourceSecret) resources() []cache_types.Resource {
+	var items []cache_types.Resource
+	if len(s.CertChain) > 0 && len(s.PrivateKey) > 0 {
+		items = append(items, serverCertSecret(s.PrivateKey, s.CertChain, s.Name))
+	}
+	if len(s.RootCa) > 0 {
+		items = append(items, validationContextSecret(s.RootCa, ValidationContextName(s.Name)))
+	}
+	return items
+}
+
+func secretName(resource cache_types.Resource) string {
+	if secret, ok := resource.(*auth.Secret); ok {
+		return secret.GetName()
+	}
+	return ""
+}
+
+// secretBytes returns the certificates and keys of a secret resource, to version snapshots.
+func secretBytes(resource cache_types.Resource) [][]byte {
+	secret, ok := resource.(*auth.Secret)
+	if !ok {
+		return nil
+	}
+	inline := func(ds *core.DataSource) []byte {
+		return ds.GetInlineBytes()
+	}
+	switch typ := secret.GetType().(type) {
+	case *auth.Secret_TlsCertificate:
+		return [][]byte{[]byte(secret.GetName()), inline(typ.TlsCertificate.GetCertificateChain()), inline(typ.TlsCertificate.GetPrivateKey())}
+	case *auth.Secret_ValidationContext:
+		return [][]byte{[]byte(secret.GetName()), inline(typ.ValidationContext.GetTrustedCa())}
+	}
+	return nil
+}
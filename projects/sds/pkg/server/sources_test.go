@@ -0,0 +1,98 @@
+package server_test
+
+import (
+	"context"
+	"time"
+
+	envoy_api_v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	auth "github.com/envoyproxy/go-control-plane/envoy/api/v2/auth"
+	core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoy_service_discovery_v2 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v2"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/solo-io/gloo/projects/sds/pkg/server"
+	"github.com/solo-io/gloo/projects/sds/pkg/testutils"
+	"google.golang.org/grpc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type staticSource []server.SourceSecret
+
+func (s staticSource) Secrets(context.Context) ([]server.SourceSecret, error) {
+	return s, nil
+}
+
+func (s staticSource) Watch(context.Context, func()) error {
+	return nil
+}
+
+var _ = Describe("SDS Server secret sources", func() {
+
+	var (
+		serverAddr = "127.0.0.1:8889"
+		ctx        context.Context
+		cancel     context.CancelFunc
+		srv        *server.Server
+		conn       *grpc.ClientConn
+		client     envoy_service_discovery_v2.SecretDiscoveryServiceClient
+	)
+
+	BeforeEach(func() {
+		cert, key, err := testutils.SelfSignedCert("gateway", time.Now().Add(time.Hour))
+		Expect(err).NotTo(HaveOccurred())
+		source := staticSource{
+			{Name: "gloo-system.gateway", CertChain: cert, PrivateKey: key, RootCa: cert},
+			{Name: "team-a.app", CertChain: cert, PrivateKey: key},
+		}
+		srv = server.SetupEnvoySDS(nil, "", serverAddr, server.Options{
+			Sources: []server.SecretSource{source},
+			NodeSecrets: []server.NodeSecrets{
+				{Node: "gateway-proxy*", Secrets: []string{"gloo-system.*"}},
+				{Node: "*", Secrets: []string{"team-a.*"}},
+			},
+		})
+
+		ctx, cancel = context.WithCancel(context.Background())
+		_, err = srv.Run(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(srv.UpdateSDSConfig(ctx)).NotTo(HaveOccurred())
+
+		Eventually(func() error {
+			conn, err = grpc.Dial(serverAddr, grpc.WithInsecure())
+			return err
+		}, time.Second*5).ShouldNot(HaveOccurred())
+		client = envoy_service_discovery_v2.NewSecretDiscoveryServiceClient(conn)
+	})
+
+	AfterEach(func() {
+		_ = conn.Close()
+		cancel()
+	})
+
+	fetchSecretNames := func(node string, names ...string) []string {
+		var resp *envoy_api_v2.DiscoveryResponse
+		Eventually(func() error {
+			var err error
+			resp, err = client.FetchSecrets(ctx, &envoy_api_v2.DiscoveryRequest{
+				Node:          &core.Node{Id: node},
+				ResourceNames: names,
+			})
+			return err
+		}, time.Second*5).ShouldNot(HaveOccurred())
+		var secretNames []string
+		for _, resource := range resp.GetResources() {
+			var secret auth.Secret
+			Expect(ptypes.UnmarshalAny(resource, &secret)).NotTo(HaveOccurred())
+			secretNames = append(secretNames, secret.GetName())
+		}
+		return secretNames
+	}
+
+	It("serves each node the secrets of the first matching rule", func() {
+		Expect(fetchSecretNames("gateway-proxy-1", "gloo-system.gateway", "gloo-system.gateway~ca", "team-a.app")).
+			To(ConsistOf("gloo-system.gateway", "gloo-system.gateway~ca"))
+		Expect(fetchSecretNames("app-1", "gloo-system.gateway", "team-a.app")).
+			To(ConsistOf("team-a.app"))
+	})
+})
@@ -0,0 +1,147 @@
+package sources
+
+import (
+	"context"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/sds/pkg/server"
+	"github.com/solo-io/go-utils/contextutils"
+	"go.uber.org/zap"
+	v1 "k8s.io/api/core/v1"
+)
+
+// events closer together than this are coalesced into a single notification, as e.g. Kubernetes updates a mounted
+// secret with several writes, renames and removes
+const debounce = 100 * time.Millisecond
+
+type directorySource struct {
+	root string
+}
+
+// NewDirectorySource discovers secrets in a directory tree: each subdirectory of root is a secret named after the
+// subdirectory, with the certificate chain, private key and root CA in the `tls.crt`, `tls.key` and `ca.crt` files,
+// as in a mounted Kubernetes TLS secret.
+func NewDirectorySource(root string) server.SecretSource {
+	return &directorySource{root: root}
+}
+
+func (d *directorySource) Secrets(ctx context.Context) ([]server.SourceSecret, error) {
+	dirs, err := d.secretDirs()
+	if err != nil {
+		return nil, err
+	}
+	var secrets []server.SourceSecret
+	for _, name := range dirs {
+		secret, err := readSecretDir(name, filepath.Join(d.root, name))
+		if err != nil {
+			contextutils.LoggerFrom(ctx).Warnw("skipping secret directory", zap.String("secret", name), zap.Error(err))
+			continue
+		}
+		secrets = append(secrets, secret)
+	}
+	return secrets, nil
+}
+
+// secretDirs returns the names of the subdirectories of root, following symlinks and skipping hidden directories
+// (such as the `..data` directory of a mounted Kubernetes volume).
+func (d *directorySource) secretDirs() ([]string, error) {
+	entries, err := ioutil.ReadDir(d.root)
+	if err != nil {
+		return nil, eris.Wrapf(err, "reading secret directory %v", d.root)
+	}
+	var dirs []string
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		info, err := os.Stat(filepath.Join(d.root, entry.Name()))
+		if err != nil || !info.IsDir() {
+			continue
+		}
+		dirs = append(dirs, entry.Name())
+	}
+	return dirs, nil
+}
+
+func readSecretDir(name, dir string) (server.SourceSecret, error) {
+	secret := server.SourceSecret{Name: name}
+	for file, data := range map[string]*[]byte{
+		v1.TLSCertKey:              &secret.CertChain,
+		v1.TLSPrivateKeyKey:        &secret.PrivateKey,
+		v1.ServiceAccountRootCAKey: &secret.RootCa,
+	} {
+		raw, err := ioutil.ReadFile(filepath.Join(dir, file))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return secret, err
+		}
+		// a write may still be in progress, the watch picks up the complete file
+		if block, _ := pem.Decode(raw); block == nil {
+			return secret, eris.Errorf("%v does not contain PEM data", filepath.Join(dir, file))
+		}
+		*data = raw
+	}
+	if (len(secret.CertChain) == 0) != (len(secret.PrivateKey) == 0) {
+		return secret, eris.Errorf("secret %v must contain both or none of %v and %v", name, v1.TLSCertKey, v1.TLSPrivateKeyKey)
+	}
+	return secret, nil
+}
+
+func (d *directorySource) Watch(ctx context.Context, notify func()) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	if err := d.addWatches(watcher); err != nil {
+		watcher.Close()
+		return err
+	}
+	go func() {
+		defer watcher.Close()
+		var pending <-chan time.Time
+		for {
+			select {
+			case event := <-watcher.Events:
+				contextutils.LoggerFrom(ctx).Debugw("received event", zap.Any("event", event))
+				pending = time.After(debounce)
+			case <-pending:
+				pending = nil
+				// watch subdirectories created since the last event
+				if err := d.addWatches(watcher); err != nil {
+					contextutils.LoggerFrom(ctx).Warnw("failed to watch secret directories", zap.Error(err))
+				}
+				notify()
+			case err := <-watcher.Errors:
+				contextutils.LoggerFrom(ctx).Warnw("Received error from file watcher", zap.Error(err))
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+func (d *directorySource) addWatches(watcher *fsnotify.Watcher) error {
+	if err := watcher.Add(d.root); err != nil {
+		return eris.Wrapf(err, "watching secret directory %v", d.root)
+	}
+	dirs, err := d.secretDirs()
+	if err != nil {
+		return err
+	}
+	for _, name := range dirs {
+		if err := watcher.Add(filepath.Join(d.root, name)); err != nil {
+			return eris.Wrapf(err, "watching secret directory %v", name)
+		}
+	}
+	return nil
+}
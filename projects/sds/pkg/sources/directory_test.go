@@ -0,0 +1,76 @@
+package sources_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/solo-io/gloo/projects/sds/pkg/server"
+	"github.com/solo-io/gloo/projects/sds/pkg/sources"
+	"github.com/solo-io/gloo/projects/sds/pkg/testutils"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Directory source", func() {
+
+	var (
+		root      string
+		cert, key []byte
+		source    server.SecretSource
+		ctx       context.Context
+		cancel    context.CancelFunc
+	)
+
+	writeSecret := func(name string, files map[string][]byte) {
+		dir := filepath.Join(root, name)
+		Expect(os.MkdirAll(dir, 0755)).NotTo(HaveOccurred())
+		for file, data := range files {
+			Expect(ioutil.WriteFile(filepath.Join(dir, file), data, 0600)).NotTo(HaveOccurred())
+		}
+	}
+
+	BeforeEach(func() {
+		var err error
+		root, err = ioutil.TempDir("", "sds-secrets")
+		Expect(err).NotTo(HaveOccurred())
+		cert, key, err = testutils.SelfSignedCert("test", time.Now().Add(time.Hour))
+		Expect(err).NotTo(HaveOccurred())
+		source = sources.NewDirectorySource(root)
+		ctx, cancel = context.WithCancel(context.Background())
+	})
+
+	AfterEach(func() {
+		cancel()
+		_ = os.RemoveAll(root)
+	})
+
+	It("reads a secret from each subdirectory", func() {
+		writeSecret("server", map[string][]byte{"tls.crt": cert, "tls.key": key})
+		writeSecret("trust", map[string][]byte{"ca.crt": cert})
+		writeSecret("..data", map[string][]byte{"tls.crt": cert, "tls.key": key})
+		writeSecret("invalid", map[string][]byte{"tls.crt": []byte("not pem"), "tls.key": key})
+
+		secrets, err := source.Secrets(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(secrets).To(ConsistOf(
+			server.SourceSecret{Name: "server", CertChain: cert, PrivateKey: key},
+			server.SourceSecret{Name: "trust", RootCa: cert},
+		))
+	})
+
+	It("notifies when a secret is added", func() {
+		notified := make(chan struct{}, 10)
+		Expect(source.Watch(ctx, func() { notified <- struct{}{} })).NotTo(HaveOccurred())
+
+		writeSecret("server", map[string][]byte{"tls.crt": cert, "tls.key": key})
+		Eventually(notified, time.Second*5).Should(Receive())
+
+		secrets, err := source.Secrets(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(secrets).To(HaveLen(1))
+	})
+})
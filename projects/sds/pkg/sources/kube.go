@@ -0,0 +1,129 @@
+package sources
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/sds/pkg/server"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	kubelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+const kubeResyncPeriod = 12 * time.Hour
+
+type kubeSource struct {
+	kube          kubernetes.Interface
+	namespace     string
+	labelSelector string
+
+	lock   sync.Mutex
+	lister kubelisters.SecretLister
+}
+
+// NewKubeSource discovers the `kubernetes.io/tls` secrets matching the label selector in a namespace (all namespaces
+// if empty). Each secret is named `<namespace>.<name>`.
+func NewKubeSource(kube kubernetes.Interface, namespace, labelSelector string) (server.SecretSource, error) {
+	if _, err := labels.Parse(labelSelector); err != nil {
+		return nil, eris.Wrapf(err, "invalid label selector %q", labelSelector)
+	}
+	if namespace == "" {
+		namespace = metav1.NamespaceAll
+	}
+	return &kubeSource{kube: kube, namespace: namespace, labelSelector: labelSelector}, nil
+}
+
+func (k *kubeSource) listOptions(opts *metav1.ListOptions) {
+	opts.LabelSelector = k.labelSelector
+	opts.FieldSelector = fields.OneTermEqualSelector("type", string(v1.SecretTypeTLS)).String()
+}
+
+func (k *kubeSource) Secrets(ctx context.Context) ([]server.SourceSecret, error) {
+	k.lock.Lock()
+	lister := k.lister
+	k.lock.Unlock()
+
+	var kubeSecrets []*v1.Secret
+	if lister != nil {
+		var err error
+		kubeSecrets, err = lister.List(labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// not watching yet
+		opts := metav1.ListOptions{}
+		k.listOptions(&opts)
+		list, err := k.kube.CoreV1().Secrets(k.namespace).List(opts)
+		if err != nil {
+			return nil, eris.Wrapf(err, "listing kubernetes TLS secrets")
+		}
+		for i := range list.Items {
+			kubeSecrets = append(kubeSecrets, &list.Items[i])
+		}
+	}
+
+	var secrets []server.SourceSecret
+	for _, kubeSecret := range kubeSecrets {
+		if kubeSecret.Type != v1.SecretTypeTLS {
+			continue
+		}
+		secrets = append(secrets, server.SourceSecret{
+			Name:       kubeSecret.Namespace + "." + kubeSecret.Name,
+			CertChain:  kubeSecret.Data[v1.TLSCertKey],
+			PrivateKey: kubeSecret.Data[v1.TLSPrivateKeyKey],
+			RootCa:     kubeSecret.Data[v1.ServiceAccountRootCAKey],
+		})
+	}
+	sort.SliceStable(secrets, func(i, j int) bool {
+		return secrets[i].Name < secrets[j].Name
+	})
+	return secrets, nil
+}
+
+func (k *kubeSource) Watch(ctx context.Context, notify func()) error {
+	factory := kubeinformers.NewSharedInformerFactoryWithOptions(k.kube, kubeResyncPeriod,
+		kubeinformers.WithNamespace(k.namespace),
+		kubeinformers.WithTweakListOptions(k.listOptions))
+	secretInformer := factory.Core().V1().Secrets()
+	informer := secretInformer.Informer()
+	// the initial sync is notified once it completes
+	notifyWatching := func() {
+		k.lock.Lock()
+		watching := k.lister != nil
+		k.lock.Unlock()
+		if watching {
+			notify()
+		}
+	}
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(interface{}) { notifyWatching() },
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			// skip resyncs
+			if oldObj.(*v1.Secret).ResourceVersion != newObj.(*v1.Secret).ResourceVersion {
+				notifyWatching()
+			}
+		},
+		DeleteFunc: func(interface{}) { notifyWatching() },
+	})
+
+	go informer.Run(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), informer.HasSynced) {
+		return eris.New("failed to sync kubernetes TLS secrets")
+	}
+
+	k.lock.Lock()
+	k.lister = secretInformer.Lister()
+	k.lock.Unlock()
+	// secrets may have changed between the initial list and the watch
+	notify()
+	return nil
+}
@@ -0,0 +1,80 @@
+package sources_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/solo-io/gloo/projects/sds/pkg/server"
+	"github.com/solo-io/gloo/projects/sds/pkg/sources"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Kubernetes source", func() {
+
+	var (
+		kube   *fake.Clientset
+		ctx    context.Context
+		cancel context.CancelFunc
+	)
+
+	tlsSecret := func(name string, labels map[string]string) *v1.Secret {
+		return &v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "gloo-system", Labels: labels},
+			Type:       v1.SecretTypeTLS,
+			Data: map[string][]byte{
+				v1.TLSCertKey:       []byte("cert"),
+				v1.TLSPrivateKeyKey: []byte("key"),
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		kube = fake.NewSimpleClientset(
+			tlsSecret("gateway", map[string]string{"sds": "true"}),
+			tlsSecret("other", nil),
+		)
+		ctx, cancel = context.WithCancel(context.Background())
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("rejects an invalid label selector", func() {
+		_, err := sources.NewKubeSource(kube, "", "sds in (")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("lists the TLS secrets matching the label selector", func() {
+		source, err := sources.NewKubeSource(kube, "gloo-system", "sds=true")
+		Expect(err).NotTo(HaveOccurred())
+
+		secrets, err := source.Secrets(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(secrets).To(Equal([]server.SourceSecret{
+			{Name: "gloo-system.gateway", CertChain: []byte("cert"), PrivateKey: []byte("key")},
+		}))
+	})
+
+	It("notifies when a secret changes", func() {
+		source, err := sources.NewKubeSource(kube, "gloo-system", "sds=true")
+		Expect(err).NotTo(HaveOccurred())
+		notified := make(chan struct{}, 10)
+		Expect(source.Watch(ctx, func() { notified <- struct{}{} })).NotTo(HaveOccurred())
+		// the initial sync
+		Eventually(notified, time.Second*5).Should(Receive())
+
+		_, err = kube.CoreV1().Secrets("gloo-system").Create(tlsSecret("added", map[string]string{"sds": "true"}))
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(notified, time.Second*5).Should(Receive())
+
+		Eventually(func() ([]server.SourceSecret, error) {
+			return source.Secrets(ctx)
+		}, time.Second*5).Should(HaveLen(2))
+	})
+})
@@ -91,7 +91,7 @@ var _ = Describe("Consul + Vault Configuration Happy Path e2e", func() {
 		consulClient, err = bootstrap.ConsulClientForSettings(ctx, settings)
 		Expect(err).NotTo(HaveOccurred())
 
-		vaultClient, err = bootstrap.VaultClientForSettings(settings.GetVaultSecretSource())
+		vaultClient, err = bootstrap.VaultClientForSettings(ctx, settings.GetVaultSecretSource())
 		Expect(err).NotTo(HaveOccurred())
 
 		consulResources = &factory.ConsulResourceClientFactory{
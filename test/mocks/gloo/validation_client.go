@@ -75,3 +75,43 @@ func (mr *MockProxyValidationServiceClientMockRecorder) ValidateProxy(arg0, arg1
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateProxy", reflect.TypeOf((*MockProxyValidationServiceClient)(nil).ValidateProxy), varargs...)
 }
+
+// ValidateResource mocks base method
+func (m *MockProxyValidationServiceClient) ValidateResource(arg0 context.Context, arg1 *validation.ResourceValidationServiceRequest, arg2 ...grpc.CallOption) (*validation.ResourceValidationServiceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ValidateResource", varargs...)
+	ret0, _ := ret[0].(*validation.ResourceValidationServiceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateResource indicates an expected call of ValidateResource
+func (mr *MockProxyValidationServiceClientMockRecorder) ValidateResource(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateResource", reflect.TypeOf((*MockProxyValidationServiceClient)(nil).ValidateResource), varargs...)
+}
+
+// TranslateProxy mocks base method
+func (m *MockProxyValidationServiceClient) TranslateProxy(arg0 context.Context, arg1 *validation.ProxyTranslationServiceRequest, arg2 ...grpc.CallOption) (*validation.ProxyTranslationServiceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "TranslateProxy", varargs...)
+	ret0, _ := ret[0].(*validation.ProxyTranslationServiceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TranslateProxy indicates an expected call of TranslateProxy
+func (mr *MockProxyValidationServiceClientMockRecorder) TranslateProxy(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TranslateProxy", reflect.TypeOf((*MockProxyValidationServiceClient)(nil).TranslateProxy), varargs...)
+}